@@ -16,7 +16,12 @@ import (
 	authservice "github.com/KarpovYuri/caraudio-backend/internal/auth/app/services"
 	authconfig "github.com/KarpovYuri/caraudio-backend/internal/auth/config"
 	authdb "github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/database/postgres"
+	authmail "github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/mail"
+	authoauth "github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/oauth"
+	authutils "github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
 	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	pkgjwt "github.com/KarpovYuri/caraudio-backend/pkg/jwt"
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
@@ -49,19 +54,136 @@ func main() {
 		}
 	}()
 
+	keyFiles := make([]authutils.KeyFile, 0, len(cfg.JWTKeys))
+	for _, key := range cfg.JWTKeys {
+		keyFiles = append(keyFiles, authutils.KeyFile{KID: key.KID, PrivateKeyFile: key.PrivateKeyFile})
+	}
+	keyRing, err := authutils.LoadKeyRing(cfg.JWTSigningKeyID, keyFiles)
+	if err != nil {
+		logger.Error("failed to load jwt signing keys", "error", err)
+		os.Exit(1)
+	}
+	tokenIssuer := authutils.NewTokenIssuer(keyRing, authutils.TokenConfig{
+		Issuer:   cfg.JWTIssuer,
+		Audience: cfg.JWTAudience,
+		Leeway:   cfg.JWTLeeway,
+	})
+	jwks, err := keyRing.JWKS()
+	if err != nil {
+		logger.Error("failed to build jwks", "error", err)
+		os.Exit(1)
+	}
+
 	userRepo := authdb.NewPostgresUserRepository(db)
 	tokenRepo := authdb.NewPgRefreshTokenRepository(db)
+	revocationRepo := authdb.NewPostgresRevocationRepository(db)
+	loginThrottleRepo := authdb.NewPostgresLoginThrottleRepository(db)
+
+	mfaKey, err := cfg.MFA.Key()
+	if err != nil {
+		logger.Error("failed to load mfa encryption key", "error", err)
+		os.Exit(1)
+	}
+	mfaSecrets, err := authutils.NewSecretBox(mfaKey)
+	if err != nil {
+		logger.Error("failed to init mfa secret encryption", "error", err)
+		os.Exit(1)
+	}
+	mfaRepo := authdb.NewPostgresMFARepository(db, mfaSecrets)
+	roleRepo := authdb.NewPostgresRoleRepository(db)
 
 	authService := authservice.NewAuthService(
 		userRepo,
 		tokenRepo,
-		cfg.JWTSecret,
+		revocationRepo,
+		loginThrottleRepo,
+		mfaRepo,
+		roleRepo,
+		tokenIssuer,
+		authservice.AuthOptions{
+			MaxSessions:       cfg.MaxSessions,
+			RefreshReuseGrace: cfg.RefreshReuseGrace,
+			LoginProtection: authservice.LoginProtectionOptions{
+				MaxFailures:      cfg.LoginProtection.MaxFailures,
+				MaxFailuresPerIP: cfg.LoginProtection.MaxFailuresPerIP,
+				Window:           cfg.LoginProtection.Window,
+				Lockout:          cfg.LoginProtection.Lockout,
+				BaseDelay:        cfg.LoginProtection.BaseDelay,
+				MaxDelay:         cfg.LoginProtection.MaxDelay,
+			},
+			MFA: authservice.MFAOptions{
+				Issuer:        cfg.MFA.Issuer,
+				RequiredRoles: cfg.MFA.RequiredRoles,
+				ChallengeTTL:  cfg.MFA.ChallengeTTL,
+			},
+		},
 	)
 
-	userService := authservice.NewUserService(userRepo)
+	userService := authservice.NewUserService(userRepo, roleRepo, revocationRepo, loginThrottleRepo)
 
-	authGRPCServer := authgrpc.NewAuthGRPCServer(authService, cfg.CookieSecure)
-	userGRPCServer := authgrpc.NewUserGRPCServer(userService, authService)
+	profileRepo := authdb.NewPostgresProfileRepository(db)
+	profileService := authservice.NewProfileService(userRepo, profileRepo)
+
+	apiClientRepo := authdb.NewPostgresAPIClientRepository(db)
+	apiClientService := authservice.NewAPIClientService(apiClientRepo, revocationRepo, tokenIssuer)
+
+	mailer := newMailer(cfg.Mailer)
+
+	verificationRepo := authdb.NewPostgresEmailVerificationRepository(db)
+	registrationService := authservice.NewRegistrationService(
+		userRepo,
+		verificationRepo,
+		mailer,
+		authservice.RegistrationOptions{
+			VerificationURL: cfg.EmailVerificationURL,
+			VerificationTTL: cfg.EmailVerificationTTL,
+		},
+	)
+
+	passwordResetRepo := authdb.NewPostgresPasswordResetRepository(db)
+	passwordResetQuotaRepo := authdb.NewPostgresPasswordResetQuotaRepository(db)
+	passwordResetService := authservice.NewPasswordResetService(
+		userRepo,
+		passwordResetRepo,
+		revocationRepo,
+		passwordResetQuotaRepo,
+		mailer,
+		authservice.PasswordResetOptions{
+			ResetURL: cfg.PasswordResetURL,
+			TTL:      cfg.PasswordResetTTL,
+		},
+	)
+
+	oauthProviders, err := newOAuthProviders(cfg.OAuth)
+	if err != nil {
+		logger.Error("failed to configure oauth providers", "error", err)
+		os.Exit(1)
+	}
+	oauthRepo := authdb.NewPostgresOAuthRepository(db)
+	oauthService := authservice.NewOAuthService(
+		authService,
+		userRepo,
+		oauthRepo,
+		oauthProviders,
+		authservice.OAuthOptions{StateTTL: cfg.OAuth.StateTTL},
+	)
+
+	trustedProxies, err := authgrpc.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		logger.Error("failed to parse trusted proxies", "error", err)
+		os.Exit(1)
+	}
+	authGRPCServer := authgrpc.NewAuthGRPCServer(
+		authService,
+		registrationService,
+		passwordResetService,
+		oauthService,
+		cfg.CookieSecure,
+		trustedProxies,
+	)
+	userGRPCServer := authgrpc.NewUserGRPCServer(userService)
+	profileGRPCServer := authgrpc.NewProfileGRPCServer(profileService)
+	apiClientGRPCServer := authgrpc.NewAPIClientGRPCServer(apiClientService)
 
 	lis, err := net.Listen("tcp", cfg.GRPCPort)
 	if err != nil {
@@ -69,9 +191,14 @@ func main() {
 		os.Exit(1)
 	}
 
-	s := grpc.NewServer(grpc.UnaryInterceptor(grpcLoggingInterceptor(logger)))
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpcLoggingInterceptor(logger),
+		authz.UnaryServerInterceptor(authgrpc.NewAuthenticator(authService), authgrpc.Policy),
+	))
 	authv1.RegisterAuthServiceServer(s, authGRPCServer)
 	authv1.RegisterUserServiceServer(s, userGRPCServer)
+	authv1.RegisterProfileServiceServer(s, profileGRPCServer)
+	authv1.RegisterApiClientServiceServer(s, apiClientGRPCServer)
 
 	ctx := context.Background()
 
@@ -122,21 +249,43 @@ func main() {
 		os.Exit(1)
 	}
 
-	cleaned, err := tokenRepo.DeleteExpired(context.Background(), time.Now())
+	err = authv1.RegisterProfileServiceHandlerFromEndpoint(ctx, mux, "localhost"+cfg.GRPCPort, opts)
+	if err != nil {
+		logger.Error("failed to register profile gateway", "error", err)
+		os.Exit(1)
+	}
+
+	err = authv1.RegisterApiClientServiceHandlerFromEndpoint(ctx, mux, "localhost"+cfg.GRPCPort, opts)
 	if err != nil {
-		logger.Error("failed to run startup refresh-token cleanup", "error", err)
-	} else if cleaned > 0 {
-		logger.Info("startup refresh-token cleanup completed", "deleted_tokens", cleaned)
+		logger.Error("failed to register API client gateway", "error", err)
+		os.Exit(1)
+	}
+
+	cleanupRecords := expiringRecords{
+		refreshTokens:       tokenRepo,
+		revocations:         revocationRepo,
+		emailVerifications:  verificationRepo,
+		passwordResets:      passwordResetRepo,
+		passwordResetQuotas: passwordResetQuotaRepo,
+		loginThrottles:      loginThrottleRepo,
+		mfaChallenges:       mfaRepo,
+		oauthStates:         oauthRepo,
+		loginFailureWindow:  cfg.LoginProtection.Window,
 	}
+	cleanupTokens(context.Background(), cleanupRecords, logger)
 
 	shutdownCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
 	cleanupCtx, cleanupCancel := context.WithCancel(context.Background())
 	defer cleanupCancel()
-	go runTokenCleanupJob(cleanupCtx, tokenRepo, cfg.TokenCleanupEvery, logger)
+	go runTokenCleanupJob(cleanupCtx, cleanupRecords, cfg.TokenCleanupEvery, logger)
+
+	httpMux := http.NewServeMux()
+	httpMux.Handle("/.well-known/jwks.json", pkgjwt.JWKSHandler(jwks))
+	httpMux.Handle("/", mux)
 
-	httpHandler := allowCORS(withRequestID(withAccessLog(mux, logger), logger), cfg.AllowedOrigins)
+	httpHandler := allowCORS(withRequestID(withAccessLog(httpMux, logger), logger), cfg.AllowedOrigins)
 	httpServer := &http.Server{
 		Addr:         cfg.HTTPPort,
 		Handler:      httpHandler,
@@ -190,11 +339,27 @@ func main() {
 		logger.Warn("gRPC graceful shutdown timeout reached, forcing stop")
 		s.Stop()
 	}
+
+	passwordResetService.Wait()
+}
+
+// expiringRecords groups the tables whose rows outlive their usefulness and
+// are purged by the cleanup job.
+type expiringRecords struct {
+	refreshTokens       authdb.RefreshTokenRepository
+	revocations         authdb.RevocationRepository
+	emailVerifications  authdb.EmailVerificationRepository
+	passwordResets      authdb.PasswordResetRepository
+	passwordResetQuotas authdb.PasswordResetQuotaRepository
+	loginThrottles      authdb.LoginThrottleRepository
+	mfaChallenges       authdb.MFARepository
+	oauthStates         authdb.OAuthRepository
+	loginFailureWindow  time.Duration
 }
 
 func runTokenCleanupJob(
 	ctx context.Context,
-	tokenRepo authdb.RefreshTokenRepository,
+	records expiringRecords,
 	interval time.Duration,
 	logger *slog.Logger,
 ) {
@@ -206,16 +371,109 @@ func runTokenCleanupJob(
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			deleted, err := tokenRepo.DeleteExpired(context.Background(), time.Now())
-			if err != nil {
-				logger.Error("periodic refresh-token cleanup failed", "error", err)
-				continue
-			}
-			if deleted > 0 {
-				logger.Info("periodic refresh-token cleanup completed", "deleted_tokens", deleted)
-			}
+			cleanupTokens(context.Background(), records, logger)
+		}
+	}
+}
+
+func cleanupTokens(ctx context.Context, records expiringRecords, logger *slog.Logger) {
+	now := time.Now()
+
+	deleted, err := records.refreshTokens.DeleteExpired(ctx, now)
+	if err != nil {
+		logger.Error("refresh-token cleanup failed", "error", err)
+	} else if deleted > 0 {
+		logger.Info("refresh-token cleanup completed", "deleted_tokens", deleted)
+	}
+
+	deleted, err = records.revocations.DeleteExpired(ctx, now, now.Add(-authservice.RevocationRetention))
+	if err != nil {
+		logger.Error("revocation cleanup failed", "error", err)
+	} else if deleted > 0 {
+		logger.Info("revocation cleanup completed", "deleted_revocations", deleted)
+	}
+
+	deleted, err = records.emailVerifications.DeleteExpired(ctx, now)
+	if err != nil {
+		logger.Error("email verification cleanup failed", "error", err)
+	} else if deleted > 0 {
+		logger.Info("email verification cleanup completed", "deleted_tokens", deleted)
+	}
+
+	deleted, err = records.passwordResets.DeleteExpired(ctx, now)
+	if err != nil {
+		logger.Error("password reset cleanup failed", "error", err)
+	} else if deleted > 0 {
+		logger.Info("password reset cleanup completed", "deleted_tokens", deleted)
+	}
+
+	deleted, err = records.passwordResetQuotas.DeleteExpired(ctx, now.Add(-authservice.DefaultPasswordResetWindow))
+	if err != nil {
+		logger.Error("password reset quota cleanup failed", "error", err)
+	} else if deleted > 0 {
+		logger.Info("password reset quota cleanup completed", "deleted_keys", deleted)
+	}
+
+	deleted, err = records.loginThrottles.DeleteExpired(ctx, now, now.Add(-records.loginFailureWindow))
+	if err != nil {
+		logger.Error("login failure cleanup failed", "error", err)
+	} else if deleted > 0 {
+		logger.Info("login failure cleanup completed", "deleted_keys", deleted)
+	}
+
+	deleted, err = records.mfaChallenges.DeleteExpiredChallenges(ctx, now)
+	if err != nil {
+		logger.Error("mfa challenge cleanup failed", "error", err)
+	} else if deleted > 0 {
+		logger.Info("mfa challenge cleanup completed", "deleted_challenges", deleted)
+	}
+
+	deleted, err = records.oauthStates.DeleteExpiredStates(ctx, now)
+	if err != nil {
+		logger.Error("oauth state cleanup failed", "error", err)
+	} else if deleted > 0 {
+		logger.Info("oauth state cleanup completed", "deleted_states", deleted)
+	}
+}
+
+func newMailer(cfg authconfig.MailerConfig) authservice.Mailer {
+	if cfg.Driver == authconfig.MailerDriverSMTP {
+		return authmail.NewSMTPMailer(authmail.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUsername,
+			Password: cfg.SMTPPassword,
+			From:     cfg.From,
+		})
+	}
+	return authmail.NewFileMailer(cfg.FilePath, cfg.From)
+}
+
+func newOAuthProviders(cfg authconfig.OAuthConfig) (map[string]authservice.OAuthProvider, error) {
+	providers := make(map[string]authservice.OAuthProvider, len(cfg.Providers))
+	for name, p := range cfg.Providers {
+		provider, err := authoauth.NewProvider(name, authoauth.Config{
+			ClientID:           p.ClientID,
+			ClientSecret:       p.ClientSecret,
+			AuthURL:            p.AuthURL,
+			TokenURL:           p.TokenURL,
+			UserInfoURL:        p.UserInfoURL,
+			UserInfoTokenType:  p.UserInfoTokenType,
+			Issuer:             p.Issuer,
+			JWKSURL:            p.JWKSURL,
+			RedirectURL:        p.RedirectURL,
+			Scopes:             p.Scopes,
+			SubjectClaim:       p.SubjectClaim,
+			EmailClaim:         p.EmailClaim,
+			EmailVerifiedClaim: p.EmailVerifiedClaim,
+			TrustEmail:         p.TrustEmail,
+		})
+		if err != nil {
+			return nil, err
 		}
+		providers[name] = provider
 	}
+	return providers, nil
 }
 
 func grpcLoggingInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
@@ -12,17 +12,30 @@ import (
 	"syscall"
 	"time"
 
+	cartgrpc "github.com/KarpovYuri/caraudio-backend/internal/cart/adapters/grpc"
+	cartservice "github.com/KarpovYuri/caraudio-backend/internal/cart/app/services"
+	cartdb "github.com/KarpovYuri/caraudio-backend/internal/cart/infrastructure/database/postgres"
 	cataloggrpc "github.com/KarpovYuri/caraudio-backend/internal/catalog/adapters/grpc"
 	catalogservice "github.com/KarpovYuri/caraudio-backend/internal/catalog/app/services"
 	catalogconfig "github.com/KarpovYuri/caraudio-backend/internal/catalog/config"
 	catalogdb "github.com/KarpovYuri/caraudio-backend/internal/catalog/infrastructure/database/postgres"
+	catalognotify "github.com/KarpovYuri/caraudio-backend/internal/catalog/infrastructure/notify"
+	discountgrpc "github.com/KarpovYuri/caraudio-backend/internal/discounts/adapters/grpc"
+	discountservice "github.com/KarpovYuri/caraudio-backend/internal/discounts/app/services"
+	discountdb "github.com/KarpovYuri/caraudio-backend/internal/discounts/infrastructure/database/postgres"
+	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+	cartv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/cart/v1"
 	catalogv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/catalog/v1"
+	discountsv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/discounts/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	pkgjwt "github.com/KarpovYuri/caraudio-backend/pkg/jwt"
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 func main() {
@@ -48,6 +61,42 @@ func main() {
 		}
 	}()
 
+	// Access tokens are verified against the auth service's public keys.
+	jwtKeys, err := pkgjwt.NewKeySource(cfg.JWKSFile, cfg.JWKSURL, cfg.JWKSCacheTTL)
+	if err != nil {
+		logger.Error("failed to set up jwt key source", "error", err)
+		os.Exit(1)
+	}
+
+	// Revoked tokens and per-user cutoffs are pulled from the auth service
+	// and cached, so a demotion takes effect within one refresh interval.
+	authConn, err := grpc.NewClient(cfg.AuthGRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Error("failed to create auth client", "addr", cfg.AuthGRPCAddr, "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := authConn.Close(); err != nil {
+			logger.Error("failed to close auth connection", "error", err)
+		}
+	}()
+	// The revocation list is only served to API clients, so the catalog
+	// service signs in as its own.
+	serviceCredentials := pkgjwt.NewClientCredentials(
+		authv1.NewApiClientServiceClient(authConn), cfg.ClientID, cfg.ClientSecret,
+	)
+	revocations := pkgjwt.NewCachedRevocations(
+		pkgjwt.NewGRPCRevocationSource(authv1.NewAuthServiceClient(authConn), serviceCredentials),
+		cfg.RevocationsRefreshEvery,
+	)
+
+	verifier := pkgjwt.NewVerifier(jwtKeys, pkgjwt.ValidationOptions{
+		Issuer:      cfg.JWTIssuer,
+		Audience:    cfg.JWTAudience,
+		Leeway:      cfg.JWTLeeway,
+		Revocations: revocations,
+	})
+
 	supplierRepo := catalogdb.NewPostgresSupplierRepository(db)
 	categoryRepo := catalogdb.NewPostgresCategoryRepository(db)
 	productRepo := catalogdb.NewPostgresProductRepository(db)
@@ -56,13 +105,28 @@ func main() {
 	productAttrRepo := catalogdb.NewPostgresProductAttributeRepository(db)
 	categoryMappingRepo := catalogdb.NewPostgresSupplierCategoryMappingRepository(db)
 	productMappingRepo := catalogdb.NewPostgresSupplierProductMappingRepository(db)
+	productRelationRepo := catalogdb.NewPostgresProductRelationRepository(db)
+	bundleRepo := catalogdb.NewPostgresBundleRepository(db)
+	productReviewRepo := catalogdb.NewPostgresProductReviewRepository(db)
+	productQuestionRepo := catalogdb.NewPostgresProductQuestionRepository(db)
+	questionNotifier := catalognotify.NewLogQuestionNotifier(logger)
+	productListRepo := catalogdb.NewPostgresProductListRepository(db)
 
-	catalogSvc := catalogservice.NewCatalogService(supplierRepo, categoryRepo, productRepo, brandRepo, productImageRepo, productAttrRepo, categoryMappingRepo, productMappingRepo)
+	catalogSvc := catalogservice.NewCatalogService(supplierRepo, categoryRepo, productRepo, brandRepo, productImageRepo, productAttrRepo, categoryMappingRepo, productMappingRepo, productRelationRepo, bundleRepo, productReviewRepo, productQuestionRepo, questionNotifier, productListRepo)
 
-	catalogGRPC := cataloggrpc.NewCatalogGRPCServer(
-		catalogSvc,
-		cfg.JWTSecret,
-	)
+	catalogGRPC := cataloggrpc.NewCatalogGRPCServer(catalogSvc)
+
+	// The cart is its own bounded context but shares the catalog database:
+	// it reads current prices and stock straight from the product repository.
+	cartRepo := cartdb.NewPostgresCartRepository(db)
+	cartSvc := cartservice.NewCartService(cartRepo, productRepo)
+	cartGRPC := cartgrpc.NewCartGRPCServer(cartSvc, cfg.CookieSecure)
+
+	// Discounts are scoped by catalog brands, categories and products and
+	// priced from the catalog, so they live next to it as well.
+	discountRepo := discountdb.NewPostgresDiscountRepository(db)
+	discountSvc := discountservice.NewDiscountService(discountRepo, productRepo, categoryRepo)
+	discountGRPC := discountgrpc.NewDiscountGRPCServer(discountSvc)
 
 	lis, err := net.Listen("tcp", cfg.GRPCPort)
 	if err != nil {
@@ -70,8 +134,16 @@ func main() {
 		os.Exit(1)
 	}
 
-	s := grpc.NewServer(grpc.UnaryInterceptor(grpcLoggingInterceptor(logger)))
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpcLoggingInterceptor(logger),
+		authz.UnaryServerInterceptor(
+			authz.NewJWTAuthenticator(verifier),
+			authz.Merge(cataloggrpc.Policy, cartgrpc.Policy, discountgrpc.Policy),
+		),
+	))
 	catalogv1.RegisterCatalogServiceServer(s, catalogGRPC)
+	cartv1.RegisterCartServiceServer(s, cartGRPC)
+	discountsv1.RegisterDiscountServiceServer(s, discountGRPC)
 
 	ctx := context.Background()
 	mux := runtime.NewServeMux(
@@ -83,10 +155,24 @@ func main() {
 			return metadata.Pairs("x-request-id", requestID)
 		}),
 		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
-			if strings.EqualFold(key, "authorization") {
+			switch strings.ToLower(key) {
+			case "cookie":
+				return "grpcgateway-cookie", true
+			case "authorization":
 				return "authorization", true
+			default:
+				return runtime.DefaultHeaderMatcher(key)
+			}
+		}),
+		runtime.WithForwardResponseOption(func(ctx context.Context, w http.ResponseWriter, resp proto.Message) error {
+			md, ok := runtime.ServerMetadataFromContext(ctx)
+			if !ok {
+				return nil
+			}
+			for _, cookie := range md.HeaderMD.Get("set-cookie") {
+				w.Header().Add("Set-Cookie", cookie)
 			}
-			return runtime.DefaultHeaderMatcher(key)
+			return nil
 		}),
 	)
 
@@ -97,10 +183,24 @@ func main() {
 		logger.Error("failed to register catalog gateway", "error", err)
 		os.Exit(1)
 	}
+	if err := cartv1.RegisterCartServiceHandlerFromEndpoint(
+		ctx, mux, "localhost"+cfg.GRPCPort, opts,
+	); err != nil {
+		logger.Error("failed to register cart gateway", "error", err)
+		os.Exit(1)
+	}
+	if err := discountsv1.RegisterDiscountServiceHandlerFromEndpoint(
+		ctx, mux, "localhost"+cfg.GRPCPort, opts,
+	); err != nil {
+		logger.Error("failed to register discount gateway", "error", err)
+		os.Exit(1)
+	}
 
 	shutdownCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	go revocations.Run(shutdownCtx)
+
 	httpHandler := allowCORS(withRequestID(withAccessLog(mux, logger), logger), cfg.AllowedOrigins)
 
 	httpServer := &http.Server{
@@ -219,7 +319,7 @@ func allowCORS(h http.Handler, allowedOrigins []string) http.Handler {
 		}
 		w.Header().Set("Vary", "Origin")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PATCH, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Authorization")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Authorization, Cookie")
 
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -0,0 +1,331 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	ordergrpc "github.com/KarpovYuri/caraudio-backend/internal/orders/adapters/grpc"
+	orderservice "github.com/KarpovYuri/caraudio-backend/internal/orders/app/services"
+	orderconfig "github.com/KarpovYuri/caraudio-backend/internal/orders/config"
+	ordercatalog "github.com/KarpovYuri/caraudio-backend/internal/orders/infrastructure/catalog"
+	orderdb "github.com/KarpovYuri/caraudio-backend/internal/orders/infrastructure/database/postgres"
+	orderdiscounts "github.com/KarpovYuri/caraudio-backend/internal/orders/infrastructure/discounts"
+	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+	catalogv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/catalog/v1"
+	discountsv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/discounts/v1"
+	ordersv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/orders/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	pkgjwt "github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+	"github.com/google/uuid"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func main() {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}))
+	slog.SetDefault(logger)
+
+	cfg, err := orderconfig.LoadConfig()
+	if err != nil {
+		logger.Error("failed to load config", "error", err)
+		os.Exit(1)
+	}
+
+	db, err := orderdb.InitDB(&cfg.Database)
+	if err != nil {
+		logger.Error("failed to connect to database", "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			logger.Error("failed to close db", "error", err)
+		}
+	}()
+
+	// Access tokens are verified against the auth service's public keys.
+	jwtKeys, err := pkgjwt.NewKeySource(cfg.JWKSFile, cfg.JWKSURL, cfg.JWKSCacheTTL)
+	if err != nil {
+		logger.Error("failed to set up jwt key source", "error", err)
+		os.Exit(1)
+	}
+
+	// Revoked tokens and per-user cutoffs are pulled from the auth service
+	// and cached, so a demotion takes effect within one refresh interval.
+	authConn, err := grpc.NewClient(cfg.AuthGRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Error("failed to create auth client", "addr", cfg.AuthGRPCAddr, "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := authConn.Close(); err != nil {
+			logger.Error("failed to close auth connection", "error", err)
+		}
+	}()
+	// Stock is reserved, promo codes redeemed and the revocation list pulled
+	// as the order service's own API client rather than as the customer.
+	serviceCredentials := pkgjwt.NewClientCredentials(
+		authv1.NewApiClientServiceClient(authConn), cfg.ClientID, cfg.ClientSecret,
+	)
+	revocations := pkgjwt.NewCachedRevocations(
+		pkgjwt.NewGRPCRevocationSource(authv1.NewAuthServiceClient(authConn), serviceCredentials),
+		cfg.RevocationsRefreshEvery,
+	)
+
+	verifier := pkgjwt.NewVerifier(jwtKeys, pkgjwt.ValidationOptions{
+		Issuer:      cfg.JWTIssuer,
+		Audience:    cfg.JWTAudience,
+		Leeway:      cfg.JWTLeeway,
+		Revocations: revocations,
+	})
+
+	// Products are priced through the catalog service's public API; the
+	// order keeps its own copy of what was sold.
+	catalogConn, err := grpc.NewClient(cfg.CatalogGRPCAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		logger.Error("failed to create catalog client", "addr", cfg.CatalogGRPCAddr, "error", err)
+		os.Exit(1)
+	}
+	defer func() {
+		if err := catalogConn.Close(); err != nil {
+			logger.Error("failed to close catalog connection", "error", err)
+		}
+	}()
+
+	productCatalog := ordercatalog.NewGRPCProductCatalog(catalogv1.NewCatalogServiceClient(catalogConn), serviceCredentials)
+	// The discount service is served by the catalog service.
+	discounts := orderdiscounts.NewGRPCDiscounts(discountsv1.NewDiscountServiceClient(catalogConn), serviceCredentials)
+
+	orderRepo := orderdb.NewPostgresOrderRepository(db)
+	orderSvc := orderservice.NewOrderService(orderRepo, productCatalog, discounts)
+	orderGRPC := ordergrpc.NewOrderGRPCServer(orderSvc)
+
+	lis, err := net.Listen("tcp", cfg.GRPCPort)
+	if err != nil {
+		logger.Error("failed to listen on gRPC port", "port", cfg.GRPCPort, "error", err)
+		os.Exit(1)
+	}
+
+	s := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpcLoggingInterceptor(logger),
+		authz.UnaryServerInterceptor(authz.NewJWTAuthenticator(verifier), ordergrpc.Policy),
+	))
+	ordersv1.RegisterOrderServiceServer(s, orderGRPC)
+
+	ctx := context.Background()
+	mux := runtime.NewServeMux(
+		runtime.WithMetadata(func(_ context.Context, req *http.Request) metadata.MD {
+			requestID := req.Header.Get("X-Request-Id")
+			if requestID == "" {
+				return metadata.MD{}
+			}
+			return metadata.Pairs("x-request-id", requestID)
+		}),
+	)
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := ordersv1.RegisterOrderServiceHandlerFromEndpoint(
+		ctx, mux, "localhost"+cfg.GRPCPort, opts,
+	); err != nil {
+		logger.Error("failed to register order gateway", "error", err)
+		os.Exit(1)
+	}
+
+	shutdownCtx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go revocations.Run(shutdownCtx)
+
+	expiryCtx, expiryCancel := context.WithCancel(context.Background())
+	defer expiryCancel()
+	go runReservationExpiryJob(expiryCtx, orderSvc, cfg.ReservationTTL, cfg.ExpireReservationsEvery, logger)
+
+	httpHandler := allowCORS(withRequestID(withAccessLog(mux, logger), logger), cfg.AllowedOrigins)
+
+	httpServer := &http.Server{
+		Addr:         cfg.HTTPPort,
+		Handler:      httpHandler,
+		ReadTimeout:  cfg.HTTPReadTimeout,
+		WriteTimeout: cfg.HTTPWriteTimeout,
+		IdleTimeout:  cfg.HTTPIdleTimeout,
+	}
+
+	serverErrCh := make(chan error, 2)
+
+	go func() {
+		logger.Info("gRPC server started", "addr", cfg.GRPCPort)
+		if serveErr := s.Serve(lis); serveErr != nil {
+			serverErrCh <- serveErr
+		}
+	}()
+
+	go func() {
+		logger.Info("HTTP gateway started", "addr", cfg.HTTPPort)
+		if serveErr := httpServer.ListenAndServe(); serveErr != nil && !errors.Is(serveErr, http.ErrServerClosed) {
+			serverErrCh <- serveErr
+		}
+	}()
+
+	select {
+	case <-shutdownCtx.Done():
+		logger.Info("shutdown signal received")
+	case serveErr := <-serverErrCh:
+		logger.Error("server terminated unexpectedly", "error", serveErr)
+	}
+
+	expiryCancel()
+	stop()
+
+	httpShutdownCtx, httpShutdownCancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer httpShutdownCancel()
+	if err := httpServer.Shutdown(httpShutdownCtx); err != nil {
+		logger.Error("http shutdown failed", "error", err)
+	}
+
+	grpcStopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	select {
+	case <-grpcStopped:
+		logger.Info("gRPC server stopped gracefully")
+	case <-time.After(cfg.ShutdownTimeout):
+		logger.Warn("gRPC graceful shutdown timeout reached, forcing stop")
+		s.Stop()
+	}
+}
+
+// runReservationExpiryJob cancels orders left unpaid for longer than ttl so
+// that the stock they hold goes back on sale.
+func runReservationExpiryJob(
+	ctx context.Context,
+	orders orderservice.OrderService,
+	ttl, interval time.Duration,
+	logger *slog.Logger,
+) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			expired, err := orders.ExpireReservations(ctx, time.Now().Add(-ttl))
+			if err != nil {
+				logger.Error("reservation expiry failed", "error", err)
+			} else if expired > 0 {
+				logger.Info("reservation expiry completed", "cancelled_orders", expired)
+			}
+		}
+	}
+}
+
+type contextKey string
+
+const requestIDContextKey contextKey = "request_id"
+
+func withRequestID(next http.Handler, logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get("X-Request-Id")
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set("X-Request-Id", requestID)
+		ctx := context.WithValue(r.Context(), requestIDContextKey, requestID)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func grpcLoggingInterceptor(logger *slog.Logger) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		start := time.Now()
+		requestID := requestIDFromMetadata(ctx)
+		resp, err := handler(ctx, req)
+
+		level := slog.LevelInfo
+		if err != nil {
+			level = slog.LevelWarn
+		}
+		logger.Log(ctx, level, "grpc request completed",
+			"request_id", requestID,
+			"method", info.FullMethod,
+			"status", status.Code(err).String(),
+			"duration_ms", time.Since(start).Milliseconds(),
+		)
+		return resp, err
+	}
+}
+
+func requestIDFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get("x-request-id")
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func allowCORS(h http.Handler, allowedOrigins []string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		if origin != "" && isAllowedOrigin(origin, allowedOrigins) {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Credentials", "true")
+		}
+		w.Header().Set("Vary", "Origin")
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PATCH, DELETE, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "Accept, Content-Type, Content-Length, Authorization")
+
+		if r.Method == "OPTIONS" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		h.ServeHTTP(w, r)
+	})
+}
+
+func isAllowedOrigin(origin string, allowedOrigins []string) bool {
+	for _, allowed := range allowedOrigins {
+		if origin == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+func withAccessLog(next http.Handler, logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		requestID, _ := r.Context().Value(requestIDContextKey).(string)
+		next.ServeHTTP(w, r)
+		logger.Info("http request completed",
+			"request_id", requestID,
+			"method", r.Method,
+			"path", r.URL.Path,
+			"remote_addr", r.RemoteAddr,
+			"duration_ms", time.Since(start).Milliseconds(),
+		)
+	})
+}
@@ -0,0 +1,146 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/app/services"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const clientCredentialsGrant = "client_credentials"
+
+type APIClientGRPCServer struct {
+	authv1.UnimplementedApiClientServiceServer
+	apiClientService services.APIClientService
+}
+
+func NewAPIClientGRPCServer(apiClientService services.APIClientService) *APIClientGRPCServer {
+	return &APIClientGRPCServer{apiClientService: apiClientService}
+}
+
+func (s *APIClientGRPCServer) ListApiClients(
+	ctx context.Context,
+	_ *authv1.ListApiClientsRequest,
+) (*authv1.ListApiClientsResponse, error) {
+	clients, err := s.apiClientService.ListClients(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	resp := &authv1.ListApiClientsResponse{Clients: make([]*authv1.ApiClient, 0, len(clients))}
+	for i := range clients {
+		resp.Clients = append(resp.Clients, toProtoAPIClient(&clients[i]))
+	}
+	return resp, nil
+}
+
+func (s *APIClientGRPCServer) CreateApiClient(
+	ctx context.Context,
+	req *authv1.CreateApiClientRequest,
+) (*authv1.CreateApiClientResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	credentials, err := s.apiClientService.CreateClient(
+		ctx,
+		actor,
+		req.Name,
+		req.Scopes,
+		time.Duration(req.TtlSeconds)*time.Second,
+	)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.CreateApiClientResponse{
+		Client:       toProtoAPIClient(credentials.Client),
+		ClientSecret: credentials.Secret,
+	}, nil
+}
+
+func (s *APIClientGRPCServer) RotateApiClientSecret(
+	ctx context.Context,
+	req *authv1.RotateApiClientSecretRequest,
+) (*authv1.RotateApiClientSecretResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "client id is required")
+	}
+
+	credentials, err := s.apiClientService.RotateSecret(ctx, req.Id)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.RotateApiClientSecretResponse{
+		Client:       toProtoAPIClient(credentials.Client),
+		ClientSecret: credentials.Secret,
+	}, nil
+}
+
+func (s *APIClientGRPCServer) RevokeApiClient(
+	ctx context.Context,
+	req *authv1.RevokeApiClientRequest,
+) (*authv1.RevokeApiClientResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "client id is required")
+	}
+
+	if err := s.apiClientService.RevokeClient(ctx, req.Id); err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.RevokeApiClientResponse{Success: true}, nil
+}
+
+func (s *APIClientGRPCServer) IssueClientToken(
+	ctx context.Context,
+	req *authv1.IssueClientTokenRequest,
+) (*authv1.IssueClientTokenResponse, error) {
+	if req.GrantType != clientCredentialsGrant {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported grant type, expected %q", clientCredentialsGrant)
+	}
+	if req.ClientId == "" || req.ClientSecret == "" {
+		return nil, status.Error(codes.InvalidArgument, "client_id and client_secret are required")
+	}
+
+	token, expiresAt, err := s.apiClientService.IssueToken(ctx, req.ClientId, req.ClientSecret)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.IssueClientTokenResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(time.Until(expiresAt).Seconds()),
+	}, nil
+}
+
+func toProtoAPIClient(client *domain.APIClient) *authv1.ApiClient {
+	return &authv1.ApiClient{
+		Id:         client.ID,
+		Name:       client.Name,
+		Scopes:     client.Scopes,
+		ExpiresAt:  formatOptionalTime(client.ExpiresAt),
+		LastUsedAt: formatOptionalTime(client.LastUsedAt),
+		RevokedAt:  formatOptionalTime(client.RevokedAt),
+		CreatedAt:  client.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:  client.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -5,10 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/app/services"
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
 	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"github.com/KarpovYuri/caraudio-backend/pkg/jwt"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
@@ -18,14 +21,29 @@ import (
 
 type AuthGRPCServer struct {
 	authv1.UnimplementedAuthServiceServer
-	authService  services.AuthService
-	cookieSecure bool
+	authService          services.AuthService
+	registrationService  services.RegistrationService
+	passwordResetService services.PasswordResetService
+	oauthService         services.OAuthService
+	cookieSecure         bool
+	trustedProxies       TrustedProxies
 }
 
-func NewAuthGRPCServer(authService services.AuthService, cookieSecure bool) *AuthGRPCServer {
+func NewAuthGRPCServer(
+	authService services.AuthService,
+	registrationService services.RegistrationService,
+	passwordResetService services.PasswordResetService,
+	oauthService services.OAuthService,
+	cookieSecure bool,
+	trustedProxies TrustedProxies,
+) *AuthGRPCServer {
 	return &AuthGRPCServer{
-		authService:  authService,
-		cookieSecure: cookieSecure,
+		authService:          authService,
+		registrationService:  registrationService,
+		passwordResetService: passwordResetService,
+		oauthService:         oauthService,
+		cookieSecure:         cookieSecure,
+		trustedProxies:       trustedProxies,
 	}
 }
 
@@ -38,35 +56,52 @@ func (s *AuthGRPCServer) Login(
 		return nil, status.Error(codes.InvalidArgument, "login and password are required")
 	}
 
-	user, accessToken, refreshToken, err :=
-		s.authService.Login(ctx, req.Login, req.Password, req.RememberMe)
+	result, err :=
+		s.authService.Login(ctx, req.Login, req.Password, req.RememberMe, s.clientInfo(ctx))
 
 	if err != nil {
 		return nil, mapServiceError(err)
 	}
+	return s.loginResponse(ctx, result)
+}
 
-	maxAge := 24 * 60 * 60
-	if req.RememberMe {
-		maxAge = 30 * 24 * 60 * 60
+// loginResponse answers a login step: with the MFA challenge, or with the
+// access token and the refresh cookie of the new session.
+func (s *AuthGRPCServer) loginResponse(
+	ctx context.Context,
+	result *domain.LoginResult,
+) (*authv1.LoginResponse, error) {
+	if result.MFARequired() {
+		return &authv1.LoginResponse{
+			UserId:                result.User.ID,
+			Role:                  result.User.Role,
+			MfaRequired:           true,
+			MfaToken:              result.MFAChallenge,
+			MfaExpiresAt:          result.MFAChallengeExpiresAt.UTC().Format(time.RFC3339),
+			MfaEnrollmentRequired: result.MFAEnrollmentRequired,
+		}, nil
 	}
 
-	cookieValue := fmt.Sprintf("refresh_token=%s; Path=/; HttpOnly; SameSite=Lax; MaxAge=%d",
-		refreshToken, maxAge)
-	if s.cookieSecure {
-		cookieValue += "; Secure"
+	if err := s.sendRefreshCookie(ctx, result); err != nil {
+		return nil, err
 	}
 
-	header := metadata.Pairs("Set-Cookie", cookieValue)
+	return &authv1.LoginResponse{
+		UserId:      result.User.ID,
+		Role:        result.User.Role,
+		AccessToken: result.AccessToken,
+	}, nil
+}
+
+// sendRefreshCookie sets the cookie of a session started by a login.
+func (s *AuthGRPCServer) sendRefreshCookie(ctx context.Context, result *domain.LoginResult) error {
+	maxAge := int(time.Until(result.RefreshExpiresAt).Seconds())
+	header := metadata.Pairs("Set-Cookie", s.refreshCookie(result.RefreshToken, maxAge))
 
 	if err := grpc.SendHeader(ctx, header); err != nil {
-		return nil, status.Error(codes.Internal, "failed to send response headers")
+		return status.Error(codes.Internal, "failed to send response headers")
 	}
-
-	return &authv1.LoginResponse{
-		UserId:      user.ID,
-		Role:        user.Role,
-		AccessToken: accessToken,
-	}, nil
+	return nil
 }
 
 func (s *AuthGRPCServer) Refresh(
@@ -74,27 +109,25 @@ func (s *AuthGRPCServer) Refresh(
 	_ *authv1.RefreshRequest,
 ) (*authv1.RefreshResponse, error) {
 
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return nil, status.Error(codes.InvalidArgument, "missing metadata")
-	}
-
-	var refreshToken string
-	cookieHeader := md.Get("grpcgateway-cookie")
-
-	if len(cookieHeader) > 0 {
-		refreshToken = extractToken(cookieHeader[0], "refresh_token")
-	}
-
+	refreshToken := refreshTokenFromContext(ctx)
 	if refreshToken == "" {
 		return nil, status.Error(codes.Unauthenticated, "refresh token is missing in cookies")
 	}
 
-	accessToken, err := s.authService.Refresh(ctx, refreshToken)
+	accessToken, newRefreshToken, expiresAt, err :=
+		s.authService.Refresh(ctx, refreshToken, s.clientInfo(ctx))
 	if err != nil {
 		return nil, mapServiceError(err)
 	}
 
+	if newRefreshToken != "" {
+		maxAge := int(time.Until(expiresAt).Seconds())
+		header := metadata.Pairs("Set-Cookie", s.refreshCookie(newRefreshToken, maxAge))
+		if err := grpc.SendHeader(ctx, header); err != nil {
+			return nil, status.Error(codes.Internal, "failed to send response headers")
+		}
+	}
+
 	return &authv1.RefreshResponse{
 		AccessToken: accessToken,
 	}, nil
@@ -141,34 +174,71 @@ func (s *AuthGRPCServer) Logout(
 	_ *authv1.LogoutRequest,
 ) (*authv1.LogoutResponse, error) {
 
-	md, ok := metadata.FromIncomingContext(ctx)
-	var refreshToken string
+	_ = s.authService.Logout(ctx, refreshTokenFromContext(ctx), authz.BearerToken(ctx))
 
-	if ok {
-		cookieHeader := md.Get("grpcgateway-cookie")
-		if len(cookieHeader) > 0 {
-			refreshToken = extractToken(cookieHeader[0], "refresh_token")
-		}
+	header := metadata.Pairs("Set-Cookie", s.refreshCookie("", -1))
+
+	if err := grpc.SendHeader(ctx, header); err != nil {
+		return nil, status.Error(codes.Internal, "failed to send logout headers")
 	}
 
-	if refreshToken != "" {
-		_ = s.authService.Logout(ctx, refreshToken)
+	return &authv1.LogoutResponse{
+		Success: true,
+	}, nil
+}
+
+// ListRevocations is served over gRPC only; other services poll it, as API
+// clients holding auth.revocations.read, to keep their verifiers' revocation
+// lists current.
+func (s *AuthGRPCServer) ListRevocations(
+	ctx context.Context,
+	_ *authv1.ListRevocationsRequest,
+) (*authv1.ListRevocationsResponse, error) {
+
+	tokens, cutoffs, err := s.authService.ListRevocations(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
 	}
 
-	deleteCookie := "refresh_token=; Path=/; HttpOnly; SameSite=Lax; MaxAge=-1"
+	resp := &authv1.ListRevocationsResponse{
+		TokenIds:    make([]string, 0, len(tokens)),
+		UserCutoffs: make([]*authv1.UserTokenCutoff, 0, len(cutoffs)),
+	}
+	for _, token := range tokens {
+		resp.TokenIds = append(resp.TokenIds, token.JTI)
+	}
+	for _, cutoff := range cutoffs {
+		resp.UserCutoffs = append(resp.UserCutoffs, &authv1.UserTokenCutoff{
+			UserId:    cutoff.UserID,
+			NotBefore: cutoff.NotBefore.UTC().Format(time.RFC3339),
+		})
+	}
+	return resp, nil
+}
+
+func (s *AuthGRPCServer) refreshCookie(value string, maxAge int) string {
+	cookie := fmt.Sprintf("refresh_token=%s; Path=/; HttpOnly; SameSite=Lax; Max-Age=%d", value, maxAge)
 	if s.cookieSecure {
-		deleteCookie += "; Secure"
+		cookie += "; Secure"
 	}
+	return cookie
+}
 
-	header := metadata.Pairs("Set-Cookie", deleteCookie)
+func refreshTokenFromContext(ctx context.Context) string {
+	return cookieFromContext(ctx, "refresh_token")
+}
 
-	if err := grpc.SendHeader(ctx, header); err != nil {
-		return nil, status.Error(codes.Internal, "failed to send logout headers")
+// cookieFromContext reads a cookie forwarded by the HTTP gateway.
+func cookieFromContext(ctx context.Context, name string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
 	}
-
-	return &authv1.LogoutResponse{
-		Success: true,
-	}, nil
+	cookieHeader := md.Get("grpcgateway-cookie")
+	if len(cookieHeader) == 0 {
+		return ""
+	}
+	return extractToken(cookieHeader[0], name)
 }
 
 func extractToken(cookieStr, name string) string {
@@ -188,14 +258,38 @@ func mapServiceError(err error) error {
 		return status.Error(codes.Unauthenticated, "invalid credentials")
 	case errors.Is(err, domain.ErrInvalidToken), errors.Is(err, domain.ErrTokenExpired):
 		return status.Error(codes.Unauthenticated, "invalid token")
-	case errors.Is(err, domain.ErrUnauthorized):
+	case errors.Is(err, domain.ErrUnauthorized), errors.Is(err, jwt.ErrUnauthorized):
 		return status.Error(codes.Unauthenticated, "unauthorized")
-	case errors.Is(err, domain.ErrForbidden):
+	case errors.Is(err, domain.ErrForbidden), errors.Is(err, jwt.ErrForbidden):
 		return status.Error(codes.PermissionDenied, "forbidden")
 	case errors.Is(err, domain.ErrUserNotFound):
 		return status.Error(codes.NotFound, "user not found")
+	case errors.Is(err, domain.ErrSessionNotFound):
+		return status.Error(codes.NotFound, "session not found")
+	case errors.Is(err, domain.ErrRoleNotFound):
+		return status.Error(codes.NotFound, "role not found")
+	case errors.Is(err, domain.ErrAddressNotFound):
+		return status.Error(codes.NotFound, "address not found")
+	case errors.Is(err, domain.ErrUnknownProvider):
+		return status.Error(codes.NotFound, "unknown login provider")
+	case errors.Is(err, domain.ErrExternalAuthFailed):
+		return status.Error(codes.Unauthenticated, "external authentication failed")
+	case errors.Is(err, domain.ErrAPIClientNotFound):
+		return status.Error(codes.NotFound, "API client not found")
 	case errors.Is(err, domain.ErrUserAlreadyExists):
 		return status.Error(codes.AlreadyExists, "user already exists")
+	case errors.Is(err, domain.ErrUserDisabled):
+		return status.Error(codes.PermissionDenied, "account disabled")
+	case errors.Is(err, domain.ErrTooManyLoginAttempts):
+		return status.Error(codes.ResourceExhausted, "too many failed login attempts, try again later")
+	case errors.Is(err, domain.ErrTooManyResetRequests):
+		return status.Error(codes.ResourceExhausted, "too many password reset requests, try again later")
+	case errors.Is(err, domain.ErrInvalidMFACode):
+		return status.Error(codes.Unauthenticated, "invalid MFA code")
+	case errors.Is(err, domain.ErrMFAAlreadyEnabled):
+		return status.Error(codes.AlreadyExists, "MFA already enabled")
+	case errors.Is(err, domain.ErrMFANotEnrolled):
+		return status.Error(codes.FailedPrecondition, "MFA not enrolled")
 	case errors.Is(err, domain.ErrInvalidArgument):
 		return status.Error(codes.InvalidArgument, err.Error())
 	default:
@@ -2,41 +2,94 @@ package grpc
 
 import (
 	"context"
-	"strings"
 
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/app/services"
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
-	"google.golang.org/grpc/metadata"
+	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"github.com/KarpovYuri/caraudio-backend/pkg/jwt"
 )
 
-func extractBearerToken(ctx context.Context) string {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return ""
-	}
-	values := md.Get("authorization")
-	if len(values) == 0 {
-		return ""
-	}
-	value := strings.TrimSpace(values[0])
-	if len(value) > 7 && strings.EqualFold(value[:7], "bearer ") {
-		return strings.TrimSpace(value[7:])
-	}
-	return value
+// Policy declares who may call each auth, user, profile and API client RPC; cmd/auth-service
+// enforces it with authz.UnaryServerInterceptor.
+var Policy = authz.Policy{
+	authv1.AuthService_Login_FullMethodName:                authz.Public,
+	authv1.AuthService_Refresh_FullMethodName:              authz.Public,
+	authv1.AuthService_ValidateToken_FullMethodName:        authz.Public,
+	authv1.AuthService_Logout_FullMethodName:               authz.Public,
+	authv1.AuthService_Register_FullMethodName:             authz.Public,
+	authv1.AuthService_VerifyEmail_FullMethodName:          authz.Public,
+	authv1.AuthService_ResendVerification_FullMethodName:   authz.Public,
+	authv1.AuthService_RequestPasswordReset_FullMethodName: authz.Public,
+	authv1.AuthService_ConfirmPasswordReset_FullMethodName: authz.Public,
+	authv1.AuthService_ListOAuthProviders_FullMethodName:   authz.Public,
+	authv1.AuthService_StartOAuthLogin_FullMethodName:      authz.Public,
+	authv1.AuthService_CompleteOAuthLogin_FullMethodName:   authz.Public,
+	// Enrollment also accepts an MFA challenge token instead of a login.
+	authv1.AuthService_EnrollMFA_FullMethodName: authz.Public,
+	authv1.AuthService_VerifyMFA_FullMethodName: authz.Public,
+	// Only reachable over gRPC, by the other services' API clients.
+	authv1.AuthService_ListRevocations_FullMethodName: authz.Permission(jwt.PermAuthRevocationsRead),
+
+	authv1.AuthService_ListSessions_FullMethodName:           authz.Authenticated,
+	authv1.AuthService_RevokeSession_FullMethodName:          authz.Authenticated,
+	authv1.AuthService_RevokeAllOtherSessions_FullMethodName: authz.Authenticated,
+	authv1.AuthService_ConfirmMFA_FullMethodName:             authz.Authenticated,
+
+	authv1.UserService_CreateUser_FullMethodName:       authz.Permission(jwt.PermUsersManage),
+	authv1.UserService_UpdateUser_FullMethodName:       authz.Permission(jwt.PermUsersManage),
+	authv1.UserService_DeleteUser_FullMethodName:       authz.Permission(jwt.PermUsersManage),
+	authv1.UserService_GetUser_FullMethodName:          authz.Permission(jwt.PermUsersManage),
+	authv1.UserService_ListUsers_FullMethodName:        authz.Permission(jwt.PermUsersManage),
+	authv1.UserService_DisableUser_FullMethodName:      authz.Permission(jwt.PermUsersManage),
+	authv1.UserService_EnableUser_FullMethodName:       authz.Permission(jwt.PermUsersManage),
+	authv1.UserService_RevokeUserTokens_FullMethodName: authz.Permission(jwt.PermUsersManage),
+	authv1.UserService_UnlockUser_FullMethodName:       authz.Permission(jwt.PermUsersManage),
+	authv1.UserService_ListRoles_FullMethodName:        authz.Permission(jwt.PermUsersManage),
+	// Editing roles changes what every holder may do, so it stays with
+	// admins rather than anyone able to manage users.
+	authv1.UserService_SaveRole_FullMethodName: authz.Admin,
+
+	authv1.ProfileService_GetMyProfile_FullMethodName:      authz.Authenticated,
+	authv1.ProfileService_UpdateMyProfile_FullMethodName:   authz.Authenticated,
+	authv1.ProfileService_ListMyAddresses_FullMethodName:   authz.Authenticated,
+	authv1.ProfileService_CreateMyAddress_FullMethodName:   authz.Authenticated,
+	authv1.ProfileService_UpdateMyAddress_FullMethodName:   authz.Authenticated,
+	authv1.ProfileService_DeleteMyAddress_FullMethodName:   authz.Authenticated,
+	authv1.ProfileService_SetDefaultAddress_FullMethodName: authz.Authenticated,
+	authv1.ProfileService_GetUserProfile_FullMethodName:    authz.Permission(jwt.PermUsersManage),
+
+	// The client credentials grant authenticates with the client secret.
+	authv1.ApiClientService_IssueClientToken_FullMethodName:      authz.Public,
+	authv1.ApiClientService_ListApiClients_FullMethodName:        authz.Permission(jwt.PermAPIClientsManage),
+	authv1.ApiClientService_CreateApiClient_FullMethodName:       authz.Permission(jwt.PermAPIClientsManage),
+	authv1.ApiClientService_RotateApiClientSecret_FullMethodName: authz.Permission(jwt.PermAPIClientsManage),
+	authv1.ApiClientService_RevokeApiClient_FullMethodName:       authz.Permission(jwt.PermAPIClientsManage),
 }
 
-func requireAdmin(ctx context.Context, authService services.AuthService) error {
-	token := extractBearerToken(ctx)
-	if token == "" {
-		return domain.ErrUnauthorized
-	}
+// NewAuthenticator checks bearer tokens with the auth service itself, so a
+// revocation applies here without waiting for a list refresh.
+func NewAuthenticator(authService services.AuthService) authz.Authenticator {
+	return authz.AuthenticatorFunc(func(ctx context.Context, token string) (*authz.Principal, error) {
+		claims, err := authService.Authenticate(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		return authz.PrincipalFromClaims(claims), nil
+	})
+}
 
-	_, role, isValid, err := authService.ValidateToken(ctx, token)
-	if err != nil || !isValid {
-		return domain.ErrUnauthorized
-	}
-	if role != domain.RoleAdmin {
-		return domain.ErrForbidden
+// actorFromContext returns the authenticated user making an administrative
+// change.
+func actorFromContext(ctx context.Context) (domain.Actor, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return domain.Actor{}, err
 	}
-	return nil
+	principal, _ := authz.FromContext(ctx)
+	return domain.Actor{
+		UserID:      userID,
+		Role:        principal.Role,
+		Permissions: principal.Permissions,
+	}, nil
 }
@@ -0,0 +1,100 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+)
+
+// maxForwardedHops bounds how much of X-Forwarded-For is looked at.
+const maxForwardedHops = 16
+
+// TrustedProxies are the networks of the proxies in front of the service,
+// including the HTTP gateway, whose X-Forwarded-For entries are believed.
+type TrustedProxies []netip.Prefix
+
+// ParseTrustedProxies accepts CIDR networks and single addresses.
+func ParseTrustedProxies(values []string) (TrustedProxies, error) {
+	proxies := make(TrustedProxies, 0, len(values))
+	for _, value := range values {
+		if !strings.Contains(value, "/") {
+			addr, err := netip.ParseAddr(value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", value, err)
+			}
+			proxies = append(proxies, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		prefix, err := netip.ParsePrefix(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", value, err)
+		}
+		proxies = append(proxies, prefix.Masked())
+	}
+	return proxies, nil
+}
+
+func (t TrustedProxies) contains(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	for _, prefix := range t {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP starts from the gRPC peer and, for as long as the address at hand
+// is a trusted proxy, steps one hop left in X-Forwarded-For. Entries added by
+// the client itself are never reached unless every proxy after them is
+// trusted, so they cannot be used to pick the address throttles count
+// against.
+func (t TrustedProxies) clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	addrPort, err := netip.ParseAddrPort(p.Addr.String())
+	if err != nil {
+		return ""
+	}
+	ip := addrPort.Addr().Unmap()
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	var hops []string
+	for _, value := range md.Get("x-forwarded-for") {
+		hops = append(hops, strings.Split(value, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0 && i >= len(hops)-maxForwardedHops; i-- {
+		if !t.contains(ip) {
+			break
+		}
+		hop, err := netip.ParseAddr(strings.TrimSpace(hops[i]))
+		if err != nil {
+			break
+		}
+		ip = hop.Unmap()
+	}
+	// Zones are free text; dropping them keeps the key short.
+	return ip.WithZone("").String()
+}
+
+// clientInfo describes the caller for sessions and login throttling. The user
+// agent is the browser's when the call came through the HTTP gateway.
+func (s *AuthGRPCServer) clientInfo(ctx context.Context) domain.ClientInfo {
+	info := domain.ClientInfo{IPAddress: s.trustedProxies.clientIP(ctx)}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("grpcgateway-user-agent"); len(values) > 0 {
+			info.UserAgent = values[0]
+		} else if values := md.Get("user-agent"); len(values) > 0 {
+			info.UserAgent = values[0]
+		}
+	}
+	return info
+}
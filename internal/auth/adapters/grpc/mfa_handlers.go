@@ -0,0 +1,93 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AuthGRPCServer) EnrollMFA(
+	ctx context.Context,
+	req *authv1.EnrollMFARequest,
+) (*authv1.EnrollMFAResponse, error) {
+
+	var (
+		userID string
+		err    error
+	)
+	if req.MfaToken != "" {
+		userID, err = s.authService.EnrollmentChallengeUser(ctx, req.MfaToken)
+		if errors.Is(err, domain.ErrTokenExpired) {
+			return nil, status.Error(codes.Unauthenticated, "MFA challenge expired, log in again")
+		}
+	} else {
+		userID, err = authz.UserID(ctx)
+	}
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	enrollment, err := s.authService.EnrollMFA(ctx, userID)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.EnrollMFAResponse{
+		Secret:          enrollment.Secret,
+		ProvisioningUri: enrollment.ProvisioningURI,
+	}, nil
+}
+
+func (s *AuthGRPCServer) ConfirmMFA(
+	ctx context.Context,
+	req *authv1.ConfirmMFARequest,
+) (*authv1.ConfirmMFAResponse, error) {
+	if req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "code is required")
+	}
+
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	recoveryCodes, err := s.authService.ConfirmMFA(ctx, userID, req.Code)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.ConfirmMFAResponse{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *AuthGRPCServer) VerifyMFA(
+	ctx context.Context,
+	req *authv1.VerifyMFARequest,
+) (*authv1.VerifyMFAResponse, error) {
+	if req.MfaToken == "" || req.Code == "" {
+		return nil, status.Error(codes.InvalidArgument, "mfa token and code are required")
+	}
+
+	result, err := s.authService.VerifyMFA(ctx, req.MfaToken, req.Code, s.clientInfo(ctx))
+	switch {
+	case errors.Is(err, domain.ErrTokenExpired):
+		return nil, status.Error(codes.Unauthenticated, "MFA challenge expired, log in again")
+	case err != nil:
+		return nil, mapServiceError(err)
+	}
+
+	if err := s.sendRefreshCookie(ctx, result); err != nil {
+		return nil, err
+	}
+
+	return &authv1.VerifyMFAResponse{
+		UserId:        result.User.ID,
+		Role:          result.User.Role,
+		AccessToken:   result.AccessToken,
+		RecoveryCodes: result.RecoveryCodes,
+	}, nil
+}
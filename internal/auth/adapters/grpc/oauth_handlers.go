@@ -0,0 +1,82 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const oauthBindingCookie = "oauth_binding"
+
+func (s *AuthGRPCServer) ListOAuthProviders(
+	_ context.Context,
+	_ *authv1.ListOAuthProvidersRequest,
+) (*authv1.ListOAuthProvidersResponse, error) {
+	return &authv1.ListOAuthProvidersResponse{Providers: s.oauthService.Providers()}, nil
+}
+
+func (s *AuthGRPCServer) StartOAuthLogin(
+	ctx context.Context,
+	req *authv1.StartOAuthLoginRequest,
+) (*authv1.StartOAuthLoginResponse, error) {
+	if req.Provider == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider is required")
+	}
+
+	login, err := s.oauthService.StartLogin(ctx, req.Provider, req.RememberMe)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	maxAge := int(time.Until(login.ExpiresAt).Seconds())
+	header := metadata.Pairs("Set-Cookie", s.oauthBindingCookie(login.Binding, maxAge))
+	if err := grpc.SendHeader(ctx, header); err != nil {
+		return nil, status.Error(codes.Internal, "failed to send response headers")
+	}
+
+	return &authv1.StartOAuthLoginResponse{
+		AuthorizationUrl: login.AuthorizationURL,
+		ExpiresAt:        login.ExpiresAt.UTC().Format(time.RFC3339),
+	}, nil
+}
+
+func (s *AuthGRPCServer) CompleteOAuthLogin(
+	ctx context.Context,
+	req *authv1.CompleteOAuthLoginRequest,
+) (*authv1.LoginResponse, error) {
+	if req.Provider == "" || req.Code == "" || req.State == "" {
+		return nil, status.Error(codes.InvalidArgument, "provider, code and state are required")
+	}
+
+	binding := cookieFromContext(ctx, oauthBindingCookie)
+	if binding == "" {
+		return nil, status.Error(codes.Unauthenticated, "login was not started in this browser")
+	}
+
+	result, err := s.oauthService.CompleteLogin(ctx, req.Provider, req.Code, req.State, binding, s.clientInfo(ctx))
+	// The binding is single-use like the state it belongs to.
+	if headerErr := grpc.SetHeader(ctx, metadata.Pairs("Set-Cookie", s.oauthBindingCookie("", -1))); headerErr != nil {
+		return nil, status.Error(codes.Internal, "failed to set response headers")
+	}
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return s.loginResponse(ctx, result)
+}
+
+// oauthBindingCookie is scoped to the social login routes. SameSite=Lax
+// still lets the frontend send it back after the provider's redirect.
+func (s *AuthGRPCServer) oauthBindingCookie(value string, maxAge int) string {
+	cookie := fmt.Sprintf("%s=%s; Path=/v1/auth/oauth; HttpOnly; SameSite=Lax; Max-Age=%d",
+		oauthBindingCookie, value, maxAge)
+	if s.cookieSecure {
+		cookie += "; Secure"
+	}
+	return cookie
+}
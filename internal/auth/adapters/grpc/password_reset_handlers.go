@@ -0,0 +1,47 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AuthGRPCServer) RequestPasswordReset(
+	ctx context.Context,
+	req *authv1.RequestPasswordResetRequest,
+) (*authv1.RequestPasswordResetResponse, error) {
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	if err := s.passwordResetService.RequestPasswordReset(ctx, req.Email, s.clientInfo(ctx)); err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.RequestPasswordResetResponse{Success: true}, nil
+}
+
+func (s *AuthGRPCServer) ConfirmPasswordReset(
+	ctx context.Context,
+	req *authv1.ConfirmPasswordResetRequest,
+) (*authv1.ConfirmPasswordResetResponse, error) {
+	if req.Token == "" || req.NewPassword == "" {
+		return nil, status.Error(codes.InvalidArgument, "token and new password are required")
+	}
+
+	err := s.passwordResetService.ConfirmPasswordReset(ctx, req.Token, req.NewPassword)
+	switch {
+	case errors.Is(err, domain.ErrTokenExpired):
+		return nil, status.Error(codes.FailedPrecondition, "password reset link expired")
+	case errors.Is(err, domain.ErrInvalidToken):
+		return nil, status.Error(codes.InvalidArgument, "invalid password reset token")
+	case err != nil:
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.ConfirmPasswordResetResponse{Success: true}, nil
+}
@@ -0,0 +1,237 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/app/services"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ProfileGRPCServer struct {
+	authv1.UnimplementedProfileServiceServer
+	profileService services.ProfileService
+}
+
+func NewProfileGRPCServer(profileService services.ProfileService) *ProfileGRPCServer {
+	return &ProfileGRPCServer{profileService: profileService}
+}
+
+func (s *ProfileGRPCServer) GetMyProfile(
+	ctx context.Context,
+	_ *authv1.GetMyProfileRequest,
+) (*authv1.GetMyProfileResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	profile, err := s.profileService.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.GetMyProfileResponse{Profile: toProtoProfile(profile)}, nil
+}
+
+func (s *ProfileGRPCServer) UpdateMyProfile(
+	ctx context.Context,
+	req *authv1.UpdateMyProfileRequest,
+) (*authv1.UpdateMyProfileResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	profile, err := s.profileService.UpdateProfile(ctx, userID, req.FirstName, req.LastName, req.Phone, req.Email)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.UpdateMyProfileResponse{Profile: toProtoProfile(profile)}, nil
+}
+
+func (s *ProfileGRPCServer) ListMyAddresses(
+	ctx context.Context,
+	_ *authv1.ListMyAddressesRequest,
+) (*authv1.ListMyAddressesResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	addresses, err := s.profileService.ListAddresses(ctx, userID)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.ListMyAddressesResponse{Addresses: toProtoAddresses(addresses)}, nil
+}
+
+func (s *ProfileGRPCServer) CreateMyAddress(
+	ctx context.Context,
+	req *authv1.CreateMyAddressRequest,
+) (*authv1.CreateMyAddressResponse, error) {
+	if req.Address == nil {
+		return nil, status.Error(codes.InvalidArgument, "address is required")
+	}
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	address, err := s.profileService.CreateAddress(ctx, userID, fromProtoAddress(req.Address))
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.CreateMyAddressResponse{Address: toProtoAddress(address)}, nil
+}
+
+func (s *ProfileGRPCServer) UpdateMyAddress(
+	ctx context.Context,
+	req *authv1.UpdateMyAddressRequest,
+) (*authv1.UpdateMyAddressResponse, error) {
+	if req.Id == "" || req.Address == nil {
+		return nil, status.Error(codes.InvalidArgument, "address id and address are required")
+	}
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	update := fromProtoAddress(req.Address)
+	update.ID = req.Id
+	address, err := s.profileService.UpdateAddress(ctx, userID, update)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.UpdateMyAddressResponse{Address: toProtoAddress(address)}, nil
+}
+
+func (s *ProfileGRPCServer) DeleteMyAddress(
+	ctx context.Context,
+	req *authv1.DeleteMyAddressRequest,
+) (*authv1.DeleteMyAddressResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "address id is required")
+	}
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	if err := s.profileService.DeleteAddress(ctx, userID, req.Id); err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.DeleteMyAddressResponse{Success: true}, nil
+}
+
+func (s *ProfileGRPCServer) SetDefaultAddress(
+	ctx context.Context,
+	req *authv1.SetDefaultAddressRequest,
+) (*authv1.SetDefaultAddressResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "address id is required")
+	}
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	address, err := s.profileService.SetDefaultAddress(ctx, userID, req.Id)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.SetDefaultAddressResponse{Address: toProtoAddress(address)}, nil
+}
+
+func (s *ProfileGRPCServer) GetUserProfile(
+	ctx context.Context,
+	req *authv1.GetUserProfileRequest,
+) (*authv1.GetUserProfileResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	profile, err := s.profileService.GetProfile(ctx, req.UserId)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	addresses, err := s.profileService.ListAddresses(ctx, req.UserId)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.GetUserProfileResponse{
+		Profile:   toProtoProfile(profile),
+		Addresses: toProtoAddresses(addresses),
+	}, nil
+}
+
+func toProtoProfile(profile *domain.Profile) *authv1.Profile {
+	resp := &authv1.Profile{
+		UserId:    profile.UserID,
+		FirstName: profile.FirstName,
+		LastName:  profile.LastName,
+		Phone:     profile.Phone,
+		Email:     profile.Email,
+	}
+	if !profile.UpdatedAt.IsZero() {
+		resp.UpdatedAt = profile.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	return resp
+}
+
+func fromProtoAddress(address *authv1.Address) domain.Address {
+	return domain.Address{
+		ID:             address.Id,
+		Label:          address.Label,
+		RecipientName:  address.RecipientName,
+		RecipientPhone: address.RecipientPhone,
+		Country:        address.Country,
+		Region:         address.Region,
+		City:           address.City,
+		Street:         address.Street,
+		House:          address.House,
+		Apartment:      address.Apartment,
+		PostalCode:     address.PostalCode,
+		Comment:        address.Comment,
+		IsDefault:      address.IsDefault,
+	}
+}
+
+func toProtoAddress(address *domain.Address) *authv1.Address {
+	return &authv1.Address{
+		Id:             address.ID,
+		Label:          address.Label,
+		RecipientName:  address.RecipientName,
+		RecipientPhone: address.RecipientPhone,
+		Country:        address.Country,
+		Region:         address.Region,
+		City:           address.City,
+		Street:         address.Street,
+		House:          address.House,
+		Apartment:      address.Apartment,
+		PostalCode:     address.PostalCode,
+		Comment:        address.Comment,
+		IsDefault:      address.IsDefault,
+		CreatedAt:      address.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:      address.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+func toProtoAddresses(addresses []domain.Address) []*authv1.Address {
+	resp := make([]*authv1.Address, 0, len(addresses))
+	for i := range addresses {
+		resp = append(resp, toProtoAddress(&addresses[i]))
+	}
+	return resp
+}
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AuthGRPCServer) Register(
+	ctx context.Context,
+	req *authv1.RegisterRequest,
+) (*authv1.RegisterResponse, error) {
+	if req.Email == "" || req.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "email and password are required")
+	}
+
+	user, err := s.registrationService.Register(ctx, req.Login, req.Email, req.Password)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.RegisterResponse{
+		UserId: user.ID,
+		Login:  user.Login,
+		Email:  user.Email,
+	}, nil
+}
+
+func (s *AuthGRPCServer) VerifyEmail(
+	ctx context.Context,
+	req *authv1.VerifyEmailRequest,
+) (*authv1.VerifyEmailResponse, error) {
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "token is required")
+	}
+
+	err := s.registrationService.VerifyEmail(ctx, req.Token)
+	switch {
+	case errors.Is(err, domain.ErrTokenExpired):
+		return nil, status.Error(codes.FailedPrecondition, "verification link expired")
+	case errors.Is(err, domain.ErrInvalidToken):
+		return nil, status.Error(codes.InvalidArgument, "invalid verification token")
+	case err != nil:
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.VerifyEmailResponse{Success: true}, nil
+}
+
+func (s *AuthGRPCServer) ResendVerification(
+	ctx context.Context,
+	req *authv1.ResendVerificationRequest,
+) (*authv1.ResendVerificationResponse, error) {
+	if req.Email == "" {
+		return nil, status.Error(codes.InvalidArgument, "email is required")
+	}
+
+	if err := s.registrationService.ResendVerification(ctx, req.Email); err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.ResendVerificationResponse{Success: true}, nil
+}
@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *AuthGRPCServer) ListSessions(
+	ctx context.Context,
+	_ *authv1.ListSessionsRequest,
+) (*authv1.ListSessionsResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	sessions, err := s.authService.ListSessions(ctx, userID, refreshTokenFromContext(ctx))
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	resp := &authv1.ListSessionsResponse{
+		Sessions: make([]*authv1.Session, 0, len(sessions)),
+	}
+	for _, session := range sessions {
+		resp.Sessions = append(resp.Sessions, toProtoSession(session))
+	}
+	return resp, nil
+}
+
+func (s *AuthGRPCServer) RevokeSession(
+	ctx context.Context,
+	req *authv1.RevokeSessionRequest,
+) (*authv1.RevokeSessionResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "session id is required")
+	}
+
+	if err := s.authService.RevokeSession(ctx, userID, req.Id); err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &authv1.RevokeSessionResponse{Success: true}, nil
+}
+
+func (s *AuthGRPCServer) RevokeAllOtherSessions(
+	ctx context.Context,
+	_ *authv1.RevokeAllOtherSessionsRequest,
+) (*authv1.RevokeAllOtherSessionsResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	refreshToken := refreshTokenFromContext(ctx)
+	if refreshToken == "" {
+		return nil, status.Error(codes.Unauthenticated, "refresh token is missing in cookies")
+	}
+
+	revoked, err := s.authService.RevokeAllOtherSessions(ctx, userID, refreshToken)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &authv1.RevokeAllOtherSessionsResponse{RevokedCount: revoked}, nil
+}
+
+func toProtoSession(session domain.Session) *authv1.Session {
+	return &authv1.Session{
+		Id:         session.FamilyID,
+		UserAgent:  session.UserAgent,
+		IpAddress:  session.IPAddress,
+		CreatedAt:  session.CreatedAt.UTC().Format(time.RFC3339),
+		LastUsedAt: session.LastUsedAt.UTC().Format(time.RFC3339),
+		ExpiresAt:  session.ExpiresAt.UTC().Format(time.RFC3339),
+		Current:    session.Current,
+	}
+}
@@ -14,31 +14,26 @@ import (
 type UserGRPCServer struct {
 	authv1.UnimplementedUserServiceServer
 	userService services.UserService
-	authService services.AuthService
 }
 
-func NewUserGRPCServer(
-	userService services.UserService,
-	authService services.AuthService,
-) *UserGRPCServer {
-	return &UserGRPCServer{
-		userService: userService,
-		authService: authService,
-	}
+func NewUserGRPCServer(userService services.UserService) *UserGRPCServer {
+	return &UserGRPCServer{userService: userService}
 }
 
 func (s *UserGRPCServer) CreateUser(
 	ctx context.Context,
 	req *authv1.CreateUserRequest,
 ) (*authv1.CreateUserResponse, error) {
-	if err := requireAdmin(ctx, s.authService); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.Login == "" || req.Password == "" {
 		return nil, status.Error(codes.InvalidArgument, "login and password are required")
 	}
 
-	user, err := s.userService.CreateUser(ctx, req.Login, req.Password, req.Role)
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	user, err := s.userService.CreateUser(ctx, actor, req.Login, req.Password, req.Role)
 	if err != nil {
 		return nil, mapServiceError(err)
 	}
@@ -50,14 +45,16 @@ func (s *UserGRPCServer) UpdateUser(
 	ctx context.Context,
 	req *authv1.UpdateUserRequest,
 ) (*authv1.UpdateUserResponse, error) {
-	if err := requireAdmin(ctx, s.authService); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "user id is required")
 	}
 
-	user, err := s.userService.UpdateUser(ctx, req.Id, req.Login, req.Password, req.Role)
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	user, err := s.userService.UpdateUser(ctx, actor, req.Id, req.Login, req.Password, req.Role)
 	if err != nil {
 		return nil, mapServiceError(err)
 	}
@@ -69,14 +66,16 @@ func (s *UserGRPCServer) DeleteUser(
 	ctx context.Context,
 	req *authv1.DeleteUserRequest,
 ) (*authv1.DeleteUserResponse, error) {
-	if err := requireAdmin(ctx, s.authService); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "user id is required")
 	}
 
-	if err := s.userService.DeleteUser(ctx, req.Id); err != nil {
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	if err := s.userService.DeleteUser(ctx, actor, req.Id); err != nil {
 		return nil, mapServiceError(err)
 	}
 
@@ -87,9 +86,6 @@ func (s *UserGRPCServer) GetUser(
 	ctx context.Context,
 	req *authv1.GetUserRequest,
 ) (*authv1.GetUserResponse, error) {
-	if err := requireAdmin(ctx, s.authService); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "user id is required")
 	}
@@ -102,12 +98,165 @@ func (s *UserGRPCServer) GetUser(
 	return &authv1.GetUserResponse{User: toProtoUser(user)}, nil
 }
 
+func (s *UserGRPCServer) ListUsers(
+	ctx context.Context,
+	req *authv1.ListUsersRequest,
+) (*authv1.ListUsersResponse, error) {
+	result, err := s.userService.ListUsers(ctx, domain.UserFilter{
+		Search:   req.Search,
+		Role:     req.Role,
+		Status:   req.Status,
+		Sort:     req.Sort,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	resp := &authv1.ListUsersResponse{
+		Users:    make([]*authv1.User, 0, len(result.Users)),
+		Total:    result.Total,
+		Page:     result.Page,
+		PageSize: result.PageSize,
+	}
+	for i := range result.Users {
+		resp.Users = append(resp.Users, toProtoUser(&result.Users[i]))
+	}
+	return resp, nil
+}
+
+func (s *UserGRPCServer) DisableUser(
+	ctx context.Context,
+	req *authv1.DisableUserRequest,
+) (*authv1.DisableUserResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	user, err := s.userService.DisableUser(ctx, actor, req.Id)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.DisableUserResponse{User: toProtoUser(user)}, nil
+}
+
+func (s *UserGRPCServer) EnableUser(
+	ctx context.Context,
+	req *authv1.EnableUserRequest,
+) (*authv1.EnableUserResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	user, err := s.userService.EnableUser(ctx, actor, req.Id)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.EnableUserResponse{User: toProtoUser(user)}, nil
+}
+
+func (s *UserGRPCServer) RevokeUserTokens(
+	ctx context.Context,
+	req *authv1.RevokeUserTokensRequest,
+) (*authv1.RevokeUserTokensResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	actor, err := actorFromContext(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	if err := s.userService.RevokeUserTokens(ctx, actor, req.Id); err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.RevokeUserTokensResponse{Success: true}, nil
+}
+
+func (s *UserGRPCServer) UnlockUser(
+	ctx context.Context,
+	req *authv1.UnlockUserRequest,
+) (*authv1.UnlockUserResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	if err := s.userService.UnlockUser(ctx, req.Id); err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.UnlockUserResponse{Success: true}, nil
+}
+
+func (s *UserGRPCServer) ListRoles(
+	ctx context.Context,
+	_ *authv1.ListRolesRequest,
+) (*authv1.ListRolesResponse, error) {
+
+	roles, err := s.userService.ListRoles(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	resp := &authv1.ListRolesResponse{Roles: make([]*authv1.Role, 0, len(roles))}
+	for i := range roles {
+		resp.Roles = append(resp.Roles, toProtoRole(&roles[i]))
+	}
+	return resp, nil
+}
+
+func (s *UserGRPCServer) SaveRole(
+	ctx context.Context,
+	req *authv1.SaveRoleRequest,
+) (*authv1.SaveRoleResponse, error) {
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "role name is required")
+	}
+
+	role, err := s.userService.SaveRole(ctx, req.Name, req.Description, req.Permissions)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	return &authv1.SaveRoleResponse{Role: toProtoRole(role)}, nil
+}
+
+func toProtoRole(role *domain.Role) *authv1.Role {
+	return &authv1.Role{
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: role.Permissions,
+		CreatedAt:   role.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:   role.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
 func toProtoUser(user *domain.User) *authv1.User {
 	return &authv1.User{
-		Id:        user.ID,
-		Login:     user.Login,
-		Role:      user.Role,
-		CreatedAt: user.CreatedAt.UTC().Format(time.RFC3339),
-		UpdatedAt: user.UpdatedAt.UTC().Format(time.RFC3339),
+		Id:            user.ID,
+		Login:         user.Login,
+		Role:          user.Role,
+		Email:         user.Email,
+		EmailVerified: user.EmailVerified,
+		IsActive:      user.IsActive(),
+		DisabledAt:    formatOptionalTime(user.DisabledAt),
+		LastLoginAt:   formatOptionalTime(user.LastLoginAt),
+		CreatedAt:     user.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:     user.UpdatedAt.UTC().Format(time.RFC3339),
 	}
 }
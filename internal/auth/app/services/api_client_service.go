@@ -0,0 +1,199 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/database/postgres"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
+	pkgjwt "github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+)
+
+const maxAPIClientNameLength = 100
+
+// APIClientService manages credentials for integrations and exchanges them
+// for access tokens (the OAuth 2.0 client credentials grant).
+type APIClientService interface {
+	ListClients(ctx context.Context) ([]domain.APIClient, error)
+	// CreateClient registers a client whose tokens grant scopes, which
+	// must all be permissions actor holds. A zero ttl creates a client that
+	// never expires.
+	CreateClient(
+		ctx context.Context,
+		actor domain.Actor,
+		name string,
+		scopes []string,
+		ttl time.Duration,
+	) (*domain.APIClientCredentials, error)
+	// RotateSecret replaces the secret; the old one and the tokens issued
+	// with it stop working at once.
+	RotateSecret(ctx context.Context, id string) (*domain.APIClientCredentials, error)
+	// RevokeClient disables the client and revokes the tokens it holds.
+	RevokeClient(ctx context.Context, id string) error
+	// IssueToken returns an access token for valid client credentials and
+	// ErrInvalidCredentials otherwise.
+	IssueToken(ctx context.Context, clientID, secret string) (string, time.Time, error)
+}
+
+type apiClientService struct {
+	clients     postgres.APIClientRepository
+	revocations postgres.RevocationRepository
+	tokens      *utils.TokenIssuer
+	now         func() time.Time
+}
+
+func NewAPIClientService(
+	clients postgres.APIClientRepository,
+	revocations postgres.RevocationRepository,
+	tokens *utils.TokenIssuer,
+) APIClientService {
+	return &apiClientService{
+		clients:     clients,
+		revocations: revocations,
+		tokens:      tokens,
+		now:         time.Now,
+	}
+}
+
+func (s *apiClientService) ListClients(ctx context.Context) ([]domain.APIClient, error) {
+	return s.clients.ListClients(ctx)
+}
+
+func (s *apiClientService) CreateClient(
+	ctx context.Context,
+	actor domain.Actor,
+	name string,
+	scopes []string,
+	ttl time.Duration,
+) (*domain.APIClientCredentials, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > maxAPIClientNameLength {
+		return nil, fmt.Errorf("%w: name must be 1 to %d characters", domain.ErrInvalidArgument, maxAPIClientNameLength)
+	}
+	if len(scopes) == 0 {
+		return nil, fmt.Errorf("%w: at least one scope is required", domain.ErrInvalidArgument)
+	}
+	for _, scope := range scopes {
+		if !pkgjwt.IsKnownPermission(scope) {
+			return nil, fmt.Errorf("%w: unknown scope %q", domain.ErrInvalidArgument, scope)
+		}
+	}
+	if missing := actor.MissingPermission(scopes); missing != "" {
+		return nil, fmt.Errorf("%w: scope %q is not one of your permissions", domain.ErrForbidden, missing)
+	}
+	if ttl < 0 {
+		return nil, fmt.Errorf("%w: ttl must not be negative", domain.ErrInvalidArgument)
+	}
+
+	secret, err := utils.GenerateClientSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	client := &domain.APIClient{
+		ID:         uuid.NewString(),
+		Name:       name,
+		SecretHash: utils.HashString(secret),
+		Scopes:     slices.Compact(slices.Sorted(slices.Values(scopes))),
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if ttl > 0 {
+		expiresAt := now.Add(ttl)
+		client.ExpiresAt = &expiresAt
+	}
+	if actor.UserID != "" {
+		client.CreatedBy = &actor.UserID
+	}
+
+	if err := s.clients.CreateClient(ctx, client); err != nil {
+		return nil, err
+	}
+	return &domain.APIClientCredentials{Client: client, Secret: secret}, nil
+}
+
+func (s *apiClientService) RotateSecret(ctx context.Context, id string) (*domain.APIClientCredentials, error) {
+	secret, err := utils.GenerateClientSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.clients.UpdateSecret(ctx, id, utils.HashString(secret), s.now()); err != nil {
+		return nil, err
+	}
+
+	// Whoever held the old secret may still hold tokens issued with it.
+	if err := revokeAccessTokensIssuedSoFar(ctx, s.revocations, id); err != nil {
+		return nil, err
+	}
+
+	client, err := s.clients.GetClient(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.APIClientCredentials{Client: client, Secret: secret}, nil
+}
+
+func (s *apiClientService) RevokeClient(ctx context.Context, id string) error {
+	if err := s.clients.RevokeClient(ctx, id, s.now()); err != nil {
+		return err
+	}
+	// Client tokens carry the client id as their subject, so the per-user
+	// cutoff covers them and reaches the other services' verifiers.
+	return revokeAccessTokensIssuedSoFar(ctx, s.revocations, id)
+}
+
+func (s *apiClientService) IssueToken(ctx context.Context, clientID, secret string) (string, time.Time, error) {
+	if uuid.Validate(clientID) != nil || secret == "" {
+		return "", time.Time{}, domain.ErrInvalidCredentials
+	}
+
+	client, err := s.clients.GetClient(ctx, clientID)
+	if err != nil {
+		if errors.Is(err, domain.ErrAPIClientNotFound) {
+			return "", time.Time{}, s.rejectClient(ctx, clientID, "unknown client")
+		}
+		return "", time.Time{}, err
+	}
+	if subtle.ConstantTimeCompare([]byte(client.SecretHash), []byte(utils.HashString(secret))) != 1 {
+		return "", time.Time{}, s.rejectClient(ctx, clientID, "wrong secret")
+	}
+
+	now := s.now()
+	if !client.IsActive(now) {
+		return "", time.Time{}, s.rejectClient(ctx, clientID, "client revoked or expired")
+	}
+
+	// A token never outlives the client it was issued to.
+	ttl := accessTokenTTL
+	if client.ExpiresAt != nil && client.ExpiresAt.Sub(now) < ttl {
+		ttl = client.ExpiresAt.Sub(now)
+	}
+	token, err := s.tokens.GenerateJWT(client.ID, domain.RoleAPIClient, client.Scopes, ttl)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	if err := s.clients.TouchLastUsed(ctx, client.ID, now); err != nil {
+		return "", time.Time{}, err
+	}
+	return token, now.Add(ttl), nil
+}
+
+func (s *apiClientService) rejectClient(ctx context.Context, clientID, reason string) error {
+	slog.WarnContext(ctx, "security event: API client authentication failed",
+		"event", "api_client_auth_failed",
+		"client_id", clientID,
+		"reason", reason,
+	)
+	return domain.ErrInvalidCredentials
+}
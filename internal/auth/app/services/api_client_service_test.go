@@ -0,0 +1,297 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	pkgjwt "github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+)
+
+// memAPIClients mirrors the postgres repository, including refusing to
+// touch revoked clients.
+type memAPIClients struct {
+	mu      sync.Mutex
+	clients map[string]domain.APIClient
+}
+
+func newMemAPIClients() *memAPIClients {
+	return &memAPIClients{clients: map[string]domain.APIClient{}}
+}
+
+func (m *memAPIClients) CreateClient(_ context.Context, client *domain.APIClient) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	saved := *client
+	saved.Scopes = slices.Clone(client.Scopes)
+	m.clients[client.ID] = saved
+	return nil
+}
+
+func (m *memAPIClients) GetClient(_ context.Context, id string) (*domain.APIClient, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	client, ok := m.clients[id]
+	if !ok {
+		return nil, domain.ErrAPIClientNotFound
+	}
+	client.Scopes = slices.Clone(client.Scopes)
+	return &client, nil
+}
+
+func (m *memAPIClients) ListClients(_ context.Context) ([]domain.APIClient, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	clients := make([]domain.APIClient, 0, len(m.clients))
+	for _, client := range m.clients {
+		clients = append(clients, client)
+	}
+	return clients, nil
+}
+
+func (m *memAPIClients) UpdateSecret(_ context.Context, id, secretHash string, updatedAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	client, ok := m.clients[id]
+	if !ok || client.RevokedAt != nil {
+		return domain.ErrAPIClientNotFound
+	}
+	client.SecretHash = secretHash
+	client.UpdatedAt = updatedAt
+	m.clients[id] = client
+	return nil
+}
+
+func (m *memAPIClients) RevokeClient(_ context.Context, id string, revokedAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	client, ok := m.clients[id]
+	if !ok || client.RevokedAt != nil {
+		return domain.ErrAPIClientNotFound
+	}
+	client.RevokedAt = &revokedAt
+	client.UpdatedAt = revokedAt
+	m.clients[id] = client
+	return nil
+}
+
+func (m *memAPIClients) TouchLastUsed(_ context.Context, id string, usedAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	client, ok := m.clients[id]
+	if !ok {
+		return nil
+	}
+	client.LastUsedAt = &usedAt
+	m.clients[id] = client
+	return nil
+}
+
+func newTestAPIClientService(revocations *fakeRevocationRepo) (*apiClientService, *memAPIClients) {
+	clients := newMemAPIClients()
+	svc := NewAPIClientService(clients, revocations, mustTokenIssuer("client-key")).(*apiClientService)
+	return svc, clients
+}
+
+func TestAPIClientServiceIssuesScopedTokens(t *testing.T) {
+	ctx := context.Background()
+	svc, clients := newTestAPIClientService(&fakeRevocationRepo{})
+
+	admin := domain.Actor{UserID: "admin-1", Role: domain.RoleAdmin}
+	credentials, err := svc.CreateClient(ctx, admin, " erp sync ", []string{
+		pkgjwt.PermCatalogProductsWrite,
+		pkgjwt.PermCatalogProductsWrite,
+	}, 0)
+	if err != nil {
+		t.Fatalf("CreateClient returned error: %v", err)
+	}
+	client := credentials.Client
+	if client.Name != "erp sync" || client.ExpiresAt != nil || *client.CreatedBy != "admin-1" {
+		t.Fatalf("unexpected client: %+v", client)
+	}
+	if client.SecretHash == credentials.Secret {
+		t.Fatal("secret stored in plain text")
+	}
+
+	token, expiresAt, err := svc.IssueToken(ctx, client.ID, credentials.Secret)
+	if err != nil {
+		t.Fatalf("IssueToken returned error: %v", err)
+	}
+	if until := time.Until(expiresAt); until <= 0 || until > accessTokenTTL {
+		t.Fatalf("unexpected expiry in %v", until)
+	}
+
+	claims, err := svc.tokens.ParseJWT(token)
+	if err != nil {
+		t.Fatalf("failed to parse client token: %v", err)
+	}
+	if claims.UserID != client.ID || claims.Role != domain.RoleAPIClient {
+		t.Fatalf("unexpected subject %q with role %q", claims.UserID, claims.Role)
+	}
+	if !slices.Equal(claims.Permissions, []string{pkgjwt.PermCatalogProductsWrite}) {
+		t.Fatalf("expected the client scopes in the token, got %v", claims.Permissions)
+	}
+
+	stored, _ := clients.GetClient(ctx, client.ID)
+	if stored.LastUsedAt == nil {
+		t.Fatal("expected last use to be recorded")
+	}
+}
+
+func TestAPIClientServiceRejectsInvalidClients(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestAPIClientService(&fakeRevocationRepo{})
+
+	for _, tc := range []struct {
+		name   string
+		scopes []string
+		ttl    time.Duration
+	}{
+		{name: "", scopes: []string{pkgjwt.PermCatalogProductsWrite}},
+		{name: "no scopes"},
+		{name: "unknown scope", scopes: []string{"catalog.everything"}},
+		{name: "negative ttl", scopes: []string{pkgjwt.PermCatalogProductsWrite}, ttl: -time.Hour},
+	} {
+		if _, err := svc.CreateClient(ctx, testAdmin, tc.name, tc.scopes, tc.ttl); !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Fatalf("%q: expected ErrInvalidArgument, got %v", tc.name, err)
+		}
+	}
+}
+
+func TestAPIClientServiceScopesLimitedToCallerPermissions(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestAPIClientService(&fakeRevocationRepo{})
+	integrator := domain.Actor{
+		UserID:      "integrator-1",
+		Role:        "integrator",
+		Permissions: []string{pkgjwt.PermAPIClientsManage, pkgjwt.PermCatalogStockWrite},
+	}
+
+	if _, err := svc.CreateClient(ctx, integrator, "erp", []string{pkgjwt.PermCatalogStockWrite}, 0); err != nil {
+		t.Fatalf("expected a scope the caller holds to be allowed, got %v", err)
+	}
+	for _, scopes := range [][]string{
+		{pkgjwt.PermUsersManage},
+		{pkgjwt.PermCatalogStockWrite, pkgjwt.PermCatalogPricesWrite},
+	} {
+		if _, err := svc.CreateClient(ctx, integrator, "erp", scopes, 0); !errors.Is(err, domain.ErrForbidden) {
+			t.Fatalf("%v: expected ErrForbidden, got %v", scopes, err)
+		}
+	}
+}
+
+func TestAPIClientServiceRejectsBadCredentials(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestAPIClientService(&fakeRevocationRepo{})
+
+	credentials, err := svc.CreateClient(ctx, testAdmin, "erp", []string{pkgjwt.PermCatalogStockWrite}, 0)
+	if err != nil {
+		t.Fatalf("CreateClient returned error: %v", err)
+	}
+
+	for _, tc := range []struct{ id, secret string }{
+		{credentials.Client.ID, "wrong"},
+		{credentials.Client.ID, ""},
+		{"not-a-uuid", credentials.Secret},
+		{"5f0c6b0e-8d51-4a43-9d8a-3c1f2f3e4d5a", credentials.Secret},
+	} {
+		if _, _, err := svc.IssueToken(ctx, tc.id, tc.secret); !errors.Is(err, domain.ErrInvalidCredentials) {
+			t.Fatalf("IssueToken(%q, %q): expected ErrInvalidCredentials, got %v", tc.id, tc.secret, err)
+		}
+	}
+}
+
+func TestAPIClientServiceTokensNeverOutliveClient(t *testing.T) {
+	ctx := context.Background()
+	svc, _ := newTestAPIClientService(&fakeRevocationRepo{})
+	clock := &fakeClock{now: time.Now()}
+	svc.now = clock.Now
+
+	credentials, err := svc.CreateClient(ctx, testAdmin, "erp", []string{pkgjwt.PermCatalogStockWrite}, accessTokenTTL+time.Minute)
+	if err != nil {
+		t.Fatalf("CreateClient returned error: %v", err)
+	}
+
+	clock.Advance(10 * time.Minute)
+	_, expiresAt, err := svc.IssueToken(ctx, credentials.Client.ID, credentials.Secret)
+	if err != nil {
+		t.Fatalf("IssueToken returned error: %v", err)
+	}
+	if !expiresAt.Equal(*credentials.Client.ExpiresAt) {
+		t.Fatalf("expected token to expire with the client at %v, got %v", credentials.Client.ExpiresAt, expiresAt)
+	}
+
+	clock.Advance(10 * time.Minute)
+	if _, _, err := svc.IssueToken(ctx, credentials.Client.ID, credentials.Secret); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("expected expired client to be rejected, got %v", err)
+	}
+}
+
+func TestAPIClientServiceRotateSecret(t *testing.T) {
+	ctx := context.Background()
+	var cutoffFor string
+	svc, _ := newTestAPIClientService(&fakeRevocationRepo{
+		revokeUserTokensBeforeFn: func(_ context.Context, userID string, _ time.Time) error {
+			cutoffFor = userID
+			return nil
+		},
+	})
+
+	credentials, err := svc.CreateClient(ctx, testAdmin, "erp", []string{pkgjwt.PermCatalogStockWrite}, 0)
+	if err != nil {
+		t.Fatalf("CreateClient returned error: %v", err)
+	}
+	rotated, err := svc.RotateSecret(ctx, credentials.Client.ID)
+	if err != nil {
+		t.Fatalf("RotateSecret returned error: %v", err)
+	}
+	if rotated.Secret == credentials.Secret {
+		t.Fatal("expected a new secret")
+	}
+	if cutoffFor != credentials.Client.ID {
+		t.Fatalf("expected tokens issued with the old secret to be revoked, got cutoff for %q", cutoffFor)
+	}
+
+	if _, _, err := svc.IssueToken(ctx, credentials.Client.ID, credentials.Secret); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("expected the old secret to be rejected, got %v", err)
+	}
+	if _, _, err := svc.IssueToken(ctx, credentials.Client.ID, rotated.Secret); err != nil {
+		t.Fatalf("expected the new secret to work, got %v", err)
+	}
+}
+
+func TestAPIClientServiceRevokeClient(t *testing.T) {
+	ctx := context.Background()
+	var cutoffFor string
+	svc, _ := newTestAPIClientService(&fakeRevocationRepo{
+		revokeUserTokensBeforeFn: func(_ context.Context, userID string, _ time.Time) error {
+			cutoffFor = userID
+			return nil
+		},
+	})
+
+	credentials, err := svc.CreateClient(ctx, testAdmin, "erp", []string{pkgjwt.PermCatalogStockWrite}, 0)
+	if err != nil {
+		t.Fatalf("CreateClient returned error: %v", err)
+	}
+	if err := svc.RevokeClient(ctx, credentials.Client.ID); err != nil {
+		t.Fatalf("RevokeClient returned error: %v", err)
+	}
+	if cutoffFor != credentials.Client.ID {
+		t.Fatalf("expected issued tokens of %s to be revoked, got cutoff for %q", credentials.Client.ID, cutoffFor)
+	}
+
+	if _, _, err := svc.IssueToken(ctx, credentials.Client.ID, credentials.Secret); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("expected revoked client to be rejected, got %v", err)
+	}
+	if _, err := svc.RotateSecret(ctx, credentials.Client.ID); !errors.Is(err, domain.ErrAPIClientNotFound) {
+		t.Fatalf("expected rotating a revoked client to fail, got %v", err)
+	}
+	if err := svc.RevokeClient(ctx, "missing"); !errors.Is(err, domain.ErrAPIClientNotFound) {
+		t.Fatalf("expected ErrAPIClientNotFound, got %v", err)
+	}
+}
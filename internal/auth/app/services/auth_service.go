@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -12,75 +15,255 @@ import (
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/database/postgres"
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
+	pkgjwt "github.com/KarpovYuri/caraudio-backend/pkg/jwt"
 )
 
 const (
 	accessTokenTTL       = 15 * time.Minute
 	defaultRefreshTTL    = 24 * time.Hour
 	rememberMeRefreshTTL = 30 * 24 * time.Hour
+	// DefaultMaxSessions is used when no per-user session cap is configured.
+	DefaultMaxSessions = 10
+	// DefaultRefreshReuseGrace is how long a just-rotated refresh token is
+	// still accepted, so that two tabs refreshing at once do not look like
+	// token theft.
+	DefaultRefreshReuseGrace = 10 * time.Second
+
+	// revocationClockMargin covers verifier leeway and clock skew when
+	// deciding which revocations can still matter.
+	revocationClockMargin = 5 * time.Minute
+	// RevocationRetention is how long a user cutoff can affect tokens.
+	RevocationRetention = accessTokenTTL + revocationClockMargin
 )
 
+type AuthOptions struct {
+	MaxSessions       int
+	RefreshReuseGrace time.Duration
+	LoginProtection   LoginProtectionOptions
+	MFA               MFAOptions
+}
+
+// LoginProtectionOptions limits password guessing. Failures are counted per
+// login name and per client IP within Window; reaching the limit locks the
+// key for Lockout. Between failures of a login the client must also wait a
+// delay that doubles from BaseDelay up to MaxDelay.
+type LoginProtectionOptions struct {
+	MaxFailures      int
+	MaxFailuresPerIP int
+	Window           time.Duration
+	Lockout          time.Duration
+	BaseDelay        time.Duration
+	MaxDelay         time.Duration
+}
+
+// DefaultLoginProtection is used for every option left unset.
+var DefaultLoginProtection = LoginProtectionOptions{
+	MaxFailures:      5,
+	MaxFailuresPerIP: 50,
+	Window:           15 * time.Minute,
+	Lockout:          15 * time.Minute,
+	BaseDelay:        time.Second,
+	MaxDelay:         30 * time.Second,
+}
+
 type AuthService interface {
 	Login(
 		ctx context.Context,
 		login, password string,
 		rememberMe bool,
-	) (*domain.User, string, string, error)
+		client domain.ClientInfo,
+	) (*domain.LoginResult, error)
 
-	Refresh(ctx context.Context, refreshToken string) (string, error)
+	// LoginUser continues the login of a user authenticated by other means,
+	// such as a social login provider, exactly as Login does after checking
+	// the password: disabled users are refused and MFA is enforced.
+	LoginUser(
+		ctx context.Context,
+		user *domain.User,
+		rememberMe bool,
+		client domain.ClientInfo,
+	) (*domain.LoginResult, error)
+
+	// Refresh rotates the refresh token. newRefreshToken is empty when the
+	// presented token was rotated moments ago by a concurrent request; the
+	// client already holds its successor.
+	Refresh(
+		ctx context.Context,
+		refreshToken string,
+		client domain.ClientInfo,
+	) (accessToken, newRefreshToken string, refreshExpiresAt time.Time, err error)
+
+	ListSessions(ctx context.Context, userID, refreshToken string) ([]domain.Session, error)
+	RevokeSession(ctx context.Context, userID, sessionID string) error
+	RevokeAllOtherSessions(ctx context.Context, userID, refreshToken string) (int64, error)
 
 	ValidateToken(
 		ctx context.Context,
 		accessToken string,
 	) (userID, role string, isValid bool, err error)
 
-	Logout(ctx context.Context, refreshToken string) error
+	// Authenticate returns the claims of a valid, unrevoked access token
+	// and ErrUnauthorized for any other token.
+	Authenticate(ctx context.Context, accessToken string) (*pkgjwt.Claims, error)
+
+	// Logout ends the refresh token's session and revokes the access token
+	// presented with the request, if any.
+	Logout(ctx context.Context, refreshToken, accessToken string) error
+
+	ListRevocations(ctx context.Context) ([]domain.RevokedAccessToken, []domain.UserTokenCutoff, error)
+
+	// EnrollMFA starts (or restarts) a TOTP enrollment for the user.
+	EnrollMFA(ctx context.Context, userID string) (*domain.MFAEnrollment, error)
+	// ConfirmMFA enables the pending enrollment once code proves the
+	// authenticator works and returns the recovery codes, shown only once.
+	ConfirmMFA(ctx context.Context, userID, code string) ([]string, error)
+	// EnrollmentChallengeUser returns the user of a login challenge that
+	// requires enrollment, letting that user call EnrollMFA before having
+	// any tokens.
+	EnrollmentChallengeUser(ctx context.Context, challengeToken string) (string, error)
+	// VerifyMFA completes a login challenge with a TOTP or recovery code.
+	VerifyMFA(
+		ctx context.Context,
+		challengeToken, code string,
+		client domain.ClientInfo,
+	) (*domain.LoginResult, error)
 }
 
 type authService struct {
-	userRepo  postgres.UserRepository
-	tokenRepo postgres.RefreshTokenRepository
-	jwtSecret string
+	userRepo    postgres.UserRepository
+	tokenRepo   postgres.RefreshTokenRepository
+	revocations postgres.RevocationRepository
+	throttles   postgres.LoginThrottleRepository
+	mfa         postgres.MFARepository
+	roles       postgres.RoleRepository
+	tokens      *utils.TokenIssuer
+	options     AuthOptions
+	now         func() time.Time
 }
 
 func NewAuthService(
 	userRepo postgres.UserRepository,
 	tokenRepo postgres.RefreshTokenRepository,
-	jwtSecret string,
+	revocations postgres.RevocationRepository,
+	throttles postgres.LoginThrottleRepository,
+	mfa postgres.MFARepository,
+	roles postgres.RoleRepository,
+	tokens *utils.TokenIssuer,
+	options AuthOptions,
 ) AuthService {
+	if options.MaxSessions <= 0 {
+		options.MaxSessions = DefaultMaxSessions
+	}
+	if options.RefreshReuseGrace < 0 {
+		options.RefreshReuseGrace = 0
+	}
+	options.LoginProtection = options.LoginProtection.withDefaults()
+	options.MFA = options.MFA.withDefaults()
 	return &authService{
-		userRepo:  userRepo,
-		tokenRepo: tokenRepo,
-		jwtSecret: jwtSecret,
+		userRepo:    userRepo,
+		tokenRepo:   tokenRepo,
+		revocations: revocations,
+		throttles:   throttles,
+		mfa:         mfa,
+		roles:       roles,
+		tokens:      tokens,
+		options:     options,
+		now:         time.Now,
+	}
+}
+
+func (o LoginProtectionOptions) withDefaults() LoginProtectionOptions {
+	if o.MaxFailures <= 0 {
+		o.MaxFailures = DefaultLoginProtection.MaxFailures
+	}
+	if o.MaxFailuresPerIP <= 0 {
+		o.MaxFailuresPerIP = DefaultLoginProtection.MaxFailuresPerIP
+	}
+	if o.Window <= 0 {
+		o.Window = DefaultLoginProtection.Window
+	}
+	if o.Lockout <= 0 {
+		o.Lockout = DefaultLoginProtection.Lockout
 	}
+	if o.BaseDelay <= 0 {
+		o.BaseDelay = DefaultLoginProtection.BaseDelay
+	}
+	if o.MaxDelay < o.BaseDelay {
+		o.MaxDelay = max(o.BaseDelay, DefaultLoginProtection.MaxDelay)
+	}
+	return o
 }
 
 func (s *authService) Login(
 	ctx context.Context,
 	login, password string,
 	rememberMe bool,
-) (*domain.User, string, string, error) {
+	client domain.ClientInfo,
+) (*domain.LoginResult, error) {
+
+	if len(login) > domain.MaxLoginLength {
+		return nil, domain.ErrInvalidCredentials
+	}
+	if err := s.checkLoginAllowed(ctx, login, client.IPAddress); err != nil {
+		return nil, err
+	}
 
 	user, err := s.userRepo.GetUserByLogin(ctx, login)
 	if err != nil {
-		if errors.Is(err, domain.ErrUserNotFound) {
-			return nil, "", "", domain.ErrInvalidCredentials
+		if !errors.Is(err, domain.ErrUserNotFound) {
+			return nil, err
 		}
-		return nil, "", "", err
+		// Spend the same bcrypt time as for a real account so response
+		// times do not reveal which logins exist.
+		utils.CheckPasswordHash(password, dummyPasswordHash())
+		return nil, s.recordLoginFailure(ctx, login, client)
 	}
 
 	if !utils.CheckPasswordHash(password, user.Password) {
-		return nil, "", "", domain.ErrInvalidCredentials
+		return nil, s.recordLoginFailure(ctx, login, client)
 	}
 
-	accessToken, err := utils.GenerateJWT(
-		user.ID,
-		user.Role,
-		s.jwtSecret,
-		accessTokenTTL,
-	)
+	if err := s.throttles.Reset(ctx, domain.LoginScopeAccount, login); err != nil {
+		return nil, err
+	}
+	// The state of the account is checked only after the password so it is
+	// not revealed to someone who does not know it.
+	return s.LoginUser(ctx, user, rememberMe, client)
+}
+
+func (s *authService) LoginUser(
+	ctx context.Context,
+	user *domain.User,
+	rememberMe bool,
+	client domain.ClientInfo,
+) (*domain.LoginResult, error) {
+	if !user.IsActive() {
+		return nil, domain.ErrUserDisabled
+	}
+
+	challenge, err := s.challengeIfMFARequired(ctx, user, rememberMe)
+	if err != nil || challenge != nil {
+		return challenge, err
+	}
+	return s.startSession(ctx, user, rememberMe, client)
+}
+
+// startSession issues the access token and a new refresh token session and
+// records the login.
+func (s *authService) startSession(
+	ctx context.Context,
+	user *domain.User,
+	rememberMe bool,
+	client domain.ClientInfo,
+) (*domain.LoginResult, error) {
+	// An MFA challenge may have been answered after the user was disabled.
+	if !user.IsActive() {
+		return nil, domain.ErrUserDisabled
+	}
+
+	accessToken, err := s.issueAccessToken(ctx, user)
 	if err != nil {
-		return nil, "", "", err
+		return nil, err
 	}
 
 	refreshTTL := defaultRefreshTTL
@@ -88,76 +271,379 @@ func (s *authService) Login(
 		refreshTTL = rememberMeRefreshTTL
 	}
 
-	_ = s.tokenRepo.DeleteByUserId(ctx, user.ID)
-
 	refreshToken := uuid.NewString()
 	refreshTokenHash := utils.HashString(refreshToken)
 
-	err = s.tokenRepo.ReplaceForUser(ctx, &domain.RefreshToken{
-		ID:        uuid.NewString(),
-		UserID:    user.ID,
-		TokenHash: refreshTokenHash,
-		ExpiresAt: time.Now().Add(refreshTTL),
-		CreatedAt: time.Now(),
-	})
+	now := time.Now()
+	expiresAt := now.Add(refreshTTL)
+	tokenID := uuid.NewString()
+	_, err = s.tokenRepo.CreateSession(ctx, &domain.RefreshToken{
+		ID:         tokenID,
+		FamilyID:   tokenID,
+		UserID:     user.ID,
+		TokenHash:  refreshTokenHash,
+		UserAgent:  truncateUserAgent(client.UserAgent),
+		IPAddress:  client.IPAddress,
+		ExpiresAt:  expiresAt,
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}, s.options.MaxSessions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userRepo.RecordLogin(ctx, user.ID, now); err != nil {
+		return nil, err
+	}
+	user.LastLoginAt = &now
+
+	return &domain.LoginResult{
+		User:             user,
+		AccessToken:      accessToken,
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: expiresAt,
+	}, nil
+}
+
+// issueAccessToken signs an access token carrying the permissions the
+// user's role grants at this moment.
+func (s *authService) issueAccessToken(ctx context.Context, user *domain.User) (string, error) {
+	permissions, err := rolePermissions(ctx, s.roles, user.Role)
+	if err != nil {
+		return "", err
+	}
+	return s.tokens.GenerateJWT(user.ID, user.Role, permissions, accessTokenTTL)
+}
+
+// rolePermissions returns what the role grants. The admin role holds every
+// permission, including ones added after it was created.
+func rolePermissions(ctx context.Context, roles postgres.RoleRepository, name string) ([]string, error) {
+	if name == domain.RoleAdmin {
+		return slices.Clone(pkgjwt.AllPermissions), nil
+	}
+	role, err := roles.GetRole(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return role.Permissions, nil
+}
+
+// dummyPasswordHash is compared against when the login does not exist.
+var dummyPasswordHash = sync.OnceValue(func() string {
+	hash, err := utils.HashPassword(uuid.NewString())
 	if err != nil {
-		return nil, "", "", err
+		panic(err)
+	}
+	return hash
+})
+
+// checkLoginAllowed rejects the attempt without looking at the password
+// while the login or client IP is locked out, or when the login's last
+// failure was too recent.
+func (s *authService) checkLoginAllowed(ctx context.Context, login, ip string) error {
+	now := s.now()
+	windowStart := now.Add(-s.options.LoginProtection.Window)
+
+	throttle, err := s.throttles.Get(ctx, domain.LoginScopeAccount, login)
+	if err != nil {
+		return err
+	}
+	if throttle != nil {
+		if throttle.IsLocked(now) {
+			return domain.ErrTooManyLoginAttempts
+		}
+		if throttle.FirstFailedAt.After(windowStart) &&
+			now.Before(throttle.LastFailedAt.Add(s.loginDelay(throttle.Failures))) {
+			return domain.ErrTooManyLoginAttempts
+		}
+	}
+
+	if ip == "" {
+		return nil
+	}
+	throttle, err = s.throttles.Get(ctx, domain.LoginScopeIP, ip)
+	if err != nil {
+		return err
+	}
+	if throttle != nil && throttle.IsLocked(now) {
+		return domain.ErrTooManyLoginAttempts
+	}
+	return nil
+}
+
+// loginDelay is the wait required after the given number of failures.
+func (s *authService) loginDelay(failures int) time.Duration {
+	delay := s.options.LoginProtection.BaseDelay
+	for i := 1; i < failures && delay < s.options.LoginProtection.MaxDelay; i++ {
+		delay *= 2
 	}
+	return min(delay, s.options.LoginProtection.MaxDelay)
+}
+
+// recordLoginFailure counts the failure against the login and the client IP
+// and returns the error to report to the caller.
+func (s *authService) recordLoginFailure(
+	ctx context.Context,
+	login string,
+	client domain.ClientInfo,
+) error {
+	now := s.now()
+	protection := s.options.LoginProtection
+	windowStart := now.Add(-protection.Window)
+	lockUntil := now.Add(protection.Lockout)
 
-	return user, accessToken, refreshToken, nil
+	keys := []struct {
+		scope, key  string
+		maxFailures int
+	}{
+		{domain.LoginScopeAccount, login, protection.MaxFailures},
+		{domain.LoginScopeIP, client.IPAddress, protection.MaxFailuresPerIP},
+	}
+	for _, k := range keys {
+		if k.key == "" {
+			continue
+		}
+		throttle, err := s.throttles.RecordFailure(ctx, k.scope, k.key, now, windowStart, lockUntil, k.maxFailures)
+		if err != nil {
+			return err
+		}
+		if throttle.Failures == k.maxFailures {
+			slog.WarnContext(ctx, "security event: login locked out after repeated failures",
+				"event", "login_lockout",
+				"scope", k.scope,
+				"login", login,
+				"ip_address", client.IPAddress,
+				"failures", throttle.Failures,
+				"locked_until", throttle.LockedUntil,
+			)
+		}
+	}
+	return domain.ErrInvalidCredentials
 }
 
 func (s *authService) Refresh(
 	ctx context.Context,
 	refreshToken string,
-) (string, error) {
+	client domain.ClientInfo,
+) (string, string, time.Time, error) {
 
 	hash := utils.HashString(refreshToken)
 
 	rt, err := s.tokenRepo.GetByHash(ctx, hash)
 	if err != nil {
-		return "", domain.ErrInvalidToken
+		return "", "", time.Time{}, domain.ErrInvalidToken
 	}
 
-	if time.Now().After(rt.ExpiresAt) {
+	now := time.Now()
+	if now.After(rt.ExpiresAt) {
 		_ = s.tokenRepo.DeleteByHash(ctx, hash)
-		return "", domain.ErrInvalidToken
+		return "", "", time.Time{}, domain.ErrInvalidToken
 	}
 
 	user, err := s.userRepo.GetUserByID(ctx, rt.UserID)
 	if err != nil {
-		return "", err
+		return "", "", time.Time{}, err
+	}
+	if !user.IsActive() {
+		return "", "", time.Time{}, domain.ErrUserDisabled
+	}
+
+	newRefreshToken := ""
+	if rt.IsRotated() {
+		if !s.withinReuseGrace(rt, now) {
+			s.revokeReusedFamily(ctx, rt, client)
+			return "", "", time.Time{}, domain.ErrInvalidToken
+		}
+	} else {
+		newRefreshToken = uuid.NewString()
+		err = s.tokenRepo.Rotate(ctx, rt.ID, &domain.RefreshToken{
+			ID:         uuid.NewString(),
+			FamilyID:   rt.FamilyID,
+			UserID:     rt.UserID,
+			TokenHash:  utils.HashString(newRefreshToken),
+			UserAgent:  truncateUserAgent(client.UserAgent),
+			IPAddress:  client.IPAddress,
+			ExpiresAt:  rt.ExpiresAt,
+			CreatedAt:  rt.CreatedAt,
+			LastUsedAt: now,
+		}, now)
+		if errors.Is(err, domain.ErrTokenAlreadyRotated) {
+			// A concurrent request rotated the token between our read and
+			// write; treat it like any other refresh inside the grace window.
+			newRefreshToken = ""
+		} else if err != nil {
+			return "", "", time.Time{}, err
+		}
+	}
+
+	accessToken, err := s.issueAccessToken(ctx, user)
+	if err != nil {
+		return "", "", time.Time{}, err
 	}
 
-	return utils.GenerateJWT(
-		user.ID,
-		user.Role,
-		s.jwtSecret,
-		accessTokenTTL,
+	return accessToken, newRefreshToken, rt.ExpiresAt, nil
+}
+
+func (s *authService) withinReuseGrace(rt *domain.RefreshToken, now time.Time) bool {
+	return rt.RotatedAt != nil && now.Sub(*rt.RotatedAt) <= s.options.RefreshReuseGrace
+}
+
+// revokeReusedFamily handles presentation of a token that was rotated long
+// ago: either the legitimate client or an attacker holds a stale copy, and we
+// cannot tell which, so the whole session is terminated.
+func (s *authService) revokeReusedFamily(
+	ctx context.Context,
+	rt *domain.RefreshToken,
+	client domain.ClientInfo,
+) {
+	revoked, err := s.tokenRepo.DeleteFamily(ctx, rt.FamilyID)
+	slog.WarnContext(ctx, "security event: refresh token reuse detected",
+		"event", "refresh_token_reuse",
+		"user_id", rt.UserID,
+		"family_id", rt.FamilyID,
+		"token_id", rt.ID,
+		"rotated_at", rt.RotatedAt,
+		"ip_address", client.IPAddress,
+		"user_agent", client.UserAgent,
+		"revoked_tokens", revoked,
+		"error", err,
 	)
 }
 
+func truncateUserAgent(userAgent string) string {
+	if len(userAgent) > domain.MaxUserAgentLength {
+		return userAgent[:domain.MaxUserAgentLength]
+	}
+	return userAgent
+}
+
 func (s *authService) ValidateToken(
-	_ context.Context,
+	ctx context.Context,
 	accessToken string,
 ) (string, string, bool, error) {
 
-	claims, err := utils.ParseJWT(accessToken, s.jwtSecret)
+	claims, err := s.verifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return "", "", false, err
+	}
+	return claims.UserID, claims.Role, true, nil
+}
+
+func (s *authService) Authenticate(ctx context.Context, accessToken string) (*pkgjwt.Claims, error) {
+	if accessToken == "" {
+		return nil, domain.ErrUnauthorized
+	}
+	claims, err := s.verifyAccessToken(ctx, accessToken)
+	if err != nil {
+		return nil, domain.ErrUnauthorized
+	}
+	return claims, nil
+}
+
+func (s *authService) verifyAccessToken(ctx context.Context, accessToken string) (*pkgjwt.Claims, error) {
+	claims, err := s.tokens.ParseJWT(accessToken)
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
-			return "", "", false, fmt.Errorf("%w: %v", domain.ErrTokenExpired, err)
+			return nil, fmt.Errorf("%w: %v", domain.ErrTokenExpired, err)
 		}
-		return "", "", false, fmt.Errorf("%w: %v", domain.ErrInvalidToken, err)
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidToken, err)
 	}
 
-	return claims.UserID, claims.Role, true, nil
+	revoked, err := s.revocations.IsRevoked(ctx, claims.ID, claims.Subject, claims.IssuedAt.Time)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		return nil, fmt.Errorf("%w: token revoked", domain.ErrInvalidToken)
+	}
+	return claims, nil
 }
 
 func (s *authService) Logout(
 	ctx context.Context,
-	refreshToken string,
+	refreshToken, accessToken string,
 ) error {
 
+	if accessToken != "" {
+		if claims, err := s.tokens.ParseJWT(accessToken); err == nil {
+			err = s.revocations.RevokeToken(ctx, &domain.RevokedAccessToken{
+				JTI:       claims.ID,
+				UserID:    claims.Subject,
+				ExpiresAt: claims.ExpiresAt.Time,
+				RevokedAt: time.Now(),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if refreshToken == "" {
+		return nil
+	}
 	hash := utils.HashString(refreshToken)
 	return s.tokenRepo.DeleteByHash(ctx, hash)
 }
+
+func (s *authService) ListRevocations(
+	ctx context.Context,
+) ([]domain.RevokedAccessToken, []domain.UserTokenCutoff, error) {
+
+	now := time.Now()
+	return s.revocations.ListActive(ctx, now.Add(-revocationClockMargin), now.Add(-RevocationRetention))
+}
+
+func (s *authService) ListSessions(
+	ctx context.Context,
+	userID, refreshToken string,
+) ([]domain.Session, error) {
+
+	tokens, err := s.tokenRepo.ListByUserID(ctx, userID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	currentHash := ""
+	if refreshToken != "" {
+		currentHash = utils.HashString(refreshToken)
+	}
+
+	sessions := make([]domain.Session, 0, len(tokens))
+	for _, token := range tokens {
+		sessions = append(sessions, domain.Session{
+			RefreshToken: token,
+			Current:      currentHash != "" && token.TokenHash == currentHash,
+		})
+	}
+	return sessions, nil
+}
+
+func (s *authService) RevokeSession(
+	ctx context.Context,
+	userID, sessionID string,
+) error {
+
+	if err := uuid.Validate(sessionID); err != nil {
+		return domain.ErrSessionNotFound
+	}
+	return s.tokenRepo.DeleteFamilyForUser(ctx, sessionID, userID)
+}
+
+func (s *authService) RevokeAllOtherSessions(
+	ctx context.Context,
+	userID, refreshToken string,
+) (int64, error) {
+
+	current, err := s.tokenRepo.GetByHash(ctx, utils.HashString(refreshToken))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidToken) {
+			return 0, domain.ErrUnauthorized
+		}
+		return 0, err
+	}
+	if current.UserID != userID || current.IsRotated() {
+		return 0, domain.ErrUnauthorized
+	}
+
+	return s.tokenRepo.DeleteOthersForUser(ctx, userID, current.FamilyID)
+}
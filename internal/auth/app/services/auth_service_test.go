@@ -2,20 +2,53 @@ package services
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
 	"errors"
+	"slices"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/golang-jwt/jwt/v5"
+
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
+	pkgjwt "github.com/KarpovYuri/caraudio-backend/pkg/jwt"
 )
 
+var testTokens = mustTokenIssuer("test-key")
+
+func mustTokenIssuer(kid string) *utils.TokenIssuer {
+	return utils.NewTokenIssuer(mustKeyRing(kid), utils.TokenConfig{})
+}
+
+func mustKeyRing(kid string) *utils.KeyRing {
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		panic(err)
+	}
+	ring, err := utils.NewKeyRing(kid, utils.SigningKey{KID: kid, PrivateKey: privateKey})
+	if err != nil {
+		panic(err)
+	}
+	return ring
+}
+
 type fakeUserRepo struct {
 	createUserFn     func(ctx context.Context, user *domain.User) error
 	getUserByLoginFn func(ctx context.Context, login string) (*domain.User, error)
 	getUserByIDFn    func(ctx context.Context, id string) (*domain.User, error)
+	getUserByEmailFn func(ctx context.Context, email string) (*domain.User, error)
 	updateUserFn     func(ctx context.Context, user *domain.User) error
 	deleteUserFn     func(ctx context.Context, id string) error
+	listByRoleFn     func(ctx context.Context, role string) ([]string, error)
+	listUsersFn      func(ctx context.Context, filter domain.UserFilter) (*domain.UserListResult, error)
+	setDisabledAtFn  func(ctx context.Context, id string, disabledAt *time.Time, updatedAt time.Time) error
+	recordLoginFn    func(ctx context.Context, id string, at time.Time) error
+	endedSessionsOf  []string
 }
 
 func (f *fakeUserRepo) CreateUser(ctx context.Context, user *domain.User) error {
@@ -39,6 +72,13 @@ func (f *fakeUserRepo) GetUserByID(ctx context.Context, id string) (*domain.User
 	return f.getUserByIDFn(ctx, id)
 }
 
+func (f *fakeUserRepo) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	if f.getUserByEmailFn == nil {
+		return nil, domain.ErrUserNotFound
+	}
+	return f.getUserByEmailFn(ctx, email)
+}
+
 func (f *fakeUserRepo) UpdateUser(ctx context.Context, user *domain.User) error {
 	if f.updateUserFn == nil {
 		return nil
@@ -46,6 +86,14 @@ func (f *fakeUserRepo) UpdateUser(ctx context.Context, user *domain.User) error
 	return f.updateUserFn(ctx, user)
 }
 
+func (f *fakeUserRepo) UpdateUserEndingSessions(ctx context.Context, user *domain.User) error {
+	if err := f.UpdateUser(ctx, user); err != nil {
+		return err
+	}
+	f.endedSessionsOf = append(f.endedSessionsOf, user.ID)
+	return nil
+}
+
 func (f *fakeUserRepo) DeleteUser(ctx context.Context, id string) error {
 	if f.deleteUserFn == nil {
 		return nil
@@ -53,12 +101,45 @@ func (f *fakeUserRepo) DeleteUser(ctx context.Context, id string) error {
 	return f.deleteUserFn(ctx, id)
 }
 
+func (f *fakeUserRepo) ListUserIDsByRole(ctx context.Context, role string) ([]string, error) {
+	if f.listByRoleFn == nil {
+		return nil, nil
+	}
+	return f.listByRoleFn(ctx, role)
+}
+
+func (f *fakeUserRepo) ListUsers(ctx context.Context, filter domain.UserFilter) (*domain.UserListResult, error) {
+	if f.listUsersFn == nil {
+		return &domain.UserListResult{}, nil
+	}
+	return f.listUsersFn(ctx, filter)
+}
+
+func (f *fakeUserRepo) SetDisabledAt(ctx context.Context, id string, disabledAt *time.Time, updatedAt time.Time) error {
+	if f.setDisabledAtFn == nil {
+		return nil
+	}
+	return f.setDisabledAtFn(ctx, id, disabledAt, updatedAt)
+}
+
+func (f *fakeUserRepo) RecordLogin(ctx context.Context, id string, at time.Time) error {
+	if f.recordLoginFn == nil {
+		return nil
+	}
+	return f.recordLoginFn(ctx, id, at)
+}
+
 type fakeRefreshTokenRepo struct {
-	createFn         func(ctx context.Context, token *domain.RefreshToken) error
-	replaceForUserFn func(ctx context.Context, token *domain.RefreshToken) error
-	getByHashFn      func(ctx context.Context, hash string) (*domain.RefreshToken, error)
-	deleteByHashFn   func(ctx context.Context, hash string) error
-	deleteByUserIDFn func(ctx context.Context, userID string) error
+	createFn              func(ctx context.Context, token *domain.RefreshToken) error
+	createSessionFn       func(ctx context.Context, token *domain.RefreshToken, maxSessions int) (int64, error)
+	getByHashFn           func(ctx context.Context, hash string) (*domain.RefreshToken, error)
+	listByUserIDFn        func(ctx context.Context, userID string, now time.Time) ([]domain.RefreshToken, error)
+	deleteByHashFn        func(ctx context.Context, hash string) error
+	rotateFn              func(ctx context.Context, id string, next *domain.RefreshToken, rotatedAt time.Time) error
+	deleteFamilyFn        func(ctx context.Context, familyID string) (int64, error)
+	deleteFamilyForUserFn func(ctx context.Context, familyID, userID string) error
+	deleteOthersForUserFn func(ctx context.Context, userID, keepID string) (int64, error)
+	deleteByUserIDFn      func(ctx context.Context, userID string) error
 }
 
 func (f *fakeRefreshTokenRepo) Create(ctx context.Context, token *domain.RefreshToken) error {
@@ -68,11 +149,15 @@ func (f *fakeRefreshTokenRepo) Create(ctx context.Context, token *domain.Refresh
 	return f.createFn(ctx, token)
 }
 
-func (f *fakeRefreshTokenRepo) ReplaceForUser(ctx context.Context, token *domain.RefreshToken) error {
-	if f.replaceForUserFn == nil {
-		return nil
+func (f *fakeRefreshTokenRepo) CreateSession(
+	ctx context.Context,
+	token *domain.RefreshToken,
+	maxSessions int,
+) (int64, error) {
+	if f.createSessionFn == nil {
+		return 0, nil
 	}
-	return f.replaceForUserFn(ctx, token)
+	return f.createSessionFn(ctx, token, maxSessions)
 }
 
 func (f *fakeRefreshTokenRepo) GetByHash(ctx context.Context, hash string) (*domain.RefreshToken, error) {
@@ -82,6 +167,29 @@ func (f *fakeRefreshTokenRepo) GetByHash(ctx context.Context, hash string) (*dom
 	return f.getByHashFn(ctx, hash)
 }
 
+func (f *fakeRefreshTokenRepo) ListByUserID(
+	ctx context.Context,
+	userID string,
+	now time.Time,
+) ([]domain.RefreshToken, error) {
+	if f.listByUserIDFn == nil {
+		return nil, nil
+	}
+	return f.listByUserIDFn(ctx, userID, now)
+}
+
+func (f *fakeRefreshTokenRepo) Rotate(
+	ctx context.Context,
+	id string,
+	next *domain.RefreshToken,
+	rotatedAt time.Time,
+) error {
+	if f.rotateFn == nil {
+		return nil
+	}
+	return f.rotateFn(ctx, id, next, rotatedAt)
+}
+
 func (f *fakeRefreshTokenRepo) DeleteByHash(ctx context.Context, hash string) error {
 	if f.deleteByHashFn == nil {
 		return nil
@@ -89,6 +197,27 @@ func (f *fakeRefreshTokenRepo) DeleteByHash(ctx context.Context, hash string) er
 	return f.deleteByHashFn(ctx, hash)
 }
 
+func (f *fakeRefreshTokenRepo) DeleteFamily(ctx context.Context, familyID string) (int64, error) {
+	if f.deleteFamilyFn == nil {
+		return 0, nil
+	}
+	return f.deleteFamilyFn(ctx, familyID)
+}
+
+func (f *fakeRefreshTokenRepo) DeleteFamilyForUser(ctx context.Context, familyID, userID string) error {
+	if f.deleteFamilyForUserFn == nil {
+		return nil
+	}
+	return f.deleteFamilyForUserFn(ctx, familyID, userID)
+}
+
+func (f *fakeRefreshTokenRepo) DeleteOthersForUser(ctx context.Context, userID, keepID string) (int64, error) {
+	if f.deleteOthersForUserFn == nil {
+		return 0, nil
+	}
+	return f.deleteOthersForUserFn(ctx, userID, keepID)
+}
+
 func (f *fakeRefreshTokenRepo) DeleteByUserId(ctx context.Context, userID string) error {
 	if f.deleteByUserIDFn == nil {
 		return nil
@@ -100,6 +229,238 @@ func (f *fakeRefreshTokenRepo) DeleteExpired(_ context.Context, _ time.Time) (in
 	return 0, nil
 }
 
+type fakeRevocationRepo struct {
+	revokeTokenFn            func(ctx context.Context, token *domain.RevokedAccessToken) error
+	revokeUserTokensBeforeFn func(ctx context.Context, userID string, notBefore time.Time) error
+	isRevokedFn              func(ctx context.Context, jti, userID string, issuedAt time.Time) (bool, error)
+}
+
+func (f *fakeRevocationRepo) RevokeToken(ctx context.Context, token *domain.RevokedAccessToken) error {
+	if f.revokeTokenFn == nil {
+		return nil
+	}
+	return f.revokeTokenFn(ctx, token)
+}
+
+func (f *fakeRevocationRepo) RevokeUserTokensBefore(ctx context.Context, userID string, notBefore time.Time) error {
+	if f.revokeUserTokensBeforeFn == nil {
+		return nil
+	}
+	return f.revokeUserTokensBeforeFn(ctx, userID, notBefore)
+}
+
+func (f *fakeRevocationRepo) IsRevoked(ctx context.Context, jti, userID string, issuedAt time.Time) (bool, error) {
+	if f.isRevokedFn == nil {
+		return false, nil
+	}
+	return f.isRevokedFn(ctx, jti, userID, issuedAt)
+}
+
+func (f *fakeRevocationRepo) ListActive(
+	_ context.Context,
+	_, _ time.Time,
+) ([]domain.RevokedAccessToken, []domain.UserTokenCutoff, error) {
+	return nil, nil, nil
+}
+
+func (f *fakeRevocationRepo) DeleteExpired(_ context.Context, _, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+// memLoginThrottles counts failures like the postgres upsert does.
+type memLoginThrottles struct {
+	mu        sync.Mutex
+	throttles map[string]*domain.LoginThrottle
+}
+
+type memRoles struct {
+	mu    sync.Mutex
+	roles map[string]domain.Role
+}
+
+func newMemRoles() *memRoles {
+	return &memRoles{roles: map[string]domain.Role{
+		domain.RoleAdmin: {Name: domain.RoleAdmin},
+		domain.RoleUser:  {Name: domain.RoleUser},
+		"content_manager": {
+			Name:        "content_manager",
+			Permissions: []string{pkgjwt.PermCatalogProductsWrite},
+		},
+	}}
+}
+
+func (m *memRoles) GetRole(_ context.Context, name string) (*domain.Role, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	role, ok := m.roles[name]
+	if !ok {
+		return nil, domain.ErrRoleNotFound
+	}
+	role.Permissions = slices.Clone(role.Permissions)
+	return &role, nil
+}
+
+func (m *memRoles) ListRoles(_ context.Context) ([]domain.Role, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	roles := make([]domain.Role, 0, len(m.roles))
+	for _, role := range m.roles {
+		role.Permissions = slices.Clone(role.Permissions)
+		roles = append(roles, role)
+	}
+	slices.SortFunc(roles, func(a, b domain.Role) int { return strings.Compare(a.Name, b.Name) })
+	return roles, nil
+}
+
+func (m *memRoles) SaveRole(_ context.Context, role *domain.Role) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	saved := *role
+	saved.Permissions = slices.Clone(role.Permissions)
+	m.roles[role.Name] = saved
+	return nil
+}
+
+func newMemLoginThrottles() *memLoginThrottles {
+	return &memLoginThrottles{throttles: map[string]*domain.LoginThrottle{}}
+}
+
+func (m *memLoginThrottles) Get(_ context.Context, scope, key string) (*domain.LoginThrottle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	throttle, ok := m.throttles[scope+"/"+key]
+	if !ok {
+		return nil, nil
+	}
+	copied := *throttle
+	return &copied, nil
+}
+
+func (m *memLoginThrottles) RecordFailure(
+	_ context.Context,
+	scope, key string,
+	now, windowStart, lockUntil time.Time,
+	maxFailures int,
+) (*domain.LoginThrottle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	throttle, ok := m.throttles[scope+"/"+key]
+	if !ok || !throttle.FirstFailedAt.After(windowStart) {
+		throttle = &domain.LoginThrottle{Scope: scope, Key: key, FirstFailedAt: now}
+		m.throttles[scope+"/"+key] = throttle
+	}
+	throttle.Failures++
+	throttle.LastFailedAt = now
+	if throttle.Failures >= maxFailures {
+		throttle.LockedUntil = &lockUntil
+	}
+	copied := *throttle
+	return &copied, nil
+}
+
+func (m *memLoginThrottles) Reset(_ context.Context, scope, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.throttles, scope+"/"+key)
+	return nil
+}
+
+func (m *memLoginThrottles) DeleteExpired(_ context.Context, _, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+// memRefreshTokens is an in-memory refresh token table for rotation tests.
+// Rotate is atomic like the postgres implementation.
+type memRefreshTokens struct {
+	mu     sync.Mutex
+	tokens map[string]*domain.RefreshToken
+}
+
+func newMemRefreshTokens(seed ...*domain.RefreshToken) *memRefreshTokens {
+	m := &memRefreshTokens{tokens: map[string]*domain.RefreshToken{}}
+	for _, token := range seed {
+		m.tokens[token.TokenHash] = token
+	}
+	return m
+}
+
+func (m *memRefreshTokens) repo() *fakeRefreshTokenRepo {
+	return &fakeRefreshTokenRepo{
+		getByHashFn: func(_ context.Context, hash string) (*domain.RefreshToken, error) {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			token, ok := m.tokens[hash]
+			if !ok {
+				return nil, domain.ErrInvalidToken
+			}
+			copied := *token
+			return &copied, nil
+		},
+		rotateFn: func(_ context.Context, id string, next *domain.RefreshToken, rotatedAt time.Time) error {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			for _, token := range m.tokens {
+				if token.ID != id {
+					continue
+				}
+				if token.RotatedAt != nil {
+					return domain.ErrTokenAlreadyRotated
+				}
+				token.RotatedAt = &rotatedAt
+				token.ReplacedBy = &next.ID
+				m.tokens[next.TokenHash] = next
+				return nil
+			}
+			return domain.ErrInvalidToken
+		},
+		deleteFamilyFn: func(_ context.Context, familyID string) (int64, error) {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			var deleted int64
+			for hash, token := range m.tokens {
+				if token.FamilyID == familyID {
+					delete(m.tokens, hash)
+					deleted++
+				}
+			}
+			return deleted, nil
+		},
+	}
+}
+
+func (m *memRefreshTokens) get(raw string) *domain.RefreshToken {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.tokens[utils.HashString(raw)]
+}
+
+func (m *memRefreshTokens) count() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.tokens)
+}
+
+func seedRefreshToken(raw string) *domain.RefreshToken {
+	now := time.Now()
+	return &domain.RefreshToken{
+		ID:         "token-1",
+		FamilyID:   "family-1",
+		UserID:     "user-1",
+		TokenHash:  utils.HashString(raw),
+		ExpiresAt:  now.Add(time.Hour),
+		CreatedAt:  now,
+		LastUsedAt: now,
+	}
+}
+
+func refreshUserRepo() *fakeUserRepo {
+	return &fakeUserRepo{
+		getUserByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			return &domain.User{ID: id, Role: domain.RoleUser}, nil
+		},
+	}
+}
+
 func TestAuthServiceLoginSuccess(t *testing.T) {
 	ctx := context.Background()
 	hashedPassword, err := utils.HashPassword("password123")
@@ -122,22 +483,133 @@ func TestAuthServiceLoginSuccess(t *testing.T) {
 	}
 	tokenRepo := &fakeRefreshTokenRepo{}
 
-	svc := NewAuthService(userRepo, tokenRepo, "secret-key")
-	user, accessToken, refreshToken, err := svc.Login(ctx, "admin", "password123", false)
+	svc := NewAuthService(userRepo, tokenRepo, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
+	result, err := svc.Login(ctx, "admin", "password123", false, domain.ClientInfo{})
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
-	if user == nil || user.ID != "user-1" {
-		t.Fatalf("unexpected user returned: %+v", user)
+	if result.User == nil || result.User.ID != "user-1" {
+		t.Fatalf("unexpected user returned: %+v", result.User)
 	}
-	if accessToken == "" {
+	if result.AccessToken == "" {
 		t.Fatalf("expected non-empty access token")
 	}
-	if refreshToken == "" {
+	if result.RefreshToken == "" {
 		t.Fatalf("expected non-empty refresh token")
 	}
 }
 
+func TestAuthServiceLoginRecordsLastLogin(t *testing.T) {
+	ctx := context.Background()
+	hashedPassword, err := utils.HashPassword("password123")
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+
+	var recordedFor string
+	userRepo := &fakeUserRepo{
+		getUserByLoginFn: func(_ context.Context, _ string) (*domain.User, error) {
+			return &domain.User{ID: "user-1", Login: "buyer", Password: hashedPassword, Role: domain.RoleUser}, nil
+		},
+		recordLoginFn: func(_ context.Context, id string, _ time.Time) error {
+			recordedFor = id
+			return nil
+		},
+	}
+	svc := NewAuthService(userRepo, &fakeRefreshTokenRepo{}, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
+
+	result, err := svc.Login(ctx, "buyer", "password123", false, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if recordedFor != "user-1" || result.User.LastLoginAt == nil {
+		t.Fatalf("expected the login to be recorded, got %q and %v", recordedFor, result.User.LastLoginAt)
+	}
+}
+
+func TestAuthServiceRejectsDisabledUser(t *testing.T) {
+	ctx := context.Background()
+	hashedPassword, err := utils.HashPassword("password123")
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	disabledAt := time.Now().Add(-time.Hour)
+	disabled := func(id string) *domain.User {
+		return &domain.User{ID: id, Login: "buyer", Password: hashedPassword, Role: domain.RoleUser, DisabledAt: &disabledAt}
+	}
+
+	userRepo := &fakeUserRepo{
+		getUserByLoginFn: func(_ context.Context, _ string) (*domain.User, error) {
+			return disabled("user-1"), nil
+		},
+		getUserByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			return disabled(id), nil
+		},
+		recordLoginFn: func(_ context.Context, _ string, _ time.Time) error {
+			t.Fatal("a disabled user must not be recorded as logged in")
+			return nil
+		},
+	}
+	rawRefreshToken := "disabled-refresh-token"
+	tokens := newMemRefreshTokens(seedRefreshToken(rawRefreshToken))
+	svc := NewAuthService(userRepo, tokens.repo(), &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
+
+	if _, err := svc.Login(ctx, "buyer", "password123", false, domain.ClientInfo{}); !errors.Is(err, domain.ErrUserDisabled) {
+		t.Fatalf("expected ErrUserDisabled, got %v", err)
+	}
+	if _, err := svc.Login(ctx, "buyer", "wrong-password", false, domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("expected ErrInvalidCredentials for a wrong password, got %v", err)
+	}
+	if _, _, _, err := svc.Refresh(ctx, rawRefreshToken, domain.ClientInfo{}); !errors.Is(err, domain.ErrUserDisabled) {
+		t.Fatalf("expected ErrUserDisabled on refresh, got %v", err)
+	}
+	if tokens.get(rawRefreshToken).IsRotated() {
+		t.Fatal("refresh token of a disabled user must not be rotated")
+	}
+}
+
+func TestAuthServiceTokensCarryRolePermissions(t *testing.T) {
+	ctx := context.Background()
+	hashedPassword, err := utils.HashPassword("password123")
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	userRepo := &fakeUserRepo{
+		getUserByLoginFn: func(_ context.Context, login string) (*domain.User, error) {
+			role := "content_manager"
+			if login == "root" {
+				role = domain.RoleAdmin
+			}
+			return &domain.User{ID: login + "-id", Login: login, Password: hashedPassword, Role: role}, nil
+		},
+	}
+	svc := NewAuthService(userRepo, &fakeRefreshTokenRepo{}, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
+
+	editor, err := svc.Login(ctx, "editor", "password123", false, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	claims, err := testTokens.ParseJWT(editor.AccessToken)
+	if err != nil {
+		t.Fatalf("failed to parse token: %v", err)
+	}
+	if !slices.Equal(claims.Permissions, []string{pkgjwt.PermCatalogProductsWrite}) {
+		t.Fatalf("expected the role's permissions in the token, got %v", claims.Permissions)
+	}
+
+	root, err := svc.Login(ctx, "root", "password123", false, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	claims, err = svc.Authenticate(ctx, root.AccessToken)
+	if err != nil {
+		t.Fatalf("expected admin token to authenticate, got %v", err)
+	}
+	if !slices.Equal(claims.Permissions, pkgjwt.AllPermissions) {
+		t.Fatalf("expected admin to hold every permission, got %v", claims.Permissions)
+	}
+}
+
 func TestAuthServiceLoginInvalidCredentials(t *testing.T) {
 	ctx := context.Background()
 	hashedPassword, err := utils.HashPassword("correct-password")
@@ -152,9 +624,9 @@ func TestAuthServiceLoginInvalidCredentials(t *testing.T) {
 			},
 		}
 		tokenRepo := &fakeRefreshTokenRepo{}
-		svc := NewAuthService(userRepo, tokenRepo, "secret-key")
+		svc := NewAuthService(userRepo, tokenRepo, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
 
-		_, _, _, err := svc.Login(ctx, "admin", "whatever", false)
+		_, err := svc.Login(ctx, "admin", "whatever", false, domain.ClientInfo{})
 		if !errors.Is(err, domain.ErrInvalidCredentials) {
 			t.Fatalf("expected ErrInvalidCredentials, got %v", err)
 		}
@@ -172,9 +644,9 @@ func TestAuthServiceLoginInvalidCredentials(t *testing.T) {
 			},
 		}
 		tokenRepo := &fakeRefreshTokenRepo{}
-		svc := NewAuthService(userRepo, tokenRepo, "secret-key")
+		svc := NewAuthService(userRepo, tokenRepo, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
 
-		_, _, _, err := svc.Login(ctx, "admin", "wrong-password", false)
+		_, err := svc.Login(ctx, "admin", "wrong-password", false, domain.ClientInfo{})
 		if !errors.Is(err, domain.ErrInvalidCredentials) {
 			t.Fatalf("expected ErrInvalidCredentials, got %v", err)
 		}
@@ -191,9 +663,9 @@ func TestAuthServiceLoginRepoError(t *testing.T) {
 		},
 	}
 	tokenRepo := &fakeRefreshTokenRepo{}
-	svc := NewAuthService(userRepo, tokenRepo, "secret-key")
+	svc := NewAuthService(userRepo, tokenRepo, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
 
-	_, _, _, err := svc.Login(ctx, "admin", "password", false)
+	_, err := svc.Login(ctx, "admin", "password", false, domain.ClientInfo{})
 	if !errors.Is(err, repoErr) {
 		t.Fatalf("expected repo error, got %v", err)
 	}
@@ -222,9 +694,9 @@ func TestAuthServiceRefreshValid(t *testing.T) {
 			}, nil
 		},
 	}
-	svc := NewAuthService(userRepo, tokenRepo, "secret-key")
+	svc := NewAuthService(userRepo, tokenRepo, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
 
-	accessToken, err := svc.Refresh(ctx, rawRefreshToken)
+	accessToken, _, _, err := svc.Refresh(ctx, rawRefreshToken, domain.ClientInfo{})
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -256,9 +728,9 @@ func TestAuthServiceRefreshExpired(t *testing.T) {
 			return nil
 		},
 	}
-	svc := NewAuthService(userRepo, tokenRepo, "secret-key")
+	svc := NewAuthService(userRepo, tokenRepo, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
 
-	_, err := svc.Refresh(ctx, rawRefreshToken)
+	_, _, _, err := svc.Refresh(ctx, rawRefreshToken, domain.ClientInfo{})
 	if !errors.Is(err, domain.ErrInvalidToken) {
 		t.Fatalf("expected ErrInvalidToken, got %v", err)
 	}
@@ -277,9 +749,9 @@ func TestAuthServiceRefreshNotFound(t *testing.T) {
 			return nil, errors.New("sql: no rows in result set")
 		},
 	}
-	svc := NewAuthService(userRepo, tokenRepo, "secret-key")
+	svc := NewAuthService(userRepo, tokenRepo, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
 
-	_, err := svc.Refresh(ctx, rawRefreshToken)
+	_, _, _, err := svc.Refresh(ctx, rawRefreshToken, domain.ClientInfo{})
 	if !errors.Is(err, domain.ErrInvalidToken) {
 		t.Fatalf("expected ErrInvalidToken, got %v", err)
 	}
@@ -287,12 +759,13 @@ func TestAuthServiceRefreshNotFound(t *testing.T) {
 
 func TestAuthServiceValidateTokenInvalidSignature(t *testing.T) {
 	ctx := context.Background()
-	token, err := utils.GenerateJWT("user-1", "admin", "good-secret", time.Minute)
+	// Same kid, different private key: the signature cannot verify.
+	token, err := mustTokenIssuer("test-key").GenerateJWT("user-1", "admin", nil, time.Minute)
 	if err != nil {
 		t.Fatalf("failed to generate token: %v", err)
 	}
 
-	svc := NewAuthService(&fakeUserRepo{}, &fakeRefreshTokenRepo{}, "wrong-secret")
+	svc := NewAuthService(&fakeUserRepo{}, &fakeRefreshTokenRepo{}, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
 	_, _, isValid, err := svc.ValidateToken(ctx, token)
 
 	if isValid {
@@ -305,12 +778,12 @@ func TestAuthServiceValidateTokenInvalidSignature(t *testing.T) {
 
 func TestAuthServiceValidateTokenExpired(t *testing.T) {
 	ctx := context.Background()
-	token, err := utils.GenerateJWT("user-1", "admin", "secret-key", -time.Minute)
+	token, err := testTokens.GenerateJWT("user-1", "admin", nil, -time.Minute)
 	if err != nil {
 		t.Fatalf("failed to generate expired token: %v", err)
 	}
 
-	svc := NewAuthService(&fakeUserRepo{}, &fakeRefreshTokenRepo{}, "secret-key")
+	svc := NewAuthService(&fakeUserRepo{}, &fakeRefreshTokenRepo{}, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
 	_, _, isValid, err := svc.ValidateToken(ctx, token)
 
 	if isValid {
@@ -320,3 +793,683 @@ func TestAuthServiceValidateTokenExpired(t *testing.T) {
 		t.Fatalf("expected ErrTokenExpired, got %v", err)
 	}
 }
+
+func TestAuthServiceLoginKeepsOtherSessions(t *testing.T) {
+	ctx := context.Background()
+	hashedPassword, err := utils.HashPassword("password123")
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+
+	userRepo := &fakeUserRepo{
+		getUserByLoginFn: func(_ context.Context, _ string) (*domain.User, error) {
+			return &domain.User{ID: "user-1", Login: "admin", Password: hashedPassword, Role: "user"}, nil
+		},
+	}
+
+	var created *domain.RefreshToken
+	var gotMax int
+	tokenRepo := &fakeRefreshTokenRepo{
+		createSessionFn: func(_ context.Context, token *domain.RefreshToken, maxSessions int) (int64, error) {
+			created = token
+			gotMax = maxSessions
+			return 0, nil
+		},
+		deleteByUserIDFn: func(_ context.Context, _ string) error {
+			t.Fatalf("login must not delete the user's other sessions")
+			return nil
+		},
+	}
+
+	svc := NewAuthService(userRepo, tokenRepo, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{MaxSessions: 3})
+	client := domain.ClientInfo{UserAgent: "Mozilla/5.0", IPAddress: "10.0.0.1"}
+	result, err := svc.Login(ctx, "admin", "password123", false, client)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	refreshToken := result.RefreshToken
+	if created == nil {
+		t.Fatalf("expected session to be created")
+	}
+	if gotMax != 3 {
+		t.Fatalf("expected session cap 3, got %d", gotMax)
+	}
+	if created.TokenHash != utils.HashString(refreshToken) {
+		t.Fatalf("stored hash does not match issued refresh token")
+	}
+	if created.UserAgent != client.UserAgent || created.IPAddress != client.IPAddress {
+		t.Fatalf("unexpected client metadata: %+v", created)
+	}
+}
+
+func TestAuthServiceListSessionsMarksCurrent(t *testing.T) {
+	ctx := context.Background()
+	tokenRepo := &fakeRefreshTokenRepo{
+		listByUserIDFn: func(_ context.Context, userID string, _ time.Time) ([]domain.RefreshToken, error) {
+			if userID != "user-1" {
+				t.Fatalf("unexpected user id: %s", userID)
+			}
+			return []domain.RefreshToken{
+				{ID: "s-1", UserID: "user-1", TokenHash: utils.HashString("laptop")},
+				{ID: "s-2", UserID: "user-1", TokenHash: utils.HashString("phone")},
+			}, nil
+		},
+	}
+	svc := NewAuthService(&fakeUserRepo{}, tokenRepo, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
+
+	sessions, err := svc.ListSessions(ctx, "user-1", "phone")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(sessions) != 2 {
+		t.Fatalf("expected 2 sessions, got %d", len(sessions))
+	}
+	if sessions[0].Current || !sessions[1].Current {
+		t.Fatalf("expected only the phone session to be current: %+v", sessions)
+	}
+}
+
+func TestAuthServiceRevokeAllOtherSessions(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("keeps current session", func(t *testing.T) {
+		tokenRepo := &fakeRefreshTokenRepo{
+			getByHashFn: func(_ context.Context, _ string) (*domain.RefreshToken, error) {
+				return &domain.RefreshToken{ID: "t-current", FamilyID: "s-current", UserID: "user-1"}, nil
+			},
+			deleteOthersForUserFn: func(_ context.Context, userID, keepID string) (int64, error) {
+				if userID != "user-1" || keepID != "s-current" {
+					t.Fatalf("unexpected arguments: %s %s", userID, keepID)
+				}
+				return 2, nil
+			},
+		}
+		svc := NewAuthService(&fakeUserRepo{}, tokenRepo, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
+
+		revoked, err := svc.RevokeAllOtherSessions(ctx, "user-1", "current-token")
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if revoked != 2 {
+			t.Fatalf("expected 2 revoked sessions, got %d", revoked)
+		}
+	})
+
+	t.Run("refresh token of another user", func(t *testing.T) {
+		tokenRepo := &fakeRefreshTokenRepo{
+			getByHashFn: func(_ context.Context, _ string) (*domain.RefreshToken, error) {
+				return &domain.RefreshToken{ID: "s-other", UserID: "user-2"}, nil
+			},
+			deleteOthersForUserFn: func(_ context.Context, _, _ string) (int64, error) {
+				t.Fatalf("sessions must not be deleted")
+				return 0, nil
+			},
+		}
+		svc := NewAuthService(&fakeUserRepo{}, tokenRepo, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
+
+		_, err := svc.RevokeAllOtherSessions(ctx, "user-1", "foreign-token")
+		if !errors.Is(err, domain.ErrUnauthorized) {
+			t.Fatalf("expected ErrUnauthorized, got %v", err)
+		}
+	})
+}
+
+func TestAuthServiceRefreshRotatesToken(t *testing.T) {
+	ctx := context.Background()
+	seed := seedRefreshToken("original")
+	store := newMemRefreshTokens(seed)
+	svc := NewAuthService(refreshUserRepo(), store.repo(), &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
+
+	accessToken, newRefreshToken, expiresAt, err := svc.Refresh(ctx, "original", domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if accessToken == "" {
+		t.Fatalf("expected non-empty access token")
+	}
+	if newRefreshToken == "" || newRefreshToken == "original" {
+		t.Fatalf("expected a new refresh token, got %q", newRefreshToken)
+	}
+	if !expiresAt.Equal(seed.ExpiresAt) {
+		t.Fatalf("rotation must keep the session expiry: got %v, want %v", expiresAt, seed.ExpiresAt)
+	}
+
+	old := store.get("original")
+	if old == nil || !old.IsRotated() {
+		t.Fatalf("expected original token to be marked rotated")
+	}
+	next := store.get(newRefreshToken)
+	if next == nil || next.FamilyID != seed.FamilyID || next.IsRotated() {
+		t.Fatalf("expected active successor in the same family, got %+v", next)
+	}
+	if old.ReplacedBy == nil || *old.ReplacedBy != next.ID {
+		t.Fatalf("expected original token to point at its successor")
+	}
+
+	if _, second, _, err := svc.Refresh(ctx, newRefreshToken, domain.ClientInfo{}); err != nil || second == "" {
+		t.Fatalf("expected successor to refresh, got token %q err %v", second, err)
+	}
+}
+
+func TestAuthServiceRefreshConcurrentTabs(t *testing.T) {
+	ctx := context.Background()
+	store := newMemRefreshTokens(seedRefreshToken("shared-cookie"))
+	svc := NewAuthService(refreshUserRepo(), store.repo(), &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{
+		RefreshReuseGrace: 5 * time.Second,
+	})
+
+	const tabs = 2
+	var (
+		wg      sync.WaitGroup
+		start   = make(chan struct{})
+		access  [tabs]string
+		rotated [tabs]string
+		errs    [tabs]error
+	)
+	for i := 0; i < tabs; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+			access[i], rotated[i], _, errs[i] = svc.Refresh(ctx, "shared-cookie", domain.ClientInfo{})
+		}(i)
+	}
+	close(start)
+	wg.Wait()
+
+	newTokens := 0
+	for i := 0; i < tabs; i++ {
+		if errs[i] != nil {
+			t.Fatalf("tab %d: expected no error, got %v", i, errs[i])
+		}
+		if access[i] == "" {
+			t.Fatalf("tab %d: expected access token", i)
+		}
+		if rotated[i] != "" {
+			newTokens++
+		}
+	}
+	if newTokens != 1 {
+		t.Fatalf("expected exactly one tab to receive a rotated token, got %d", newTokens)
+	}
+	if store.count() != 2 {
+		t.Fatalf("family must survive a refresh race, got %d tokens", store.count())
+	}
+}
+
+func TestAuthServiceRefreshLostRotationRace(t *testing.T) {
+	ctx := context.Background()
+	seed := seedRefreshToken("cookie")
+	tokenRepo := &fakeRefreshTokenRepo{
+		getByHashFn: func(_ context.Context, _ string) (*domain.RefreshToken, error) {
+			copied := *seed
+			return &copied, nil
+		},
+		rotateFn: func(_ context.Context, _ string, _ *domain.RefreshToken, _ time.Time) error {
+			return domain.ErrTokenAlreadyRotated
+		},
+		deleteFamilyFn: func(_ context.Context, _ string) (int64, error) {
+			t.Fatalf("losing a rotation race must not revoke the family")
+			return 0, nil
+		},
+	}
+	svc := NewAuthService(refreshUserRepo(), tokenRepo, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
+
+	accessToken, newRefreshToken, _, err := svc.Refresh(ctx, "cookie", domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if accessToken == "" {
+		t.Fatalf("expected access token")
+	}
+	if newRefreshToken != "" {
+		t.Fatalf("expected no new refresh token, got %q", newRefreshToken)
+	}
+}
+
+func TestAuthServiceRefreshReuseRevokesFamily(t *testing.T) {
+	ctx := context.Background()
+	store := newMemRefreshTokens(seedRefreshToken("stolen"))
+	svc := NewAuthService(refreshUserRepo(), store.repo(), &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{
+		RefreshReuseGrace: time.Second,
+	})
+
+	_, current, _, err := svc.Refresh(ctx, "stolen", domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	// Move the rotation outside the grace window.
+	rotatedAt := time.Now().Add(-time.Minute)
+	store.get("stolen").RotatedAt = &rotatedAt
+
+	_, _, _, err = svc.Refresh(ctx, "stolen", domain.ClientInfo{IPAddress: "203.0.113.7"})
+	if !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken on reuse, got %v", err)
+	}
+	if store.count() != 0 {
+		t.Fatalf("expected the whole family to be revoked, %d tokens left", store.count())
+	}
+
+	_, _, _, err = svc.Refresh(ctx, current, domain.ClientInfo{})
+	if !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected successor to be revoked too, got %v", err)
+	}
+}
+
+func TestAuthServiceTokensVerifyWithPublishedJWKS(t *testing.T) {
+	ctx := context.Background()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+	_, edKey, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate ed25519 key: %v", err)
+	}
+
+	oldRing, err := utils.NewKeyRing("old", utils.SigningKey{KID: "old", PrivateKey: rsaKey})
+	if err != nil {
+		t.Fatalf("failed to build old key ring: %v", err)
+	}
+	// After rotation the new key signs while the old one stays published.
+	rotatedRing, err := utils.NewKeyRing("new",
+		utils.SigningKey{KID: "old", PrivateKey: rsaKey},
+		utils.SigningKey{KID: "new", PrivateKey: edKey},
+	)
+	if err != nil {
+		t.Fatalf("failed to build rotated key ring: %v", err)
+	}
+
+	oldToken, err := utils.NewTokenIssuer(oldRing, utils.TokenConfig{}).GenerateJWT("user-1", domain.RoleAdmin, pkgjwt.AllPermissions, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to sign with old key: %v", err)
+	}
+	rotatedTokens := utils.NewTokenIssuer(rotatedRing, utils.TokenConfig{})
+	newToken, err := rotatedTokens.GenerateJWT("user-1", domain.RoleAdmin, pkgjwt.AllPermissions, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to sign with new key: %v", err)
+	}
+
+	svc := NewAuthService(&fakeUserRepo{}, &fakeRefreshTokenRepo{}, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), rotatedTokens, AuthOptions{})
+	for name, token := range map[string]string{"old": oldToken, "new": newToken} {
+		if _, _, ok, err := svc.ValidateToken(ctx, token); !ok || err != nil {
+			t.Fatalf("%s token: expected valid, got %v", name, err)
+		}
+	}
+
+	jwks, err := rotatedRing.JWKS()
+	if err != nil {
+		t.Fatalf("failed to build jwks: %v", err)
+	}
+	keySet, err := pkgjwt.NewStaticKeySet(jwks)
+	if err != nil {
+		t.Fatalf("failed to load jwks: %v", err)
+	}
+	verifier := pkgjwt.NewVerifier(keySet, pkgjwt.ValidationOptions{
+		Issuer:   pkgjwt.DefaultIssuer,
+		Audience: pkgjwt.DefaultAudience,
+	})
+	for name, token := range map[string]string{"old": oldToken, "new": newToken} {
+		if _, err := verifier.RequirePermission(token, pkgjwt.PermUsersManage); err != nil {
+			t.Fatalf("%s token: expected shared verifier to accept it, got %v", name, err)
+		}
+	}
+
+	forged, err := mustTokenIssuer("new").GenerateJWT("user-1", domain.RoleAdmin, pkgjwt.AllPermissions, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to sign forged token: %v", err)
+	}
+	if _, err := verifier.RequirePermission(forged, pkgjwt.PermUsersManage); !errors.Is(err, pkgjwt.ErrUnauthorized) {
+		t.Fatalf("expected forged token to be rejected, got %v", err)
+	}
+}
+
+func TestAuthServiceTokenStandardClaims(t *testing.T) {
+	ctx := context.Background()
+	keys := mustKeyRing("claims-key")
+	issuer := utils.NewTokenIssuer(keys, utils.TokenConfig{Leeway: time.Minute})
+	svc := NewAuthService(&fakeUserRepo{}, &fakeRefreshTokenRepo{}, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), issuer, AuthOptions{})
+
+	t.Run("issued claims", func(t *testing.T) {
+		token, err := issuer.GenerateJWT("user-1", domain.RoleUser, nil, time.Minute)
+		if err != nil {
+			t.Fatalf("failed to generate token: %v", err)
+		}
+		claims, err := issuer.ParseJWT(token)
+		if err != nil {
+			t.Fatalf("expected token to parse, got %v", err)
+		}
+		if claims.Subject != "user-1" || claims.Issuer != pkgjwt.DefaultIssuer || claims.ID == "" {
+			t.Fatalf("unexpected registered claims: %+v", claims.RegisteredClaims)
+		}
+		if len(claims.Audience) != 1 || claims.Audience[0] != pkgjwt.DefaultAudience {
+			t.Fatalf("unexpected audience: %v", claims.Audience)
+		}
+
+		other, err := issuer.GenerateJWT("user-1", domain.RoleUser, nil, time.Minute)
+		if err != nil {
+			t.Fatalf("failed to generate token: %v", err)
+		}
+		otherClaims, err := issuer.ParseJWT(other)
+		if err != nil {
+			t.Fatalf("expected token to parse, got %v", err)
+		}
+		if otherClaims.ID == claims.ID {
+			t.Fatalf("expected a unique jti per token")
+		}
+	})
+
+	t.Run("expired within leeway", func(t *testing.T) {
+		token, err := issuer.GenerateJWT("user-1", domain.RoleUser, nil, -30*time.Second)
+		if err != nil {
+			t.Fatalf("failed to generate token: %v", err)
+		}
+		if _, _, ok, err := svc.ValidateToken(ctx, token); !ok || err != nil {
+			t.Fatalf("expected token inside leeway to be valid, got %v", err)
+		}
+	})
+
+	t.Run("foreign issuer and audience", func(t *testing.T) {
+		for name, config := range map[string]utils.TokenConfig{
+			"issuer":   {Issuer: "someone-else"},
+			"audience": {Audience: "another-api"},
+		} {
+			token, err := utils.NewTokenIssuer(keys, config).GenerateJWT("user-1", domain.RoleAdmin, nil, time.Minute)
+			if err != nil {
+				t.Fatalf("%s: failed to generate token: %v", name, err)
+			}
+			_, _, ok, err := svc.ValidateToken(ctx, token)
+			if ok || !errors.Is(err, domain.ErrInvalidToken) {
+				t.Fatalf("%s: expected ErrInvalidToken, got valid=%v err=%v", name, ok, err)
+			}
+		}
+	})
+
+	t.Run("symmetric algorithm", func(t *testing.T) {
+		now := time.Now()
+		forged := jwt.NewWithClaims(jwt.SigningMethodHS256, pkgjwt.Claims{
+			UserID: "user-1",
+			Role:   domain.RoleAdmin,
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    pkgjwt.DefaultIssuer,
+				Subject:   "user-1",
+				Audience:  jwt.ClaimStrings{pkgjwt.DefaultAudience},
+				ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute)),
+				IssuedAt:  jwt.NewNumericDate(now),
+				ID:        "forged",
+			},
+		})
+		forged.Header["kid"] = "claims-key"
+		token, err := forged.SignedString([]byte("guessable-secret"))
+		if err != nil {
+			t.Fatalf("failed to sign forged token: %v", err)
+		}
+		_, _, ok, err := svc.ValidateToken(ctx, token)
+		if ok || !errors.Is(err, domain.ErrInvalidToken) {
+			t.Fatalf("expected HS256 token to be rejected, got valid=%v err=%v", ok, err)
+		}
+	})
+}
+
+func TestAuthServiceValidateTokenRevoked(t *testing.T) {
+	ctx := context.Background()
+	token, err := testTokens.GenerateJWT("user-1", domain.RoleAdmin, nil, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	claims, err := testTokens.ParseJWT(token)
+	if err != nil {
+		t.Fatalf("failed to parse token: %v", err)
+	}
+
+	revocations := &fakeRevocationRepo{
+		isRevokedFn: func(_ context.Context, jti, userID string, issuedAt time.Time) (bool, error) {
+			if jti != claims.ID || userID != "user-1" || !issuedAt.Equal(claims.IssuedAt.Time) {
+				t.Fatalf("unexpected revocation lookup: %s %s %v", jti, userID, issuedAt)
+			}
+			return true, nil
+		},
+	}
+	svc := NewAuthService(&fakeUserRepo{}, &fakeRefreshTokenRepo{}, revocations, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
+
+	_, _, isValid, err := svc.ValidateToken(ctx, token)
+	if isValid || !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected revoked token to be invalid, got valid=%v err=%v", isValid, err)
+	}
+}
+
+func TestAuthServiceLogoutRevokesAccessToken(t *testing.T) {
+	ctx := context.Background()
+	token, err := testTokens.GenerateJWT("user-1", domain.RoleUser, nil, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	claims, err := testTokens.ParseJWT(token)
+	if err != nil {
+		t.Fatalf("failed to parse token: %v", err)
+	}
+
+	var revoked *domain.RevokedAccessToken
+	revocations := &fakeRevocationRepo{
+		revokeTokenFn: func(_ context.Context, token *domain.RevokedAccessToken) error {
+			revoked = token
+			return nil
+		},
+	}
+	deletedHash := ""
+	tokenRepo := &fakeRefreshTokenRepo{
+		deleteByHashFn: func(_ context.Context, hash string) error {
+			deletedHash = hash
+			return nil
+		},
+	}
+	svc := NewAuthService(&fakeUserRepo{}, tokenRepo, revocations, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{})
+
+	if err := svc.Logout(ctx, "refresh-token", token); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if revoked == nil || revoked.JTI != claims.ID || revoked.UserID != "user-1" {
+		t.Fatalf("expected access token jti to be revoked, got %+v", revoked)
+	}
+	if !revoked.ExpiresAt.Equal(claims.ExpiresAt.Time) {
+		t.Fatalf("expected revocation to expire with the token, got %v", revoked.ExpiresAt)
+	}
+	if deletedHash != utils.HashString("refresh-token") {
+		t.Fatalf("expected refresh session to be deleted")
+	}
+}
+
+type staticRevocationSource struct {
+	mu   sync.Mutex
+	list *pkgjwt.RevocationList
+}
+
+func (s *staticRevocationSource) FetchRevocations(_ context.Context) (*pkgjwt.RevocationList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.list, nil
+}
+
+func TestSharedVerifierRejectsRevokedTokens(t *testing.T) {
+	keys := mustKeyRing("revocation-key")
+	issuer := utils.NewTokenIssuer(keys, utils.TokenConfig{})
+	jwks, err := keys.JWKS()
+	if err != nil {
+		t.Fatalf("failed to build jwks: %v", err)
+	}
+	keySet, err := pkgjwt.NewStaticKeySet(jwks)
+	if err != nil {
+		t.Fatalf("failed to load jwks: %v", err)
+	}
+
+	loggedOut, err := issuer.GenerateJWT("user-1", domain.RoleUser, nil, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	loggedOutClaims, err := issuer.ParseJWT(loggedOut)
+	if err != nil {
+		t.Fatalf("failed to parse token: %v", err)
+	}
+	demoted, err := issuer.GenerateJWT("admin-1", domain.RoleAdmin, pkgjwt.AllPermissions, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	other, err := issuer.GenerateJWT("admin-2", domain.RoleAdmin, pkgjwt.AllPermissions, time.Minute)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+
+	source := &staticRevocationSource{list: &pkgjwt.RevocationList{}}
+	revocations := pkgjwt.NewCachedRevocations(source, time.Minute)
+	verifier := pkgjwt.NewVerifier(keySet, pkgjwt.ValidationOptions{
+		Revocations: revocations,
+	})
+	if _, err := verifier.RequirePermission(demoted, pkgjwt.PermUsersManage); err != nil {
+		t.Fatalf("expected admin token to be valid before demotion, got %v", err)
+	}
+
+	// What UserService.UpdateUser and AuthService.Logout publish.
+	source.mu.Lock()
+	source.list = &pkgjwt.RevocationList{
+		TokenIDs:    map[string]struct{}{loggedOutClaims.ID: {}},
+		UserCutoffs: map[string]time.Time{"admin-1": time.Now().Truncate(time.Second).Add(time.Second)},
+	}
+	source.mu.Unlock()
+	if err := revocations.Refresh(context.Background()); err != nil {
+		t.Fatalf("failed to refresh revocations: %v", err)
+	}
+
+	if _, err := verifier.ParseToken(loggedOut); !errors.Is(err, pkgjwt.ErrTokenRevoked) {
+		t.Fatalf("expected logged out token to be revoked, got %v", err)
+	}
+	if _, err := verifier.RequirePermission(demoted, pkgjwt.PermUsersManage); err == nil {
+		t.Fatalf("expected demoted admin token to be rejected")
+	}
+	if _, err := verifier.RequirePermission(other, pkgjwt.PermUsersManage); err != nil {
+		t.Fatalf("expected unrelated admin token to stay valid, got %v", err)
+	}
+}
+
+// fakeClock lets login protection tests move time forward.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newLoginProtectionService(t *testing.T, throttles *memLoginThrottles, clock *fakeClock) AuthService {
+	t.Helper()
+	hashedPassword, err := utils.HashPassword("password123")
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	userRepo := &fakeUserRepo{
+		getUserByLoginFn: func(_ context.Context, login string) (*domain.User, error) {
+			if login != "alice" && login != "bob" {
+				return nil, domain.ErrUserNotFound
+			}
+			return &domain.User{ID: login + "-id", Login: login, Password: hashedPassword, Role: domain.RoleUser}, nil
+		},
+	}
+	svc := NewAuthService(userRepo, &fakeRefreshTokenRepo{}, &fakeRevocationRepo{}, throttles, newMemMFA(), newMemRoles(), testTokens, AuthOptions{
+		LoginProtection: LoginProtectionOptions{
+			MaxFailures:      3,
+			MaxFailuresPerIP: 5,
+			Window:           10 * time.Minute,
+			Lockout:          5 * time.Minute,
+			BaseDelay:        time.Second,
+			MaxDelay:         4 * time.Second,
+		},
+	})
+	svc.(*authService).now = clock.Now
+	return svc
+}
+
+func TestAuthServiceLoginProgressiveDelay(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+	svc := newLoginProtectionService(t, newMemLoginThrottles(), clock)
+	client := domain.ClientInfo{IPAddress: "203.0.113.7"}
+
+	if _, err := svc.Login(ctx, "alice", "wrong", false, client); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("expected ErrInvalidCredentials, got %v", err)
+	}
+	// Even the right password is refused until the delay has passed.
+	if _, err := svc.Login(ctx, "alice", "password123", false, client); !errors.Is(err, domain.ErrTooManyLoginAttempts) {
+		t.Fatalf("expected ErrTooManyLoginAttempts inside the delay, got %v", err)
+	}
+
+	clock.Advance(time.Second)
+	if _, err := svc.Login(ctx, "alice", "wrong", false, client); !errors.Is(err, domain.ErrInvalidCredentials) {
+		t.Fatalf("expected ErrInvalidCredentials, got %v", err)
+	}
+	clock.Advance(time.Second)
+	if _, err := svc.Login(ctx, "alice", "password123", false, client); !errors.Is(err, domain.ErrTooManyLoginAttempts) {
+		t.Fatalf("expected the delay to double after the second failure, got %v", err)
+	}
+
+	clock.Advance(time.Second)
+	if _, err := svc.Login(ctx, "alice", "password123", false, client); err != nil {
+		t.Fatalf("expected login after the delay, got %v", err)
+	}
+}
+
+func TestAuthServiceLoginLockout(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+	throttles := newMemLoginThrottles()
+	svc := newLoginProtectionService(t, throttles, clock)
+
+	for _, login := range []string{"alice", "nobody"} {
+		for i := 0; i < 3; i++ {
+			clock.Advance(4 * time.Second)
+			_, err := svc.Login(ctx, login, "wrong", false, domain.ClientInfo{})
+			if !errors.Is(err, domain.ErrInvalidCredentials) {
+				t.Fatalf("%s attempt %d: expected ErrInvalidCredentials, got %v", login, i+1, err)
+			}
+		}
+		// Unknown logins lock exactly like real ones.
+		clock.Advance(4 * time.Second)
+		if _, err := svc.Login(ctx, login, "password123", false, domain.ClientInfo{}); !errors.Is(err, domain.ErrTooManyLoginAttempts) {
+			t.Fatalf("%s: expected lockout, got %v", login, err)
+		}
+	}
+
+	if _, err := svc.Login(ctx, "bob", "password123", false, domain.ClientInfo{}); err != nil {
+		t.Fatalf("expected other logins to be unaffected, got %v", err)
+	}
+
+	clock.Advance(5 * time.Minute)
+	if _, err := svc.Login(ctx, "alice", "password123", false, domain.ClientInfo{}); err != nil {
+		t.Fatalf("expected login after the lockout, got %v", err)
+	}
+	if throttle, _ := throttles.Get(ctx, domain.LoginScopeAccount, "alice"); throttle != nil {
+		t.Fatalf("expected failures to be cleared by a successful login, got %+v", throttle)
+	}
+}
+
+func TestAuthServiceLoginLockoutPerIP(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+	svc := newLoginProtectionService(t, newMemLoginThrottles(), clock)
+	attacker := domain.ClientInfo{IPAddress: "198.51.100.1"}
+
+	// Spraying one guess over many logins stays under the per-login limit
+	// but trips the per-IP one.
+	for i := 0; i < 5; i++ {
+		login := "user-" + string(rune('a'+i))
+		if _, err := svc.Login(ctx, login, "wrong", false, attacker); !errors.Is(err, domain.ErrInvalidCredentials) {
+			t.Fatalf("attempt %d: expected ErrInvalidCredentials, got %v", i+1, err)
+		}
+	}
+	if _, err := svc.Login(ctx, "bob", "password123", false, attacker); !errors.Is(err, domain.ErrTooManyLoginAttempts) {
+		t.Fatalf("expected IP lockout, got %v", err)
+	}
+	if _, err := svc.Login(ctx, "bob", "password123", false, domain.ClientInfo{IPAddress: "192.0.2.10"}); err != nil {
+		t.Fatalf("expected other clients to be unaffected, got %v", err)
+	}
+}
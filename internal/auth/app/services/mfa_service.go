@@ -0,0 +1,344 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
+)
+
+const (
+	// totpSkew is how many 30 second steps of clock drift are tolerated.
+	totpSkew          = 1
+	recoveryCodeCount = 10
+)
+
+// MFAOptions configures the TOTP second factor. Users with one of
+// RequiredRoles must pass MFA on every login and, until they have enrolled,
+// get a challenge that only allows enrollment.
+type MFAOptions struct {
+	Issuer               string
+	RequiredRoles        []string
+	ChallengeTTL         time.Duration
+	MaxChallengeAttempts int
+}
+
+// DefaultMFA is used for every option left unset.
+var DefaultMFA = MFAOptions{
+	Issuer:               "CarAudio",
+	ChallengeTTL:         5 * time.Minute,
+	MaxChallengeAttempts: 5,
+}
+
+func (o MFAOptions) withDefaults() MFAOptions {
+	if o.Issuer == "" {
+		o.Issuer = DefaultMFA.Issuer
+	}
+	if o.ChallengeTTL <= 0 {
+		o.ChallengeTTL = DefaultMFA.ChallengeTTL
+	}
+	if o.MaxChallengeAttempts <= 0 {
+		o.MaxChallengeAttempts = DefaultMFA.MaxChallengeAttempts
+	}
+	return o
+}
+
+func (o MFAOptions) requiredFor(role string) bool {
+	return slices.Contains(o.RequiredRoles, role)
+}
+
+func (s *authService) EnrollMFA(ctx context.Context, userID string) (*domain.MFAEnrollment, error) {
+	current, err := s.mfa.GetMFA(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if current.IsEnabled() {
+		return nil, domain.ErrMFAAlreadyEnabled
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := utils.GenerateTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.mfa.SaveEnrollment(ctx, &domain.UserMFA{
+		UserID:    user.ID,
+		Secret:    secret,
+		CreatedAt: s.now(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &domain.MFAEnrollment{
+		Secret:          secret,
+		ProvisioningURI: utils.TOTPProvisioningURI(s.options.MFA.Issuer, user.Login, secret),
+	}, nil
+}
+
+func (s *authService) ConfirmMFA(ctx context.Context, userID, code string) ([]string, error) {
+	current, err := s.mfa.GetMFA(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, domain.ErrMFANotEnrolled
+	}
+	if current.IsEnabled() {
+		return nil, domain.ErrMFAAlreadyEnabled
+	}
+
+	step, ok := utils.MatchTOTP(current.Secret, code, s.now(), totpSkew)
+	if !ok {
+		return nil, domain.ErrInvalidMFACode
+	}
+	return s.confirmEnrollment(ctx, userID, step)
+}
+
+// confirmEnrollment enables MFA and returns freshly generated recovery
+// codes; only their hashes are stored.
+func (s *authService) confirmEnrollment(ctx context.Context, userID string, step int64) ([]string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		code, err := utils.GenerateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		codes[i] = code
+		hashes[i] = utils.HashString(code)
+	}
+
+	if err := s.mfa.Confirm(ctx, userID, step, hashes, s.now()); err != nil {
+		return nil, err
+	}
+	return codes, nil
+}
+
+func (s *authService) EnrollmentChallengeUser(ctx context.Context, challengeToken string) (string, error) {
+	challenge, err := s.activeChallenge(ctx, challengeToken)
+	if err != nil {
+		return "", err
+	}
+	if !challenge.EnrollmentRequired {
+		return "", domain.ErrInvalidToken
+	}
+	return challenge.UserID, nil
+}
+
+func (s *authService) VerifyMFA(
+	ctx context.Context,
+	challengeToken, code string,
+	client domain.ClientInfo,
+) (*domain.LoginResult, error) {
+
+	challenge, err := s.activeChallenge(ctx, challengeToken)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkMFAAllowed(ctx, challenge.UserID); err != nil {
+		return nil, err
+	}
+
+	current, err := s.mfa.GetMFA(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var recoveryCodes []string
+	switch {
+	case current.IsEnabled():
+		ok, err := s.checkSecondFactor(ctx, current, code)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, s.recordChallengeFailure(ctx, challenge, client)
+		}
+	case challenge.EnrollmentRequired && current != nil:
+		// The first code from the new authenticator completes both the
+		// enrollment and the login.
+		step, ok := utils.MatchTOTP(current.Secret, code, s.now(), totpSkew)
+		if !ok {
+			return nil, s.recordChallengeFailure(ctx, challenge, client)
+		}
+		recoveryCodes, err = s.confirmEnrollment(ctx, challenge.UserID, step)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, domain.ErrMFANotEnrolled
+	}
+
+	consumed, err := s.mfa.DeleteChallenge(ctx, challenge.ID)
+	if err != nil {
+		return nil, err
+	}
+	if !consumed {
+		return nil, domain.ErrInvalidToken
+	}
+	if err := s.throttles.Reset(ctx, domain.LoginScopeMFA, challenge.UserID); err != nil {
+		return nil, err
+	}
+
+	user, err := s.userRepo.GetUserByID(ctx, challenge.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.startSession(ctx, user, challenge.RememberMe, client)
+	if err != nil {
+		return nil, err
+	}
+	result.RecoveryCodes = recoveryCodes
+	return result, nil
+}
+
+// challengeIfMFARequired returns a login challenge instead of tokens when
+// the user has MFA enabled or their role requires it, and nil otherwise.
+func (s *authService) challengeIfMFARequired(
+	ctx context.Context,
+	user *domain.User,
+	rememberMe bool,
+) (*domain.LoginResult, error) {
+	current, err := s.mfa.GetMFA(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+	enrollmentRequired := !current.IsEnabled()
+	if enrollmentRequired && !s.options.MFA.requiredFor(user.Role) {
+		return nil, nil
+	}
+
+	token := uuid.NewString()
+	now := s.now()
+	challenge := &domain.MFAChallenge{
+		ID:                 uuid.NewString(),
+		UserID:             user.ID,
+		TokenHash:          utils.HashString(token),
+		RememberMe:         rememberMe,
+		EnrollmentRequired: enrollmentRequired,
+		ExpiresAt:          now.Add(s.options.MFA.ChallengeTTL),
+		CreatedAt:          now,
+	}
+	if err := s.mfa.CreateChallenge(ctx, challenge); err != nil {
+		return nil, err
+	}
+
+	return &domain.LoginResult{
+		User:                  user,
+		MFAChallenge:          token,
+		MFAChallengeExpiresAt: challenge.ExpiresAt,
+		MFAEnrollmentRequired: enrollmentRequired,
+	}, nil
+}
+
+func (s *authService) activeChallenge(ctx context.Context, challengeToken string) (*domain.MFAChallenge, error) {
+	if challengeToken == "" {
+		return nil, domain.ErrInvalidToken
+	}
+	challenge, err := s.mfa.GetChallenge(ctx, utils.HashString(challengeToken))
+	if err != nil {
+		return nil, err
+	}
+	if !s.now().Before(challenge.ExpiresAt) {
+		return nil, domain.ErrTokenExpired
+	}
+	return challenge, nil
+}
+
+// checkSecondFactor accepts a TOTP code not used before or an unused
+// recovery code.
+func (s *authService) checkSecondFactor(ctx context.Context, mfa *domain.UserMFA, code string) (bool, error) {
+	if step, ok := utils.MatchTOTP(mfa.Secret, code, s.now(), totpSkew); ok {
+		return s.mfa.UseStep(ctx, mfa.UserID, step)
+	}
+
+	used, err := s.mfa.UseRecoveryCode(ctx, mfa.UserID, utils.HashString(utils.NormalizeRecoveryCode(code)))
+	if err != nil || !used {
+		return false, err
+	}
+	slog.WarnContext(ctx, "security event: MFA recovery code used",
+		"event", "mfa_recovery_code_used",
+		"user_id", mfa.UserID,
+	)
+	return true, nil
+}
+
+// checkMFAAllowed rejects second factor attempts while the user is locked
+// out. The failures are counted per user because every correct password
+// hands out a fresh challenge with its own attempt limit.
+func (s *authService) checkMFAAllowed(ctx context.Context, userID string) error {
+	throttle, err := s.throttles.Get(ctx, domain.LoginScopeMFA, userID)
+	if err != nil {
+		return err
+	}
+	if throttle != nil && throttle.IsLocked(s.now()) {
+		return domain.ErrTooManyLoginAttempts
+	}
+	return nil
+}
+
+// recordChallengeFailure counts a wrong code and drops the challenge once
+// it ran out of attempts, so the password has to be entered again.
+func (s *authService) recordChallengeFailure(
+	ctx context.Context,
+	challenge *domain.MFAChallenge,
+	client domain.ClientInfo,
+) error {
+	attempts, err := s.mfa.RecordChallengeFailure(ctx, challenge.ID)
+	if err != nil {
+		return err
+	}
+	if attempts >= s.options.MFA.MaxChallengeAttempts {
+		if _, err := s.mfa.DeleteChallenge(ctx, challenge.ID); err != nil {
+			return err
+		}
+		slog.WarnContext(ctx, "security event: MFA challenge failed too many times",
+			"event", "mfa_challenge_exhausted",
+			"user_id", challenge.UserID,
+			"attempts", attempts,
+		)
+	}
+
+	now := s.now()
+	protection := s.options.LoginProtection
+	windowStart := now.Add(-protection.Window)
+	lockUntil := now.Add(protection.Lockout)
+
+	keys := []struct {
+		scope, key  string
+		maxFailures int
+	}{
+		{domain.LoginScopeMFA, challenge.UserID, protection.MaxFailures},
+		{domain.LoginScopeIP, client.IPAddress, protection.MaxFailuresPerIP},
+	}
+	for _, k := range keys {
+		if k.key == "" {
+			continue
+		}
+		throttle, err := s.throttles.RecordFailure(ctx, k.scope, k.key, now, windowStart, lockUntil, k.maxFailures)
+		if err != nil {
+			return err
+		}
+		if throttle.Failures == k.maxFailures {
+			slog.WarnContext(ctx, "security event: MFA locked out after repeated failures",
+				"event", "mfa_lockout",
+				"scope", k.scope,
+				"user_id", challenge.UserID,
+				"ip_address", client.IPAddress,
+				"failures", throttle.Failures,
+				"locked_until", throttle.LockedUntil,
+			)
+		}
+	}
+	return domain.ErrInvalidMFACode
+}
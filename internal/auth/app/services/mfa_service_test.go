@@ -0,0 +1,456 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
+)
+
+// memMFA is an in-memory MFA store with the same single-use semantics as
+// the postgres implementation.
+type memMFA struct {
+	mu            sync.Mutex
+	enrollments   map[string]*domain.UserMFA
+	recoveryCodes map[string]map[string]bool
+	challenges    map[string]*domain.MFAChallenge
+}
+
+func newMemMFA() *memMFA {
+	return &memMFA{
+		enrollments:   map[string]*domain.UserMFA{},
+		recoveryCodes: map[string]map[string]bool{},
+		challenges:    map[string]*domain.MFAChallenge{},
+	}
+}
+
+func (m *memMFA) GetMFA(_ context.Context, userID string) (*domain.UserMFA, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	enrollment, ok := m.enrollments[userID]
+	if !ok {
+		return nil, nil
+	}
+	copied := *enrollment
+	return &copied, nil
+}
+
+func (m *memMFA) SaveEnrollment(_ context.Context, mfa *domain.UserMFA) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if current, ok := m.enrollments[mfa.UserID]; ok && current.IsEnabled() {
+		return domain.ErrMFAAlreadyEnabled
+	}
+	copied := *mfa
+	m.enrollments[mfa.UserID] = &copied
+	return nil
+}
+
+func (m *memMFA) Confirm(_ context.Context, userID string, step int64, hashes []string, now time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	enrollment, ok := m.enrollments[userID]
+	if !ok || enrollment.IsEnabled() {
+		return domain.ErrMFANotEnrolled
+	}
+	enrollment.ConfirmedAt = &now
+	enrollment.LastUsedStep = step
+	m.recoveryCodes[userID] = map[string]bool{}
+	for _, hash := range hashes {
+		m.recoveryCodes[userID][hash] = true
+	}
+	return nil
+}
+
+func (m *memMFA) UseStep(_ context.Context, userID string, step int64) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	enrollment, ok := m.enrollments[userID]
+	if !ok || enrollment.LastUsedStep >= step {
+		return false, nil
+	}
+	enrollment.LastUsedStep = step
+	return true, nil
+}
+
+func (m *memMFA) UseRecoveryCode(_ context.Context, userID, codeHash string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.recoveryCodes[userID][codeHash] {
+		return false, nil
+	}
+	delete(m.recoveryCodes[userID], codeHash)
+	return true, nil
+}
+
+func (m *memMFA) CreateChallenge(_ context.Context, challenge *domain.MFAChallenge) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	copied := *challenge
+	m.challenges[challenge.ID] = &copied
+	return nil
+}
+
+func (m *memMFA) GetChallenge(_ context.Context, tokenHash string) (*domain.MFAChallenge, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, challenge := range m.challenges {
+		if challenge.TokenHash == tokenHash {
+			copied := *challenge
+			return &copied, nil
+		}
+	}
+	return nil, domain.ErrInvalidToken
+}
+
+func (m *memMFA) RecordChallengeFailure(_ context.Context, id string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	challenge, ok := m.challenges[id]
+	if !ok {
+		return 0, domain.ErrInvalidToken
+	}
+	challenge.Attempts++
+	return challenge.Attempts, nil
+}
+
+func (m *memMFA) DeleteChallenge(_ context.Context, id string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.challenges[id]
+	delete(m.challenges, id)
+	return ok, nil
+}
+
+func (m *memMFA) DeleteExpiredChallenges(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+func newMFAService(t *testing.T, mfa *memMFA, clock *fakeClock, options MFAOptions) AuthService {
+	t.Helper()
+	hashedPassword, err := utils.HashPassword("password123")
+	if err != nil {
+		t.Fatalf("failed to hash password: %v", err)
+	}
+	users := map[string]*domain.User{
+		"alice": {ID: "alice-id", Login: "alice", Password: hashedPassword, Role: domain.RoleUser},
+		"root":  {ID: "root-id", Login: "root", Password: hashedPassword, Role: domain.RoleAdmin},
+	}
+	userRepo := &fakeUserRepo{
+		getUserByLoginFn: func(_ context.Context, login string) (*domain.User, error) {
+			if user, ok := users[login]; ok {
+				return user, nil
+			}
+			return nil, domain.ErrUserNotFound
+		},
+		getUserByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			for _, user := range users {
+				if user.ID == id {
+					return user, nil
+				}
+			}
+			return nil, domain.ErrUserNotFound
+		},
+	}
+	svc := NewAuthService(userRepo, &fakeRefreshTokenRepo{}, &fakeRevocationRepo{}, newMemLoginThrottles(), mfa, newMemRoles(), testTokens, AuthOptions{
+		MFA: options,
+	})
+	svc.(*authService).now = clock.Now
+	return svc
+}
+
+func totpCodeAt(t *testing.T, secret string, at time.Time) string {
+	t.Helper()
+	code, err := utils.TOTPCode(secret, utils.TOTPStep(at))
+	if err != nil {
+		t.Fatalf("failed to compute TOTP code: %v", err)
+	}
+	return code
+}
+
+// enrollMFA enables MFA for the user and returns the secret and recovery
+// codes.
+func enrollMFA(t *testing.T, svc AuthService, clock *fakeClock, userID string) (string, []string) {
+	t.Helper()
+	ctx := context.Background()
+	enrollment, err := svc.EnrollMFA(ctx, userID)
+	if err != nil {
+		t.Fatalf("enroll: %v", err)
+	}
+	codes, err := svc.ConfirmMFA(ctx, userID, totpCodeAt(t, enrollment.Secret, clock.Now()))
+	if err != nil {
+		t.Fatalf("confirm: %v", err)
+	}
+	return enrollment.Secret, codes
+}
+
+func TestTOTPCodeMatchesRFC6238Vectors(t *testing.T) {
+	// RFC 6238 appendix B, SHA-1 secret "12345678901234567890", truncated
+	// to six digits.
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for unix, want := range vectors {
+		got, err := utils.TOTPCode(secret, utils.TOTPStep(time.Unix(unix, 0)))
+		if err != nil {
+			t.Fatalf("TOTPCode: %v", err)
+		}
+		if got != want {
+			t.Fatalf("at %d: expected %s, got %s", unix, want, got)
+		}
+	}
+}
+
+func TestAuthServiceEnrollMFAProvisioningURI(t *testing.T) {
+	clock := &fakeClock{now: time.Now()}
+	svc := newMFAService(t, newMemMFA(), clock, MFAOptions{Issuer: "CarAudio"})
+
+	enrollment, err := svc.EnrollMFA(context.Background(), "alice-id")
+	if err != nil {
+		t.Fatalf("enroll: %v", err)
+	}
+	if !strings.HasPrefix(enrollment.ProvisioningURI, "otpauth://totp/CarAudio:alice?") {
+		t.Fatalf("unexpected provisioning URI %q", enrollment.ProvisioningURI)
+	}
+	if !strings.Contains(enrollment.ProvisioningURI, "secret="+enrollment.Secret) {
+		t.Fatalf("provisioning URI does not carry the secret: %q", enrollment.ProvisioningURI)
+	}
+
+	if _, err := svc.ConfirmMFA(context.Background(), "alice-id", "abcdef"); !errors.Is(err, domain.ErrInvalidMFACode) {
+		t.Fatalf("expected ErrInvalidMFACode, got %v", err)
+	}
+}
+
+func TestAuthServiceLoginWithoutMFAIssuesTokens(t *testing.T) {
+	clock := &fakeClock{now: time.Now()}
+	svc := newMFAService(t, newMemMFA(), clock, MFAOptions{})
+
+	result, err := svc.Login(context.Background(), "root", "password123", false, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if result.MFARequired() || result.AccessToken == "" {
+		t.Fatalf("expected tokens without MFA, got %+v", result)
+	}
+}
+
+func TestAuthServiceLoginRequiresTOTP(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+	svc := newMFAService(t, newMemMFA(), clock, MFAOptions{})
+	secret, _ := enrollMFA(t, svc, clock, "alice-id")
+	clock.Advance(utils.TOTPPeriod)
+
+	result, err := svc.Login(ctx, "alice", "password123", true, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if !result.MFARequired() || result.AccessToken != "" || result.RefreshToken != "" {
+		t.Fatalf("expected an MFA challenge instead of tokens, got %+v", result)
+	}
+
+	code := totpCodeAt(t, secret, clock.Now())
+	verified, err := svc.VerifyMFA(ctx, result.MFAChallenge, code, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if verified.AccessToken == "" || verified.RefreshToken == "" {
+		t.Fatalf("expected tokens after MFA, got %+v", verified)
+	}
+	if verified.RefreshExpiresAt.Before(time.Now().Add(rememberMeRefreshTTL - time.Minute)) {
+		t.Fatalf("remember me was not carried through the challenge")
+	}
+
+	if _, err := svc.VerifyMFA(ctx, result.MFAChallenge, code, domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected the challenge to be single-use, got %v", err)
+	}
+
+	// The same code must not work again within its time step.
+	again, err := svc.Login(ctx, "alice", "password123", false, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if _, err := svc.VerifyMFA(ctx, again.MFAChallenge, code, domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidMFACode) {
+		t.Fatalf("expected replayed code to be rejected, got %v", err)
+	}
+}
+
+func TestAuthServiceVerifyMFAWithRecoveryCode(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+	svc := newMFAService(t, newMemMFA(), clock, MFAOptions{})
+	_, recoveryCodes := enrollMFA(t, svc, clock, "alice-id")
+	if len(recoveryCodes) != recoveryCodeCount {
+		t.Fatalf("expected %d recovery codes, got %d", recoveryCodeCount, len(recoveryCodes))
+	}
+
+	result, err := svc.Login(ctx, "alice", "password123", false, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	typed := strings.ToUpper(strings.ReplaceAll(recoveryCodes[0], "-", " "))
+	if _, err := svc.VerifyMFA(ctx, result.MFAChallenge, typed, domain.ClientInfo{}); err != nil {
+		t.Fatalf("verify with recovery code: %v", err)
+	}
+
+	result, err = svc.Login(ctx, "alice", "password123", false, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if _, err := svc.VerifyMFA(ctx, result.MFAChallenge, recoveryCodes[0], domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidMFACode) {
+		t.Fatalf("expected used recovery code to be rejected, got %v", err)
+	}
+}
+
+func TestAuthServiceMFAChallengeLimits(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+	svc := newMFAService(t, newMemMFA(), clock, MFAOptions{MaxChallengeAttempts: 2, ChallengeTTL: time.Minute})
+	secret, _ := enrollMFA(t, svc, clock, "alice-id")
+	clock.Advance(utils.TOTPPeriod)
+
+	t.Run("attempts", func(t *testing.T) {
+		result, err := svc.Login(ctx, "alice", "password123", false, domain.ClientInfo{})
+		if err != nil {
+			t.Fatalf("login: %v", err)
+		}
+		for range 2 {
+			if _, err := svc.VerifyMFA(ctx, result.MFAChallenge, "not-a-code", domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidMFACode) {
+				t.Fatalf("expected ErrInvalidMFACode, got %v", err)
+			}
+		}
+		code := totpCodeAt(t, secret, clock.Now())
+		if _, err := svc.VerifyMFA(ctx, result.MFAChallenge, code, domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidToken) {
+			t.Fatalf("expected exhausted challenge to be dropped, got %v", err)
+		}
+	})
+
+	t.Run("expiry", func(t *testing.T) {
+		result, err := svc.Login(ctx, "alice", "password123", false, domain.ClientInfo{})
+		if err != nil {
+			t.Fatalf("login: %v", err)
+		}
+		clock.Advance(2 * time.Minute)
+		code := totpCodeAt(t, secret, clock.Now())
+		if _, err := svc.VerifyMFA(ctx, result.MFAChallenge, code, domain.ClientInfo{}); !errors.Is(err, domain.ErrTokenExpired) {
+			t.Fatalf("expected ErrTokenExpired, got %v", err)
+		}
+	})
+}
+
+func TestAuthServiceMFALockoutSpansChallenges(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+	svc := newMFAService(t, newMemMFA(), clock, MFAOptions{MaxChallengeAttempts: 1})
+	secret, _ := enrollMFA(t, svc, clock, "alice-id")
+	clock.Advance(utils.TOTPPeriod)
+
+	// Logging in again hands out a fresh challenge each time; the wrong
+	// codes must still add up.
+	for i := range DefaultLoginProtection.MaxFailures {
+		result, err := svc.Login(ctx, "alice", "password123", false, domain.ClientInfo{})
+		if err != nil {
+			t.Fatalf("login after %d failures: %v", i, err)
+		}
+		if _, err := svc.VerifyMFA(ctx, result.MFAChallenge, "not-a-code", domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidMFACode) {
+			t.Fatalf("expected ErrInvalidMFACode after %d failures, got %v", i, err)
+		}
+	}
+
+	result, err := svc.Login(ctx, "alice", "password123", false, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	code := totpCodeAt(t, secret, clock.Now())
+	if _, err := svc.VerifyMFA(ctx, result.MFAChallenge, code, domain.ClientInfo{}); !errors.Is(err, domain.ErrTooManyLoginAttempts) {
+		t.Fatalf("expected the locked out user to be refused, got %v", err)
+	}
+
+	clock.Advance(DefaultLoginProtection.Lockout)
+	result, err = svc.Login(ctx, "alice", "password123", false, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	code = totpCodeAt(t, secret, clock.Now())
+	if _, err := svc.VerifyMFA(ctx, result.MFAChallenge, code, domain.ClientInfo{}); err != nil {
+		t.Fatalf("verify after lockout: %v", err)
+	}
+
+	// A successful second factor starts the count over.
+	result, err = svc.Login(ctx, "alice", "password123", false, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if _, err := svc.VerifyMFA(ctx, result.MFAChallenge, "not-a-code", domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidMFACode) {
+		t.Fatalf("expected ErrInvalidMFACode, got %v", err)
+	}
+}
+
+func TestAuthServiceEnforcedMFAForAdmins(t *testing.T) {
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Now()}
+	mfa := newMemMFA()
+	svc := newMFAService(t, mfa, clock, MFAOptions{RequiredRoles: []string{domain.RoleAdmin}})
+
+	user, err := svc.Login(ctx, "alice", "password123", false, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if user.MFARequired() {
+		t.Fatalf("MFA must only be enforced for admins")
+	}
+
+	result, err := svc.Login(ctx, "root", "password123", false, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if !result.MFARequired() || !result.MFAEnrollmentRequired {
+		t.Fatalf("expected an enrollment challenge, got %+v", result)
+	}
+
+	if _, err := svc.VerifyMFA(ctx, result.MFAChallenge, "123456", domain.ClientInfo{}); !errors.Is(err, domain.ErrMFANotEnrolled) {
+		t.Fatalf("expected ErrMFANotEnrolled before enrolling, got %v", err)
+	}
+
+	userID, err := svc.EnrollmentChallengeUser(ctx, result.MFAChallenge)
+	if err != nil || userID != "root-id" {
+		t.Fatalf("expected challenge to authorize enrollment for root-id, got %q, %v", userID, err)
+	}
+	enrollment, err := svc.EnrollMFA(ctx, userID)
+	if err != nil {
+		t.Fatalf("enroll: %v", err)
+	}
+
+	verified, err := svc.VerifyMFA(ctx, result.MFAChallenge, totpCodeAt(t, enrollment.Secret, clock.Now()), domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("verify: %v", err)
+	}
+	if verified.AccessToken == "" || len(verified.RecoveryCodes) != recoveryCodeCount {
+		t.Fatalf("expected tokens and recovery codes, got %+v", verified)
+	}
+	if current, _ := mfa.GetMFA(ctx, "root-id"); !current.IsEnabled() {
+		t.Fatalf("expected MFA to be enabled after the first verified login")
+	}
+
+	clock.Advance(utils.TOTPPeriod)
+	next, err := svc.Login(ctx, "root", "password123", false, domain.ClientInfo{})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if !next.MFARequired() || next.MFAEnrollmentRequired {
+		t.Fatalf("expected a regular MFA challenge, got %+v", next)
+	}
+	if _, err := svc.EnrollmentChallengeUser(ctx, next.MFAChallenge); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("a regular challenge must not authorize enrollment, got %v", err)
+	}
+}
@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/database/postgres"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
+)
+
+const (
+	// DefaultOAuthStateTTL is how long the user has to sign in at the
+	// provider and come back.
+	DefaultOAuthStateTTL = 10 * time.Minute
+
+	maxExternalSubjectLength = 200
+)
+
+// OAuthProvider is a social login provider reached with the authorization
+// code flow.
+type OAuthProvider interface {
+	// AuthCodeURL returns where to send the browser to sign in.
+	AuthCodeURL(state, nonce, codeVerifier string) string
+	// Authenticate redeems the authorization code and returns the account
+	// it was issued for.
+	Authenticate(ctx context.Context, code, codeVerifier, nonce string) (*domain.ExternalIdentity, error)
+}
+
+type OAuthOptions struct {
+	StateTTL time.Duration
+}
+
+// OAuthService signs users in through social login providers. A provider
+// account is linked to a local user on its first login, either to the
+// user owning the same verified email or to a newly created customer.
+type OAuthService interface {
+	// Providers returns the names of the configured providers.
+	Providers() []string
+	// StartLogin returns the provider URL to send the browser to. The
+	// returned binding must be kept by the browser (in a cookie) and handed
+	// back to CompleteLogin, which ties the callback to the browser that
+	// started the login.
+	StartLogin(ctx context.Context, provider string, rememberMe bool) (*domain.OAuthLogin, error)
+	// CompleteLogin handles the provider callback and ends like Login:
+	// with a session or with an MFA challenge.
+	CompleteLogin(
+		ctx context.Context,
+		provider, code, state, binding string,
+		client domain.ClientInfo,
+	) (*domain.LoginResult, error)
+}
+
+type oauthService struct {
+	auth      AuthService
+	userRepo  postgres.UserRepository
+	oauth     postgres.OAuthRepository
+	providers map[string]OAuthProvider
+	options   OAuthOptions
+	now       func() time.Time
+}
+
+func NewOAuthService(
+	auth AuthService,
+	userRepo postgres.UserRepository,
+	oauth postgres.OAuthRepository,
+	providers map[string]OAuthProvider,
+	options OAuthOptions,
+) OAuthService {
+	if options.StateTTL <= 0 {
+		options.StateTTL = DefaultOAuthStateTTL
+	}
+	return &oauthService{
+		auth:      auth,
+		userRepo:  userRepo,
+		oauth:     oauth,
+		providers: providers,
+		options:   options,
+		now:       time.Now,
+	}
+}
+
+func (s *oauthService) Providers() []string {
+	names := make([]string, 0, len(s.providers))
+	for name := range s.providers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (s *oauthService) StartLogin(
+	ctx context.Context,
+	provider string,
+	rememberMe bool,
+) (*domain.OAuthLogin, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return nil, domain.ErrUnknownProvider
+	}
+
+	values := make([]string, 4)
+	for i := range values {
+		value, err := utils.GenerateToken()
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	state, nonce, codeVerifier, binding := values[0], values[1], values[2], values[3]
+
+	now := s.now()
+	expiresAt := now.Add(s.options.StateTTL)
+	if err := s.oauth.CreateState(ctx, &domain.OAuthState{
+		ID:           uuid.NewString(),
+		StateHash:    utils.HashString(state),
+		Provider:     provider,
+		CodeVerifier: codeVerifier,
+		Nonce:        nonce,
+		BindingHash:  utils.HashString(binding),
+		RememberMe:   rememberMe,
+		ExpiresAt:    expiresAt,
+		CreatedAt:    now,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &domain.OAuthLogin{
+		AuthorizationURL: p.AuthCodeURL(state, nonce, codeVerifier),
+		Binding:          binding,
+		ExpiresAt:        expiresAt,
+	}, nil
+}
+
+func (s *oauthService) CompleteLogin(
+	ctx context.Context,
+	provider, code, state, binding string,
+	client domain.ClientInfo,
+) (*domain.LoginResult, error) {
+	p, ok := s.providers[provider]
+	if !ok {
+		return nil, domain.ErrUnknownProvider
+	}
+	if code == "" || state == "" {
+		return nil, fmt.Errorf("%w: code and state are required", domain.ErrInvalidArgument)
+	}
+
+	// Consumed before anything else so that a state is good for one
+	// attempt only, whatever its outcome.
+	pending, err := s.oauth.ConsumeState(ctx, utils.HashString(state))
+	if err != nil {
+		if errors.Is(err, domain.ErrInvalidToken) {
+			return nil, s.rejectLogin(ctx, provider, client, "unknown state", domain.ErrInvalidToken)
+		}
+		return nil, err
+	}
+	if pending.Provider != provider || !s.now().Before(pending.ExpiresAt) {
+		return nil, s.rejectLogin(ctx, provider, client, "state expired or issued for another provider", domain.ErrInvalidToken)
+	}
+	// A callback opened in another browser is a login CSRF attempt: it
+	// would sign the victim into the attacker's account.
+	if subtle.ConstantTimeCompare([]byte(pending.BindingHash), []byte(utils.HashString(binding))) != 1 {
+		return nil, s.rejectLogin(ctx, provider, client, "browser binding mismatch", domain.ErrInvalidToken)
+	}
+
+	identity, err := p.Authenticate(ctx, code, pending.CodeVerifier, pending.Nonce)
+	if err != nil {
+		slog.WarnContext(ctx, "external login failed", "provider", provider, "error", err)
+		return nil, domain.ErrExternalAuthFailed
+	}
+	if len(identity.Subject) > maxExternalSubjectLength {
+		return nil, s.rejectLogin(ctx, provider, client, "subject too long", domain.ErrExternalAuthFailed)
+	}
+
+	user, err := s.resolveUser(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	return s.auth.LoginUser(ctx, user, pending.RememberMe, client)
+}
+
+// resolveUser returns the user the identity is linked to, linking it on
+// its first login.
+func (s *oauthService) resolveUser(ctx context.Context, identity *domain.ExternalIdentity) (*domain.User, error) {
+	linked, err := s.oauth.GetIdentity(ctx, identity.Provider, identity.Subject)
+	if err != nil {
+		return nil, err
+	}
+	if linked != nil {
+		return s.userRepo.GetUserByID(ctx, linked.UserID)
+	}
+
+	var email string
+	if identity.EmailVerified {
+		// An address we cannot store is treated as not shared.
+		email, _ = domain.NormalizeEmail(identity.Email)
+	}
+	link := &domain.UserIdentity{
+		Provider:  identity.Provider,
+		Subject:   identity.Subject,
+		Email:     email,
+		CreatedAt: s.now(),
+	}
+
+	if email != "" {
+		user, err := s.userRepo.GetUserByEmail(ctx, email)
+		switch {
+		case err == nil:
+			// Both sides must have proven they own the address; otherwise
+			// whoever claimed it first could take over the other account.
+			if !user.EmailVerified {
+				return nil, fmt.Errorf("%w: verify the email of the existing account first", domain.ErrUserAlreadyExists)
+			}
+			link.UserID = user.ID
+			if err := s.oauth.LinkIdentity(ctx, link); err != nil {
+				return nil, err
+			}
+			slog.InfoContext(ctx, "external identity linked",
+				"user_id", user.ID,
+				"provider", identity.Provider,
+			)
+			return user, nil
+		case !errors.Is(err, domain.ErrUserNotFound):
+			return nil, err
+		}
+	}
+
+	return s.createUser(ctx, link)
+}
+
+// createUser registers a customer for an identity seen for the first time.
+// The account gets a random password nobody knows; a user with an email can
+// set one through a password reset.
+func (s *oauthService) createUser(ctx context.Context, link *domain.UserIdentity) (*domain.User, error) {
+	password, err := utils.GenerateToken()
+	if err != nil {
+		return nil, err
+	}
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	login := link.Email
+	if login == "" {
+		login = link.Provider + ":" + link.Subject
+	}
+
+	now := s.now()
+	user := &domain.User{
+		ID:            uuid.NewString(),
+		Login:         login,
+		Password:      hashedPassword,
+		Role:          domain.RoleUser,
+		Email:         link.Email,
+		EmailVerified: link.Email != "",
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	link.UserID = user.ID
+	if err := s.oauth.CreateUserWithIdentity(ctx, user, link); err != nil {
+		return nil, err
+	}
+	slog.InfoContext(ctx, "user registered through external login",
+		"user_id", user.ID,
+		"provider", link.Provider,
+	)
+	return user, nil
+}
+
+func (s *oauthService) rejectLogin(
+	ctx context.Context,
+	provider string,
+	client domain.ClientInfo,
+	reason string,
+	err error,
+) error {
+	slog.WarnContext(ctx, "security event: external login rejected",
+		"event", "external_login_rejected",
+		"provider", provider,
+		"ip_address", client.IPAddress,
+		"reason", reason,
+	)
+	return err
+}
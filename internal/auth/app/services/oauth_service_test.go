@@ -0,0 +1,520 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/oauth"
+	pkgjwt "github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+)
+
+const (
+	testOAuthClientID     = "shop-client"
+	testOAuthClientSecret = "shop-secret"
+	testOAuthRedirectURL  = "http://localhost:4200/oauth/callback/acme"
+)
+
+// fakeOAuthServer is a local OAuth 2.0 / OpenID Connect provider. Tests
+// play the user's part with authorize and get the code the provider would
+// redirect back with.
+type fakeOAuthServer struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+	// tamper edits ID token claims before signing.
+	tamper func(claims jwt.MapClaims)
+
+	mu     sync.Mutex
+	grants map[string]fakeOAuthGrant
+	tokens map[string]map[string]any
+}
+
+type fakeOAuthGrant struct {
+	challenge string
+	nonce     string
+	userInfo  map[string]any
+}
+
+func newFakeOAuthServer(t *testing.T) *fakeOAuthServer {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	jwk, err := pkgjwt.NewJSONWebKey("provider-key", &key.PublicKey)
+	if err != nil {
+		t.Fatalf("failed to build jwk: %v", err)
+	}
+
+	f := &fakeOAuthServer{
+		key:    key,
+		grants: map[string]fakeOAuthGrant{},
+		tokens: map[string]map[string]any{},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/token", f.handleToken)
+	mux.HandleFunc("/userinfo", f.handleUserInfo)
+	mux.Handle("/jwks", pkgjwt.JWKSHandler(pkgjwt.JWKS{Keys: []pkgjwt.JSONWebKey{jwk}}))
+	f.Server = httptest.NewServer(mux)
+	t.Cleanup(f.Close)
+	return f
+}
+
+// oidcConfig verifies ID tokens; oauth2Config relies on userinfo alone.
+func (f *fakeOAuthServer) oidcConfig() oauth.Config {
+	config := f.oauth2Config()
+	config.Issuer = f.URL
+	config.JWKSURL = f.URL + "/jwks"
+	config.Scopes = []string{"openid", "email"}
+	return config
+}
+
+func (f *fakeOAuthServer) oauth2Config() oauth.Config {
+	return oauth.Config{
+		ClientID:     testOAuthClientID,
+		ClientSecret: testOAuthClientSecret,
+		AuthURL:      f.URL + "/authorize",
+		TokenURL:     f.URL + "/token",
+		UserInfoURL:  f.URL + "/userinfo",
+		RedirectURL:  testOAuthRedirectURL,
+	}
+}
+
+// authorize signs the user in at the provider and returns the code and
+// state of the redirect back.
+func (f *fakeOAuthServer) authorize(t *testing.T, authorizationURL string, userInfo map[string]any) (string, string) {
+	t.Helper()
+	parsed, err := url.Parse(authorizationURL)
+	if err != nil {
+		t.Fatalf("invalid authorization url: %v", err)
+	}
+	query := parsed.Query()
+	if parsed.Path != "/authorize" ||
+		query.Get("response_type") != "code" ||
+		query.Get("client_id") != testOAuthClientID ||
+		query.Get("redirect_uri") != testOAuthRedirectURL ||
+		query.Get("code_challenge_method") != "S256" ||
+		query.Get("code_challenge") == "" ||
+		query.Get("state") == "" {
+		t.Fatalf("unexpected authorization url %s", authorizationURL)
+	}
+
+	code := uuid.NewString()
+	f.mu.Lock()
+	f.grants[code] = fakeOAuthGrant{
+		challenge: query.Get("code_challenge"),
+		nonce:     query.Get("nonce"),
+		userInfo:  userInfo,
+	}
+	f.mu.Unlock()
+	return code, query.Get("state")
+}
+
+func (f *fakeOAuthServer) handleToken(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil || r.Method != http.MethodPost {
+		writeOAuthError(w, "invalid_request")
+		return
+	}
+	if r.PostForm.Get("grant_type") != "authorization_code" ||
+		r.PostForm.Get("client_id") != testOAuthClientID ||
+		r.PostForm.Get("client_secret") != testOAuthClientSecret ||
+		r.PostForm.Get("redirect_uri") != testOAuthRedirectURL {
+		writeOAuthError(w, "invalid_client")
+		return
+	}
+
+	f.mu.Lock()
+	grant, ok := f.grants[r.PostForm.Get("code")]
+	delete(f.grants, r.PostForm.Get("code"))
+	f.mu.Unlock()
+	verifier := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+	if !ok || base64.RawURLEncoding.EncodeToString(verifier[:]) != grant.challenge {
+		writeOAuthError(w, "invalid_grant")
+		return
+	}
+
+	accessToken := uuid.NewString()
+	f.mu.Lock()
+	f.tokens[accessToken] = grant.userInfo
+	f.mu.Unlock()
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":   f.URL,
+		"aud":   testOAuthClientID,
+		"sub":   grant.userInfo["sub"],
+		"nonce": grant.nonce,
+		"iat":   now.Unix(),
+		"exp":   now.Add(5 * time.Minute).Unix(),
+	}
+	if f.tamper != nil {
+		f.tamper(claims)
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "provider-key"
+	idToken, err := token.SignedString(f.key)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"id_token":     idToken,
+	})
+}
+
+func (f *fakeOAuthServer) handleUserInfo(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	userInfo, ok := f.tokens[strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")]
+	f.mu.Unlock()
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(userInfo)
+}
+
+func writeOAuthError(w http.ResponseWriter, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusBadRequest)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+// memOAuth keeps states, identities and the users they belong to, with the
+// single-use and uniqueness rules of the postgres implementation.
+type memOAuth struct {
+	mu         sync.Mutex
+	states     map[string]domain.OAuthState
+	identities map[string]domain.UserIdentity
+	users      map[string]*domain.User
+}
+
+func newMemOAuth(users ...*domain.User) *memOAuth {
+	m := &memOAuth{
+		states:     map[string]domain.OAuthState{},
+		identities: map[string]domain.UserIdentity{},
+		users:      map[string]*domain.User{},
+	}
+	for _, user := range users {
+		m.users[user.ID] = user
+	}
+	return m
+}
+
+func (m *memOAuth) CreateState(_ context.Context, state *domain.OAuthState) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.states[state.StateHash] = *state
+	return nil
+}
+
+func (m *memOAuth) ConsumeState(_ context.Context, stateHash string) (*domain.OAuthState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	state, ok := m.states[stateHash]
+	if !ok {
+		return nil, domain.ErrInvalidToken
+	}
+	delete(m.states, stateHash)
+	return &state, nil
+}
+
+func (m *memOAuth) DeleteExpiredStates(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (m *memOAuth) GetIdentity(_ context.Context, provider, subject string) (*domain.UserIdentity, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	identity, ok := m.identities[provider+"/"+subject]
+	if !ok {
+		return nil, nil
+	}
+	return &identity, nil
+}
+
+func (m *memOAuth) LinkIdentity(_ context.Context, identity *domain.UserIdentity) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.linkLocked(identity)
+}
+
+func (m *memOAuth) linkLocked(identity *domain.UserIdentity) error {
+	key := identity.Provider + "/" + identity.Subject
+	if _, ok := m.identities[key]; ok {
+		return domain.ErrUserAlreadyExists
+	}
+	m.identities[key] = *identity
+	return nil
+}
+
+func (m *memOAuth) CreateUserWithIdentity(_ context.Context, user *domain.User, identity *domain.UserIdentity) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, existing := range m.users {
+		if existing.Login == user.Login || (user.Email != "" && existing.Email == user.Email) {
+			return domain.ErrUserAlreadyExists
+		}
+	}
+	if err := m.linkLocked(identity); err != nil {
+		return err
+	}
+	m.users[user.ID] = user
+	return nil
+}
+
+func (m *memOAuth) userRepo() *fakeUserRepo {
+	return &fakeUserRepo{
+		getUserByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			if user, ok := m.users[id]; ok {
+				return user, nil
+			}
+			return nil, domain.ErrUserNotFound
+		},
+		getUserByEmailFn: func(_ context.Context, email string) (*domain.User, error) {
+			m.mu.Lock()
+			defer m.mu.Unlock()
+			for _, user := range m.users {
+				if user.Email == email {
+					return user, nil
+				}
+			}
+			return nil, domain.ErrUserNotFound
+		},
+	}
+}
+
+func (m *memOAuth) userCount() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.users)
+}
+
+func newTestOAuthService(t *testing.T, config oauth.Config, store *memOAuth, mfa MFAOptions) *oauthService {
+	t.Helper()
+	provider, err := oauth.NewProvider("acme", config)
+	if err != nil {
+		t.Fatalf("failed to create provider: %v", err)
+	}
+	users := store.userRepo()
+	auth := NewAuthService(users, &fakeRefreshTokenRepo{}, &fakeRevocationRepo{}, newMemLoginThrottles(), newMemMFA(), newMemRoles(), testTokens, AuthOptions{
+		MFA: mfa,
+	})
+	return NewOAuthService(auth, users, store, map[string]OAuthProvider{"acme": provider}, OAuthOptions{}).(*oauthService)
+}
+
+// signInWith runs a whole social login as the provider user described by
+// userInfo.
+func signInWith(
+	t *testing.T,
+	svc OAuthService,
+	server *fakeOAuthServer,
+	userInfo map[string]any,
+) (*domain.LoginResult, error) {
+	t.Helper()
+	ctx := context.Background()
+	login, err := svc.StartLogin(ctx, "acme", true)
+	if err != nil {
+		t.Fatalf("start login: %v", err)
+	}
+	code, state := server.authorize(t, login.AuthorizationURL, userInfo)
+	return svc.CompleteLogin(ctx, "acme", code, state, login.Binding, domain.ClientInfo{IPAddress: "203.0.113.7"})
+}
+
+func TestOAuthServiceLoginCreatesAndReusesUser(t *testing.T) {
+	server := newFakeOAuthServer(t)
+	store := newMemOAuth()
+	svc := newTestOAuthService(t, server.oidcConfig(), store, MFAOptions{})
+	userInfo := map[string]any{"sub": "acme-42", "email": "Alice@Example.com", "email_verified": true}
+
+	result, err := signInWith(t, svc, server, userInfo)
+	if err != nil {
+		t.Fatalf("first login: %v", err)
+	}
+	if result.AccessToken == "" || result.RefreshToken == "" || result.MFARequired() {
+		t.Fatalf("expected a session, got %+v", result)
+	}
+	if time.Until(result.RefreshExpiresAt) < 24*time.Hour {
+		t.Fatalf("expected a remember-me session, expires at %v", result.RefreshExpiresAt)
+	}
+	user := result.User
+	if user.Login != "alice@example.com" || user.Email != "alice@example.com" || !user.EmailVerified || user.Role != domain.RoleUser {
+		t.Fatalf("unexpected user %+v", user)
+	}
+
+	again, err := signInWith(t, svc, server, userInfo)
+	if err != nil {
+		t.Fatalf("second login: %v", err)
+	}
+	if again.User.ID != user.ID || store.userCount() != 1 {
+		t.Fatalf("expected the linked user %s, got %s with %d users", user.ID, again.User.ID, store.userCount())
+	}
+}
+
+func TestOAuthServiceLinksByVerifiedEmailOnly(t *testing.T) {
+	server := newFakeOAuthServer(t)
+	verified := &domain.User{ID: "verified-id", Login: "bob", Email: "bob@example.com", EmailVerified: true, Role: domain.RoleUser}
+	unverified := &domain.User{ID: "unverified-id", Login: "carol", Email: "carol@example.com", Role: domain.RoleUser}
+	store := newMemOAuth(verified, unverified)
+	svc := newTestOAuthService(t, server.oidcConfig(), store, MFAOptions{})
+
+	result, err := signInWith(t, svc, server, map[string]any{"sub": "b-1", "email": "bob@example.com", "email_verified": true})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if result.User.ID != verified.ID {
+		t.Fatalf("expected link to %s, got %s", verified.ID, result.User.ID)
+	}
+
+	_, err = signInWith(t, svc, server, map[string]any{"sub": "c-1", "email": "carol@example.com", "email_verified": true})
+	if !errors.Is(err, domain.ErrUserAlreadyExists) {
+		t.Fatalf("expected ErrUserAlreadyExists for an unverified local account, got %v", err)
+	}
+
+	// An address the provider did not verify proves nothing: a separate
+	// account without an email is created instead.
+	result, err = signInWith(t, svc, server, map[string]any{"sub": "b-2", "email": "bob@example.com", "email_verified": false})
+	if err != nil {
+		t.Fatalf("login with unverified email: %v", err)
+	}
+	if result.User.ID == verified.ID || result.User.Login != "acme:b-2" || result.User.Email != "" {
+		t.Fatalf("unexpected user %+v", result.User)
+	}
+}
+
+func TestOAuthServiceRejectsInvalidState(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeOAuthServer(t)
+	store := newMemOAuth()
+	svc := newTestOAuthService(t, server.oidcConfig(), store, MFAOptions{})
+	clock := &fakeClock{now: time.Now()}
+	svc.now = clock.Now
+	userInfo := map[string]any{"sub": "acme-1"}
+
+	start := func() (*domain.OAuthLogin, string, string) {
+		login, err := svc.StartLogin(ctx, "acme", false)
+		if err != nil {
+			t.Fatalf("start login: %v", err)
+		}
+		code, state := server.authorize(t, login.AuthorizationURL, userInfo)
+		return login, code, state
+	}
+
+	if _, err := svc.CompleteLogin(ctx, "acme", "code", "forged", "binding", domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken for an unknown state, got %v", err)
+	}
+
+	login, code, state := start()
+	if _, err := svc.CompleteLogin(ctx, "acme", code, state, "other-browser", domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken for a wrong binding, got %v", err)
+	}
+	// The failed attempt used the state up.
+	if _, err := svc.CompleteLogin(ctx, "acme", code, state, login.Binding, domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken for a reused state, got %v", err)
+	}
+
+	login, code, state = start()
+	clock.Advance(DefaultOAuthStateTTL)
+	if _, err := svc.CompleteLogin(ctx, "acme", code, state, login.Binding, domain.ClientInfo{}); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected ErrInvalidToken for an expired state, got %v", err)
+	}
+
+	if _, err := svc.StartLogin(ctx, "unknown", false); !errors.Is(err, domain.ErrUnknownProvider) {
+		t.Fatalf("expected ErrUnknownProvider, got %v", err)
+	}
+	if store.userCount() != 0 {
+		t.Fatalf("expected no users, got %d", store.userCount())
+	}
+}
+
+func TestOAuthServiceRejectsInvalidIDTokens(t *testing.T) {
+	tampers := map[string]func(jwt.MapClaims){
+		"nonce":    func(claims jwt.MapClaims) { claims["nonce"] = "replayed" },
+		"audience": func(claims jwt.MapClaims) { claims["aud"] = "another-client" },
+		"issuer":   func(claims jwt.MapClaims) { claims["iss"] = "https://evil.example.com" },
+		"expired":  func(claims jwt.MapClaims) { claims["exp"] = time.Now().Add(-time.Hour).Unix() },
+		"subject":  func(claims jwt.MapClaims) { claims["sub"] = "someone-else" },
+	}
+	for name, tamper := range tampers {
+		t.Run(name, func(t *testing.T) {
+			server := newFakeOAuthServer(t)
+			server.tamper = tamper
+			store := newMemOAuth()
+			svc := newTestOAuthService(t, server.oidcConfig(), store, MFAOptions{})
+
+			_, err := signInWith(t, svc, server, map[string]any{"sub": "acme-1", "email": "dave@example.com", "email_verified": true})
+			if !errors.Is(err, domain.ErrExternalAuthFailed) {
+				t.Fatalf("expected ErrExternalAuthFailed, got %v", err)
+			}
+			if store.userCount() != 0 {
+				t.Fatalf("expected no users, got %d", store.userCount())
+			}
+		})
+	}
+}
+
+func TestOAuthServiceMapsProviderClaims(t *testing.T) {
+	server := newFakeOAuthServer(t)
+	config := server.oauth2Config()
+	config.SubjectClaim = "user.id"
+	config.EmailClaim = "user.default_email"
+	config.TrustEmail = true
+	store := newMemOAuth()
+	svc := newTestOAuthService(t, config, store, MFAOptions{})
+
+	result, err := signInWith(t, svc, server, map[string]any{
+		"user": map[string]any{"id": 1234567890123, "default_email": "erin@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if result.User.Email != "erin@example.com" || !result.User.EmailVerified {
+		t.Fatalf("unexpected user %+v", result.User)
+	}
+	identity, _ := store.GetIdentity(context.Background(), "acme", "1234567890123")
+	if identity == nil || identity.UserID != result.User.ID {
+		t.Fatalf("expected the numeric id to be linked, got %+v", identity)
+	}
+}
+
+func TestOAuthServiceEnforcesMFA(t *testing.T) {
+	server := newFakeOAuthServer(t)
+	admin := &domain.User{ID: "admin-id", Login: "root", Email: "root@example.com", EmailVerified: true, Role: domain.RoleAdmin}
+	store := newMemOAuth(admin)
+	svc := newTestOAuthService(t, server.oidcConfig(), store, MFAOptions{RequiredRoles: []string{domain.RoleAdmin}})
+
+	result, err := signInWith(t, svc, server, map[string]any{"sub": "root-1", "email": "root@example.com", "email_verified": true})
+	if err != nil {
+		t.Fatalf("login: %v", err)
+	}
+	if !result.MFARequired() || result.AccessToken != "" {
+		t.Fatalf("expected an MFA challenge instead of tokens, got %+v", result)
+	}
+
+	now := time.Now()
+	admin.DisabledAt = &now
+	if _, err := signInWith(t, svc, server, map[string]any{"sub": "root-1"}); !errors.Is(err, domain.ErrUserDisabled) {
+		t.Fatalf("expected ErrUserDisabled, got %v", err)
+	}
+}
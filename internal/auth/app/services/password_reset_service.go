@@ -0,0 +1,240 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/database/postgres"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
+)
+
+const (
+	// DefaultPasswordResetTTL is how long a password reset link works.
+	DefaultPasswordResetTTL = 30 * time.Minute
+
+	// Reset requests allowed per email address and per client IP within
+	// DefaultPasswordResetWindow; further requests are refused until the
+	// window has passed.
+	DefaultMaxResetRequestsPerEmail = 3
+	DefaultMaxResetRequestsPerIP    = 20
+	DefaultPasswordResetWindow      = time.Hour
+
+	// passwordResetMailTimeout bounds the background delivery of a link.
+	passwordResetMailTimeout = time.Minute
+)
+
+type PasswordResetOptions struct {
+	// ResetURL is the frontend page that handles reset links; the token is
+	// appended as the "token" query parameter.
+	ResetURL string
+	TTL      time.Duration
+
+	MaxRequestsPerEmail int
+	MaxRequestsPerIP    int
+	RequestWindow       time.Duration
+}
+
+type PasswordResetService interface {
+	// RequestPasswordReset mails a reset link if the address belongs to an
+	// account. It reports success either way, and looks the account up and
+	// sends the mail in the background, so that neither the response nor
+	// its timing reveals which addresses are registered. Requests are
+	// limited per address and per client IP (ErrTooManyResetRequests).
+	RequestPasswordReset(ctx context.Context, email string, client domain.ClientInfo) error
+	// ConfirmPasswordReset sets a new password and signs the user out
+	// everywhere.
+	ConfirmPasswordReset(ctx context.Context, token, newPassword string) error
+	// Wait blocks until the reset links being sent in the background have
+	// been delivered or have failed.
+	Wait()
+}
+
+type passwordResetService struct {
+	userRepo    postgres.UserRepository
+	resets      postgres.PasswordResetRepository
+	revocations postgres.RevocationRepository
+	quotas      postgres.PasswordResetQuotaRepository
+	mailer      Mailer
+	options     PasswordResetOptions
+	pending     sync.WaitGroup
+}
+
+func NewPasswordResetService(
+	userRepo postgres.UserRepository,
+	resets postgres.PasswordResetRepository,
+	revocations postgres.RevocationRepository,
+	quotas postgres.PasswordResetQuotaRepository,
+	mailer Mailer,
+	options PasswordResetOptions,
+) PasswordResetService {
+	if options.TTL <= 0 {
+		options.TTL = DefaultPasswordResetTTL
+	}
+	if options.MaxRequestsPerEmail <= 0 {
+		options.MaxRequestsPerEmail = DefaultMaxResetRequestsPerEmail
+	}
+	if options.MaxRequestsPerIP <= 0 {
+		options.MaxRequestsPerIP = DefaultMaxResetRequestsPerIP
+	}
+	if options.RequestWindow <= 0 {
+		options.RequestWindow = DefaultPasswordResetWindow
+	}
+	return &passwordResetService{
+		userRepo:    userRepo,
+		resets:      resets,
+		revocations: revocations,
+		quotas:      quotas,
+		mailer:      mailer,
+		options:     options,
+	}
+}
+
+func (s *passwordResetService) RequestPasswordReset(
+	ctx context.Context,
+	email string,
+	client domain.ClientInfo,
+) error {
+	email, err := domain.NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+	if err := s.countRequest(ctx, email, client.IPAddress); err != nil {
+		return err
+	}
+
+	// The link is sent after the client has had its answer, so it must not
+	// be cancelled together with the request.
+	mailCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), passwordResetMailTimeout)
+	s.pending.Add(1)
+	go func() {
+		defer s.pending.Done()
+		defer cancel()
+		s.sendResetLinkIfRegistered(mailCtx, email)
+	}()
+	return nil
+}
+
+func (s *passwordResetService) Wait() {
+	s.pending.Wait()
+}
+
+// countRequest refuses the request while the address or client IP has used
+// up its requests, and counts it otherwise. Unknown addresses are counted
+// too, so the limit reveals nothing either.
+func (s *passwordResetService) countRequest(ctx context.Context, email, ip string) error {
+	now := time.Now()
+	windowStart := now.Add(-s.options.RequestWindow)
+
+	keys := []struct {
+		scope, key  string
+		maxRequests int
+	}{
+		{domain.PasswordResetScopeEmail, email, s.options.MaxRequestsPerEmail},
+		{domain.PasswordResetScopeIP, ip, s.options.MaxRequestsPerIP},
+	}
+	for _, k := range keys {
+		if k.key == "" {
+			continue
+		}
+		requests, err := s.quotas.Requests(ctx, k.scope, k.key, windowStart)
+		if err != nil {
+			return err
+		}
+		if requests >= k.maxRequests {
+			return domain.ErrTooManyResetRequests
+		}
+	}
+	for _, k := range keys {
+		if k.key == "" {
+			continue
+		}
+		if err := s.quotas.Record(ctx, k.scope, k.key, now, windowStart); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendResetLinkIfRegistered runs in the background. Failures are logged
+// only: the client has already been answered.
+func (s *passwordResetService) sendResetLinkIfRegistered(ctx context.Context, email string) {
+	user, err := s.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if !errors.Is(err, domain.ErrUserNotFound) {
+			slog.ErrorContext(ctx, "failed to look up password reset account", "error", err)
+		}
+		return
+	}
+
+	if err := s.sendResetLink(ctx, user); err != nil {
+		slog.ErrorContext(ctx, "failed to send password reset email",
+			"user_id", user.ID,
+			"error", err,
+		)
+	}
+}
+
+func (s *passwordResetService) ConfirmPasswordReset(
+	ctx context.Context,
+	token, newPassword string,
+) error {
+	if token == "" {
+		return domain.ErrInvalidToken
+	}
+	if err := domain.ValidatePassword(newPassword); err != nil {
+		return err
+	}
+
+	hashedPassword, err := utils.HashPassword(newPassword)
+	if err != nil {
+		return err
+	}
+
+	userID, err := s.resets.Consume(ctx, utils.HashString(token), hashedPassword, time.Now())
+	if err != nil {
+		return err
+	}
+
+	// Refresh sessions are gone with the reset; access tokens still in
+	// flight are cut off here.
+	return revokeAccessTokensIssuedSoFar(ctx, s.revocations, userID)
+}
+
+func (s *passwordResetService) sendResetLink(ctx context.Context, user *domain.User) error {
+	token := uuid.NewString()
+	now := time.Now()
+	expiresAt := now.Add(s.options.TTL)
+
+	err := s.resets.Create(ctx, &domain.PasswordResetToken{
+		ID:        uuid.NewString(),
+		UserID:    user.ID,
+		TokenHash: utils.HashString(token),
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+
+	link, err := linkWithToken(s.options.ResetURL, token)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, domain.EmailMessage{
+		To:      user.Email,
+		Subject: "Reset your password",
+		Body: fmt.Sprintf(
+			"We received a request to reset the password of your CarAudio account.\n\nChoose a new password by opening the link below:\n\n%s\n\nThe link can be used once and expires on %s. If you did not ask for a reset, ignore this email; your password stays unchanged.\n",
+			link,
+			expiresAt.UTC().Format("2006-01-02 15:04 MST"),
+		),
+	})
+}
@@ -0,0 +1,288 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
+)
+
+// memPasswordResets mirrors the postgres repository: one pending token per
+// user, consumed on use together with the password change and session wipe.
+type memPasswordResets struct {
+	mu              sync.Mutex
+	tokens          map[string]domain.PasswordResetToken
+	passwords       map[string]string
+	sessionsRevoked map[string]bool
+}
+
+func newMemPasswordResets() *memPasswordResets {
+	return &memPasswordResets{
+		tokens:          map[string]domain.PasswordResetToken{},
+		passwords:       map[string]string{},
+		sessionsRevoked: map[string]bool{},
+	}
+}
+
+func (m *memPasswordResets) Create(_ context.Context, token *domain.PasswordResetToken) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for hash, existing := range m.tokens {
+		if existing.UserID == token.UserID {
+			delete(m.tokens, hash)
+		}
+	}
+	m.tokens[token.TokenHash] = *token
+	return nil
+}
+
+func (m *memPasswordResets) Consume(
+	_ context.Context,
+	tokenHash, passwordHash string,
+	now time.Time,
+) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	token, ok := m.tokens[tokenHash]
+	if !ok {
+		return "", domain.ErrInvalidToken
+	}
+	delete(m.tokens, tokenHash)
+	if !now.Before(token.ExpiresAt) {
+		return "", domain.ErrTokenExpired
+	}
+	m.passwords[token.UserID] = passwordHash
+	m.sessionsRevoked[token.UserID] = true
+	return token.UserID, nil
+}
+
+func (m *memPasswordResets) DeleteExpired(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+// memResetQuotas counts requests like the postgres upsert does.
+type memResetQuotas struct {
+	mu       sync.Mutex
+	requests map[string]int
+	started  map[string]time.Time
+}
+
+func newMemResetQuotas() *memResetQuotas {
+	return &memResetQuotas{requests: map[string]int{}, started: map[string]time.Time{}}
+}
+
+func (m *memResetQuotas) Requests(_ context.Context, scope, key string, windowStart time.Time) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.started[scope+"/"+key].After(windowStart) {
+		return 0, nil
+	}
+	return m.requests[scope+"/"+key], nil
+}
+
+func (m *memResetQuotas) Record(_ context.Context, scope, key string, now, windowStart time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !m.started[scope+"/"+key].After(windowStart) {
+		m.requests[scope+"/"+key] = 0
+		m.started[scope+"/"+key] = now
+	}
+	m.requests[scope+"/"+key]++
+	return nil
+}
+
+func (m *memResetQuotas) DeleteExpired(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+func passwordResetUserRepo() *fakeUserRepo {
+	return &fakeUserRepo{
+		getUserByEmailFn: func(_ context.Context, email string) (*domain.User, error) {
+			if email != "shopper@example.com" {
+				return nil, domain.ErrUserNotFound
+			}
+			return &domain.User{ID: "user-1", Email: email}, nil
+		},
+	}
+}
+
+func newTestPasswordResetService(
+	resets *memPasswordResets,
+	revocations *fakeRevocationRepo,
+	mailer *fakeMailer,
+) PasswordResetService {
+	return NewPasswordResetService(passwordResetUserRepo(), resets, revocations, newMemResetQuotas(), mailer, PasswordResetOptions{
+		ResetURL: "https://shop.example/reset-password",
+	})
+}
+
+var resetClient = domain.ClientInfo{IPAddress: "203.0.113.7"}
+
+func TestPasswordResetServiceResetFlow(t *testing.T) {
+	ctx := context.Background()
+	resets := newMemPasswordResets()
+	var cutoffUser string
+	revocations := &fakeRevocationRepo{
+		revokeUserTokensBeforeFn: func(_ context.Context, userID string, _ time.Time) error {
+			cutoffUser = userID
+			return nil
+		},
+	}
+	mailer := &fakeMailer{}
+	svc := newTestPasswordResetService(resets, revocations, mailer)
+
+	if err := svc.RequestPasswordReset(ctx, "Shopper@Example.com", resetClient); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	svc.Wait()
+	if len(mailer.sent) != 1 || mailer.sent[0].To != "shopper@example.com" {
+		t.Fatalf("expected one reset email, got %+v", mailer.sent)
+	}
+	token := tokenFromMail(t, mailer.sent[0])
+
+	if err := svc.ConfirmPasswordReset(ctx, token, "short"); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Fatalf("expected weak password to be rejected, got %v", err)
+	}
+
+	if err := svc.ConfirmPasswordReset(ctx, token, "newsecret42"); err != nil {
+		t.Fatalf("expected reset to succeed, got %v", err)
+	}
+	if !utils.CheckPasswordHash("newsecret42", resets.passwords["user-1"]) {
+		t.Fatalf("expected new password hash to be stored")
+	}
+	if !resets.sessionsRevoked["user-1"] {
+		t.Fatalf("expected all sessions to be revoked")
+	}
+	if cutoffUser != "user-1" {
+		t.Fatalf("expected access tokens of user-1 to be revoked, got %q", cutoffUser)
+	}
+
+	if err := svc.ConfirmPasswordReset(ctx, token, "another42"); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected reset token to be single-use, got %v", err)
+	}
+}
+
+func TestPasswordResetServiceUnknownEmail(t *testing.T) {
+	ctx := context.Background()
+	mailer := &fakeMailer{}
+	svc := newTestPasswordResetService(newMemPasswordResets(), &fakeRevocationRepo{}, mailer)
+
+	if err := svc.RequestPasswordReset(ctx, "nobody@example.com", resetClient); err != nil {
+		t.Fatalf("expected silent success, got %v", err)
+	}
+	svc.Wait()
+	if len(mailer.sent) != 0 {
+		t.Fatalf("expected no mail for an unknown address")
+	}
+
+	failing := newTestPasswordResetService(newMemPasswordResets(), &fakeRevocationRepo{}, &fakeMailer{err: errors.New("smtp down")})
+	if err := failing.RequestPasswordReset(ctx, "shopper@example.com", resetClient); err != nil {
+		t.Fatalf("expected delivery errors to be hidden, got %v", err)
+	}
+	failing.Wait()
+}
+
+func TestPasswordResetServiceExpiredToken(t *testing.T) {
+	ctx := context.Background()
+	resets := newMemPasswordResets()
+	resets.tokens[utils.HashString("expired")] = domain.PasswordResetToken{
+		ID:        "reset-1",
+		UserID:    "user-1",
+		TokenHash: utils.HashString("expired"),
+		ExpiresAt: time.Now().Add(-time.Second),
+	}
+	svc := newTestPasswordResetService(resets, &fakeRevocationRepo{}, &fakeMailer{})
+
+	if err := svc.ConfirmPasswordReset(ctx, "expired", "newsecret42"); !errors.Is(err, domain.ErrTokenExpired) {
+		t.Fatalf("expected ErrTokenExpired, got %v", err)
+	}
+	if _, changed := resets.passwords["user-1"]; changed {
+		t.Fatalf("expected password to stay unchanged")
+	}
+}
+
+func TestPasswordResetServiceNewRequestSupersedesOld(t *testing.T) {
+	ctx := context.Background()
+	mailer := &fakeMailer{}
+	svc := newTestPasswordResetService(newMemPasswordResets(), &fakeRevocationRepo{}, mailer)
+
+	for i := 0; i < 2; i++ {
+		if err := svc.RequestPasswordReset(ctx, "shopper@example.com", resetClient); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+	svc.Wait()
+	if err := svc.ConfirmPasswordReset(ctx, tokenFromMail(t, mailer.sent[0]), "newsecret42"); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected superseded link to be rejected, got %v", err)
+	}
+	if err := svc.ConfirmPasswordReset(ctx, tokenFromMail(t, mailer.sent[1]), "newsecret42"); err != nil {
+		t.Fatalf("expected latest link to work, got %v", err)
+	}
+}
+
+func TestPasswordResetServiceSendsInBackground(t *testing.T) {
+	release := make(chan struct{})
+	userRepo := passwordResetUserRepo()
+	lookup := userRepo.getUserByEmailFn
+	userRepo.getUserByEmailFn = func(ctx context.Context, email string) (*domain.User, error) {
+		<-release
+		return lookup(ctx, email)
+	}
+	mailer := &fakeMailer{}
+	svc := NewPasswordResetService(userRepo, newMemPasswordResets(), &fakeRevocationRepo{}, newMemResetQuotas(), mailer, PasswordResetOptions{
+		ResetURL: "https://shop.example/reset-password",
+	})
+
+	// The request is answered before the account is even looked up, and a
+	// cancelled request does not stop the mail.
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := svc.RequestPasswordReset(ctx, "shopper@example.com", resetClient); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	cancel()
+	close(release)
+	svc.Wait()
+
+	if len(mailer.sent) != 1 {
+		t.Fatalf("expected the reset email to be sent, got %d", len(mailer.sent))
+	}
+}
+
+func TestPasswordResetServiceLimitsRequests(t *testing.T) {
+	ctx := context.Background()
+	mailer := &fakeMailer{}
+	svc := NewPasswordResetService(passwordResetUserRepo(), newMemPasswordResets(), &fakeRevocationRepo{}, newMemResetQuotas(), mailer, PasswordResetOptions{
+		ResetURL:            "https://shop.example/reset-password",
+		MaxRequestsPerEmail: 2,
+		MaxRequestsPerIP:    5,
+	})
+	defer svc.Wait()
+
+	// Registered and unknown addresses run out alike.
+	for _, email := range []string{"shopper@example.com", "nobody@example.com"} {
+		for i := 0; i < 2; i++ {
+			if err := svc.RequestPasswordReset(ctx, email, domain.ClientInfo{IPAddress: "198.51.100.1"}); err != nil {
+				t.Fatalf("%s: expected request %d to be accepted, got %v", email, i+1, err)
+			}
+		}
+		if err := svc.RequestPasswordReset(ctx, email, domain.ClientInfo{IPAddress: "198.51.100.2"}); !errors.Is(err, domain.ErrTooManyResetRequests) {
+			t.Fatalf("%s: expected ErrTooManyResetRequests, got %v", email, err)
+		}
+	}
+
+	// One client cycling through addresses runs out too.
+	for i := 0; i < 5; i++ {
+		email := fmt.Sprintf("guess%d@example.com", i)
+		if err := svc.RequestPasswordReset(ctx, email, resetClient); err != nil {
+			t.Fatalf("expected request %d to be accepted, got %v", i+1, err)
+		}
+	}
+	if err := svc.RequestPasswordReset(ctx, "another@example.com", resetClient); !errors.Is(err, domain.ErrTooManyResetRequests) {
+		t.Fatalf("expected ErrTooManyResetRequests for the client IP, got %v", err)
+	}
+}
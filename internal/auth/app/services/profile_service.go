@@ -0,0 +1,180 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/database/postgres"
+)
+
+const maxProfileNameLength = 100
+
+// ProfileService manages the contact details and address book customers
+// use at checkout. Every method acts on the given user's own data.
+type ProfileService interface {
+	GetProfile(ctx context.Context, userID string) (*domain.Profile, error)
+	// UpdateProfile replaces the profile; empty values clear a field.
+	UpdateProfile(ctx context.Context, userID, firstName, lastName, phone, email string) (*domain.Profile, error)
+
+	ListAddresses(ctx context.Context, userID string) ([]domain.Address, error)
+	// CreateAddress adds an address; the first one becomes the default.
+	CreateAddress(ctx context.Context, userID string, address domain.Address) (*domain.Address, error)
+	// UpdateAddress replaces the fields of an address. The default address
+	// stays the default until another one is made default.
+	UpdateAddress(ctx context.Context, userID string, address domain.Address) (*domain.Address, error)
+	DeleteAddress(ctx context.Context, userID, id string) error
+	SetDefaultAddress(ctx context.Context, userID, id string) (*domain.Address, error)
+}
+
+type profileService struct {
+	userRepo postgres.UserRepository
+	profiles postgres.ProfileRepository
+}
+
+func NewProfileService(userRepo postgres.UserRepository, profiles postgres.ProfileRepository) ProfileService {
+	return &profileService{userRepo: userRepo, profiles: profiles}
+}
+
+func (s *profileService) GetProfile(ctx context.Context, userID string) (*domain.Profile, error) {
+	user, err := s.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := s.profiles.GetProfile(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if profile == nil {
+		profile = &domain.Profile{UserID: userID}
+	}
+	if profile.Email == "" {
+		profile.Email = user.Email
+	}
+	return profile, nil
+}
+
+func (s *profileService) UpdateProfile(
+	ctx context.Context,
+	userID, firstName, lastName, phone, email string,
+) (*domain.Profile, error) {
+	profile := &domain.Profile{
+		UserID:    userID,
+		FirstName: strings.TrimSpace(firstName),
+		LastName:  strings.TrimSpace(lastName),
+		UpdatedAt: time.Now(),
+	}
+	if utf8.RuneCountInString(profile.FirstName) > maxProfileNameLength ||
+		utf8.RuneCountInString(profile.LastName) > maxProfileNameLength {
+		return nil, fmt.Errorf("%w: names must be at most %d characters", domain.ErrInvalidArgument, maxProfileNameLength)
+	}
+	if strings.TrimSpace(phone) != "" {
+		normalized, err := domain.NormalizePhone(phone)
+		if err != nil {
+			return nil, err
+		}
+		profile.Phone = normalized
+	}
+	if strings.TrimSpace(email) != "" {
+		normalized, err := domain.NormalizeEmail(email)
+		if err != nil {
+			return nil, err
+		}
+		profile.Email = normalized
+	}
+
+	if _, err := s.userRepo.GetUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	if err := s.profiles.SaveProfile(ctx, profile); err != nil {
+		return nil, err
+	}
+	return s.GetProfile(ctx, userID)
+}
+
+func (s *profileService) ListAddresses(ctx context.Context, userID string) ([]domain.Address, error) {
+	return s.profiles.ListAddresses(ctx, userID)
+}
+
+func (s *profileService) CreateAddress(
+	ctx context.Context,
+	userID string,
+	address domain.Address,
+) (*domain.Address, error) {
+	if err := address.Normalize(); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.profiles.ListAddresses(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= domain.MaxAddressesPerUser {
+		return nil, fmt.Errorf("%w: at most %d addresses can be saved", domain.ErrInvalidArgument, domain.MaxAddressesPerUser)
+	}
+
+	now := time.Now()
+	address.ID = uuid.NewString()
+	address.UserID = userID
+	address.IsDefault = address.IsDefault || len(existing) == 0
+	address.CreatedAt = now
+	address.UpdatedAt = now
+	if err := s.profiles.CreateAddress(ctx, &address); err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+func (s *profileService) UpdateAddress(
+	ctx context.Context,
+	userID string,
+	address domain.Address,
+) (*domain.Address, error) {
+	current, err := s.getAddress(ctx, userID, address.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := address.Normalize(); err != nil {
+		return nil, err
+	}
+
+	address.UserID = userID
+	address.IsDefault = address.IsDefault || current.IsDefault
+	address.CreatedAt = current.CreatedAt
+	address.UpdatedAt = time.Now()
+	if err := s.profiles.UpdateAddress(ctx, &address); err != nil {
+		return nil, err
+	}
+	return &address, nil
+}
+
+func (s *profileService) DeleteAddress(ctx context.Context, userID, id string) error {
+	if uuid.Validate(id) != nil {
+		return domain.ErrAddressNotFound
+	}
+	return s.profiles.DeleteAddress(ctx, userID, id)
+}
+
+func (s *profileService) SetDefaultAddress(ctx context.Context, userID, id string) (*domain.Address, error) {
+	if uuid.Validate(id) != nil {
+		return nil, domain.ErrAddressNotFound
+	}
+	if err := s.profiles.SetDefaultAddress(ctx, userID, id, time.Now()); err != nil {
+		return nil, err
+	}
+	return s.profiles.GetAddress(ctx, userID, id)
+}
+
+// getAddress treats a malformed id like an unknown one instead of passing
+// it to the database.
+func (s *profileService) getAddress(ctx context.Context, userID, id string) (*domain.Address, error) {
+	if uuid.Validate(id) != nil {
+		return nil, domain.ErrAddressNotFound
+	}
+	return s.profiles.GetAddress(ctx, userID, id)
+}
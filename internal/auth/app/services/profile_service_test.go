@@ -0,0 +1,309 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+)
+
+// memProfiles keeps a single default address per user like the postgres
+// implementation does.
+type memProfiles struct {
+	mu        sync.Mutex
+	profiles  map[string]domain.Profile
+	addresses []domain.Address
+}
+
+func newMemProfiles() *memProfiles {
+	return &memProfiles{profiles: map[string]domain.Profile{}}
+}
+
+func (m *memProfiles) GetProfile(_ context.Context, userID string) (*domain.Profile, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	profile, ok := m.profiles[userID]
+	if !ok {
+		return nil, nil
+	}
+	return &profile, nil
+}
+
+func (m *memProfiles) SaveProfile(_ context.Context, profile *domain.Profile) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.profiles[profile.UserID] = *profile
+	return nil
+}
+
+func (m *memProfiles) ListAddresses(_ context.Context, userID string) ([]domain.Address, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var addresses []domain.Address
+	for _, address := range m.addresses {
+		if address.UserID == userID {
+			addresses = append(addresses, address)
+		}
+	}
+	slices.SortStableFunc(addresses, func(a, b domain.Address) int {
+		switch {
+		case a.IsDefault == b.IsDefault:
+			return 0
+		case a.IsDefault:
+			return -1
+		default:
+			return 1
+		}
+	})
+	return addresses, nil
+}
+
+func (m *memProfiles) GetAddress(_ context.Context, userID, id string) (*domain.Address, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	i := m.index(userID, id)
+	if i < 0 {
+		return nil, domain.ErrAddressNotFound
+	}
+	address := m.addresses[i]
+	return &address, nil
+}
+
+func (m *memProfiles) CreateAddress(_ context.Context, address *domain.Address) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if address.IsDefault {
+		m.clearDefault(address.UserID)
+	}
+	m.addresses = append(m.addresses, *address)
+	return nil
+}
+
+func (m *memProfiles) UpdateAddress(_ context.Context, address *domain.Address) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	i := m.index(address.UserID, address.ID)
+	if i < 0 {
+		return domain.ErrAddressNotFound
+	}
+	if address.IsDefault {
+		m.clearDefault(address.UserID)
+	}
+	m.addresses[i] = *address
+	return nil
+}
+
+func (m *memProfiles) DeleteAddress(_ context.Context, userID, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	i := m.index(userID, id)
+	if i < 0 {
+		return domain.ErrAddressNotFound
+	}
+	wasDefault := m.addresses[i].IsDefault
+	m.addresses = slices.Delete(m.addresses, i, i+1)
+	if wasDefault {
+		for j := len(m.addresses) - 1; j >= 0; j-- {
+			if m.addresses[j].UserID == userID {
+				m.addresses[j].IsDefault = true
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (m *memProfiles) SetDefaultAddress(_ context.Context, userID, id string, updatedAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	i := m.index(userID, id)
+	if i < 0 {
+		return domain.ErrAddressNotFound
+	}
+	m.clearDefault(userID)
+	m.addresses[i].IsDefault = true
+	m.addresses[i].UpdatedAt = updatedAt
+	return nil
+}
+
+func (m *memProfiles) index(userID, id string) int {
+	return slices.IndexFunc(m.addresses, func(a domain.Address) bool {
+		return a.ID == id && a.UserID == userID
+	})
+}
+
+func (m *memProfiles) clearDefault(userID string) {
+	for i := range m.addresses {
+		if m.addresses[i].UserID == userID {
+			m.addresses[i].IsDefault = false
+		}
+	}
+}
+
+func profileUserRepo() *fakeUserRepo {
+	return &fakeUserRepo{
+		getUserByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			if id != "user-1" && id != "user-2" {
+				return nil, domain.ErrUserNotFound
+			}
+			return &domain.User{ID: id, Email: id + "@example.com"}, nil
+		},
+	}
+}
+
+func testAddress(city string) domain.Address {
+	return domain.Address{City: city, Street: "Lenina", House: "1"}
+}
+
+func TestProfileServiceProfile(t *testing.T) {
+	ctx := context.Background()
+	svc := NewProfileService(profileUserRepo(), newMemProfiles())
+
+	profile, err := svc.GetProfile(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("GetProfile returned error: %v", err)
+	}
+	if profile.Email != "user-1@example.com" {
+		t.Fatalf("expected the account email as contact email, got %q", profile.Email)
+	}
+	if _, err := svc.GetProfile(ctx, "missing"); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+
+	profile, err = svc.UpdateProfile(ctx, "user-1", " Ivan ", "Petrov", "8 (900) 123-45-67", "Orders@Example.com")
+	if err != nil {
+		t.Fatalf("UpdateProfile returned error: %v", err)
+	}
+	if profile.FirstName != "Ivan" || profile.Phone != "+79001234567" || profile.Email != "orders@example.com" {
+		t.Fatalf("unexpected profile: %+v", profile)
+	}
+
+	for _, tc := range []struct{ phone, email string }{
+		{"12345", ""},
+		{"", "not-an-email"},
+	} {
+		if _, err := svc.UpdateProfile(ctx, "user-1", "", "", tc.phone, tc.email); !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Fatalf("%+v: expected ErrInvalidArgument, got %v", tc, err)
+		}
+	}
+
+	profile, err = svc.UpdateProfile(ctx, "user-1", "Ivan", "", "", "")
+	if err != nil {
+		t.Fatalf("UpdateProfile returned error: %v", err)
+	}
+	if profile.Phone != "" || profile.Email != "user-1@example.com" {
+		t.Fatalf("expected cleared fields, got %+v", profile)
+	}
+}
+
+func TestProfileServiceAddressBook(t *testing.T) {
+	ctx := context.Background()
+	svc := NewProfileService(profileUserRepo(), newMemProfiles())
+
+	home, err := svc.CreateAddress(ctx, "user-1", domain.Address{
+		Label:      "Home",
+		City:       "Moscow",
+		Street:     "Tverskaya",
+		House:      "7",
+		PostalCode: "125009",
+	})
+	if err != nil {
+		t.Fatalf("CreateAddress returned error: %v", err)
+	}
+	if !home.IsDefault || home.Country != domain.DefaultCountry {
+		t.Fatalf("expected the first address to be the default in %s, got %+v", domain.DefaultCountry, home)
+	}
+
+	office, err := svc.CreateAddress(ctx, "user-1", testAddress("Kazan"))
+	if err != nil {
+		t.Fatalf("CreateAddress returned error: %v", err)
+	}
+	if office.IsDefault {
+		t.Fatal("expected the second address not to become the default")
+	}
+
+	// Unchecking the default flag does not leave the user without one.
+	update := testAddress("Moscow")
+	update.ID = home.ID
+	updated, err := svc.UpdateAddress(ctx, "user-1", update)
+	if err != nil {
+		t.Fatalf("UpdateAddress returned error: %v", err)
+	}
+	if !updated.IsDefault || updated.CreatedAt != home.CreatedAt {
+		t.Fatalf("unexpected updated address: %+v", updated)
+	}
+
+	if _, err := svc.SetDefaultAddress(ctx, "user-1", office.ID); err != nil {
+		t.Fatalf("SetDefaultAddress returned error: %v", err)
+	}
+	addresses, err := svc.ListAddresses(ctx, "user-1")
+	if err != nil {
+		t.Fatalf("ListAddresses returned error: %v", err)
+	}
+	if len(addresses) != 2 || addresses[0].ID != office.ID || addresses[1].IsDefault {
+		t.Fatalf("expected only the office address to be the default, got %+v", addresses)
+	}
+
+	if err := svc.DeleteAddress(ctx, "user-1", office.ID); err != nil {
+		t.Fatalf("DeleteAddress returned error: %v", err)
+	}
+	addresses, _ = svc.ListAddresses(ctx, "user-1")
+	if len(addresses) != 1 || !addresses[0].IsDefault {
+		t.Fatalf("expected the remaining address to become the default, got %+v", addresses)
+	}
+}
+
+func TestProfileServiceAddressesArePrivate(t *testing.T) {
+	ctx := context.Background()
+	svc := NewProfileService(profileUserRepo(), newMemProfiles())
+
+	address, err := svc.CreateAddress(ctx, "user-1", testAddress("Moscow"))
+	if err != nil {
+		t.Fatalf("CreateAddress returned error: %v", err)
+	}
+
+	update := testAddress("Omsk")
+	update.ID = address.ID
+	if _, err := svc.UpdateAddress(ctx, "user-2", update); !errors.Is(err, domain.ErrAddressNotFound) {
+		t.Fatalf("expected ErrAddressNotFound, got %v", err)
+	}
+	if err := svc.DeleteAddress(ctx, "user-2", address.ID); !errors.Is(err, domain.ErrAddressNotFound) {
+		t.Fatalf("expected ErrAddressNotFound, got %v", err)
+	}
+	if _, err := svc.SetDefaultAddress(ctx, "user-2", address.ID); !errors.Is(err, domain.ErrAddressNotFound) {
+		t.Fatalf("expected ErrAddressNotFound, got %v", err)
+	}
+	if err := svc.DeleteAddress(ctx, "user-1", "not-a-uuid"); !errors.Is(err, domain.ErrAddressNotFound) {
+		t.Fatalf("expected ErrAddressNotFound for a malformed id, got %v", err)
+	}
+}
+
+func TestProfileServiceValidatesAddresses(t *testing.T) {
+	ctx := context.Background()
+	svc := NewProfileService(profileUserRepo(), newMemProfiles())
+
+	for name, address := range map[string]domain.Address{
+		"missing city":   {Street: "Lenina", House: "1"},
+		"bad country":    {Country: "Russia", City: "Moscow", Street: "Lenina", House: "1"},
+		"bad postcode":   {City: "Moscow", Street: "Lenina", House: "1", PostalCode: "#1"},
+		"bad phone":      {City: "Moscow", Street: "Lenina", House: "1", RecipientPhone: "call me"},
+		"long apartment": {City: "Moscow", Street: "Lenina", House: "1", Apartment: "123456789012345678901"},
+	} {
+		if _, err := svc.CreateAddress(ctx, "user-1", address); !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Fatalf("%s: expected ErrInvalidArgument, got %v", name, err)
+		}
+	}
+
+	for range domain.MaxAddressesPerUser {
+		if _, err := svc.CreateAddress(ctx, "user-1", testAddress("Moscow")); err != nil {
+			t.Fatalf("CreateAddress returned error: %v", err)
+		}
+	}
+	if _, err := svc.CreateAddress(ctx, "user-1", testAddress("Moscow")); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Fatalf("expected the address book to be full, got %v", err)
+	}
+}
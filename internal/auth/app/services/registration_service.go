@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/database/postgres"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
+)
+
+// DefaultEmailVerificationTTL is how long an email verification link works.
+const DefaultEmailVerificationTTL = 24 * time.Hour
+
+// Mailer delivers transactional email such as verification links.
+type Mailer interface {
+	Send(ctx context.Context, msg domain.EmailMessage) error
+}
+
+type RegistrationOptions struct {
+	// VerificationURL is the frontend page that handles verification
+	// links; the token is appended as the "token" query parameter.
+	VerificationURL string
+	VerificationTTL time.Duration
+}
+
+type RegistrationService interface {
+	// Register creates a customer account with an unverified email and
+	// mails a verification link to it. An empty login defaults to the email.
+	Register(ctx context.Context, login, email, password string) (*domain.User, error)
+	VerifyEmail(ctx context.Context, token string) error
+	// ResendVerification mails a fresh link if the address belongs to an
+	// unverified account. It never reveals whether that is the case.
+	ResendVerification(ctx context.Context, email string) error
+}
+
+type registrationService struct {
+	userRepo      postgres.UserRepository
+	verifications postgres.EmailVerificationRepository
+	mailer        Mailer
+	options       RegistrationOptions
+}
+
+func NewRegistrationService(
+	userRepo postgres.UserRepository,
+	verifications postgres.EmailVerificationRepository,
+	mailer Mailer,
+	options RegistrationOptions,
+) RegistrationService {
+	if options.VerificationTTL <= 0 {
+		options.VerificationTTL = DefaultEmailVerificationTTL
+	}
+	return &registrationService{
+		userRepo:      userRepo,
+		verifications: verifications,
+		mailer:        mailer,
+		options:       options,
+	}
+}
+
+func (s *registrationService) Register(
+	ctx context.Context,
+	login, email, password string,
+) (*domain.User, error) {
+	email, err := domain.NormalizeEmail(email)
+	if err != nil {
+		return nil, err
+	}
+	login = strings.TrimSpace(login)
+	if login == "" {
+		login = email
+	}
+	if err := domain.ValidatePassword(password); err != nil {
+		return nil, err
+	}
+
+	hashedPassword, err := utils.HashPassword(password)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	user := &domain.User{
+		ID:        uuid.NewString(),
+		Login:     login,
+		Password:  hashedPassword,
+		Role:      domain.RoleUser,
+		Email:     email,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.userRepo.CreateUser(ctx, user); err != nil {
+		return nil, err
+	}
+
+	// The account exists at this point; a lost email can be requested
+	// again through ResendVerification, so delivery errors are not fatal.
+	if err := s.sendVerification(ctx, user); err != nil {
+		slog.ErrorContext(ctx, "failed to send verification email",
+			"user_id", user.ID,
+			"error", err,
+		)
+	}
+
+	return user, nil
+}
+
+func (s *registrationService) VerifyEmail(ctx context.Context, token string) error {
+	if token == "" {
+		return domain.ErrInvalidToken
+	}
+	_, err := s.verifications.Consume(ctx, utils.HashString(token), time.Now())
+	return err
+}
+
+func (s *registrationService) ResendVerification(ctx context.Context, email string) error {
+	email, err := domain.NormalizeEmail(email)
+	if err != nil {
+		return err
+	}
+
+	user, err := s.userRepo.GetUserByEmail(ctx, email)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserNotFound) {
+			return nil
+		}
+		return err
+	}
+	if user.EmailVerified {
+		return nil
+	}
+	return s.sendVerification(ctx, user)
+}
+
+func (s *registrationService) sendVerification(ctx context.Context, user *domain.User) error {
+	token := uuid.NewString()
+	now := time.Now()
+	expiresAt := now.Add(s.options.VerificationTTL)
+
+	err := s.verifications.Create(ctx, &domain.EmailVerificationToken{
+		ID:        uuid.NewString(),
+		UserID:    user.ID,
+		TokenHash: utils.HashString(token),
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+
+	link, err := linkWithToken(s.options.VerificationURL, token)
+	if err != nil {
+		return err
+	}
+
+	return s.mailer.Send(ctx, domain.EmailMessage{
+		To:      user.Email,
+		Subject: "Confirm your email address",
+		Body: fmt.Sprintf(
+			"Welcome to CarAudio!\n\nConfirm your email address by opening the link below:\n\n%s\n\nThe link expires on %s. If you did not sign up, ignore this email.\n",
+			link,
+			expiresAt.UTC().Format("2006-01-02 15:04 MST"),
+		),
+	})
+}
+
+// linkWithToken appends token as the "token" query parameter of base.
+func linkWithToken(base, token string) (string, error) {
+	link, err := url.Parse(base)
+	if err != nil {
+		return "", fmt.Errorf("invalid link url: %w", err)
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String(), nil
+}
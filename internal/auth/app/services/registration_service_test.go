@@ -0,0 +1,253 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
+)
+
+type fakeMailer struct {
+	mu   sync.Mutex
+	sent []domain.EmailMessage
+	err  error
+}
+
+func (m *fakeMailer) Send(_ context.Context, msg domain.EmailMessage) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return m.err
+	}
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+// memEmailVerifications mirrors the postgres repository: one pending token
+// per user, consumed on use.
+type memEmailVerifications struct {
+	mu       sync.Mutex
+	tokens   map[string]domain.EmailVerificationToken
+	verified map[string]bool
+}
+
+func newMemEmailVerifications() *memEmailVerifications {
+	return &memEmailVerifications{
+		tokens:   map[string]domain.EmailVerificationToken{},
+		verified: map[string]bool{},
+	}
+}
+
+func (m *memEmailVerifications) Create(_ context.Context, token *domain.EmailVerificationToken) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for hash, existing := range m.tokens {
+		if existing.UserID == token.UserID {
+			delete(m.tokens, hash)
+		}
+	}
+	m.tokens[token.TokenHash] = *token
+	return nil
+}
+
+func (m *memEmailVerifications) Consume(_ context.Context, tokenHash string, now time.Time) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	token, ok := m.tokens[tokenHash]
+	if !ok {
+		return "", domain.ErrInvalidToken
+	}
+	delete(m.tokens, tokenHash)
+	if !now.Before(token.ExpiresAt) {
+		return "", domain.ErrTokenExpired
+	}
+	m.verified[token.UserID] = true
+	return token.UserID, nil
+}
+
+func (m *memEmailVerifications) DeleteExpired(_ context.Context, _ time.Time) (int64, error) {
+	return 0, nil
+}
+
+var verificationTokenPattern = regexp.MustCompile(`https?://\S+`)
+
+func tokenFromMail(t *testing.T, msg domain.EmailMessage) string {
+	t.Helper()
+	link, err := url.Parse(verificationTokenPattern.FindString(msg.Body))
+	if err != nil {
+		t.Fatalf("failed to parse verification link: %v", err)
+	}
+	token := link.Query().Get("token")
+	if token == "" {
+		t.Fatalf("verification link without token: %q", msg.Body)
+	}
+	return token
+}
+
+func newTestRegistrationService(
+	userRepo *fakeUserRepo,
+	verifications *memEmailVerifications,
+	mailer *fakeMailer,
+) RegistrationService {
+	return NewRegistrationService(userRepo, verifications, mailer, RegistrationOptions{
+		VerificationURL: "https://shop.example/verify-email",
+	})
+}
+
+func TestRegistrationServiceRegisterAndVerify(t *testing.T) {
+	ctx := context.Background()
+	var saved *domain.User
+	userRepo := &fakeUserRepo{
+		createUserFn: func(_ context.Context, user *domain.User) error {
+			saved = user
+			return nil
+		},
+	}
+	verifications := newMemEmailVerifications()
+	mailer := &fakeMailer{}
+	svc := newTestRegistrationService(userRepo, verifications, mailer)
+
+	user, err := svc.Register(ctx, "", " Shopper@Example.com ", "secret123")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if user.Email != "shopper@example.com" || user.Login != "shopper@example.com" {
+		t.Fatalf("expected normalized email as login, got login=%q email=%q", user.Login, user.Email)
+	}
+	if saved == nil || saved.Role != domain.RoleUser || saved.EmailVerified {
+		t.Fatalf("expected unverified customer to be stored, got %+v", saved)
+	}
+	if !utils.CheckPasswordHash("secret123", saved.Password) {
+		t.Fatalf("expected hashed password to be stored")
+	}
+	if len(mailer.sent) != 1 || mailer.sent[0].To != "shopper@example.com" {
+		t.Fatalf("expected one verification email, got %+v", mailer.sent)
+	}
+
+	token := tokenFromMail(t, mailer.sent[0])
+	if err := svc.VerifyEmail(ctx, token); err != nil {
+		t.Fatalf("expected verification to succeed, got %v", err)
+	}
+	if !verifications.verified[user.ID] {
+		t.Fatalf("expected email to be marked verified")
+	}
+	if err := svc.VerifyEmail(ctx, token); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected used token to be rejected, got %v", err)
+	}
+}
+
+func TestRegistrationServicePasswordPolicy(t *testing.T) {
+	ctx := context.Background()
+	userRepo := &fakeUserRepo{
+		createUserFn: func(_ context.Context, _ *domain.User) error {
+			t.Fatalf("expected user not to be created")
+			return nil
+		},
+	}
+	svc := newTestRegistrationService(userRepo, newMemEmailVerifications(), &fakeMailer{})
+
+	for name, password := range map[string]string{
+		"too short":  "abc123",
+		"no digits":  "passwordonly",
+		"no letters": "1234567890",
+		"too long":   "a1" + string(make([]byte, domain.MaxPasswordLength)),
+	} {
+		if _, err := svc.Register(ctx, "", "shopper@example.com", password); !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Fatalf("%s: expected ErrInvalidArgument, got %v", name, err)
+		}
+	}
+
+	if _, err := svc.Register(ctx, "", "not-an-email", "secret123"); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Fatalf("expected invalid email to be rejected, got %v", err)
+	}
+}
+
+func TestRegistrationServiceExpiredToken(t *testing.T) {
+	ctx := context.Background()
+	verifications := newMemEmailVerifications()
+	verifications.tokens[utils.HashString("expired")] = domain.EmailVerificationToken{
+		ID:        "token-1",
+		UserID:    "user-1",
+		TokenHash: utils.HashString("expired"),
+		ExpiresAt: time.Now().Add(-time.Minute),
+	}
+	svc := newTestRegistrationService(&fakeUserRepo{}, verifications, &fakeMailer{})
+
+	if err := svc.VerifyEmail(ctx, "expired"); !errors.Is(err, domain.ErrTokenExpired) {
+		t.Fatalf("expected ErrTokenExpired, got %v", err)
+	}
+	if verifications.verified["user-1"] {
+		t.Fatalf("expected expired token not to verify the email")
+	}
+}
+
+func TestRegistrationServiceResendVerification(t *testing.T) {
+	ctx := context.Background()
+	users := map[string]*domain.User{
+		"pending@example.com":  {ID: "user-1", Email: "pending@example.com"},
+		"verified@example.com": {ID: "user-2", Email: "verified@example.com", EmailVerified: true},
+	}
+	userRepo := &fakeUserRepo{
+		getUserByEmailFn: func(_ context.Context, email string) (*domain.User, error) {
+			if user, ok := users[email]; ok {
+				return user, nil
+			}
+			return nil, domain.ErrUserNotFound
+		},
+	}
+	verifications := newMemEmailVerifications()
+	mailer := &fakeMailer{}
+	svc := newTestRegistrationService(userRepo, verifications, mailer)
+
+	for _, email := range []string{"unknown@example.com", "verified@example.com"} {
+		if err := svc.ResendVerification(ctx, email); err != nil {
+			t.Fatalf("%s: expected silent success, got %v", email, err)
+		}
+	}
+	if len(mailer.sent) != 0 {
+		t.Fatalf("expected no mail for unknown or verified addresses, got %d", len(mailer.sent))
+	}
+
+	if err := svc.ResendVerification(ctx, "pending@example.com"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if err := svc.ResendVerification(ctx, "pending@example.com"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(mailer.sent) != 2 {
+		t.Fatalf("expected two verification emails, got %d", len(mailer.sent))
+	}
+
+	if err := svc.VerifyEmail(ctx, tokenFromMail(t, mailer.sent[0])); !errors.Is(err, domain.ErrInvalidToken) {
+		t.Fatalf("expected superseded link to be rejected, got %v", err)
+	}
+	if err := svc.VerifyEmail(ctx, tokenFromMail(t, mailer.sent[1])); err != nil {
+		t.Fatalf("expected latest link to work, got %v", err)
+	}
+}
+
+func TestRegistrationServiceMailFailureKeepsAccount(t *testing.T) {
+	ctx := context.Background()
+	created := false
+	userRepo := &fakeUserRepo{
+		createUserFn: func(_ context.Context, _ *domain.User) error {
+			created = true
+			return nil
+		},
+	}
+	mailer := &fakeMailer{err: errors.New("smtp unavailable")}
+	svc := newTestRegistrationService(userRepo, newMemEmailVerifications(), mailer)
+
+	if _, err := svc.Register(ctx, "shopper", "shopper@example.com", "secret123"); err != nil {
+		t.Fatalf("expected registration to succeed despite mail failure, got %v", err)
+	}
+	if !created {
+		t.Fatalf("expected account to be created")
+	}
+}
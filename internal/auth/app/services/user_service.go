@@ -2,6 +2,10 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,30 +13,78 @@ import (
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/database/postgres"
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
+	pkgjwt "github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+)
+
+const (
+	defaultUserPageSize = 20
+	maxUserPageSize     = 100
 )
 
 type UserService interface {
-	CreateUser(ctx context.Context, login, password, role string) (*domain.User, error)
-	UpdateUser(ctx context.Context, id, login, password, role string) (*domain.User, error)
-	DeleteUser(ctx context.Context, id string) error
+	// CreateUser and UpdateUser only let actor hand out roles whose
+	// permissions they hold themselves, and only admins assign the admin
+	// role. UpdateUser, DeleteUser and RevokeUserTokens likewise refuse to
+	// touch a user whose current role the actor could not assign.
+	CreateUser(ctx context.Context, actor domain.Actor, login, password, role string) (*domain.User, error)
+	UpdateUser(ctx context.Context, actor domain.Actor, id, login, password, role string) (*domain.User, error)
+	DeleteUser(ctx context.Context, actor domain.Actor, id string) error
 	GetUser(ctx context.Context, id string) (*domain.User, error)
+	// ListUsers returns one page of users; a zero page or page size
+	// selects the first page of the default size.
+	ListUsers(ctx context.Context, filter domain.UserFilter) (*domain.UserListResult, error)
+	// DisableUser blocks login and refresh without deleting the user and
+	// revokes the access tokens issued so far. The actor cannot disable
+	// themselves, and like UpdateUser, DisableUser and EnableUser refuse
+	// to touch a user whose role the actor could not assign.
+	DisableUser(ctx context.Context, actor domain.Actor, id string) (*domain.User, error)
+	EnableUser(ctx context.Context, actor domain.Actor, id string) (*domain.User, error)
+	// RevokeUserTokens invalidates every access token issued to the user
+	// so far; refresh tokens keep working and yield tokens with the
+	// user's current role.
+	RevokeUserTokens(ctx context.Context, actor domain.Actor, id string) error
+	// UnlockUser clears the failed login attempts that locked the user out.
+	UnlockUser(ctx context.Context, id string) error
+
+	ListRoles(ctx context.Context) ([]domain.Role, error)
+	// SaveRole creates or updates a role with exactly the given
+	// permissions. Access tokens of the role's users are revoked when a
+	// permission is taken away; granted ones apply from the next refresh.
+	SaveRole(ctx context.Context, name, description string, permissions []string) (*domain.Role, error)
 }
 
 type userService struct {
-	userRepo postgres.UserRepository
+	userRepo    postgres.UserRepository
+	roles       postgres.RoleRepository
+	revocations postgres.RevocationRepository
+	throttles   postgres.LoginThrottleRepository
 }
 
-func NewUserService(userRepo postgres.UserRepository) UserService {
-	return &userService{userRepo: userRepo}
+func NewUserService(
+	userRepo postgres.UserRepository,
+	roles postgres.RoleRepository,
+	revocations postgres.RevocationRepository,
+	throttles postgres.LoginThrottleRepository,
+) UserService {
+	return &userService{
+		userRepo:    userRepo,
+		roles:       roles,
+		revocations: revocations,
+		throttles:   throttles,
+	}
 }
 
 func (s *userService) CreateUser(
 	ctx context.Context,
+	actor domain.Actor,
 	login, password, role string,
 ) (*domain.User, error) {
 	if role == "" {
 		role = domain.RoleUser
 	}
+	if err := s.requireAssignable(ctx, actor, role); err != nil {
+		return nil, err
+	}
 
 	hashedPassword, err := utils.HashPassword(password)
 	if err != nil {
@@ -58,6 +110,7 @@ func (s *userService) CreateUser(
 
 func (s *userService) UpdateUser(
 	ctx context.Context,
+	actor domain.Actor,
 	id, login, password, role string,
 ) (*domain.User, error) {
 	user, err := s.userRepo.GetUserByID(ctx, id)
@@ -68,6 +121,20 @@ func (s *userService) UpdateUser(
 	if login == "" && password == "" && role == "" {
 		return nil, domain.ErrInvalidArgument
 	}
+	// Changing the login or password of a more privileged user would be a
+	// way to sign in as them.
+	if err := s.requireAssignable(ctx, actor, user.Role); err != nil {
+		return nil, err
+	}
+	if role != "" && role != user.Role {
+		if err := s.requireAssignable(ctx, actor, role); err != nil {
+			return nil, err
+		}
+	}
+
+	// Tokens carry the role, so existing ones must stop working when it
+	// changes or the password is reset by an admin.
+	revokeTokens := (role != "" && role != user.Role) || password != ""
 
 	if login != "" {
 		user.Login = login
@@ -84,15 +151,255 @@ func (s *userService) UpdateUser(
 	}
 	user.UpdatedAt = time.Now()
 
-	if err := s.userRepo.UpdateUser(ctx, user); err != nil {
+	// Like a password reset, a new password set by an admin ends the
+	// user's sessions so that whoever knew the old one is signed out.
+	update := s.userRepo.UpdateUser
+	if password != "" {
+		update = s.userRepo.UpdateUserEndingSessions
+	}
+	if err := update(ctx, user); err != nil {
 		return nil, err
 	}
 
+	if revokeTokens {
+		if err := s.revokeTokensBeforeNow(ctx, user.ID); err != nil {
+			return nil, err
+		}
+	}
+
 	return user, nil
 }
 
-func (s *userService) DeleteUser(ctx context.Context, id string) error {
-	return s.userRepo.DeleteUser(ctx, id)
+func (s *userService) DeleteUser(ctx context.Context, actor domain.Actor, id string) error {
+	user, err := s.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.requireAssignable(ctx, actor, user.Role); err != nil {
+		return err
+	}
+	if err := s.userRepo.DeleteUser(ctx, id); err != nil {
+		return err
+	}
+	return s.revokeTokensBeforeNow(ctx, id)
+}
+
+func (s *userService) ListUsers(ctx context.Context, filter domain.UserFilter) (*domain.UserListResult, error) {
+	filter.Search = strings.TrimSpace(filter.Search)
+	if filter.Sort == "" {
+		filter.Sort = domain.UserSortNewest
+	}
+	if !domain.IsValidUserSort(filter.Sort) {
+		return nil, fmt.Errorf("%w: unknown sort %q", domain.ErrInvalidArgument, filter.Sort)
+	}
+	if filter.Status != "" && !domain.IsValidUserStatus(filter.Status) {
+		return nil, fmt.Errorf("%w: unknown status %q", domain.ErrInvalidArgument, filter.Status)
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+	if filter.PageSize <= 0 {
+		filter.PageSize = defaultUserPageSize
+	}
+	filter.PageSize = min(filter.PageSize, maxUserPageSize)
+
+	result, err := s.userRepo.ListUsers(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	result.Page = filter.Page
+	result.PageSize = filter.PageSize
+	return result, nil
+}
+
+func (s *userService) DisableUser(ctx context.Context, actor domain.Actor, id string) (*domain.User, error) {
+	if id == actor.UserID {
+		return nil, fmt.Errorf("%w: you cannot disable your own account", domain.ErrInvalidArgument)
+	}
+	user, err := s.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireAssignable(ctx, actor, user.Role); err != nil {
+		return nil, err
+	}
+	if !user.IsActive() {
+		return user, nil
+	}
+
+	now := time.Now()
+	if err := s.userRepo.SetDisabledAt(ctx, id, &now, now); err != nil {
+		return nil, err
+	}
+	if err := s.revokeTokensBeforeNow(ctx, id); err != nil {
+		return nil, err
+	}
+	user.DisabledAt = &now
+	user.UpdatedAt = now
+	return user, nil
+}
+
+func (s *userService) EnableUser(ctx context.Context, actor domain.Actor, id string) (*domain.User, error) {
+	user, err := s.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireAssignable(ctx, actor, user.Role); err != nil {
+		return nil, err
+	}
+	if user.IsActive() {
+		return user, nil
+	}
+
+	now := time.Now()
+	if err := s.userRepo.SetDisabledAt(ctx, id, nil, now); err != nil {
+		return nil, err
+	}
+	user.DisabledAt = nil
+	user.UpdatedAt = now
+	return user, nil
+}
+
+func (s *userService) RevokeUserTokens(ctx context.Context, actor domain.Actor, id string) error {
+	user, err := s.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.requireAssignable(ctx, actor, user.Role); err != nil {
+		return err
+	}
+	return s.revokeTokensBeforeNow(ctx, id)
+}
+
+func (s *userService) UnlockUser(ctx context.Context, id string) error {
+	user, err := s.userRepo.GetUserByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return s.throttles.Reset(ctx, domain.LoginScopeAccount, user.Login)
+}
+
+// requireAssignable checks that the role exists and that the actor may give
+// it to a user: admins may assign any role, anyone else only a role that
+// grants nothing they do not already hold.
+func (s *userService) requireAssignable(ctx context.Context, actor domain.Actor, name string) error {
+	role, err := s.roles.GetRole(ctx, name)
+	if err != nil {
+		if errors.Is(err, domain.ErrRoleNotFound) {
+			return fmt.Errorf("%w: unknown role %q", domain.ErrInvalidArgument, name)
+		}
+		return err
+	}
+	if actor.IsAdmin() {
+		return nil
+	}
+	if name == domain.RoleAdmin {
+		return fmt.Errorf("%w: only admins may manage admins", domain.ErrForbidden)
+	}
+	if missing := actor.MissingPermission(role.Permissions); missing != "" {
+		return fmt.Errorf("%w: role %q grants %q, which you do not hold", domain.ErrForbidden, name, missing)
+	}
+	return nil
+}
+
+func (s *userService) ListRoles(ctx context.Context) ([]domain.Role, error) {
+	roles, err := s.roles.ListRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range roles {
+		if roles[i].Name == domain.RoleAdmin {
+			roles[i].Permissions = slices.Clone(pkgjwt.AllPermissions)
+		}
+	}
+	return roles, nil
+}
+
+func (s *userService) SaveRole(
+	ctx context.Context,
+	name, description string,
+	permissions []string,
+) (*domain.Role, error) {
+	if !domain.IsValidRoleName(name) {
+		return nil, fmt.Errorf("%w: invalid role name %q", domain.ErrInvalidArgument, name)
+	}
+	if name == domain.RoleAdmin {
+		return nil, fmt.Errorf("%w: the admin role always has every permission", domain.ErrInvalidArgument)
+	}
+	if name == domain.RoleAPIClient {
+		return nil, fmt.Errorf("%w: role %q is reserved for API clients", domain.ErrInvalidArgument, name)
+	}
+	for _, permission := range permissions {
+		if !pkgjwt.IsKnownPermission(permission) {
+			return nil, fmt.Errorf("%w: unknown permission %q", domain.ErrInvalidArgument, permission)
+		}
+	}
+	permissions = slices.Compact(slices.Sorted(slices.Values(permissions)))
+
+	var previous []string
+	current, err := s.roles.GetRole(ctx, name)
+	switch {
+	case err == nil:
+		previous = current.Permissions
+	case !errors.Is(err, domain.ErrRoleNotFound):
+		return nil, err
+	}
+
+	now := time.Now()
+	role := &domain.Role{
+		Name:        name,
+		Description: strings.TrimSpace(description),
+		Permissions: permissions,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := s.roles.SaveRole(ctx, role); err != nil {
+		return nil, err
+	}
+
+	for _, permission := range previous {
+		if slices.Contains(permissions, permission) {
+			continue
+		}
+		if err := s.revokeRoleTokens(ctx, name); err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	return s.roles.GetRole(ctx, name)
+}
+
+// revokeRoleTokens cuts off the access tokens of every user with the role,
+// so that a withdrawn permission stops working right away.
+func (s *userService) revokeRoleTokens(ctx context.Context, role string) error {
+	userIDs, err := s.userRepo.ListUserIDsByRole(ctx, role)
+	if err != nil {
+		return err
+	}
+	for _, userID := range userIDs {
+		if err := s.revokeTokensBeforeNow(ctx, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *userService) revokeTokensBeforeNow(ctx context.Context, userID string) error {
+	return revokeAccessTokensIssuedSoFar(ctx, s.revocations, userID)
+}
+
+// revokeAccessTokensIssuedSoFar rounds the cutoff up to the next whole
+// second: iat has second precision, so a token issued earlier in the current
+// second must not slip through. A token minted later in that same second is
+// rejected as well and the client simply refreshes again.
+func revokeAccessTokensIssuedSoFar(
+	ctx context.Context,
+	revocations postgres.RevocationRepository,
+	userID string,
+) error {
+	cutoff := time.Now().Truncate(time.Second).Add(time.Second)
+	return revocations.RevokeUserTokensBefore(ctx, userID, cutoff)
 }
 
 func (s *userService) GetUser(ctx context.Context, id string) (*domain.User, error) {
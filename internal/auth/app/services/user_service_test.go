@@ -3,13 +3,17 @@ package services
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
+	pkgjwt "github.com/KarpovYuri/caraudio-backend/pkg/jwt"
 )
 
+var testAdmin = domain.Actor{UserID: "root-id", Role: domain.RoleAdmin}
+
 func TestUserServiceCreateUserSuccess(t *testing.T) {
 	ctx := context.Background()
 	var saved *domain.User
@@ -20,9 +24,9 @@ func TestUserServiceCreateUserSuccess(t *testing.T) {
 			return nil
 		},
 	}
-	svc := NewUserService(userRepo)
+	svc := NewUserService(userRepo, newMemRoles(), &fakeRevocationRepo{}, newMemLoginThrottles())
 
-	user, err := svc.CreateUser(ctx, "new-user", "password123", "")
+	user, err := svc.CreateUser(ctx, testAdmin, "new-user", "password123", "")
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -44,14 +48,39 @@ func TestUserServiceCreateUserAlreadyExists(t *testing.T) {
 			return domain.ErrUserAlreadyExists
 		},
 	}
-	svc := NewUserService(userRepo)
+	svc := NewUserService(userRepo, newMemRoles(), &fakeRevocationRepo{}, newMemLoginThrottles())
 
-	_, err := svc.CreateUser(ctx, "existing", "password123", domain.RoleAdmin)
+	_, err := svc.CreateUser(ctx, testAdmin, "existing", "password123", domain.RoleAdmin)
 	if !errors.Is(err, domain.ErrUserAlreadyExists) {
 		t.Fatalf("expected ErrUserAlreadyExists, got %v", err)
 	}
 }
 
+func TestUserServiceRejectsUnknownRole(t *testing.T) {
+	ctx := context.Background()
+	userRepo := &fakeUserRepo{
+		createUserFn: func(_ context.Context, _ *domain.User) error {
+			t.Fatalf("expected the user not to be saved")
+			return nil
+		},
+		getUserByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			return &domain.User{ID: id, Role: domain.RoleUser}, nil
+		},
+		updateUserFn: func(_ context.Context, _ *domain.User) error {
+			t.Fatalf("expected the user not to be updated")
+			return nil
+		},
+	}
+	svc := NewUserService(userRepo, newMemRoles(), &fakeRevocationRepo{}, newMemLoginThrottles())
+
+	if _, err := svc.CreateUser(ctx, testAdmin, "new-user", "password123", "superuser"); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument on create, got %v", err)
+	}
+	if _, err := svc.UpdateUser(ctx, testAdmin, "user-1", "", "", "superuser"); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument on update, got %v", err)
+	}
+}
+
 func TestUserServiceUpdateUserPartial(t *testing.T) {
 	ctx := context.Background()
 	existing := &domain.User{
@@ -78,9 +107,9 @@ func TestUserServiceUpdateUserPartial(t *testing.T) {
 			return nil
 		},
 	}
-	svc := NewUserService(userRepo)
+	svc := NewUserService(userRepo, newMemRoles(), &fakeRevocationRepo{}, newMemLoginThrottles())
 
-	user, err := svc.UpdateUser(ctx, "user-1", "", "", domain.RoleAdmin)
+	user, err := svc.UpdateUser(ctx, testAdmin, "user-1", "", "", domain.RoleAdmin)
 	if err != nil {
 		t.Fatalf("expected no error, got %v", err)
 	}
@@ -96,25 +125,407 @@ func TestUserServiceUpdateUserNoFields(t *testing.T) {
 			return &domain.User{ID: "user-1"}, nil
 		},
 	}
-	svc := NewUserService(userRepo)
+	svc := NewUserService(userRepo, newMemRoles(), &fakeRevocationRepo{}, newMemLoginThrottles())
 
-	_, err := svc.UpdateUser(ctx, "user-1", "", "", "")
+	_, err := svc.UpdateUser(ctx, testAdmin, "user-1", "", "", "")
 	if !errors.Is(err, domain.ErrInvalidArgument) {
 		t.Fatalf("expected ErrInvalidArgument, got %v", err)
 	}
 }
 
+func TestUserServiceLimitsRoleAssignment(t *testing.T) {
+	ctx := context.Background()
+	users := map[string]*domain.User{
+		"user-1":  {ID: "user-1", Login: "customer", Role: domain.RoleUser},
+		"admin-1": {ID: "admin-1", Login: "root", Role: domain.RoleAdmin},
+	}
+	userRepo := &fakeUserRepo{
+		createUserFn: func(_ context.Context, _ *domain.User) error { return nil },
+		getUserByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			copied := *users[id]
+			return &copied, nil
+		},
+		updateUserFn: func(_ context.Context, _ *domain.User) error { return nil },
+	}
+	svc := NewUserService(userRepo, newMemRoles(), &fakeRevocationRepo{}, newMemLoginThrottles())
+
+	support := domain.Actor{UserID: "support-id", Role: "support", Permissions: []string{pkgjwt.PermUsersManage}}
+	manager := domain.Actor{
+		UserID:      "manager-id",
+		Role:        "manager",
+		Permissions: []string{pkgjwt.PermUsersManage, pkgjwt.PermCatalogProductsWrite},
+	}
+
+	if _, err := svc.CreateUser(ctx, support, "new-user", "password123", ""); err != nil {
+		t.Fatalf("expected a plain user to be created, got %v", err)
+	}
+	if _, err := svc.CreateUser(ctx, support, "new-admin", "password123", domain.RoleAdmin); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for the admin role, got %v", err)
+	}
+	if _, err := svc.CreateUser(ctx, support, "editor", "password123", "content_manager"); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for a role granting more, got %v", err)
+	}
+	if _, err := svc.CreateUser(ctx, manager, "editor", "password123", "content_manager"); err != nil {
+		t.Fatalf("expected a role within the caller's permissions to be allowed, got %v", err)
+	}
+	if _, err := svc.UpdateUser(ctx, manager, "user-1", "", "", domain.RoleAdmin); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for a promotion to admin, got %v", err)
+	}
+	if _, err := svc.UpdateUser(ctx, manager, "admin-1", "", "new-password", ""); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for a password change of an admin, got %v", err)
+	}
+	if _, err := svc.UpdateUser(ctx, testAdmin, "user-1", "", "", domain.RoleAdmin); err != nil {
+		t.Fatalf("expected admins to assign any role, got %v", err)
+	}
+}
+
+func TestUserServiceLimitsDisablingPrivilegedUsers(t *testing.T) {
+	ctx := context.Background()
+	disabledAt := time.Now()
+	users := map[string]*domain.User{
+		"user-1":   {ID: "user-1", Login: "customer", Role: domain.RoleUser},
+		"admin-1":  {ID: "admin-1", Login: "root", Role: domain.RoleAdmin},
+		"editor-1": {ID: "editor-1", Login: "editor", Role: "content_manager", DisabledAt: &disabledAt},
+	}
+	userRepo := &fakeUserRepo{
+		getUserByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			copied := *users[id]
+			return &copied, nil
+		},
+		setDisabledAtFn: func(_ context.Context, _ string, _ *time.Time, _ time.Time) error { return nil },
+	}
+	svc := NewUserService(userRepo, newMemRoles(), &fakeRevocationRepo{}, newMemLoginThrottles())
+	support := domain.Actor{UserID: "support-id", Role: "support", Permissions: []string{pkgjwt.PermUsersManage}}
+
+	if _, err := svc.DisableUser(ctx, support, "admin-1"); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for disabling an admin, got %v", err)
+	}
+	if _, err := svc.EnableUser(ctx, support, "editor-1"); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for enabling a role granting more, got %v", err)
+	}
+	if _, err := svc.DisableUser(ctx, support, "user-1"); err != nil {
+		t.Fatalf("expected a plain user to be disabled, got %v", err)
+	}
+	if _, err := svc.DisableUser(ctx, testAdmin, "admin-1"); err != nil {
+		t.Fatalf("expected admins to disable other admins, got %v", err)
+	}
+}
+
+func TestUserServiceLimitsDeletingPrivilegedUsers(t *testing.T) {
+	ctx := context.Background()
+	users := map[string]*domain.User{
+		"user-1":  {ID: "user-1", Login: "customer", Role: domain.RoleUser},
+		"admin-1": {ID: "admin-1", Login: "root", Role: domain.RoleAdmin},
+	}
+	var deleted, revoked []string
+	userRepo := &fakeUserRepo{
+		getUserByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			copied := *users[id]
+			return &copied, nil
+		},
+		deleteUserFn: func(_ context.Context, id string) error {
+			deleted = append(deleted, id)
+			return nil
+		},
+	}
+	revocations := &fakeRevocationRepo{
+		revokeUserTokensBeforeFn: func(_ context.Context, userID string, _ time.Time) error {
+			revoked = append(revoked, userID)
+			return nil
+		},
+	}
+	svc := NewUserService(userRepo, newMemRoles(), revocations, newMemLoginThrottles())
+	support := domain.Actor{UserID: "support-id", Role: "support", Permissions: []string{pkgjwt.PermUsersManage}}
+
+	if err := svc.DeleteUser(ctx, support, "admin-1"); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for deleting an admin, got %v", err)
+	}
+	if err := svc.RevokeUserTokens(ctx, support, "admin-1"); !errors.Is(err, domain.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for revoking an admin's tokens, got %v", err)
+	}
+	if len(deleted) != 0 || len(revoked) != 0 {
+		t.Fatalf("expected the admin to be left alone, deleted %v, revoked %v", deleted, revoked)
+	}
+
+	if err := svc.RevokeUserTokens(ctx, support, "user-1"); err != nil {
+		t.Fatalf("expected a plain user's tokens to be revoked, got %v", err)
+	}
+	if err := svc.DeleteUser(ctx, testAdmin, "admin-1"); err != nil {
+		t.Fatalf("expected admins to delete other admins, got %v", err)
+	}
+	if !slices.Equal(deleted, []string{"admin-1"}) || !slices.Equal(revoked, []string{"user-1", "admin-1"}) {
+		t.Fatalf("unexpected changes: deleted %v, revoked %v", deleted, revoked)
+	}
+}
+
 func TestUserServiceDeleteUserNotFound(t *testing.T) {
 	ctx := context.Background()
 	userRepo := &fakeUserRepo{
-		deleteUserFn: func(_ context.Context, _ string) error {
-			return domain.ErrUserNotFound
+		getUserByIDFn: func(_ context.Context, _ string) (*domain.User, error) {
+			return nil, domain.ErrUserNotFound
 		},
 	}
-	svc := NewUserService(userRepo)
+	svc := NewUserService(userRepo, newMemRoles(), &fakeRevocationRepo{}, newMemLoginThrottles())
 
-	err := svc.DeleteUser(ctx, "missing-id")
+	err := svc.DeleteUser(ctx, testAdmin, "missing-id")
 	if !errors.Is(err, domain.ErrUserNotFound) {
 		t.Fatalf("expected ErrUserNotFound, got %v", err)
 	}
 }
+
+func TestUserServiceRoleChangeRevokesTokens(t *testing.T) {
+	ctx := context.Background()
+	userRepo := &fakeUserRepo{
+		getUserByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			return &domain.User{ID: id, Login: "admin", Role: domain.RoleAdmin}, nil
+		},
+		updateUserFn: func(_ context.Context, _ *domain.User) error {
+			return nil
+		},
+	}
+
+	t.Run("demotion", func(t *testing.T) {
+		var revokedUser string
+		var cutoff time.Time
+		revocations := &fakeRevocationRepo{
+			revokeUserTokensBeforeFn: func(_ context.Context, userID string, notBefore time.Time) error {
+				revokedUser, cutoff = userID, notBefore
+				return nil
+			},
+		}
+		svc := NewUserService(userRepo, newMemRoles(), revocations, newMemLoginThrottles())
+
+		before := time.Now()
+		if _, err := svc.UpdateUser(ctx, testAdmin, "admin-1", "", "", domain.RoleUser); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if revokedUser != "admin-1" {
+			t.Fatalf("expected tokens of admin-1 to be revoked, got %q", revokedUser)
+		}
+		if !cutoff.After(before) {
+			t.Fatalf("expected cutoff to cover tokens issued so far, got %v", cutoff)
+		}
+	})
+
+	t.Run("login only", func(t *testing.T) {
+		revocations := &fakeRevocationRepo{
+			revokeUserTokensBeforeFn: func(_ context.Context, _ string, _ time.Time) error {
+				t.Fatalf("expected no revocation for a login change")
+				return nil
+			},
+		}
+		svc := NewUserService(userRepo, newMemRoles(), revocations, newMemLoginThrottles())
+
+		if _, err := svc.UpdateUser(ctx, testAdmin, "admin-1", "renamed", "", domain.RoleAdmin); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if len(userRepo.endedSessionsOf) != 0 {
+			t.Fatalf("expected sessions to survive a login change, ended for %v", userRepo.endedSessionsOf)
+		}
+	})
+
+	t.Run("password", func(t *testing.T) {
+		var revokedUser string
+		revocations := &fakeRevocationRepo{
+			revokeUserTokensBeforeFn: func(_ context.Context, userID string, _ time.Time) error {
+				revokedUser = userID
+				return nil
+			},
+		}
+		svc := NewUserService(userRepo, newMemRoles(), revocations, newMemLoginThrottles())
+
+		if _, err := svc.UpdateUser(ctx, testAdmin, "admin-1", "", "new-password", ""); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if revokedUser != "admin-1" {
+			t.Fatalf("expected tokens of admin-1 to be revoked, got %q", revokedUser)
+		}
+		if !slices.Equal(userRepo.endedSessionsOf, []string{"admin-1"}) {
+			t.Fatalf("expected the sessions of admin-1 to end, got %v", userRepo.endedSessionsOf)
+		}
+	})
+}
+
+func TestUserServiceUnlockUser(t *testing.T) {
+	ctx := context.Background()
+	throttles := newMemLoginThrottles()
+	now := time.Now()
+	if _, err := throttles.RecordFailure(ctx, domain.LoginScopeAccount, "alice", now, now.Add(-time.Hour), now.Add(time.Hour), 1); err != nil {
+		t.Fatalf("failed to seed lockout: %v", err)
+	}
+	userRepo := &fakeUserRepo{
+		getUserByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			if id != "user-1" {
+				return nil, domain.ErrUserNotFound
+			}
+			return &domain.User{ID: id, Login: "alice"}, nil
+		},
+	}
+	svc := NewUserService(userRepo, newMemRoles(), &fakeRevocationRepo{}, throttles)
+
+	if err := svc.UnlockUser(ctx, "missing"); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+	if err := svc.UnlockUser(ctx, "user-1"); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if throttle, _ := throttles.Get(ctx, domain.LoginScopeAccount, "alice"); throttle != nil {
+		t.Fatalf("expected lockout to be cleared, got %+v", throttle)
+	}
+}
+
+func TestUserServiceSaveRole(t *testing.T) {
+	ctx := context.Background()
+	roles := newMemRoles()
+	var revoked []string
+	revocations := &fakeRevocationRepo{
+		revokeUserTokensBeforeFn: func(_ context.Context, userID string, _ time.Time) error {
+			revoked = append(revoked, userID)
+			return nil
+		},
+	}
+	userRepo := &fakeUserRepo{
+		listByRoleFn: func(_ context.Context, role string) ([]string, error) {
+			if role != "content_manager" {
+				t.Fatalf("unexpected role: %s", role)
+			}
+			return []string{"editor-1", "editor-2"}, nil
+		},
+	}
+	svc := NewUserService(userRepo, roles, revocations, newMemLoginThrottles())
+
+	t.Run("validation", func(t *testing.T) {
+		for name, permissions := range map[string][]string{
+			"Bad Name":           nil,
+			domain.RoleAdmin:     nil,
+			domain.RoleAPIClient: nil,
+			"content_manager2":   {"catalog.everything"},
+		} {
+			if _, err := svc.SaveRole(ctx, name, "", permissions); !errors.Is(err, domain.ErrInvalidArgument) {
+				t.Fatalf("%s: expected ErrInvalidArgument, got %v", name, err)
+			}
+		}
+	})
+
+	t.Run("grant keeps tokens", func(t *testing.T) {
+		role, err := svc.SaveRole(ctx, "content_manager", "Edits products", []string{
+			pkgjwt.PermCatalogPricesWrite,
+			pkgjwt.PermCatalogProductsWrite,
+			pkgjwt.PermCatalogPricesWrite,
+		})
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		want := []string{pkgjwt.PermCatalogPricesWrite, pkgjwt.PermCatalogProductsWrite}
+		if !slices.Equal(role.Permissions, want) {
+			t.Fatalf("expected %v, got %v", want, role.Permissions)
+		}
+		if len(revoked) != 0 {
+			t.Fatalf("expected no revocation when only granting, got %v", revoked)
+		}
+	})
+
+	t.Run("withdrawal revokes tokens", func(t *testing.T) {
+		if _, err := svc.SaveRole(ctx, "content_manager", "Edits products", []string{pkgjwt.PermCatalogProductsWrite}); err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		if !slices.Equal(revoked, []string{"editor-1", "editor-2"}) {
+			t.Fatalf("expected the role's users to be revoked, got %v", revoked)
+		}
+	})
+}
+
+func TestUserServiceListUsers(t *testing.T) {
+	ctx := context.Background()
+	var got domain.UserFilter
+	userRepo := &fakeUserRepo{
+		listUsersFn: func(_ context.Context, filter domain.UserFilter) (*domain.UserListResult, error) {
+			got = filter
+			return &domain.UserListResult{Users: []domain.User{{ID: "user-1"}}, Total: 1}, nil
+		},
+	}
+	svc := NewUserService(userRepo, newMemRoles(), &fakeRevocationRepo{}, newMemLoginThrottles())
+
+	result, err := svc.ListUsers(ctx, domain.UserFilter{Search: "  buyer ", Role: domain.RoleUser})
+	if err != nil {
+		t.Fatalf("ListUsers returned error: %v", err)
+	}
+	if got.Search != "buyer" || got.Sort != domain.UserSortNewest || got.Page != 1 || got.PageSize != defaultUserPageSize {
+		t.Fatalf("unexpected filter passed to the repository: %+v", got)
+	}
+	if result.Total != 1 || result.Page != 1 || result.PageSize != defaultUserPageSize {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+
+	if _, err := svc.ListUsers(ctx, domain.UserFilter{Page: 3, PageSize: 1000, Sort: domain.UserSortLastLogin}); err != nil {
+		t.Fatalf("ListUsers returned error: %v", err)
+	}
+	if got.Page != 3 || got.PageSize != maxUserPageSize {
+		t.Fatalf("expected page size capped at %d, got %+v", maxUserPageSize, got)
+	}
+
+	for _, filter := range []domain.UserFilter{{Sort: "password"}, {Status: "deleted"}} {
+		if _, err := svc.ListUsers(ctx, filter); !errors.Is(err, domain.ErrInvalidArgument) {
+			t.Fatalf("%+v: expected ErrInvalidArgument, got %v", filter, err)
+		}
+	}
+}
+
+func TestUserServiceDisableAndEnableUser(t *testing.T) {
+	ctx := context.Background()
+	user := &domain.User{ID: "user-1", Role: domain.RoleUser}
+	var revokedFor []string
+	userRepo := &fakeUserRepo{
+		getUserByIDFn: func(_ context.Context, id string) (*domain.User, error) {
+			if id != user.ID {
+				return nil, domain.ErrUserNotFound
+			}
+			copied := *user
+			return &copied, nil
+		},
+		setDisabledAtFn: func(_ context.Context, _ string, disabledAt *time.Time, _ time.Time) error {
+			user.DisabledAt = disabledAt
+			return nil
+		},
+	}
+	revocations := &fakeRevocationRepo{
+		revokeUserTokensBeforeFn: func(_ context.Context, userID string, _ time.Time) error {
+			revokedFor = append(revokedFor, userID)
+			return nil
+		},
+	}
+	svc := NewUserService(userRepo, newMemRoles(), revocations, newMemLoginThrottles())
+
+	if _, err := svc.DisableUser(ctx, testAdmin, testAdmin.UserID); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Fatalf("expected admins to be unable to disable themselves, got %v", err)
+	}
+	if _, err := svc.DisableUser(ctx, testAdmin, "missing"); !errors.Is(err, domain.ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound, got %v", err)
+	}
+
+	disabled, err := svc.DisableUser(ctx, testAdmin, user.ID)
+	if err != nil {
+		t.Fatalf("DisableUser returned error: %v", err)
+	}
+	if disabled.IsActive() || user.DisabledAt == nil {
+		t.Fatal("expected the user to be disabled")
+	}
+	if !slices.Equal(revokedFor, []string{user.ID}) {
+		t.Fatalf("expected the user's access tokens to be revoked, got %v", revokedFor)
+	}
+
+	if _, err := svc.DisableUser(ctx, testAdmin, user.ID); err != nil {
+		t.Fatalf("disabling twice returned error: %v", err)
+	}
+	if len(revokedFor) != 1 {
+		t.Fatalf("expected no further revocation, got %v", revokedFor)
+	}
+
+	enabled, err := svc.EnableUser(ctx, testAdmin, user.ID)
+	if err != nil {
+		t.Fatalf("EnableUser returned error: %v", err)
+	}
+	if !enabled.IsActive() || user.DisabledAt != nil {
+		t.Fatal("expected the user to be enabled")
+	}
+}
@@ -1,10 +1,12 @@
 package config
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"log/slog"
 	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -16,15 +18,124 @@ import (
 type Config struct {
 	GRPCPort          string         `mapstructure:"grpc_port"`
 	HTTPPort          string         `mapstructure:"http_port"`
-	JWTSecret         string         `mapstructure:"jwt_secret"`
+	JWTSigningKeyID   string         `mapstructure:"jwt_signing_key_id"`
+	JWTKeys           []JWTKeyConfig `mapstructure:"jwt_keys"`
+	JWTIssuer         string         `mapstructure:"jwt_issuer"`
+	JWTAudience       string         `mapstructure:"jwt_audience"`
+	JWTLeeway         time.Duration  `mapstructure:"jwt_leeway"`
 	AllowedOrigins    []string       `mapstructure:"allowed_origins"`
+	TrustedProxies    []string       `mapstructure:"trusted_proxies"`
 	CookieSecure      bool           `mapstructure:"cookie_secure"`
 	HTTPReadTimeout   time.Duration  `mapstructure:"http_read_timeout"`
 	HTTPWriteTimeout  time.Duration  `mapstructure:"http_write_timeout"`
 	HTTPIdleTimeout   time.Duration  `mapstructure:"http_idle_timeout"`
 	ShutdownTimeout   time.Duration  `mapstructure:"shutdown_timeout"`
 	TokenCleanupEvery time.Duration  `mapstructure:"token_cleanup_every"`
-	Database          DatabaseConfig `mapstructure:"database"`
+	MaxSessions       int            `mapstructure:"max_sessions_per_user"`
+	RefreshReuseGrace time.Duration  `mapstructure:"refresh_reuse_grace"`
+	// EmailVerificationURL is the frontend page verification links point to.
+	EmailVerificationURL string        `mapstructure:"email_verification_url"`
+	EmailVerificationTTL time.Duration `mapstructure:"email_verification_ttl"`
+	// PasswordResetURL is the frontend page password reset links point to.
+	PasswordResetURL string                `mapstructure:"password_reset_url"`
+	PasswordResetTTL time.Duration         `mapstructure:"password_reset_ttl"`
+	Mailer           MailerConfig          `mapstructure:"mailer"`
+	LoginProtection  LoginProtectionConfig `mapstructure:"login_protection"`
+	MFA              MFAConfig             `mapstructure:"mfa"`
+	OAuth            OAuthConfig           `mapstructure:"oauth"`
+	Database         DatabaseConfig        `mapstructure:"database"`
+}
+
+// LoginProtectionConfig limits password guessing per login and per client
+// IP; see services.LoginProtectionOptions.
+type LoginProtectionConfig struct {
+	MaxFailures      int           `mapstructure:"max_failures"`
+	MaxFailuresPerIP int           `mapstructure:"max_failures_per_ip"`
+	Window           time.Duration `mapstructure:"window"`
+	Lockout          time.Duration `mapstructure:"lockout"`
+	BaseDelay        time.Duration `mapstructure:"base_delay"`
+	MaxDelay         time.Duration `mapstructure:"max_delay"`
+}
+
+// MFAConfig configures TOTP two-factor authentication. Users with one of
+// RequiredRoles cannot log in without it. EncryptionKey is the base64
+// encoded 32 byte key TOTP secrets are encrypted with at rest.
+type MFAConfig struct {
+	Issuer        string        `mapstructure:"issuer"`
+	RequiredRoles []string      `mapstructure:"required_roles"`
+	ChallengeTTL  time.Duration `mapstructure:"challenge_ttl"`
+	EncryptionKey string        `mapstructure:"encryption_key"`
+}
+
+// Key decodes EncryptionKey.
+func (c MFAConfig) Key() ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(c.EncryptionKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid MFA encryption key: %w", err)
+	}
+	if len(key) != mfaKeySize {
+		return nil, fmt.Errorf("MFA encryption key must be %d bytes, got %d", mfaKeySize, len(key))
+	}
+	return key, nil
+}
+
+const mfaKeySize = 32
+
+// OAuthConfig configures social login. Providers are keyed by the name used
+// in the login routes. RedirectURL is the frontend page providers send the
+// user back to; the provider name is appended to it unless the provider sets
+// its own. Client secrets are best set through AUTH_OAUTH_<NAME>_CLIENT_SECRET.
+type OAuthConfig struct {
+	RedirectURL string                         `mapstructure:"redirect_url"`
+	StateTTL    time.Duration                  `mapstructure:"state_ttl"`
+	Providers   map[string]OAuthProviderConfig `mapstructure:"providers"`
+}
+
+// OAuthProviderConfig describes one provider; see oauth.Config.
+type OAuthProviderConfig struct {
+	ClientID           string   `mapstructure:"client_id"`
+	ClientSecret       string   `mapstructure:"client_secret"`
+	AuthURL            string   `mapstructure:"auth_url"`
+	TokenURL           string   `mapstructure:"token_url"`
+	UserInfoURL        string   `mapstructure:"userinfo_url"`
+	UserInfoTokenType  string   `mapstructure:"userinfo_token_type"`
+	Issuer             string   `mapstructure:"issuer"`
+	JWKSURL            string   `mapstructure:"jwks_url"`
+	RedirectURL        string   `mapstructure:"redirect_url"`
+	Scopes             []string `mapstructure:"scopes"`
+	SubjectClaim       string   `mapstructure:"subject_claim"`
+	EmailClaim         string   `mapstructure:"email_claim"`
+	EmailVerifiedClaim string   `mapstructure:"email_verified_claim"`
+	TrustEmail         bool     `mapstructure:"trust_email"`
+}
+
+// oauthProviderName keeps provider names usable in URLs, env var names and
+// generated logins.
+var oauthProviderName = regexp.MustCompile(`^[a-z0-9_]{1,50}$`)
+
+const (
+	MailerDriverFile = "file"
+	MailerDriverSMTP = "smtp"
+)
+
+// MailerConfig selects how transactional email is delivered. The file
+// driver writes messages to FilePath (or the log when empty) and is meant
+// for local development.
+type MailerConfig struct {
+	Driver       string `mapstructure:"driver"`
+	From         string `mapstructure:"from"`
+	FilePath     string `mapstructure:"file_path"`
+	SMTPHost     string `mapstructure:"smtp_host"`
+	SMTPPort     int    `mapstructure:"smtp_port"`
+	SMTPUsername string `mapstructure:"smtp_username"`
+	SMTPPassword string `mapstructure:"smtp_password"`
+}
+
+// JWTKeyConfig is one private key in the signing key ring. Keep retired keys
+// listed until tokens they signed have expired.
+type JWTKeyConfig struct {
+	KID            string `mapstructure:"kid"`
+	PrivateKeyFile string `mapstructure:"private_key_file"`
 }
 
 type DatabaseConfig struct {
@@ -71,8 +182,13 @@ func LoadConfig() (*Config, error) {
 	if dbHost := os.Getenv("AUTH_DATABASE_HOST"); dbHost != "" {
 		cfg.Database.Host = dbHost
 	}
-	if jwtSecret := os.Getenv("AUTH_JWT_SECRET"); jwtSecret != "" {
-		cfg.JWTSecret = jwtSecret
+	if keyFile := os.Getenv("AUTH_JWT_PRIVATE_KEY_FILE"); keyFile != "" {
+		kid := os.Getenv("AUTH_JWT_KEY_ID")
+		if kid == "" {
+			kid = "default"
+		}
+		cfg.JWTKeys = []JWTKeyConfig{{KID: kid, PrivateKeyFile: keyFile}}
+		cfg.JWTSigningKeyID = kid
 	}
 	if grpcPort := os.Getenv("AUTH_GRPC_PORT"); grpcPort != "" {
 		cfg.GRPCPort = grpcPort
@@ -83,6 +199,9 @@ func LoadConfig() (*Config, error) {
 	if allowedOrigins := os.Getenv("AUTH_ALLOWED_ORIGINS"); allowedOrigins != "" {
 		cfg.AllowedOrigins = parseCommaSeparatedList(allowedOrigins)
 	}
+	if trustedProxies := os.Getenv("AUTH_TRUSTED_PROXIES"); trustedProxies != "" {
+		cfg.TrustedProxies = parseCommaSeparatedList(trustedProxies)
+	}
 	if cookieSecure := os.Getenv("AUTH_COOKIE_SECURE"); cookieSecure != "" {
 		parsed, err := strconv.ParseBool(cookieSecure)
 		if err != nil {
@@ -125,9 +244,139 @@ func LoadConfig() (*Config, error) {
 		}
 		cfg.TokenCleanupEvery = duration
 	}
+	if issuer := os.Getenv("AUTH_JWT_ISSUER"); issuer != "" {
+		cfg.JWTIssuer = issuer
+	}
+	if audience := os.Getenv("AUTH_JWT_AUDIENCE"); audience != "" {
+		cfg.JWTAudience = audience
+	}
+	if leeway := os.Getenv("AUTH_JWT_LEEWAY"); leeway != "" {
+		duration, err := time.ParseDuration(leeway)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_JWT_LEEWAY value: %w", err)
+		}
+		cfg.JWTLeeway = duration
+	}
+	if maxSessions := os.Getenv("AUTH_MAX_SESSIONS_PER_USER"); maxSessions != "" {
+		parsed, err := strconv.Atoi(maxSessions)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_MAX_SESSIONS_PER_USER value: %w", err)
+		}
+		cfg.MaxSessions = parsed
+	}
+	if reuseGrace := os.Getenv("AUTH_REFRESH_REUSE_GRACE"); reuseGrace != "" {
+		duration, err := time.ParseDuration(reuseGrace)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_REFRESH_REUSE_GRACE value: %w", err)
+		}
+		cfg.RefreshReuseGrace = duration
+	}
+	if maxFailures := os.Getenv("AUTH_LOGIN_MAX_FAILURES"); maxFailures != "" {
+		parsed, err := strconv.Atoi(maxFailures)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_LOGIN_MAX_FAILURES value: %w", err)
+		}
+		cfg.LoginProtection.MaxFailures = parsed
+	}
+	if maxFailuresPerIP := os.Getenv("AUTH_LOGIN_MAX_FAILURES_PER_IP"); maxFailuresPerIP != "" {
+		parsed, err := strconv.Atoi(maxFailuresPerIP)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_LOGIN_MAX_FAILURES_PER_IP value: %w", err)
+		}
+		cfg.LoginProtection.MaxFailuresPerIP = parsed
+	}
+	if window := os.Getenv("AUTH_LOGIN_FAILURE_WINDOW"); window != "" {
+		duration, err := time.ParseDuration(window)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_LOGIN_FAILURE_WINDOW value: %w", err)
+		}
+		cfg.LoginProtection.Window = duration
+	}
+	if lockout := os.Getenv("AUTH_LOGIN_LOCKOUT"); lockout != "" {
+		duration, err := time.ParseDuration(lockout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_LOGIN_LOCKOUT value: %w", err)
+		}
+		cfg.LoginProtection.Lockout = duration
+	}
+	if verificationURL := os.Getenv("AUTH_EMAIL_VERIFICATION_URL"); verificationURL != "" {
+		cfg.EmailVerificationURL = verificationURL
+	}
+	if verificationTTL := os.Getenv("AUTH_EMAIL_VERIFICATION_TTL"); verificationTTL != "" {
+		duration, err := time.ParseDuration(verificationTTL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_EMAIL_VERIFICATION_TTL value: %w", err)
+		}
+		cfg.EmailVerificationTTL = duration
+	}
+	if resetURL := os.Getenv("AUTH_PASSWORD_RESET_URL"); resetURL != "" {
+		cfg.PasswordResetURL = resetURL
+	}
+	if resetTTL := os.Getenv("AUTH_PASSWORD_RESET_TTL"); resetTTL != "" {
+		duration, err := time.ParseDuration(resetTTL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_PASSWORD_RESET_TTL value: %w", err)
+		}
+		cfg.PasswordResetTTL = duration
+	}
+	if driver := os.Getenv("AUTH_MAILER_DRIVER"); driver != "" {
+		cfg.Mailer.Driver = driver
+	}
+	if from := os.Getenv("AUTH_MAILER_FROM"); from != "" {
+		cfg.Mailer.From = from
+	}
+	if filePath := os.Getenv("AUTH_MAILER_FILE_PATH"); filePath != "" {
+		cfg.Mailer.FilePath = filePath
+	}
+	if smtpHost := os.Getenv("AUTH_SMTP_HOST"); smtpHost != "" {
+		cfg.Mailer.SMTPHost = smtpHost
+	}
+	if smtpPort := os.Getenv("AUTH_SMTP_PORT"); smtpPort != "" {
+		parsed, err := strconv.Atoi(smtpPort)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_SMTP_PORT value: %w", err)
+		}
+		cfg.Mailer.SMTPPort = parsed
+	}
+	if smtpUsername := os.Getenv("AUTH_SMTP_USERNAME"); smtpUsername != "" {
+		cfg.Mailer.SMTPUsername = smtpUsername
+	}
+	if smtpPassword := os.Getenv("AUTH_SMTP_PASSWORD"); smtpPassword != "" {
+		cfg.Mailer.SMTPPassword = smtpPassword
+	}
+	if issuer := os.Getenv("AUTH_MFA_ISSUER"); issuer != "" {
+		cfg.MFA.Issuer = issuer
+	}
+	if requiredRoles := os.Getenv("AUTH_MFA_REQUIRED_ROLES"); requiredRoles != "" {
+		cfg.MFA.RequiredRoles = parseCommaSeparatedList(requiredRoles)
+	}
+	if challengeTTL := os.Getenv("AUTH_MFA_CHALLENGE_TTL"); challengeTTL != "" {
+		duration, err := time.ParseDuration(challengeTTL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid AUTH_MFA_CHALLENGE_TTL value: %w", err)
+		}
+		cfg.MFA.ChallengeTTL = duration
+	}
+	if encryptionKey := os.Getenv("AUTH_MFA_ENCRYPTION_KEY"); encryptionKey != "" {
+		cfg.MFA.EncryptionKey = encryptionKey
+	}
+	if redirectURL := os.Getenv("AUTH_OAUTH_REDIRECT_URL"); redirectURL != "" {
+		cfg.OAuth.RedirectURL = redirectURL
+	}
+	for name, provider := range cfg.OAuth.Providers {
+		if secret := os.Getenv("AUTH_OAUTH_" + strings.ToUpper(name) + "_CLIENT_SECRET"); secret != "" {
+			provider.ClientSecret = secret
+			cfg.OAuth.Providers[name] = provider
+		}
+	}
 
-	if cfg.JWTSecret == "" {
-		return nil, errors.New("AUTH_JWT_SECRET is required")
+	if len(cfg.JWTKeys) == 0 {
+		return nil, errors.New("jwt_keys (or AUTH_JWT_PRIVATE_KEY_FILE) is required")
+	}
+	for _, key := range cfg.JWTKeys {
+		if key.KID == "" || key.PrivateKeyFile == "" {
+			return nil, errors.New("every jwt_keys entry needs kid and private_key_file")
+		}
 	}
 	if cfg.Database.User == "" {
 		return nil, errors.New("AUTH_DATABASE_USER is required")
@@ -150,6 +399,11 @@ func LoadConfig() (*Config, error) {
 	if len(cfg.AllowedOrigins) == 0 {
 		return nil, errors.New("AUTH_ALLOWED_ORIGINS (or AUTH_ALLOWED_ORIGIN) is required")
 	}
+	if len(cfg.TrustedProxies) == 0 {
+		// The HTTP gateway runs in the same process and calls in over
+		// loopback.
+		cfg.TrustedProxies = []string{"127.0.0.1", "::1"}
+	}
 	if cfg.HTTPReadTimeout <= 0 {
 		cfg.HTTPReadTimeout = 15 * time.Second
 	}
@@ -165,6 +419,93 @@ func LoadConfig() (*Config, error) {
 	if cfg.TokenCleanupEvery <= 0 {
 		cfg.TokenCleanupEvery = 10 * time.Minute
 	}
+	if cfg.JWTIssuer == "" {
+		cfg.JWTIssuer = "caraudio-auth"
+	}
+	if cfg.JWTAudience == "" {
+		cfg.JWTAudience = "caraudio-api"
+	}
+	if cfg.JWTLeeway < 0 {
+		cfg.JWTLeeway = 0
+	}
+	if cfg.MaxSessions <= 0 {
+		cfg.MaxSessions = 10
+	}
+	if cfg.RefreshReuseGrace <= 0 {
+		cfg.RefreshReuseGrace = 10 * time.Second
+	}
+	if cfg.LoginProtection.MaxFailures <= 0 {
+		cfg.LoginProtection.MaxFailures = 5
+	}
+	if cfg.LoginProtection.MaxFailuresPerIP <= 0 {
+		cfg.LoginProtection.MaxFailuresPerIP = 50
+	}
+	if cfg.LoginProtection.Window <= 0 {
+		cfg.LoginProtection.Window = 15 * time.Minute
+	}
+	if cfg.LoginProtection.Lockout <= 0 {
+		cfg.LoginProtection.Lockout = 15 * time.Minute
+	}
+	if cfg.EmailVerificationURL == "" {
+		return nil, errors.New("AUTH_EMAIL_VERIFICATION_URL is required")
+	}
+	if cfg.EmailVerificationTTL <= 0 {
+		cfg.EmailVerificationTTL = 24 * time.Hour
+	}
+	if cfg.PasswordResetURL == "" {
+		return nil, errors.New("AUTH_PASSWORD_RESET_URL is required")
+	}
+	if cfg.PasswordResetTTL <= 0 {
+		cfg.PasswordResetTTL = 30 * time.Minute
+	}
+	if cfg.Mailer.Driver == "" {
+		cfg.Mailer.Driver = MailerDriverFile
+	}
+	if cfg.Mailer.From == "" {
+		return nil, errors.New("AUTH_MAILER_FROM is required")
+	}
+	switch cfg.Mailer.Driver {
+	case MailerDriverFile:
+	case MailerDriverSMTP:
+		if cfg.Mailer.SMTPHost == "" {
+			return nil, errors.New("AUTH_SMTP_HOST is required for the smtp mailer")
+		}
+		if cfg.Mailer.SMTPPort == 0 {
+			cfg.Mailer.SMTPPort = 587
+		}
+	default:
+		return nil, fmt.Errorf("unknown mailer driver %q", cfg.Mailer.Driver)
+	}
+	if cfg.MFA.EncryptionKey == "" {
+		return nil, errors.New("AUTH_MFA_ENCRYPTION_KEY is required")
+	}
+	if _, err := cfg.MFA.Key(); err != nil {
+		return nil, err
+	}
+	if cfg.MFA.Issuer == "" {
+		cfg.MFA.Issuer = "CarAudio"
+	}
+	if cfg.MFA.ChallengeTTL <= 0 {
+		cfg.MFA.ChallengeTTL = 5 * time.Minute
+	}
+	if cfg.OAuth.StateTTL <= 0 {
+		cfg.OAuth.StateTTL = 10 * time.Minute
+	}
+	for name, provider := range cfg.OAuth.Providers {
+		if !oauthProviderName.MatchString(name) {
+			return nil, fmt.Errorf("oauth provider name %q must be 1 to 50 lowercase letters, digits or underscores", name)
+		}
+		if provider.ClientSecret == "" {
+			return nil, fmt.Errorf("AUTH_OAUTH_%s_CLIENT_SECRET is required", strings.ToUpper(name))
+		}
+		if provider.RedirectURL == "" {
+			if cfg.OAuth.RedirectURL == "" {
+				return nil, fmt.Errorf("oauth redirect_url is required for provider %q", name)
+			}
+			provider.RedirectURL = strings.TrimRight(cfg.OAuth.RedirectURL, "/") + "/" + name
+			cfg.OAuth.Providers[name] = provider
+		}
+	}
 
 	slog.Info("auth service configuration loaded")
 	return &cfg, nil
@@ -0,0 +1,37 @@
+package domain
+
+import "time"
+
+// RoleAPIClient is the role of access tokens issued to API clients rather
+// than users. It is reserved and cannot be given to users.
+const RoleAPIClient = "api_client"
+
+// APIClient is a machine credential that exchanges its secret for access
+// tokens granting Scopes.
+type APIClient struct {
+	ID         string     `db:"id"`
+	Name       string     `db:"name"`
+	SecretHash string     `db:"secret_hash"`
+	Scopes     []string   `db:"-"`
+	ExpiresAt  *time.Time `db:"expires_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+	CreatedBy  *string    `db:"created_by"`
+	CreatedAt  time.Time  `db:"created_at"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+}
+
+// IsActive reports whether the client may still obtain tokens.
+func (c *APIClient) IsActive(now time.Time) bool {
+	if c.RevokedAt != nil {
+		return false
+	}
+	return c.ExpiresAt == nil || now.Before(*c.ExpiresAt)
+}
+
+// APIClientCredentials is returned when a secret is created or rotated;
+// the secret is never shown again.
+type APIClientCredentials struct {
+	Client *APIClient
+	Secret string
+}
@@ -0,0 +1,88 @@
+package domain
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	MinPasswordLength = 8
+	// MaxPasswordLength is the longest input bcrypt takes into account.
+	MaxPasswordLength = 72
+	MaxEmailLength    = 320
+)
+
+const (
+	// Password reset requests are counted per email address and per client
+	// IP, whether or not the address is registered.
+	PasswordResetScopeEmail = "email"
+	PasswordResetScopeIP    = "ip"
+)
+
+// EmailVerificationToken proves ownership of the user's email address. Only
+// the hash of the token sent by mail is stored.
+type EmailVerificationToken struct {
+	ID        string    `db:"id"`
+	UserID    string    `db:"user_id"`
+	TokenHash string    `db:"token_hash"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// PasswordResetToken authorises a single password change. Like refresh
+// tokens, only its hash is stored.
+type PasswordResetToken struct {
+	ID        string    `db:"id"`
+	UserID    string    `db:"user_id"`
+	TokenHash string    `db:"token_hash"`
+	ExpiresAt time.Time `db:"expires_at"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// EmailMessage is a plain-text transactional email.
+type EmailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// NormalizeEmail validates a bare address and lower-cases it so that
+// uniqueness does not depend on how the user typed it.
+func NormalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	if email == "" || len(email) > MaxEmailLength {
+		return "", fmt.Errorf("%w: a valid email is required", ErrInvalidArgument)
+	}
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", fmt.Errorf("%w: a valid email is required", ErrInvalidArgument)
+	}
+	return strings.ToLower(email), nil
+}
+
+// ValidatePassword enforces the password policy for self-service accounts.
+func ValidatePassword(password string) error {
+	if len(password) < MinPasswordLength {
+		return fmt.Errorf("%w: password must be at least %d characters", ErrInvalidArgument, MinPasswordLength)
+	}
+	if len(password) > MaxPasswordLength {
+		return fmt.Errorf("%w: password must be at most %d bytes", ErrInvalidArgument, MaxPasswordLength)
+	}
+
+	var hasLetter, hasDigit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			hasLetter = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		}
+	}
+	if !hasLetter || !hasDigit {
+		return fmt.Errorf("%w: password must contain letters and digits", ErrInvalidArgument)
+	}
+	return nil
+}
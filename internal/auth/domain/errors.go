@@ -3,13 +3,26 @@ package domain
 import "errors"
 
 var (
-	ErrInvalidArgument     = errors.New("invalid argument")
-	ErrUserNotFound        = errors.New("user not found")
-	ErrUserAlreadyExists   = errors.New("user already exists")
-	ErrInvalidCredentials  = errors.New("invalid credentials")
-	ErrInvalidToken        = errors.New("invalid token")
-	ErrTokenExpired        = errors.New("token expired")
-	ErrInternalServerError = errors.New("internal server error")
-	ErrUnauthorized        = errors.New("unauthorized")
-	ErrForbidden           = errors.New("forbidden")
+	ErrInvalidArgument      = errors.New("invalid argument")
+	ErrUserNotFound         = errors.New("user not found")
+	ErrUserAlreadyExists    = errors.New("user already exists")
+	ErrInvalidCredentials   = errors.New("invalid credentials")
+	ErrInvalidToken         = errors.New("invalid token")
+	ErrTokenExpired         = errors.New("token expired")
+	ErrInternalServerError  = errors.New("internal server error")
+	ErrUnauthorized         = errors.New("unauthorized")
+	ErrForbidden            = errors.New("forbidden")
+	ErrSessionNotFound      = errors.New("session not found")
+	ErrTokenAlreadyRotated  = errors.New("refresh token already rotated")
+	ErrTooManyLoginAttempts = errors.New("too many failed login attempts")
+	ErrTooManyResetRequests = errors.New("too many password reset requests")
+	ErrInvalidMFACode       = errors.New("invalid MFA code")
+	ErrMFAAlreadyEnabled    = errors.New("MFA already enabled")
+	ErrMFANotEnrolled       = errors.New("MFA not enrolled")
+	ErrRoleNotFound         = errors.New("role not found")
+	ErrAPIClientNotFound    = errors.New("api client not found")
+	ErrUserDisabled         = errors.New("user disabled")
+	ErrAddressNotFound      = errors.New("address not found")
+	ErrUnknownProvider      = errors.New("unknown login provider")
+	ErrExternalAuthFailed   = errors.New("external authentication failed")
 )
@@ -0,0 +1,26 @@
+package domain
+
+import "time"
+
+const (
+	LoginScopeAccount = "login"
+	LoginScopeIP      = "ip"
+	// LoginScopeMFA counts wrong second factor codes per user ID, across
+	// all the challenges the user was given.
+	LoginScopeMFA = "mfa"
+)
+
+// LoginThrottle counts failed logins for one login name, client IP or user
+// (for the second factor) since FirstFailedAt. LockedUntil is set once the failure limit is reached.
+type LoginThrottle struct {
+	Scope         string     `db:"scope"`
+	Key           string     `db:"key"`
+	Failures      int        `db:"failures"`
+	FirstFailedAt time.Time  `db:"first_failed_at"`
+	LastFailedAt  time.Time  `db:"last_failed_at"`
+	LockedUntil   *time.Time `db:"locked_until"`
+}
+
+func (t *LoginThrottle) IsLocked(now time.Time) bool {
+	return t.LockedUntil != nil && now.Before(*t.LockedUntil)
+}
@@ -0,0 +1,60 @@
+package domain
+
+import "time"
+
+// UserMFA is a user's TOTP enrollment. It protects logins only once
+// ConfirmedAt is set, i.e. after the user proved the authenticator works.
+type UserMFA struct {
+	UserID       string
+	Secret       string
+	ConfirmedAt  *time.Time
+	LastUsedStep int64
+	CreatedAt    time.Time
+}
+
+func (m *UserMFA) IsEnabled() bool {
+	return m != nil && m.ConfirmedAt != nil
+}
+
+// MFAEnrollment is handed to the user to set up an authenticator app,
+// usually by rendering ProvisioningURI as a QR code.
+type MFAEnrollment struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+// MFAChallenge is the short-lived second step of a password login. Only the
+// hash of the challenge token is stored. EnrollmentRequired is set when the
+// user's role requires MFA but none is confirmed yet: the challenge then
+// lets the user enroll and completes once the first code is verified.
+type MFAChallenge struct {
+	ID                 string    `db:"id"`
+	UserID             string    `db:"user_id"`
+	TokenHash          string    `db:"token_hash"`
+	RememberMe         bool      `db:"remember_me"`
+	EnrollmentRequired bool      `db:"enrollment_required"`
+	Attempts           int       `db:"attempts"`
+	ExpiresAt          time.Time `db:"expires_at"`
+	CreatedAt          time.Time `db:"created_at"`
+}
+
+// LoginResult is the outcome of a login step. Either the tokens are set, or
+// MFAChallenge is and the tokens must be obtained with the second factor.
+type LoginResult struct {
+	User         *User
+	AccessToken  string
+	RefreshToken string
+	// RefreshExpiresAt is when the session ends unless refreshed.
+	RefreshExpiresAt time.Time
+
+	MFAChallenge          string
+	MFAChallengeExpiresAt time.Time
+	MFAEnrollmentRequired bool
+
+	// RecoveryCodes is set when the login completed an MFA enrollment.
+	RecoveryCodes []string
+}
+
+func (r *LoginResult) MFARequired() bool {
+	return r.MFAChallenge != ""
+}
@@ -0,0 +1,45 @@
+package domain
+
+import "time"
+
+// ExternalIdentity is the account a social login provider vouched for.
+// Email is empty when the provider did not share one.
+type ExternalIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+}
+
+// UserIdentity links a provider account to a local user.
+type UserIdentity struct {
+	Provider  string    `db:"provider"`
+	Subject   string    `db:"subject"`
+	UserID    string    `db:"user_id"`
+	Email     string    `db:"email"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// OAuthState is a social login in progress, between the redirect to the
+// provider and its callback. Only the hashes of the state parameter and of
+// the browser binding are stored; the PKCE verifier and the nonce are kept
+// for the code exchange.
+type OAuthState struct {
+	ID           string    `db:"id"`
+	StateHash    string    `db:"state_hash"`
+	Provider     string    `db:"provider"`
+	CodeVerifier string    `db:"code_verifier"`
+	Nonce        string    `db:"nonce"`
+	BindingHash  string    `db:"binding_hash"`
+	RememberMe   bool      `db:"remember_me"`
+	ExpiresAt    time.Time `db:"expires_at"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+// OAuthLogin is a started social login: the browser is sent to
+// AuthorizationURL and must present Binding again with the callback.
+type OAuthLogin struct {
+	AuthorizationURL string
+	Binding          string
+	ExpiresAt        time.Time
+}
@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	DefaultCountry      = "RU"
+	MaxAddressesPerUser = 20
+)
+
+var (
+	phonePattern      = regexp.MustCompile(`^\+[1-9][0-9]{9,14}$`)
+	countryPattern    = regexp.MustCompile(`^[A-Z]{2}$`)
+	postalCodePattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9 -]{1,8}[A-Z0-9]$`)
+	phoneSeparators   = strings.NewReplacer(" ", "", "-", "", "(", "", ")", "")
+)
+
+// Profile holds the customer's contact details. Email is the contact
+// address for orders; it falls back to the account email when unset.
+type Profile struct {
+	UserID    string    `db:"user_id"`
+	FirstName string    `db:"first_name"`
+	LastName  string    `db:"last_name"`
+	Phone     string    `db:"phone"`
+	Email     string    `db:"email"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// Address is an entry of the user's address book. At most one address per
+// user is the default.
+type Address struct {
+	ID             string    `db:"id"`
+	UserID         string    `db:"user_id"`
+	Label          string    `db:"label"`
+	RecipientName  string    `db:"recipient_name"`
+	RecipientPhone string    `db:"recipient_phone"`
+	Country        string    `db:"country"`
+	Region         string    `db:"region"`
+	City           string    `db:"city"`
+	Street         string    `db:"street"`
+	House          string    `db:"house"`
+	Apartment      string    `db:"apartment"`
+	PostalCode     string    `db:"postal_code"`
+	Comment        string    `db:"comment"`
+	IsDefault      bool      `db:"is_default"`
+	CreatedAt      time.Time `db:"created_at"`
+	UpdatedAt      time.Time `db:"updated_at"`
+}
+
+// NormalizePhone accepts a number in international format, with or without
+// spaces, dashes and parentheses, and returns it as +<digits>. A Russian
+// number starting with 8 is rewritten to +7.
+func NormalizePhone(phone string) (string, error) {
+	phone = phoneSeparators.Replace(strings.TrimSpace(phone))
+	if len(phone) == 11 && strings.HasPrefix(phone, "8") {
+		phone = "+7" + phone[1:]
+	}
+	if !phonePattern.MatchString(phone) {
+		return "", fmt.Errorf("%w: phone must be in international format, e.g. +79001234567", ErrInvalidArgument)
+	}
+	return phone, nil
+}
+
+// Normalize trims the fields of the address, fills in the default country
+// and validates it.
+func (a *Address) Normalize() error {
+	fields := []struct {
+		name     string
+		value    *string
+		max      int
+		required bool
+	}{
+		{"label", &a.Label, 50, false},
+		{"recipient_name", &a.RecipientName, 200, false},
+		{"region", &a.Region, 100, false},
+		{"city", &a.City, 100, true},
+		{"street", &a.Street, 200, true},
+		{"house", &a.House, 20, true},
+		{"apartment", &a.Apartment, 20, false},
+		{"comment", &a.Comment, 500, false},
+	}
+	for _, field := range fields {
+		*field.value = strings.TrimSpace(*field.value)
+		if field.required && *field.value == "" {
+			return fmt.Errorf("%w: %s is required", ErrInvalidArgument, field.name)
+		}
+		if utf8.RuneCountInString(*field.value) > field.max {
+			return fmt.Errorf("%w: %s must be at most %d characters", ErrInvalidArgument, field.name, field.max)
+		}
+	}
+
+	a.Country = strings.ToUpper(strings.TrimSpace(a.Country))
+	if a.Country == "" {
+		a.Country = DefaultCountry
+	}
+	if !countryPattern.MatchString(a.Country) {
+		return fmt.Errorf("%w: country must be an ISO 3166-1 alpha-2 code", ErrInvalidArgument)
+	}
+
+	a.PostalCode = strings.ToUpper(strings.TrimSpace(a.PostalCode))
+	if a.PostalCode != "" && !postalCodePattern.MatchString(a.PostalCode) {
+		return fmt.Errorf("%w: invalid postal code", ErrInvalidArgument)
+	}
+
+	if a.RecipientPhone != "" {
+		phone, err := NormalizePhone(a.RecipientPhone)
+		if err != nil {
+			return err
+		}
+		a.RecipientPhone = phone
+	}
+	return nil
+}
@@ -2,10 +2,40 @@ package domain
 
 import "time"
 
+// MaxUserAgentLength bounds the user agent stored with a session.
+const MaxUserAgentLength = 512
+
+// RefreshToken is one link of a session's token chain. Every refresh
+// rotates the token: the presented one gets RotatedAt/ReplacedBy set and a
+// successor with the same FamilyID becomes the active token. The family is
+// the session as seen by the user.
 type RefreshToken struct {
-	ID        string    `db:"id"`
-	UserID    string    `db:"user_id"`
-	TokenHash string    `db:"token_hash"`
-	ExpiresAt time.Time `db:"expires_at"`
-	CreatedAt time.Time `db:"created_at"`
+	ID         string     `db:"id"`
+	FamilyID   string     `db:"family_id"`
+	UserID     string     `db:"user_id"`
+	TokenHash  string     `db:"token_hash"`
+	UserAgent  string     `db:"user_agent"`
+	IPAddress  string     `db:"ip_address"`
+	ExpiresAt  time.Time  `db:"expires_at"`
+	CreatedAt  time.Time  `db:"created_at"`
+	LastUsedAt time.Time  `db:"last_used_at"`
+	RotatedAt  *time.Time `db:"rotated_at"`
+	ReplacedBy *string    `db:"replaced_by"`
+}
+
+func (t *RefreshToken) IsRotated() bool {
+	return t.RotatedAt != nil
+}
+
+// ClientInfo describes the device a login or refresh request came from.
+type ClientInfo struct {
+	UserAgent string
+	IPAddress string
+}
+
+// Session is the active token of a family as shown to its owner; Current
+// marks the session the request was made with.
+type Session struct {
+	RefreshToken
+	Current bool
 }
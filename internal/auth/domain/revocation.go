@@ -0,0 +1,19 @@
+package domain
+
+import "time"
+
+// RevokedAccessToken rejects a single access token by its jti until the
+// token would have expired anyway.
+type RevokedAccessToken struct {
+	JTI       string    `db:"jti"`
+	UserID    string    `db:"user_id"`
+	ExpiresAt time.Time `db:"expires_at"`
+	RevokedAt time.Time `db:"revoked_at"`
+}
+
+// UserTokenCutoff rejects every access token of the user issued before
+// NotBefore, e.g. after a role change or account deletion.
+type UserTokenCutoff struct {
+	UserID    string    `db:"user_id"`
+	NotBefore time.Time `db:"not_before"`
+}
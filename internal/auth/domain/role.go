@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"regexp"
+	"slices"
+	"time"
+)
+
+// rolePattern keeps role names usable in URLs and config files.
+var rolePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{1,49}$`)
+
+// Role grants its users a set of permissions, which are embedded in their
+// access tokens. RoleAdmin implicitly holds every permission.
+type Role struct {
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	Permissions []string  `db:"-"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+func IsValidRoleName(name string) bool {
+	return rolePattern.MatchString(name)
+}
+
+// Actor is the authenticated user performing an administrative change.
+// Permissions are the ones carried by their access token.
+type Actor struct {
+	UserID      string
+	Role        string
+	Permissions []string
+}
+
+func (a Actor) IsAdmin() bool {
+	return a.Role == RoleAdmin
+}
+
+// MissingPermission returns the first of permissions the actor does not
+// hold, or "" when they hold all of them.
+func (a Actor) MissingPermission(permissions []string) string {
+	if a.IsAdmin() {
+		return ""
+	}
+	for _, permission := range permissions {
+		if !slices.Contains(a.Permissions, permission) {
+			return permission
+		}
+	}
+	return ""
+}
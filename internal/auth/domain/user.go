@@ -7,13 +7,68 @@ import (
 const (
 	RoleUser  = "user"
 	RoleAdmin = "admin"
+
+	MaxLoginLength = 255
+)
+
+// Orderings accepted by UserFilter.Sort.
+const (
+	UserSortNewest    = "newest"
+	UserSortOldest    = "oldest"
+	UserSortLogin     = "login"
+	UserSortLastLogin = "last_login"
+)
+
+// States accepted by UserFilter.Status.
+const (
+	UserStatusActive   = "active"
+	UserStatusDisabled = "disabled"
 )
 
 type User struct {
-	ID        string    `db:"id"`
-	Login     string    `db:"login"`
-	Password  string    `db:"password"`
-	Role      string    `db:"role"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID       string `db:"id"`
+	Login    string `db:"login"`
+	Password string `db:"password"`
+	Role     string `db:"role"`
+	// Email is empty for accounts created by an admin without one.
+	Email         string     `db:"email"`
+	EmailVerified bool       `db:"email_verified"`
+	DisabledAt    *time.Time `db:"disabled_at"`
+	LastLoginAt   *time.Time `db:"last_login_at"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+// IsActive reports whether the user may log in.
+func (u *User) IsActive() bool {
+	return u.DisabledAt == nil
+}
+
+type UserFilter struct {
+	// Search matches logins containing it, ignoring case.
+	Search   string
+	Role     string
+	Status   string
+	Sort     string
+	Page     int32
+	PageSize int32
+}
+
+type UserListResult struct {
+	Users    []User
+	Total    int32
+	Page     int32
+	PageSize int32
+}
+
+func IsValidUserSort(sort string) bool {
+	switch sort {
+	case UserSortNewest, UserSortOldest, UserSortLogin, UserSortLastLogin:
+		return true
+	}
+	return false
+}
+
+func IsValidUserStatus(status string) bool {
+	return status == UserStatusActive || status == UserStatusDisabled
 }
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+)
+
+type APIClientRepository interface {
+	CreateClient(ctx context.Context, client *domain.APIClient) error
+	// GetClient returns ErrAPIClientNotFound for an unknown id.
+	GetClient(ctx context.Context, id string) (*domain.APIClient, error)
+	ListClients(ctx context.Context) ([]domain.APIClient, error)
+	UpdateSecret(ctx context.Context, id, secretHash string, updatedAt time.Time) error
+	RevokeClient(ctx context.Context, id string, revokedAt time.Time) error
+	TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error
+}
+
+type postgresAPIClientRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresAPIClientRepository(db *sqlx.DB) APIClientRepository {
+	return &postgresAPIClientRepository{db: db}
+}
+
+// apiClientRow scans the scopes array, which the domain type keeps as a
+// plain slice.
+type apiClientRow struct {
+	domain.APIClient
+	Scopes pq.StringArray `db:"scopes"`
+}
+
+func (row *apiClientRow) toDomain() domain.APIClient {
+	client := row.APIClient
+	client.Scopes = []string(row.Scopes)
+	return client
+}
+
+const apiClientColumns = `id, name, secret_hash, scopes, expires_at, last_used_at, revoked_at,
+	created_by, created_at, updated_at`
+
+func (r *postgresAPIClientRepository) CreateClient(ctx context.Context, client *domain.APIClient) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO api_clients (`+apiClientColumns+`)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+		client.ID,
+		client.Name,
+		client.SecretHash,
+		pq.Array(client.Scopes),
+		client.ExpiresAt,
+		client.LastUsedAt,
+		client.RevokedAt,
+		client.CreatedBy,
+		client.CreatedAt,
+		client.UpdatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create api client: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresAPIClientRepository) GetClient(ctx context.Context, id string) (*domain.APIClient, error) {
+	var row apiClientRow
+	err := r.db.GetContext(ctx, &row, `SELECT `+apiClientColumns+` FROM api_clients WHERE id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAPIClientNotFound
+		}
+		return nil, fmt.Errorf("failed to get api client: %w", err)
+	}
+	client := row.toDomain()
+	return &client, nil
+}
+
+func (r *postgresAPIClientRepository) ListClients(ctx context.Context) ([]domain.APIClient, error) {
+	var rows []apiClientRow
+	if err := r.db.SelectContext(ctx, &rows,
+		`SELECT `+apiClientColumns+` FROM api_clients ORDER BY created_at DESC, id`,
+	); err != nil {
+		return nil, fmt.Errorf("failed to list api clients: %w", err)
+	}
+
+	clients := make([]domain.APIClient, 0, len(rows))
+	for i := range rows {
+		clients = append(clients, rows[i].toDomain())
+	}
+	return clients, nil
+}
+
+func (r *postgresAPIClientRepository) UpdateSecret(
+	ctx context.Context,
+	id, secretHash string,
+	updatedAt time.Time,
+) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE api_clients SET secret_hash = $2, updated_at = $3
+		 WHERE id = $1 AND revoked_at IS NULL`,
+		id, secretHash, updatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update api client secret: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrAPIClientNotFound
+	}
+	return nil
+}
+
+func (r *postgresAPIClientRepository) RevokeClient(ctx context.Context, id string, revokedAt time.Time) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE api_clients SET revoked_at = $2, updated_at = $2
+		 WHERE id = $1 AND revoked_at IS NULL`,
+		id, revokedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke api client: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrAPIClientNotFound
+	}
+	return nil
+}
+
+func (r *postgresAPIClientRepository) TouchLastUsed(ctx context.Context, id string, usedAt time.Time) error {
+	if _, err := r.db.ExecContext(ctx,
+		`UPDATE api_clients SET last_used_at = $2 WHERE id = $1`, id, usedAt,
+	); err != nil {
+		return fmt.Errorf("failed to record api client use: %w", err)
+	}
+	return nil
+}
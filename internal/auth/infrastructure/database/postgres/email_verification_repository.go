@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+)
+
+type EmailVerificationRepository interface {
+	// Create stores a new token and drops any token the user was sent
+	// before, so only the latest email link works.
+	Create(ctx context.Context, token *domain.EmailVerificationToken) error
+	// Consume deletes the token and marks its user's email verified. It
+	// returns the user id, ErrInvalidToken for an unknown token and
+	// ErrTokenExpired for an expired one.
+	Consume(ctx context.Context, tokenHash string, now time.Time) (string, error)
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type postgresEmailVerificationRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresEmailVerificationRepository(db *sqlx.DB) EmailVerificationRepository {
+	return &postgresEmailVerificationRepository{db: db}
+}
+
+func (r *postgresEmailVerificationRepository) Create(
+	ctx context.Context,
+	token *domain.EmailVerificationToken,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.ExecContext(
+		ctx,
+		`DELETE FROM email_verification_tokens WHERE user_id = $1`,
+		token.UserID,
+	); err != nil {
+		return fmt.Errorf("failed to delete previous verification tokens: %w", err)
+	}
+
+	if _, err = tx.NamedExecContext(
+		ctx,
+		`INSERT INTO email_verification_tokens (id, user_id, token_hash, expires_at, created_at)
+		 VALUES (:id, :user_id, :token_hash, :expires_at, :created_at)`,
+		token,
+	); err != nil {
+		return fmt.Errorf("failed to create verification token: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit verification token: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func (r *postgresEmailVerificationRepository) Consume(
+	ctx context.Context,
+	tokenHash string,
+	now time.Time,
+) (string, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var token domain.EmailVerificationToken
+	err = tx.GetContext(
+		ctx,
+		&token,
+		`DELETE FROM email_verification_tokens
+		 WHERE token_hash = $1
+		 RETURNING id, user_id, token_hash, expires_at, created_at`,
+		tokenHash,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrInvalidToken
+		}
+		return "", fmt.Errorf("failed to consume verification token: %w", err)
+	}
+
+	if !now.Before(token.ExpiresAt) {
+		// Keep the expired token deleted; the user has to request a new one.
+		if err = tx.Commit(); err != nil {
+			return "", fmt.Errorf("failed to commit verification token: %w", err)
+		}
+		tx = nil
+		return "", domain.ErrTokenExpired
+	}
+
+	if _, err = tx.ExecContext(
+		ctx,
+		`UPDATE users SET email_verified = TRUE, updated_at = $2 WHERE id = $1`,
+		token.UserID,
+		now,
+	); err != nil {
+		return "", fmt.Errorf("failed to mark email verified: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit email verification: %w", err)
+	}
+	tx = nil
+	return token.UserID, nil
+}
+
+func (r *postgresEmailVerificationRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM email_verification_tokens WHERE expires_at <= $1`,
+		now,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired verification tokens: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows for verification token cleanup: %w", err)
+	}
+	return rowsAffected, nil
+}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+)
+
+type LoginThrottleRepository interface {
+	// Get returns nil when the key has no recorded failures.
+	Get(ctx context.Context, scope, key string) (*domain.LoginThrottle, error)
+	// RecordFailure counts a failed login. Counting restarts when the
+	// first failure is older than windowStart; reaching maxFailures locks
+	// the key until lockUntil.
+	RecordFailure(
+		ctx context.Context,
+		scope, key string,
+		now, windowStart, lockUntil time.Time,
+		maxFailures int,
+	) (*domain.LoginThrottle, error)
+	Reset(ctx context.Context, scope, key string) error
+	// DeleteExpired removes keys that last failed before staleBefore and
+	// are not locked at now.
+	DeleteExpired(ctx context.Context, now, staleBefore time.Time) (int64, error)
+}
+
+type postgresLoginThrottleRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresLoginThrottleRepository(db *sqlx.DB) LoginThrottleRepository {
+	return &postgresLoginThrottleRepository{db: db}
+}
+
+const loginThrottleColumns = `scope, key, failures, first_failed_at, last_failed_at, locked_until`
+
+func (r *postgresLoginThrottleRepository) Get(
+	ctx context.Context,
+	scope, key string,
+) (*domain.LoginThrottle, error) {
+	var throttle domain.LoginThrottle
+	err := r.db.GetContext(
+		ctx,
+		&throttle,
+		`SELECT `+loginThrottleColumns+` FROM login_failures WHERE scope = $1 AND key = $2`,
+		scope,
+		key,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get login failures: %w", err)
+	}
+	return &throttle, nil
+}
+
+func (r *postgresLoginThrottleRepository) RecordFailure(
+	ctx context.Context,
+	scope, key string,
+	now, windowStart, lockUntil time.Time,
+	maxFailures int,
+) (*domain.LoginThrottle, error) {
+	query := `
+		INSERT INTO login_failures AS lf (scope, key, failures, first_failed_at, last_failed_at, locked_until)
+		VALUES ($1, $2, 1, $3, $3, CASE WHEN $5 <= 1 THEN $6::timestamptz END)
+		ON CONFLICT (scope, key) DO UPDATE SET
+			failures = CASE
+				WHEN lf.first_failed_at <= $4 THEN 1
+				ELSE lf.failures + 1
+			END,
+			first_failed_at = CASE
+				WHEN lf.first_failed_at <= $4 THEN $3
+				ELSE lf.first_failed_at
+			END,
+			last_failed_at = $3,
+			locked_until = CASE
+				WHEN (CASE WHEN lf.first_failed_at <= $4 THEN 1 ELSE lf.failures + 1 END) >= $5 THEN $6
+				ELSE lf.locked_until
+			END
+		RETURNING ` + loginThrottleColumns
+
+	var throttle domain.LoginThrottle
+	if err := r.db.GetContext(
+		ctx,
+		&throttle,
+		query,
+		scope,
+		key,
+		now,
+		windowStart,
+		maxFailures,
+		lockUntil,
+	); err != nil {
+		return nil, fmt.Errorf("failed to record login failure: %w", err)
+	}
+	return &throttle, nil
+}
+
+func (r *postgresLoginThrottleRepository) Reset(ctx context.Context, scope, key string) error {
+	if _, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM login_failures WHERE scope = $1 AND key = $2`,
+		scope,
+		key,
+	); err != nil {
+		return fmt.Errorf("failed to reset login failures: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresLoginThrottleRepository) DeleteExpired(
+	ctx context.Context,
+	now, staleBefore time.Time,
+) (int64, error) {
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM login_failures
+		 WHERE last_failed_at < $2 AND (locked_until IS NULL OR locked_until <= $1)`,
+		now,
+		staleBefore,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired login failures: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows for login failure cleanup: %w", err)
+	}
+	return rowsAffected, nil
+}
@@ -0,0 +1,296 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/infrastructure/utils"
+)
+
+type MFARepository interface {
+	// GetMFA returns nil when the user has not started an enrollment.
+	GetMFA(ctx context.Context, userID string) (*domain.UserMFA, error)
+	// SaveEnrollment stores a new unconfirmed secret, replacing an earlier
+	// unconfirmed one. It returns ErrMFAAlreadyEnabled when MFA is already
+	// confirmed for the user.
+	SaveEnrollment(ctx context.Context, mfa *domain.UserMFA) error
+	// Confirm enables the pending enrollment, records step as used and
+	// replaces the user's recovery codes. It returns ErrMFANotEnrolled when
+	// there is no pending enrollment.
+	Confirm(ctx context.Context, userID string, step int64, recoveryCodeHashes []string, now time.Time) error
+	// UseStep records a TOTP time step as used. It reports false when that
+	// step or a later one was accepted before, i.e. the code is replayed.
+	UseStep(ctx context.Context, userID string, step int64) (bool, error)
+	// UseRecoveryCode deletes the code and reports whether it existed.
+	UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error)
+
+	CreateChallenge(ctx context.Context, challenge *domain.MFAChallenge) error
+	// GetChallenge returns ErrInvalidToken for an unknown token.
+	GetChallenge(ctx context.Context, tokenHash string) (*domain.MFAChallenge, error)
+	// RecordChallengeFailure counts a wrong code and returns the attempts
+	// made so far.
+	RecordChallengeFailure(ctx context.Context, id string) (int, error)
+	// DeleteChallenge reports false when the challenge was already gone,
+	// so that it can be redeemed only once.
+	DeleteChallenge(ctx context.Context, id string) (bool, error)
+	DeleteExpiredChallenges(ctx context.Context, now time.Time) (int64, error)
+}
+
+type postgresMFARepository struct {
+	db      *sqlx.DB
+	secrets *utils.SecretBox
+}
+
+// NewPostgresMFARepository stores TOTP secrets sealed with secrets.
+func NewPostgresMFARepository(db *sqlx.DB, secrets *utils.SecretBox) MFARepository {
+	return &postgresMFARepository{db: db, secrets: secrets}
+}
+
+type userMFARow struct {
+	UserID       string     `db:"user_id"`
+	Secret       string     `db:"secret"`
+	ConfirmedAt  *time.Time `db:"confirmed_at"`
+	LastUsedStep int64      `db:"last_used_step"`
+	CreatedAt    time.Time  `db:"created_at"`
+}
+
+const mfaChallengeColumns = `id, user_id, token_hash, remember_me, enrollment_required, attempts, expires_at, created_at`
+
+func (r *postgresMFARepository) GetMFA(ctx context.Context, userID string) (*domain.UserMFA, error) {
+	var row userMFARow
+	err := r.db.GetContext(
+		ctx,
+		&row,
+		`SELECT user_id, secret, confirmed_at, last_used_step, created_at FROM user_mfa WHERE user_id = $1`,
+		userID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get MFA enrollment: %w", err)
+	}
+
+	secret, err := r.secrets.Open(row.Secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open MFA secret: %w", err)
+	}
+	return &domain.UserMFA{
+		UserID:       row.UserID,
+		Secret:       secret,
+		ConfirmedAt:  row.ConfirmedAt,
+		LastUsedStep: row.LastUsedStep,
+		CreatedAt:    row.CreatedAt,
+	}, nil
+}
+
+func (r *postgresMFARepository) SaveEnrollment(ctx context.Context, mfa *domain.UserMFA) error {
+	sealed, err := r.secrets.Seal(mfa.Secret)
+	if err != nil {
+		return fmt.Errorf("failed to seal MFA secret: %w", err)
+	}
+
+	result, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO user_mfa (user_id, secret, created_at)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (user_id) DO UPDATE SET
+			secret = EXCLUDED.secret,
+			last_used_step = 0,
+			created_at = EXCLUDED.created_at
+		 WHERE user_mfa.confirmed_at IS NULL`,
+		mfa.UserID,
+		sealed,
+		mfa.CreatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save MFA enrollment: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrMFAAlreadyEnabled
+	}
+	return nil
+}
+
+func (r *postgresMFARepository) Confirm(
+	ctx context.Context,
+	userID string,
+	step int64,
+	recoveryCodeHashes []string,
+	now time.Time,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE user_mfa
+		 SET confirmed_at = $3, last_used_step = $2
+		 WHERE user_id = $1 AND confirmed_at IS NULL`,
+		userID,
+		step,
+		now,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to confirm MFA enrollment: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrMFANotEnrolled
+	}
+
+	if _, err = tx.ExecContext(
+		ctx,
+		`DELETE FROM mfa_recovery_codes WHERE user_id = $1`,
+		userID,
+	); err != nil {
+		return fmt.Errorf("failed to delete previous recovery codes: %w", err)
+	}
+	for _, hash := range recoveryCodeHashes {
+		if _, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO mfa_recovery_codes (user_id, code_hash) VALUES ($1, $2)`,
+			userID,
+			hash,
+		); err != nil {
+			return fmt.Errorf("failed to store recovery code: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit MFA enrollment: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func (r *postgresMFARepository) UseStep(ctx context.Context, userID string, step int64) (bool, error) {
+	result, err := r.db.ExecContext(
+		ctx,
+		`UPDATE user_mfa SET last_used_step = $2
+		 WHERE user_id = $1 AND last_used_step < $2`,
+		userID,
+		step,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to record TOTP step: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+func (r *postgresMFARepository) UseRecoveryCode(ctx context.Context, userID, codeHash string) (bool, error) {
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM mfa_recovery_codes WHERE user_id = $1 AND code_hash = $2`,
+		userID,
+		codeHash,
+	)
+	if err != nil {
+		return false, fmt.Errorf("failed to use recovery code: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+func (r *postgresMFARepository) CreateChallenge(ctx context.Context, challenge *domain.MFAChallenge) error {
+	if _, err := r.db.NamedExecContext(
+		ctx,
+		`INSERT INTO mfa_challenges (`+mfaChallengeColumns+`)
+		 VALUES (:id, :user_id, :token_hash, :remember_me, :enrollment_required, :attempts, :expires_at, :created_at)`,
+		challenge,
+	); err != nil {
+		return fmt.Errorf("failed to create MFA challenge: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresMFARepository) GetChallenge(ctx context.Context, tokenHash string) (*domain.MFAChallenge, error) {
+	var challenge domain.MFAChallenge
+	err := r.db.GetContext(
+		ctx,
+		&challenge,
+		`SELECT `+mfaChallengeColumns+` FROM mfa_challenges WHERE token_hash = $1`,
+		tokenHash,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvalidToken
+		}
+		return nil, fmt.Errorf("failed to get MFA challenge: %w", err)
+	}
+	return &challenge, nil
+}
+
+func (r *postgresMFARepository) RecordChallengeFailure(ctx context.Context, id string) (int, error) {
+	var attempts int
+	err := r.db.GetContext(
+		ctx,
+		&attempts,
+		`UPDATE mfa_challenges SET attempts = attempts + 1 WHERE id = $1 RETURNING attempts`,
+		id,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, domain.ErrInvalidToken
+		}
+		return 0, fmt.Errorf("failed to record MFA challenge failure: %w", err)
+	}
+	return attempts, nil
+}
+
+func (r *postgresMFARepository) DeleteChallenge(ctx context.Context, id string) (bool, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM mfa_challenges WHERE id = $1`, id)
+	if err != nil {
+		return false, fmt.Errorf("failed to delete MFA challenge: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	return rows > 0, nil
+}
+
+func (r *postgresMFARepository) DeleteExpiredChallenges(ctx context.Context, now time.Time) (int64, error) {
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM mfa_challenges WHERE expires_at <= $1`,
+		now,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired MFA challenges: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows for MFA challenge cleanup: %w", err)
+	}
+	return rowsAffected, nil
+}
@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+)
+
+type OAuthRepository interface {
+	CreateState(ctx context.Context, state *domain.OAuthState) error
+	// ConsumeState deletes the state and returns it, so that a callback is
+	// accepted only once. It returns ErrInvalidToken for an unknown state.
+	ConsumeState(ctx context.Context, stateHash string) (*domain.OAuthState, error)
+	DeleteExpiredStates(ctx context.Context, now time.Time) (int64, error)
+
+	// GetIdentity returns nil when the provider account is not linked.
+	GetIdentity(ctx context.Context, provider, subject string) (*domain.UserIdentity, error)
+	// LinkIdentity returns ErrUserAlreadyExists when the provider account
+	// is already linked.
+	LinkIdentity(ctx context.Context, identity *domain.UserIdentity) error
+	// CreateUserWithIdentity creates the user and links the identity to it
+	// in one transaction. It returns ErrUserAlreadyExists when the login,
+	// the email or the identity is taken.
+	CreateUserWithIdentity(ctx context.Context, user *domain.User, identity *domain.UserIdentity) error
+}
+
+type postgresOAuthRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresOAuthRepository(db *sqlx.DB) OAuthRepository {
+	return &postgresOAuthRepository{db: db}
+}
+
+const (
+	oauthStateColumns   = `id, state_hash, provider, code_verifier, nonce, binding_hash, remember_me, expires_at, created_at`
+	userIdentityColumns = `provider, subject, user_id, COALESCE(email, '') AS email, created_at`
+)
+
+func (r *postgresOAuthRepository) CreateState(ctx context.Context, state *domain.OAuthState) error {
+	_, err := r.db.NamedExecContext(ctx,
+		`INSERT INTO oauth_states (id, state_hash, provider, code_verifier, nonce, binding_hash, remember_me, expires_at, created_at)
+		 VALUES (:id, :state_hash, :provider, :code_verifier, :nonce, :binding_hash, :remember_me, :expires_at, :created_at)`,
+		state,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create OAuth state: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresOAuthRepository) ConsumeState(ctx context.Context, stateHash string) (*domain.OAuthState, error) {
+	var state domain.OAuthState
+	err := r.db.GetContext(ctx, &state,
+		`DELETE FROM oauth_states WHERE state_hash = $1 RETURNING `+oauthStateColumns,
+		stateHash,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrInvalidToken
+		}
+		return nil, fmt.Errorf("failed to consume OAuth state: %w", err)
+	}
+	return &state, nil
+}
+
+func (r *postgresOAuthRepository) DeleteExpiredStates(ctx context.Context, now time.Time) (int64, error) {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM oauth_states WHERE expires_at < $1`, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired OAuth states: %w", err)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	return deleted, nil
+}
+
+func (r *postgresOAuthRepository) GetIdentity(
+	ctx context.Context,
+	provider, subject string,
+) (*domain.UserIdentity, error) {
+	var identity domain.UserIdentity
+	err := r.db.GetContext(ctx, &identity,
+		`SELECT `+userIdentityColumns+` FROM user_identities WHERE provider = $1 AND subject = $2`,
+		provider, subject,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get user identity: %w", err)
+	}
+	return &identity, nil
+}
+
+func (r *postgresOAuthRepository) LinkIdentity(ctx context.Context, identity *domain.UserIdentity) error {
+	return linkIdentity(ctx, r.db, identity)
+}
+
+func (r *postgresOAuthRepository) CreateUserWithIdentity(
+	ctx context.Context,
+	user *domain.User,
+	identity *domain.UserIdentity,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err := tx.NamedExecContext(ctx,
+		`INSERT INTO users (id, login, password, role, email, email_verified, created_at, updated_at)
+		 VALUES (:id, :login, :password, :role, NULLIF(:email, ''), :email_verified, :created_at, :updated_at)`,
+		user,
+	); err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return domain.ErrUserAlreadyExists
+		}
+		return fmt.Errorf("failed to create user: %w", err)
+	}
+	if err := linkIdentity(ctx, tx, identity); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func linkIdentity(ctx context.Context, db sqlx.ExtContext, identity *domain.UserIdentity) error {
+	_, err := sqlx.NamedExecContext(ctx, db,
+		`INSERT INTO user_identities (provider, subject, user_id, email, created_at)
+		 VALUES (:provider, :subject, :user_id, NULLIF(:email, ''), :created_at)`,
+		identity,
+	)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
+			return domain.ErrUserAlreadyExists
+		}
+		return fmt.Errorf("failed to link user identity: %w", err)
+	}
+	return nil
+}
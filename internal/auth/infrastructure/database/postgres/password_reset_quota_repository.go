@@ -0,0 +1,101 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// PasswordResetQuotaRepository counts password reset requests per email
+// address and per client IP. It is kept apart from the login throttle, so
+// unlocking a user or logging in never touches the reset quotas.
+type PasswordResetQuotaRepository interface {
+	// Requests returns how many requests the key made in the window that
+	// started after windowStart.
+	Requests(ctx context.Context, scope, key string, windowStart time.Time) (int, error)
+	// Record counts a request. Counting restarts when the first counted
+	// request is older than windowStart.
+	Record(ctx context.Context, scope, key string, now, windowStart time.Time) error
+	// DeleteExpired removes keys whose window started before windowStart.
+	DeleteExpired(ctx context.Context, windowStart time.Time) (int64, error)
+}
+
+type postgresPasswordResetQuotaRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresPasswordResetQuotaRepository(db *sqlx.DB) PasswordResetQuotaRepository {
+	return &postgresPasswordResetQuotaRepository{db: db}
+}
+
+func (r *postgresPasswordResetQuotaRepository) Requests(
+	ctx context.Context,
+	scope, key string,
+	windowStart time.Time,
+) (int, error) {
+	var requests int
+	err := r.db.GetContext(
+		ctx,
+		&requests,
+		`SELECT requests FROM password_reset_requests
+		 WHERE scope = $1 AND key = $2 AND first_requested_at > $3`,
+		scope,
+		key,
+		windowStart,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get password reset requests: %w", err)
+	}
+	return requests, nil
+}
+
+func (r *postgresPasswordResetQuotaRepository) Record(
+	ctx context.Context,
+	scope, key string,
+	now, windowStart time.Time,
+) error {
+	query := `
+		INSERT INTO password_reset_requests AS pr (scope, key, requests, first_requested_at)
+		VALUES ($1, $2, 1, $3)
+		ON CONFLICT (scope, key) DO UPDATE SET
+			requests = CASE
+				WHEN pr.first_requested_at <= $4 THEN 1
+				ELSE pr.requests + 1
+			END,
+			first_requested_at = CASE
+				WHEN pr.first_requested_at <= $4 THEN $3
+				ELSE pr.first_requested_at
+			END`
+
+	if _, err := r.db.ExecContext(ctx, query, scope, key, now, windowStart); err != nil {
+		return fmt.Errorf("failed to record password reset request: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresPasswordResetQuotaRepository) DeleteExpired(
+	ctx context.Context,
+	windowStart time.Time,
+) (int64, error) {
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM password_reset_requests WHERE first_requested_at <= $1`,
+		windowStart,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired password reset requests: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows for password reset request cleanup: %w", err)
+	}
+	return rowsAffected, nil
+}
@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+)
+
+type PasswordResetRepository interface {
+	// Create stores a new token and drops any earlier one of the user.
+	Create(ctx context.Context, token *domain.PasswordResetToken) error
+	// Consume deletes the token and, in the same transaction, sets the new
+	// password hash, marks the email verified (the link proved ownership)
+	// and ends every session of the user. It returns the user id,
+	// ErrInvalidToken for an unknown token and ErrTokenExpired for an
+	// expired one.
+	Consume(ctx context.Context, tokenHash, passwordHash string, now time.Time) (string, error)
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
+}
+
+type postgresPasswordResetRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresPasswordResetRepository(db *sqlx.DB) PasswordResetRepository {
+	return &postgresPasswordResetRepository{db: db}
+}
+
+func (r *postgresPasswordResetRepository) Create(
+	ctx context.Context,
+	token *domain.PasswordResetToken,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.ExecContext(
+		ctx,
+		`DELETE FROM password_reset_tokens WHERE user_id = $1`,
+		token.UserID,
+	); err != nil {
+		return fmt.Errorf("failed to delete previous password reset tokens: %w", err)
+	}
+
+	if _, err = tx.NamedExecContext(
+		ctx,
+		`INSERT INTO password_reset_tokens (id, user_id, token_hash, expires_at, created_at)
+		 VALUES (:id, :user_id, :token_hash, :expires_at, :created_at)`,
+		token,
+	); err != nil {
+		return fmt.Errorf("failed to create password reset token: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit password reset token: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func (r *postgresPasswordResetRepository) Consume(
+	ctx context.Context,
+	tokenHash, passwordHash string,
+	now time.Time,
+) (string, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var token domain.PasswordResetToken
+	err = tx.GetContext(
+		ctx,
+		&token,
+		`DELETE FROM password_reset_tokens
+		 WHERE token_hash = $1
+		 RETURNING id, user_id, token_hash, expires_at, created_at`,
+		tokenHash,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", domain.ErrInvalidToken
+		}
+		return "", fmt.Errorf("failed to consume password reset token: %w", err)
+	}
+
+	if !now.Before(token.ExpiresAt) {
+		if err = tx.Commit(); err != nil {
+			return "", fmt.Errorf("failed to commit password reset token: %w", err)
+		}
+		tx = nil
+		return "", domain.ErrTokenExpired
+	}
+
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE users
+		 SET password = $2, email_verified = TRUE, updated_at = $3
+		 WHERE id = $1`,
+		token.UserID,
+		passwordHash,
+		now,
+	)
+	if err != nil {
+		return "", fmt.Errorf("failed to reset password: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return "", fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return "", domain.ErrUserNotFound
+	}
+
+	if _, err = tx.ExecContext(
+		ctx,
+		`DELETE FROM refresh_tokens WHERE user_id = $1`,
+		token.UserID,
+	); err != nil {
+		return "", fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", fmt.Errorf("failed to commit password reset: %w", err)
+	}
+	tx = nil
+	return token.UserID, nil
+}
+
+func (r *postgresPasswordResetRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM password_reset_tokens WHERE expires_at <= $1`,
+		now,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired password reset tokens: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows for password reset token cleanup: %w", err)
+	}
+	return rowsAffected, nil
+}
@@ -0,0 +1,285 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+)
+
+type ProfileRepository interface {
+	// GetProfile returns nil when the user has not filled in a profile.
+	GetProfile(ctx context.Context, userID string) (*domain.Profile, error)
+	SaveProfile(ctx context.Context, profile *domain.Profile) error
+
+	// ListAddresses returns the default address first, then the others in
+	// the order they were added.
+	ListAddresses(ctx context.Context, userID string) ([]domain.Address, error)
+	// GetAddress returns ErrAddressNotFound unless the address belongs to
+	// the user.
+	GetAddress(ctx context.Context, userID, id string) (*domain.Address, error)
+	// CreateAddress and UpdateAddress clear the user's previous default
+	// when the address is marked default.
+	CreateAddress(ctx context.Context, address *domain.Address) error
+	UpdateAddress(ctx context.Context, address *domain.Address) error
+	// DeleteAddress promotes the most recently added remaining address when
+	// the default one is deleted.
+	DeleteAddress(ctx context.Context, userID, id string) error
+	SetDefaultAddress(ctx context.Context, userID, id string, updatedAt time.Time) error
+}
+
+type postgresProfileRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresProfileRepository(db *sqlx.DB) ProfileRepository {
+	return &postgresProfileRepository{db: db}
+}
+
+const addressColumns = `id, user_id, label, recipient_name, recipient_phone, country, region, city,
+	street, house, apartment, postal_code, comment, is_default, created_at, updated_at`
+
+func (r *postgresProfileRepository) GetProfile(ctx context.Context, userID string) (*domain.Profile, error) {
+	var profile domain.Profile
+	err := r.db.GetContext(ctx, &profile,
+		`SELECT user_id, first_name, last_name, phone, email, updated_at
+		 FROM user_profiles WHERE user_id = $1`,
+		userID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get profile: %w", err)
+	}
+	return &profile, nil
+}
+
+func (r *postgresProfileRepository) SaveProfile(ctx context.Context, profile *domain.Profile) error {
+	_, err := r.db.NamedExecContext(ctx,
+		`INSERT INTO user_profiles (user_id, first_name, last_name, phone, email, updated_at)
+		 VALUES (:user_id, :first_name, :last_name, :phone, :email, :updated_at)
+		 ON CONFLICT (user_id) DO UPDATE SET
+			first_name = EXCLUDED.first_name,
+			last_name = EXCLUDED.last_name,
+			phone = EXCLUDED.phone,
+			email = EXCLUDED.email,
+			updated_at = EXCLUDED.updated_at`,
+		profile,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save profile: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresProfileRepository) ListAddresses(ctx context.Context, userID string) ([]domain.Address, error) {
+	addresses := []domain.Address{}
+	err := r.db.SelectContext(ctx, &addresses,
+		`SELECT `+addressColumns+` FROM user_addresses
+		 WHERE user_id = $1 ORDER BY is_default DESC, created_at, id`,
+		userID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list addresses: %w", err)
+	}
+	return addresses, nil
+}
+
+func (r *postgresProfileRepository) GetAddress(ctx context.Context, userID, id string) (*domain.Address, error) {
+	var address domain.Address
+	err := r.db.GetContext(ctx, &address,
+		`SELECT `+addressColumns+` FROM user_addresses WHERE id = $1 AND user_id = $2`,
+		id, userID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrAddressNotFound
+		}
+		return nil, fmt.Errorf("failed to get address: %w", err)
+	}
+	return &address, nil
+}
+
+func (r *postgresProfileRepository) CreateAddress(ctx context.Context, address *domain.Address) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if address.IsDefault {
+		if err := clearDefaultAddress(ctx, tx, address.UserID, address.UpdatedAt); err != nil {
+			return err
+		}
+	}
+	if _, err := tx.NamedExecContext(ctx,
+		`INSERT INTO user_addresses (`+addressColumns+`)
+		 VALUES (:id, :user_id, :label, :recipient_name, :recipient_phone, :country, :region, :city,
+			:street, :house, :apartment, :postal_code, :comment, :is_default, :created_at, :updated_at)`,
+		address,
+	); err != nil {
+		return fmt.Errorf("failed to create address: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func (r *postgresProfileRepository) UpdateAddress(ctx context.Context, address *domain.Address) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if address.IsDefault {
+		if err := clearDefaultAddress(ctx, tx, address.UserID, address.UpdatedAt); err != nil {
+			return err
+		}
+	}
+	result, err := tx.NamedExecContext(ctx,
+		`UPDATE user_addresses SET
+			label = :label,
+			recipient_name = :recipient_name,
+			recipient_phone = :recipient_phone,
+			country = :country,
+			region = :region,
+			city = :city,
+			street = :street,
+			house = :house,
+			apartment = :apartment,
+			postal_code = :postal_code,
+			comment = :comment,
+			is_default = :is_default,
+			updated_at = :updated_at
+		 WHERE id = :id AND user_id = :user_id`,
+		address,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update address: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrAddressNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func (r *postgresProfileRepository) DeleteAddress(ctx context.Context, userID, id string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var wasDefault bool
+	err = tx.GetContext(ctx, &wasDefault,
+		`DELETE FROM user_addresses WHERE id = $1 AND user_id = $2 RETURNING is_default`,
+		id, userID,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrAddressNotFound
+		}
+		return fmt.Errorf("failed to delete address: %w", err)
+	}
+	if wasDefault {
+		if _, err := tx.ExecContext(ctx,
+			`UPDATE user_addresses SET is_default = TRUE
+			 WHERE id = (
+				SELECT id FROM user_addresses WHERE user_id = $1
+				ORDER BY created_at DESC, id DESC LIMIT 1
+			 )`,
+			userID,
+		); err != nil {
+			return fmt.Errorf("failed to promote default address: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func (r *postgresProfileRepository) SetDefaultAddress(
+	ctx context.Context,
+	userID, id string,
+	updatedAt time.Time,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err := clearDefaultAddress(ctx, tx, userID, updatedAt); err != nil {
+		return err
+	}
+	result, err := tx.ExecContext(ctx,
+		`UPDATE user_addresses SET is_default = TRUE, updated_at = $3 WHERE id = $1 AND user_id = $2`,
+		id, userID, updatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set default address: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrAddressNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+// clearDefaultAddress runs before a new default is written so the partial
+// unique index on the default flag is never violated.
+func clearDefaultAddress(ctx context.Context, tx *sqlx.Tx, userID string, updatedAt time.Time) error {
+	if _, err := tx.ExecContext(ctx,
+		`UPDATE user_addresses SET is_default = FALSE, updated_at = $2 WHERE user_id = $1 AND is_default`,
+		userID, updatedAt,
+	); err != nil {
+		return fmt.Errorf("failed to clear default address: %w", err)
+	}
+	return nil
+}
@@ -14,9 +14,20 @@ import (
 
 type RefreshTokenRepository interface {
 	Create(ctx context.Context, token *domain.RefreshToken) error
-	ReplaceForUser(ctx context.Context, token *domain.RefreshToken) error
+	// CreateSession stores the first token of a new session and evicts the
+	// user's oldest sessions so that at most maxSessions remain.
+	CreateSession(ctx context.Context, token *domain.RefreshToken, maxSessions int) (int64, error)
+	// Rotate marks the token with id as replaced by next and stores next.
+	// ErrTokenAlreadyRotated is returned when another request won the race.
+	Rotate(ctx context.Context, id string, next *domain.RefreshToken, rotatedAt time.Time) error
 	GetByHash(ctx context.Context, hash string) (*domain.RefreshToken, error)
+	// ListByUserID returns the active token of every unexpired session.
+	ListByUserID(ctx context.Context, userID string, now time.Time) ([]domain.RefreshToken, error)
+	// DeleteByHash removes the whole session the token belongs to.
 	DeleteByHash(ctx context.Context, hash string) error
+	DeleteFamily(ctx context.Context, familyID string) (int64, error)
+	DeleteFamilyForUser(ctx context.Context, familyID, userID string) error
+	DeleteOthersForUser(ctx context.Context, userID, keepFamilyID string) (int64, error)
 	DeleteByUserId(ctx context.Context, userId string) error
 	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
 }
@@ -29,38 +40,169 @@ func NewPgRefreshTokenRepository(db *sqlx.DB) RefreshTokenRepository {
 	return &PgRefreshTokenRepository{db: db}
 }
 
-func (r *PgRefreshTokenRepository) Create(
-	ctx context.Context,
-	token *domain.RefreshToken,
-) error {
+const refreshTokenColumns = `
+	id, family_id, user_id, token_hash, user_agent, ip_address,
+	expires_at, created_at, last_used_at, rotated_at, replaced_by
+`
 
-	query := `
-		INSERT INTO refresh_tokens (
-			id, user_id, token_hash, expires_at, created_at
-		)
-		VALUES ($1, $2, $3, $4, $5)
-	`
+const insertRefreshTokenQuery = `
+	INSERT INTO refresh_tokens (
+		id, family_id, user_id, token_hash, user_agent, ip_address,
+		expires_at, created_at, last_used_at
+	)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
 
-	_, err := r.db.ExecContext(
-		ctx,
-		query,
+func insertRefreshTokenArgs(token *domain.RefreshToken) []any {
+	return []any{
 		token.ID,
+		token.FamilyID,
 		token.UserID,
 		token.TokenHash,
+		token.UserAgent,
+		token.IPAddress,
 		token.ExpiresAt,
 		token.CreatedAt,
+		token.LastUsedAt,
+	}
+}
+
+func (r *PgRefreshTokenRepository) Create(
+	ctx context.Context,
+	token *domain.RefreshToken,
+) error {
+
+	_, err := r.db.ExecContext(
+		ctx,
+		insertRefreshTokenQuery,
+		insertRefreshTokenArgs(token)...,
 	)
 
 	return err
 }
 
+func (r *PgRefreshTokenRepository) CreateSession(
+	ctx context.Context,
+	token *domain.RefreshToken,
+	maxSessions int,
+) (int64, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Serialises concurrent logins of the same user so the cap holds.
+	if _, err = tx.ExecContext(
+		ctx,
+		`SELECT id FROM users WHERE id = $1 FOR UPDATE`,
+		token.UserID,
+	); err != nil {
+		return 0, fmt.Errorf("failed to lock user sessions: %w", err)
+	}
+
+	if _, err = tx.ExecContext(
+		ctx,
+		insertRefreshTokenQuery,
+		insertRefreshTokenArgs(token)...,
+	); err != nil {
+		return 0, fmt.Errorf("failed to create refresh token: %w", err)
+	}
+
+	var evicted int64
+	if maxSessions > 0 {
+		if err = tx.GetContext(
+			ctx,
+			&evicted,
+			`WITH evicted AS (
+			     DELETE FROM refresh_tokens
+			     WHERE user_id = $1
+			       AND family_id NOT IN (
+			           SELECT family_id FROM refresh_tokens
+			           WHERE user_id = $1 AND rotated_at IS NULL
+			           ORDER BY created_at DESC, family_id DESC
+			           LIMIT $2
+			       )
+			     RETURNING rotated_at
+			 )
+			 SELECT COUNT(*) FROM evicted WHERE rotated_at IS NULL`,
+			token.UserID,
+			maxSessions,
+		); err != nil {
+			return 0, fmt.Errorf("failed to evict old sessions: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit refresh token transaction: %w", err)
+	}
+	tx = nil
+	return evicted, nil
+}
+
+func (r *PgRefreshTokenRepository) Rotate(
+	ctx context.Context,
+	id string,
+	next *domain.RefreshToken,
+	rotatedAt time.Time,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	result, err := tx.ExecContext(
+		ctx,
+		`UPDATE refresh_tokens
+		 SET rotated_at = $2, replaced_by = $3, last_used_at = $2
+		 WHERE id = $1 AND rotated_at IS NULL`,
+		id,
+		rotatedAt,
+		next.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark refresh token rotated: %w", err)
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows for refresh token rotation: %w", err)
+	}
+	if rowsAffected == 0 {
+		return domain.ErrTokenAlreadyRotated
+	}
+
+	if _, err = tx.ExecContext(
+		ctx,
+		insertRefreshTokenQuery,
+		insertRefreshTokenArgs(next)...,
+	); err != nil {
+		return fmt.Errorf("failed to create rotated refresh token: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit refresh token rotation: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
 func (r *PgRefreshTokenRepository) GetByHash(
 	ctx context.Context,
 	hash string,
 ) (*domain.RefreshToken, error) {
 
-	query := `
-		SELECT id, user_id, token_hash, expires_at, created_at
+	query := `SELECT ` + refreshTokenColumns + `
 		FROM refresh_tokens
 		WHERE token_hash = $1
 	`
@@ -78,6 +220,25 @@ func (r *PgRefreshTokenRepository) GetByHash(
 	return &token, nil
 }
 
+func (r *PgRefreshTokenRepository) ListByUserID(
+	ctx context.Context,
+	userID string,
+	now time.Time,
+) ([]domain.RefreshToken, error) {
+
+	query := `SELECT ` + refreshTokenColumns + `
+		FROM refresh_tokens
+		WHERE user_id = $1 AND expires_at > $2 AND rotated_at IS NULL
+		ORDER BY last_used_at DESC, id DESC
+	`
+
+	var tokens []domain.RefreshToken
+	if err := r.db.SelectContext(ctx, &tokens, query, userID, now); err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+	return tokens, nil
+}
+
 func (r *PgRefreshTokenRepository) DeleteByHash(
 	ctx context.Context,
 	hash string,
@@ -85,65 +246,88 @@ func (r *PgRefreshTokenRepository) DeleteByHash(
 
 	query := `
 		DELETE FROM refresh_tokens
-		WHERE token_hash = $1
+		WHERE family_id IN (
+			SELECT family_id FROM refresh_tokens WHERE token_hash = $1
+		)
 	`
 
 	_, err := r.db.ExecContext(ctx, query, hash)
 	return err
 }
 
-func (r *PgRefreshTokenRepository) DeleteByUserId(
+func (r *PgRefreshTokenRepository) DeleteFamily(
 	ctx context.Context,
-	userId string) error {
-	query := `
-		DELETE FROM refresh_tokens
-		WHERE user_id = $1
-`
-	_, err := r.db.ExecContext(ctx, query, userId)
-	return err
+	familyID string,
+) (int64, error) {
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM refresh_tokens WHERE family_id = $1`,
+		familyID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete refresh token family: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows for refresh token family delete: %w", err)
+	}
+	return rowsAffected, nil
 }
 
-func (r *PgRefreshTokenRepository) ReplaceForUser(
+func (r *PgRefreshTokenRepository) DeleteFamilyForUser(
 	ctx context.Context,
-	token *domain.RefreshToken,
+	familyID, userID string,
 ) error {
-	tx, err := r.db.BeginTxx(ctx, nil)
+	result, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM refresh_tokens WHERE family_id = $1 AND user_id = $2`,
+		familyID,
+		userID,
+	)
 	if err != nil {
-		return fmt.Errorf("failed to start transaction: %w", err)
+		return fmt.Errorf("failed to delete session: %w", err)
 	}
 
-	defer func() {
-		if tx != nil {
-			_ = tx.Rollback()
-		}
-	}()
-
-	if _, err = tx.ExecContext(
-		ctx,
-		`DELETE FROM refresh_tokens WHERE user_id = $1`,
-		token.UserID,
-	); err != nil {
-		return fmt.Errorf("failed to delete old refresh tokens: %w", err)
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows for session delete: %w", err)
 	}
-
-	if _, err = tx.ExecContext(
-		ctx,
-		`INSERT INTO refresh_tokens (id, user_id, token_hash, expires_at, created_at)
-		 VALUES ($1, $2, $3, $4, $5)`,
-		token.ID,
-		token.UserID,
-		token.TokenHash,
-		token.ExpiresAt,
-		token.CreatedAt,
-	); err != nil {
-		return fmt.Errorf("failed to create refresh token: %w", err)
+	if rowsAffected == 0 {
+		return domain.ErrSessionNotFound
 	}
+	return nil
+}
 
-	if err = tx.Commit(); err != nil {
-		return fmt.Errorf("failed to commit refresh token transaction: %w", err)
+func (r *PgRefreshTokenRepository) DeleteOthersForUser(
+	ctx context.Context,
+	userID, keepFamilyID string,
+) (int64, error) {
+	query := `
+		WITH deleted AS (
+			DELETE FROM refresh_tokens
+			WHERE user_id = $1 AND family_id <> $2
+			RETURNING rotated_at
+		)
+		SELECT COUNT(*) FROM deleted WHERE rotated_at IS NULL
+	`
+
+	var revoked int64
+	if err := r.db.GetContext(ctx, &revoked, query, userID, keepFamilyID); err != nil {
+		return 0, fmt.Errorf("failed to delete other sessions: %w", err)
 	}
-	tx = nil
-	return nil
+	return revoked, nil
+}
+
+func (r *PgRefreshTokenRepository) DeleteByUserId(
+	ctx context.Context,
+	userId string) error {
+	query := `
+		DELETE FROM refresh_tokens
+		WHERE user_id = $1
+`
+	_, err := r.db.ExecContext(ctx, query, userId)
+	return err
 }
 
 func (r *PgRefreshTokenRepository) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
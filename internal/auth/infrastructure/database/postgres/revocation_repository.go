@@ -0,0 +1,160 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+)
+
+type RevocationRepository interface {
+	RevokeToken(ctx context.Context, token *domain.RevokedAccessToken) error
+	// RevokeUserTokensBefore moves the user's cutoff forward; an older
+	// cutoff never replaces a newer one.
+	RevokeUserTokensBefore(ctx context.Context, userID string, notBefore time.Time) error
+	IsRevoked(ctx context.Context, jti, userID string, issuedAt time.Time) (bool, error)
+	// ListActive returns the revocations that can still affect unexpired
+	// tokens: jtis expiring after now and cutoffs set after cutoffsSince.
+	ListActive(
+		ctx context.Context,
+		now, cutoffsSince time.Time,
+	) ([]domain.RevokedAccessToken, []domain.UserTokenCutoff, error)
+	DeleteExpired(ctx context.Context, now, cutoffsBefore time.Time) (int64, error)
+}
+
+type postgresRevocationRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRevocationRepository(db *sqlx.DB) RevocationRepository {
+	return &postgresRevocationRepository{db: db}
+}
+
+func (r *postgresRevocationRepository) RevokeToken(
+	ctx context.Context,
+	token *domain.RevokedAccessToken,
+) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO revoked_access_tokens (jti, user_id, expires_at, revoked_at)
+		 VALUES ($1, $2, $3, $4)
+		 ON CONFLICT (jti) DO NOTHING`,
+		token.JTI,
+		token.UserID,
+		token.ExpiresAt,
+		token.RevokedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke access token: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresRevocationRepository) RevokeUserTokensBefore(
+	ctx context.Context,
+	userID string,
+	notBefore time.Time,
+) error {
+	_, err := r.db.ExecContext(
+		ctx,
+		`INSERT INTO user_token_cutoffs (user_id, not_before)
+		 VALUES ($1, $2)
+		 ON CONFLICT (user_id) DO UPDATE
+		 SET not_before = GREATEST(user_token_cutoffs.not_before, EXCLUDED.not_before)`,
+		userID,
+		notBefore,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to revoke user access tokens: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresRevocationRepository) IsRevoked(
+	ctx context.Context,
+	jti, userID string,
+	issuedAt time.Time,
+) (bool, error) {
+	query := `
+		SELECT
+			EXISTS (SELECT 1 FROM revoked_access_tokens WHERE jti = $1)
+			OR EXISTS (
+				SELECT 1 FROM user_token_cutoffs
+				WHERE user_id = $2 AND not_before > $3
+			)
+	`
+
+	var revoked bool
+	if err := r.db.GetContext(ctx, &revoked, query, jti, userID, issuedAt); err != nil {
+		return false, fmt.Errorf("failed to check access token revocation: %w", err)
+	}
+	return revoked, nil
+}
+
+func (r *postgresRevocationRepository) ListActive(
+	ctx context.Context,
+	now, cutoffsSince time.Time,
+) ([]domain.RevokedAccessToken, []domain.UserTokenCutoff, error) {
+	var tokens []domain.RevokedAccessToken
+	if err := r.db.SelectContext(
+		ctx,
+		&tokens,
+		`SELECT jti, user_id, expires_at, revoked_at
+		 FROM revoked_access_tokens
+		 WHERE expires_at > $1
+		 ORDER BY revoked_at`,
+		now,
+	); err != nil {
+		return nil, nil, fmt.Errorf("failed to list revoked access tokens: %w", err)
+	}
+
+	var cutoffs []domain.UserTokenCutoff
+	if err := r.db.SelectContext(
+		ctx,
+		&cutoffs,
+		`SELECT user_id, not_before
+		 FROM user_token_cutoffs
+		 WHERE not_before > $1
+		 ORDER BY not_before`,
+		cutoffsSince,
+	); err != nil {
+		return nil, nil, fmt.Errorf("failed to list user token cutoffs: %w", err)
+	}
+
+	return tokens, cutoffs, nil
+}
+
+func (r *postgresRevocationRepository) DeleteExpired(
+	ctx context.Context,
+	now, cutoffsBefore time.Time,
+) (int64, error) {
+	tokensResult, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM revoked_access_tokens WHERE expires_at <= $1`,
+		now,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired access token revocations: %w", err)
+	}
+	cutoffsResult, err := r.db.ExecContext(
+		ctx,
+		`DELETE FROM user_token_cutoffs WHERE not_before <= $1`,
+		cutoffsBefore,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale user token cutoffs: %w", err)
+	}
+
+	deletedTokens, err := tokensResult.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows for revocation cleanup: %w", err)
+	}
+	deletedCutoffs, err := cutoffsResult.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("failed to get affected rows for cutoff cleanup: %w", err)
+	}
+	return deletedTokens + deletedCutoffs, nil
+}
@@ -0,0 +1,125 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+)
+
+type RoleRepository interface {
+	// GetRole returns ErrRoleNotFound for an unknown role.
+	GetRole(ctx context.Context, name string) (*domain.Role, error)
+	ListRoles(ctx context.Context) ([]domain.Role, error)
+	// SaveRole creates the role or updates its description, and replaces
+	// its permissions.
+	SaveRole(ctx context.Context, role *domain.Role) error
+}
+
+type postgresRoleRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRoleRepository(db *sqlx.DB) RoleRepository {
+	return &postgresRoleRepository{db: db}
+}
+
+const roleColumns = `name, description, created_at, updated_at`
+
+func (r *postgresRoleRepository) GetRole(ctx context.Context, name string) (*domain.Role, error) {
+	var role domain.Role
+	err := r.db.GetContext(ctx, &role, `SELECT `+roleColumns+` FROM roles WHERE name = $1`, name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRoleNotFound
+		}
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	if err := r.db.SelectContext(
+		ctx,
+		&role.Permissions,
+		`SELECT permission FROM role_permissions WHERE role = $1 ORDER BY permission`,
+		name,
+	); err != nil {
+		return nil, fmt.Errorf("failed to get role permissions: %w", err)
+	}
+	return &role, nil
+}
+
+func (r *postgresRoleRepository) ListRoles(ctx context.Context) ([]domain.Role, error) {
+	var roles []domain.Role
+	if err := r.db.SelectContext(ctx, &roles, `SELECT `+roleColumns+` FROM roles ORDER BY name`); err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+
+	var grants []struct {
+		Role       string `db:"role"`
+		Permission string `db:"permission"`
+	}
+	if err := r.db.SelectContext(
+		ctx,
+		&grants,
+		`SELECT role, permission FROM role_permissions ORDER BY role, permission`,
+	); err != nil {
+		return nil, fmt.Errorf("failed to list role permissions: %w", err)
+	}
+
+	byRole := make(map[string][]string, len(roles))
+	for _, grant := range grants {
+		byRole[grant.Role] = append(byRole[grant.Role], grant.Permission)
+	}
+	for i := range roles {
+		roles[i].Permissions = byRole[roles[i].Name]
+	}
+	return roles, nil
+}
+
+func (r *postgresRoleRepository) SaveRole(ctx context.Context, role *domain.Role) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.NamedExecContext(
+		ctx,
+		`INSERT INTO roles (name, description, created_at, updated_at)
+		 VALUES (:name, :description, :created_at, :updated_at)
+		 ON CONFLICT (name) DO UPDATE SET
+			description = EXCLUDED.description,
+			updated_at = EXCLUDED.updated_at`,
+		role,
+	); err != nil {
+		return fmt.Errorf("failed to save role: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM role_permissions WHERE role = $1`, role.Name); err != nil {
+		return fmt.Errorf("failed to delete role permissions: %w", err)
+	}
+	for _, permission := range role.Permissions {
+		if _, err = tx.ExecContext(
+			ctx,
+			`INSERT INTO role_permissions (role, permission) VALUES ($1, $2)`,
+			role.Name,
+			permission,
+		); err != nil {
+			return fmt.Errorf("failed to grant role permission: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit role: %w", err)
+	}
+	tx = nil
+	return nil
+}
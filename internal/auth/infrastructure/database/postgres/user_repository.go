@@ -5,6 +5,8 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
 	"github.com/jmoiron/sqlx"
@@ -15,8 +17,18 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, user *domain.User) error
 	GetUserByLogin(ctx context.Context, login string) (*domain.User, error)
 	GetUserByID(ctx context.Context, id string) (*domain.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*domain.User, error)
 	UpdateUser(ctx context.Context, user *domain.User) error
+	// UpdateUserEndingSessions is UpdateUser for a password change: in the
+	// same transaction it ends every session of the user.
+	UpdateUserEndingSessions(ctx context.Context, user *domain.User) error
 	DeleteUser(ctx context.Context, id string) error
+	ListUserIDsByRole(ctx context.Context, role string) ([]string, error)
+	ListUsers(ctx context.Context, filter domain.UserFilter) (*domain.UserListResult, error)
+	// SetDisabledAt disables the user, or enables them again when
+	// disabledAt is nil.
+	SetDisabledAt(ctx context.Context, id string, disabledAt *time.Time, updatedAt time.Time) error
+	RecordLogin(ctx context.Context, id string, at time.Time) error
 }
 
 type postgresUserRepository struct {
@@ -27,15 +39,33 @@ func NewPostgresUserRepository(db *sqlx.DB) UserRepository {
 	return &postgresUserRepository{db: db}
 }
 
+const userColumns = `id, login, password, role, COALESCE(email, '') AS email, email_verified,
+	disabled_at, last_login_at, created_at, updated_at`
+
+// userSorts is the whitelist of orderings accepted by ListUsers; id breaks
+// ties so pages do not overlap.
+var userSorts = map[string]string{
+	domain.UserSortNewest:    "created_at DESC, id DESC",
+	domain.UserSortOldest:    "created_at ASC, id ASC",
+	domain.UserSortLogin:     "login ASC, id ASC",
+	domain.UserSortLastLogin: "last_login_at DESC NULLS LAST, id DESC",
+}
+
+// likeEscaper makes a search term match literally inside ILIKE.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
 func (r *postgresUserRepository) CreateUser(ctx context.Context, user *domain.User) error {
-	query := `INSERT INTO users (id, login, password, role, created_at, updated_at)
-              VALUES (:id, :login, :password, :role, :created_at, :updated_at)`
+	query := `INSERT INTO users (id, login, password, role, email, email_verified, created_at, updated_at)
+              VALUES (:id, :login, :password, :role, NULLIF(:email, ''), :email_verified, :created_at, :updated_at)`
 	_, err := r.db.NamedExecContext(ctx, query, user)
 	if err != nil {
 		var pqErr *pq.Error
 		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
 			return domain.ErrUserAlreadyExists
 		}
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" && pqErr.Constraint == "fk_users_role" {
+			return domain.ErrRoleNotFound
+		}
 		return fmt.Errorf("failed to create user: %w", err)
 	}
 	return nil
@@ -43,7 +73,7 @@ func (r *postgresUserRepository) CreateUser(ctx context.Context, user *domain.Us
 
 func (r *postgresUserRepository) GetUserByLogin(ctx context.Context, login string) (*domain.User, error) {
 	var user domain.User
-	query := `SELECT id, login, password, role, created_at, updated_at FROM users WHERE login = $1`
+	query := `SELECT ` + userColumns + ` FROM users WHERE login = $1`
 	err := r.db.GetContext(ctx, &user, query, login)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -56,7 +86,7 @@ func (r *postgresUserRepository) GetUserByLogin(ctx context.Context, login strin
 
 func (r *postgresUserRepository) GetUserByID(ctx context.Context, id string) (*domain.User, error) {
 	var user domain.User
-	query := `SELECT id, login, password, role, created_at, updated_at FROM users WHERE id = $1`
+	query := `SELECT ` + userColumns + ` FROM users WHERE id = $1`
 	err := r.db.GetContext(ctx, &user, query, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -67,16 +97,66 @@ func (r *postgresUserRepository) GetUserByID(ctx context.Context, id string) (*d
 	return &user, nil
 }
 
+func (r *postgresUserRepository) GetUserByEmail(ctx context.Context, email string) (*domain.User, error) {
+	var user domain.User
+	query := `SELECT ` + userColumns + ` FROM users WHERE email = $1`
+	err := r.db.GetContext(ctx, &user, query, email)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrUserNotFound
+		}
+		return nil, fmt.Errorf("failed to get user by email: %w", err)
+	}
+	return &user, nil
+}
+
 func (r *postgresUserRepository) UpdateUser(ctx context.Context, user *domain.User) error {
+	return updateUser(ctx, r.db, user)
+}
+
+func (r *postgresUserRepository) UpdateUserEndingSessions(ctx context.Context, user *domain.User) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = updateUser(ctx, tx, user); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(
+		ctx,
+		`DELETE FROM refresh_tokens WHERE user_id = $1`,
+		user.ID,
+	); err != nil {
+		return fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit user update: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func updateUser(ctx context.Context, db sqlx.ExtContext, user *domain.User) error {
 	query := `UPDATE users
               SET login = :login, password = :password, role = :role, updated_at = :updated_at
               WHERE id = :id`
-	result, err := r.db.NamedExecContext(ctx, query, user)
+	result, err := sqlx.NamedExecContext(ctx, db, query, user)
 	if err != nil {
 		var pqErr *pq.Error
 		if errors.As(err, &pqErr) && pqErr.Code == "23505" {
 			return domain.ErrUserAlreadyExists
 		}
+		if errors.As(err, &pqErr) && pqErr.Code == "23503" && pqErr.Constraint == "fk_users_role" {
+			return domain.ErrRoleNotFound
+		}
 		return fmt.Errorf("failed to update user: %w", err)
 	}
 	rows, err := result.RowsAffected()
@@ -103,3 +183,87 @@ func (r *postgresUserRepository) DeleteUser(ctx context.Context, id string) erro
 	}
 	return nil
 }
+
+func (r *postgresUserRepository) ListUserIDsByRole(ctx context.Context, role string) ([]string, error) {
+	var ids []string
+	if err := r.db.SelectContext(ctx, &ids, `SELECT id FROM users WHERE role = $1`, role); err != nil {
+		return nil, fmt.Errorf("failed to list users by role: %w", err)
+	}
+	return ids, nil
+}
+
+func (r *postgresUserRepository) ListUsers(
+	ctx context.Context,
+	filter domain.UserFilter,
+) (*domain.UserListResult, error) {
+	var conditions []string
+	var args []any
+	if filter.Search != "" {
+		args = append(args, "%"+likeEscaper.Replace(filter.Search)+"%")
+		conditions = append(conditions, fmt.Sprintf("login ILIKE $%d", len(args)))
+	}
+	if filter.Role != "" {
+		args = append(args, filter.Role)
+		conditions = append(conditions, fmt.Sprintf("role = $%d", len(args)))
+	}
+	switch filter.Status {
+	case domain.UserStatusActive:
+		conditions = append(conditions, "disabled_at IS NULL")
+	case domain.UserStatusDisabled:
+		conditions = append(conditions, "disabled_at IS NOT NULL")
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	orderBy, ok := userSorts[filter.Sort]
+	if !ok {
+		orderBy = userSorts[domain.UserSortNewest]
+	}
+
+	var total int32
+	if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM users`+where, args...); err != nil {
+		return nil, fmt.Errorf("failed to count users: %w", err)
+	}
+
+	args = append(args, filter.PageSize, (filter.Page-1)*filter.PageSize)
+	query := `SELECT ` + userColumns + ` FROM users` + where +
+		fmt.Sprintf(" ORDER BY %s LIMIT $%d OFFSET $%d", orderBy, len(args)-1, len(args))
+
+	users := []domain.User{}
+	if err := r.db.SelectContext(ctx, &users, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list users: %w", err)
+	}
+	return &domain.UserListResult{Users: users, Total: total}, nil
+}
+
+func (r *postgresUserRepository) SetDisabledAt(
+	ctx context.Context,
+	id string,
+	disabledAt *time.Time,
+	updatedAt time.Time,
+) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE users SET disabled_at = $2, updated_at = $3 WHERE id = $1`,
+		id, disabledAt, updatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update user status: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrUserNotFound
+	}
+	return nil
+}
+
+func (r *postgresUserRepository) RecordLogin(ctx context.Context, id string, at time.Time) error {
+	if _, err := r.db.ExecContext(ctx, `UPDATE users SET last_login_at = $2 WHERE id = $1`, id, at); err != nil {
+		return fmt.Errorf("failed to record login: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+)
+
+// FileMailer is meant for local development: instead of sending, it appends
+// every message to a file, or logs it when no path is configured.
+type FileMailer struct {
+	path string
+	from string
+	mu   sync.Mutex
+}
+
+func NewFileMailer(path, from string) *FileMailer {
+	return &FileMailer{path: path, from: from}
+}
+
+func (m *FileMailer) Send(ctx context.Context, msg domain.EmailMessage) error {
+	if m.path == "" {
+		slog.InfoContext(ctx, "mail not sent, logging instead",
+			"to", msg.To,
+			"subject", msg.Subject,
+			"body", msg.Body,
+		)
+		return nil
+	}
+
+	body, err := buildMessage(m.from, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	file, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open mail file: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := fmt.Fprintf(file, "%s\r\n\r\n", body); err != nil {
+		return fmt.Errorf("failed to write mail file: %w", err)
+	}
+	return nil
+}
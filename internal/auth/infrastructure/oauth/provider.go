@@ -0,0 +1,331 @@
+// Package oauth signs users in through third-party OAuth 2.0 and OpenID
+// Connect providers with the authorization code flow and PKCE.
+package oauth
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/auth/domain"
+	pkgjwt "github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+)
+
+const (
+	defaultSubjectClaim       = "sub"
+	defaultEmailClaim         = "email"
+	defaultEmailVerifiedClaim = "email_verified"
+
+	requestTimeout = 10 * time.Second
+	// maxResponseSize bounds what is read from a provider.
+	maxResponseSize = 1 << 20
+	// idTokenLeeway covers clock skew between us and the provider.
+	idTokenLeeway = time.Minute
+)
+
+// Config describes one provider. Claim names may be dotted paths into
+// nested objects, e.g. "user.user_id".
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	AuthURL      string
+	TokenURL     string
+	// UserInfoURL is queried with the access token for the user's claims.
+	UserInfoURL string
+	// UserInfoTokenType is the Authorization scheme of the userinfo
+	// request, "Bearer" unless the provider wants another (Yandex: "OAuth").
+	UserInfoTokenType string
+	// Issuer and JWKSURL are set for OpenID Connect providers; the ID
+	// token is then required and verified against them.
+	Issuer  string
+	JWKSURL string
+	// RedirectURL is the frontend page the provider sends the user back to.
+	RedirectURL string
+	Scopes      []string
+
+	SubjectClaim       string
+	EmailClaim         string
+	EmailVerifiedClaim string
+	// TrustEmail treats every email the provider returns as verified, for
+	// providers that only hand out confirmed addresses without saying so.
+	TrustEmail bool
+}
+
+type Provider struct {
+	name   string
+	config Config
+	client *http.Client
+	keys   pkgjwt.KeySource
+}
+
+func NewProvider(name string, config Config) (*Provider, error) {
+	if config.ClientID == "" || config.AuthURL == "" || config.TokenURL == "" || config.RedirectURL == "" {
+		return nil, fmt.Errorf("provider %q: client_id, auth_url, token_url and redirect_url are required", name)
+	}
+	if config.UserInfoURL == "" && config.JWKSURL == "" {
+		return nil, fmt.Errorf("provider %q: userinfo_url or jwks_url is required", name)
+	}
+	if config.UserInfoTokenType == "" {
+		config.UserInfoTokenType = "Bearer"
+	}
+	if config.SubjectClaim == "" {
+		config.SubjectClaim = defaultSubjectClaim
+	}
+	if config.EmailClaim == "" {
+		config.EmailClaim = defaultEmailClaim
+	}
+	if config.EmailVerifiedClaim == "" {
+		config.EmailVerifiedClaim = defaultEmailVerifiedClaim
+	}
+
+	provider := &Provider{
+		name:   name,
+		config: config,
+		client: &http.Client{Timeout: requestTimeout},
+	}
+	if config.JWKSURL != "" {
+		provider.keys = pkgjwt.NewRemoteKeySet(config.JWKSURL, pkgjwt.DefaultJWKSCacheTTL)
+	}
+	return provider, nil
+}
+
+func (p *Provider) Name() string {
+	return p.name
+}
+
+// AuthCodeURL returns where to send the browser to sign in. The PKCE
+// challenge is derived from codeVerifier with S256.
+func (p *Provider) AuthCodeURL(state, nonce, codeVerifier string) string {
+	params := url.Values{
+		"response_type":         {"code"},
+		"client_id":             {p.config.ClientID},
+		"redirect_uri":          {p.config.RedirectURL},
+		"state":                 {state},
+		"code_challenge":        {codeChallenge(codeVerifier)},
+		"code_challenge_method": {"S256"},
+	}
+	if len(p.config.Scopes) > 0 {
+		params.Set("scope", strings.Join(p.config.Scopes, " "))
+	}
+	if p.keys != nil {
+		params.Set("nonce", nonce)
+	}
+
+	separator := "?"
+	if strings.Contains(p.config.AuthURL, "?") {
+		separator = "&"
+	}
+	return p.config.AuthURL + separator + params.Encode()
+}
+
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Authenticate redeems the authorization code and returns the account it
+// was issued for. nonce must match the one the ID token was requested with.
+func (p *Provider) Authenticate(
+	ctx context.Context,
+	code, codeVerifier, nonce string,
+) (*domain.ExternalIdentity, error) {
+	token, err := p.exchange(ctx, code, codeVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := map[string]any{}
+	if p.keys != nil {
+		if token.IDToken == "" {
+			return nil, errors.New("token response has no ID token")
+		}
+		if claims, err = p.verifyIDToken(token.IDToken, nonce); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.config.UserInfoURL != "" {
+		userInfo, err := p.userInfo(ctx, token.AccessToken)
+		if err != nil {
+			return nil, err
+		}
+		// OpenID Connect requires the userinfo to describe the same
+		// user as the ID token.
+		if idSubject := claimString(claims, p.config.SubjectClaim); idSubject != "" &&
+			claimString(userInfo, p.config.SubjectClaim) != idSubject {
+			return nil, errors.New("userinfo subject does not match the ID token")
+		}
+		for name, value := range userInfo {
+			claims[name] = value
+		}
+	}
+
+	identity := &domain.ExternalIdentity{
+		Provider: p.name,
+		Subject:  claimString(claims, p.config.SubjectClaim),
+		Email:    claimString(claims, p.config.EmailClaim),
+	}
+	if identity.Subject == "" {
+		return nil, fmt.Errorf("claim %q is missing", p.config.SubjectClaim)
+	}
+	if identity.Email != "" {
+		identity.EmailVerified = p.config.TrustEmail || claimBool(claims, p.config.EmailVerifiedClaim)
+	}
+	return identity, nil
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	IDToken          string `json:"id_token"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func (p *Provider) exchange(ctx context.Context, code, codeVerifier string) (*tokenResponse, error) {
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {p.config.RedirectURL},
+		"client_id":     {p.config.ClientID},
+		"client_secret": {p.config.ClientSecret},
+		"code_verifier": {codeVerifier},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.config.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	body, status, err := p.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange code: %w", err)
+	}
+
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("failed to decode token response (status %d): %w", status, err)
+	}
+	if token.Error != "" {
+		return nil, fmt.Errorf("token endpoint refused the code: %s %s", token.Error, token.ErrorDescription)
+	}
+	if status != http.StatusOK || token.AccessToken == "" {
+		return nil, fmt.Errorf("unexpected token response status %d", status)
+	}
+	return &token, nil
+}
+
+func (p *Provider) userInfo(ctx context.Context, accessToken string) (map[string]any, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.config.UserInfoURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build userinfo request: %w", err)
+	}
+	req.Header.Set("Authorization", p.config.UserInfoTokenType+" "+accessToken)
+	req.Header.Set("Accept", "application/json")
+
+	body, status, err := p.do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch userinfo: %w", err)
+	}
+	if status != http.StatusOK {
+		return nil, fmt.Errorf("unexpected userinfo response status %d", status)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var claims map[string]any
+	if err := decoder.Decode(&claims); err != nil {
+		return nil, fmt.Errorf("failed to decode userinfo: %w", err)
+	}
+	return claims, nil
+}
+
+func (p *Provider) do(req *http.Request) ([]byte, int, error) {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, 0, err
+	}
+	return body, resp.StatusCode, nil
+}
+
+func (p *Provider) verifyIDToken(raw, nonce string) (map[string]any, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "EdDSA"}),
+		jwt.WithAudience(p.config.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(idTokenLeeway),
+		jwt.WithJSONNumber(),
+	}
+	if p.config.Issuer != "" {
+		options = append(options, jwt.WithIssuer(p.config.Issuer))
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := jwt.NewParser(options...).ParseWithClaims(raw, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.keys.Key(kid)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID token: %w", err)
+	}
+	if value, _ := claims["nonce"].(string); value == "" || value != nonce {
+		return nil, errors.New("invalid ID token: nonce mismatch")
+	}
+	return claims, nil
+}
+
+// claimValue resolves a dotted path such as "user.email".
+func claimValue(claims map[string]any, path string) any {
+	var value any = claims
+	for _, name := range strings.Split(path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+	return value
+}
+
+// claimString accepts numbers too, since some providers return numeric
+// user ids.
+func claimString(claims map[string]any, path string) string {
+	switch value := claimValue(claims, path).(type) {
+	case string:
+		return strings.TrimSpace(value)
+	case json.Number:
+		return value.String()
+	default:
+		return ""
+	}
+}
+
+func claimBool(claims map[string]any, path string) bool {
+	switch value := claimValue(claims, path).(type) {
+	case bool:
+		return value
+	case string:
+		verified, _ := strconv.ParseBool(value)
+		return verified
+	default:
+		return false
+	}
+}
@@ -1,11 +1,29 @@
 package utils
 
 import (
+	"crypto/rand"
 	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
+	"fmt"
 )
 
 func HashString(value string) string {
 	hash := sha256.Sum256([]byte(value))
 	return hex.EncodeToString(hash[:])
 }
+
+// GenerateClientSecret returns a random API client secret. It carries
+// enough entropy for HashString to be a safe way to store it.
+func GenerateClientSecret() (string, error) {
+	return GenerateToken()
+}
+
+// GenerateToken returns a random URL-safe string with 256 bits of entropy.
+func GenerateToken() (string, error) {
+	token := make([]byte, 32)
+	if _, err := rand.Read(token); err != nil {
+		return "", fmt.Errorf("failed to generate random token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
@@ -4,55 +4,83 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	pkgjwt "github.com/KarpovYuri/caraudio-backend/pkg/jwt"
 )
 
-type Claims struct {
-	UserID string `json:"user_id"`
-	Role   string `json:"role"`
-	jwt.RegisteredClaims
+type TokenConfig struct {
+	Issuer     string
+	Audience   string
+	Algorithms []string
+	Leeway     time.Duration
+}
+
+// TokenIssuer signs access tokens and validates them with the same shared
+// verifier the other services use, so auth accepts exactly what they accept.
+type TokenIssuer struct {
+	keys     *KeyRing
+	config   TokenConfig
+	verifier *pkgjwt.Verifier
 }
 
-func GenerateJWT(
+func NewTokenIssuer(keys *KeyRing, config TokenConfig) *TokenIssuer {
+	if config.Issuer == "" {
+		config.Issuer = pkgjwt.DefaultIssuer
+	}
+	if config.Audience == "" {
+		config.Audience = pkgjwt.DefaultAudience
+	}
+	return &TokenIssuer{
+		keys:   keys,
+		config: config,
+		verifier: pkgjwt.NewVerifier(keys, pkgjwt.ValidationOptions{
+			Issuer:     config.Issuer,
+			Audience:   config.Audience,
+			Algorithms: config.Algorithms,
+			Leeway:     config.Leeway,
+		}),
+	}
+}
+
+func (i *TokenIssuer) Keys() *KeyRing {
+	return i.keys
+}
+
+func (i *TokenIssuer) GenerateJWT(
 	userID string,
 	role string,
-	secret string,
+	permissions []string,
 	ttl time.Duration,
 ) (string, error) {
 
-	claims := Claims{
-		UserID: userID,
-		Role:   role,
+	now := time.Now()
+	claims := pkgjwt.Claims{
+		UserID:      userID,
+		Role:        role,
+		Permissions: permissions,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(ttl)),
-			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			Issuer:    i.config.Issuer,
+			Subject:   userID,
+			Audience:  jwt.ClaimStrings{i.config.Audience},
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+			NotBefore: jwt.NewNumericDate(now),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ID:        uuid.NewString(),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secret))
-}
-
-func ParseJWT(
-	tokenString string,
-	secret string,
-) (*Claims, error) {
-
-	token, err := jwt.ParseWithClaims(
-		tokenString,
-		&Claims{},
-		func(token *jwt.Token) (interface{}, error) {
-			return []byte(secret), nil
-		},
-	)
-
+	kid, key := i.keys.signingKey()
+	alg, err := pkgjwt.AlgorithmFor(key.Public())
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	claims, ok := token.Claims.(*Claims)
-	if !ok || !token.Valid {
-		return nil, jwt.ErrTokenInvalidClaims
-	}
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(alg), claims)
+	token.Header["kid"] = kid
+	return token.SignedString(key)
+}
 
-	return claims, nil
+func (i *TokenIssuer) ParseJWT(tokenString string) (*pkgjwt.Claims, error) {
+	return i.verifier.ParseToken(tokenString)
 }
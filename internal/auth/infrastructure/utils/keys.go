@@ -0,0 +1,133 @@
+package utils
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	pkgjwt "github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+)
+
+const minRSAKeyBits = 2048
+
+type SigningKey struct {
+	KID        string
+	PrivateKey crypto.Signer
+}
+
+// KeyFile points at a PEM encoded private key (PKCS#8, or PKCS#1 for RSA).
+type KeyFile struct {
+	KID            string
+	PrivateKeyFile string
+}
+
+// KeyRing holds every key tokens may currently be signed with. New tokens
+// use the signing key only; the others stay published and accepted until
+// tokens they signed have expired, which makes rotation seamless.
+type KeyRing struct {
+	signingKID string
+	keys       map[string]crypto.Signer
+	order      []string
+}
+
+func NewKeyRing(signingKID string, keys ...SigningKey) (*KeyRing, error) {
+	ring := &KeyRing{keys: make(map[string]crypto.Signer, len(keys))}
+	for _, key := range keys {
+		if key.KID == "" {
+			return nil, errors.New("signing key id is required")
+		}
+		if _, ok := ring.keys[key.KID]; ok {
+			return nil, fmt.Errorf("duplicate signing key id %q", key.KID)
+		}
+		if _, err := pkgjwt.AlgorithmFor(key.PrivateKey.Public()); err != nil {
+			return nil, fmt.Errorf("key %q: %w", key.KID, err)
+		}
+		if rsaKey, ok := key.PrivateKey.(*rsa.PrivateKey); ok && rsaKey.N.BitLen() < minRSAKeyBits {
+			return nil, fmt.Errorf("key %q: RSA keys must be at least %d bits", key.KID, minRSAKeyBits)
+		}
+		ring.keys[key.KID] = key.PrivateKey
+		ring.order = append(ring.order, key.KID)
+	}
+	if len(ring.order) == 0 {
+		return nil, errors.New("at least one signing key is required")
+	}
+
+	if signingKID == "" {
+		signingKID = ring.order[0]
+	}
+	if _, ok := ring.keys[signingKID]; !ok {
+		return nil, fmt.Errorf("signing key %q is not configured", signingKID)
+	}
+	ring.signingKID = signingKID
+	return ring, nil
+}
+
+func LoadKeyRing(signingKID string, files []KeyFile) (*KeyRing, error) {
+	keys := make([]SigningKey, 0, len(files))
+	for _, file := range files {
+		key, err := loadPrivateKey(file.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", file.KID, err)
+		}
+		keys = append(keys, SigningKey{KID: file.KID, PrivateKey: key})
+	}
+	return NewKeyRing(signingKID, keys...)
+}
+
+func (r *KeyRing) signingKey() (string, crypto.Signer) {
+	return r.signingKID, r.keys[r.signingKID]
+}
+
+func (r *KeyRing) Key(kid string) (crypto.PublicKey, error) {
+	key, ok := r.keys[kid]
+	if !ok {
+		return nil, pkgjwt.ErrUnknownKey
+	}
+	return key.Public(), nil
+}
+
+// JWKS returns the public halves of all keys in the ring.
+func (r *KeyRing) JWKS() (pkgjwt.JWKS, error) {
+	set := pkgjwt.JWKS{Keys: make([]pkgjwt.JSONWebKey, 0, len(r.order))}
+	for _, kid := range r.order {
+		jwk, err := pkgjwt.NewJSONWebKey(kid, r.keys[kid].Public())
+		if err != nil {
+			return pkgjwt.JWKS{}, err
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set, nil
+}
+
+func loadPrivateKey(path string) (crypto.Signer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read private key: %w", err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("private key file is not PEM encoded")
+	}
+
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	switch key := parsed.(type) {
+	case *rsa.PrivateKey:
+		return key, nil
+	case ed25519.PrivateKey:
+		return key, nil
+	default:
+		return nil, pkgjwt.ErrUnsupportedKey
+	}
+}
@@ -0,0 +1,17 @@
+package grpc
+
+import (
+	cartv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/cart/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+)
+
+// Policy declares who may call each cart RPC. Guests have carts too, so
+// every call is public and the owner is resolved per request.
+var Policy = authz.Policy{
+	cartv1.CartService_GetCart_FullMethodName:        authz.Public,
+	cartv1.CartService_AddCartItem_FullMethodName:    authz.Public,
+	cartv1.CartService_UpdateCartItem_FullMethodName: authz.Public,
+	cartv1.CartService_RemoveCartItem_FullMethodName: authz.Public,
+	cartv1.CartService_ClearCart_FullMethodName:      authz.Public,
+	cartv1.CartService_MergeCart_FullMethodName:      authz.Public,
+}
@@ -0,0 +1,257 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/cart/app/services"
+	"github.com/KarpovYuri/caraudio-backend/internal/cart/domain"
+	cartv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/cart/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	guestCookieName   = "cart_id"
+	guestCookieMaxAge = 30 * 24 * 60 * 60
+)
+
+type CartGRPCServer struct {
+	cartv1.UnimplementedCartServiceServer
+	cartService  services.CartService
+	cookieSecure bool
+}
+
+func NewCartGRPCServer(cartService services.CartService, cookieSecure bool) *CartGRPCServer {
+	return &CartGRPCServer{
+		cartService:  cartService,
+		cookieSecure: cookieSecure,
+	}
+}
+
+func (s *CartGRPCServer) GetCart(
+	ctx context.Context,
+	_ *cartv1.GetCartRequest,
+) (*cartv1.GetCartResponse, error) {
+	owner, err := s.resolveOwner(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	summary, err := s.cartService.GetCart(ctx, owner)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &cartv1.GetCartResponse{Cart: toProtoCart(summary)}, nil
+}
+
+func (s *CartGRPCServer) AddCartItem(
+	ctx context.Context,
+	req *cartv1.AddCartItemRequest,
+) (*cartv1.AddCartItemResponse, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+	owner, err := s.resolveOrIssueOwner(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	summary, err := s.cartService.AddItem(ctx, owner, req.ProductId, req.Quantity)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &cartv1.AddCartItemResponse{Cart: toProtoCart(summary)}, nil
+}
+
+func (s *CartGRPCServer) UpdateCartItem(
+	ctx context.Context,
+	req *cartv1.UpdateCartItemRequest,
+) (*cartv1.UpdateCartItemResponse, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+	owner, err := s.resolveOwner(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	summary, err := s.cartService.UpdateItem(ctx, owner, req.ProductId, req.Quantity)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &cartv1.UpdateCartItemResponse{Cart: toProtoCart(summary)}, nil
+}
+
+func (s *CartGRPCServer) RemoveCartItem(
+	ctx context.Context,
+	req *cartv1.RemoveCartItemRequest,
+) (*cartv1.RemoveCartItemResponse, error) {
+	owner, err := s.resolveOwner(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	summary, err := s.cartService.RemoveItem(ctx, owner, req.ProductId)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &cartv1.RemoveCartItemResponse{Cart: toProtoCart(summary)}, nil
+}
+
+func (s *CartGRPCServer) ClearCart(
+	ctx context.Context,
+	_ *cartv1.ClearCartRequest,
+) (*cartv1.ClearCartResponse, error) {
+	owner, err := s.resolveOwner(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	if err := s.cartService.Clear(ctx, owner); err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &cartv1.ClearCartResponse{Success: true}, nil
+}
+
+func (s *CartGRPCServer) MergeCart(
+	ctx context.Context,
+	_ *cartv1.MergeCartRequest,
+) (*cartv1.MergeCartResponse, error) {
+	owner, err := s.resolveOwner(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	if owner.UserID == "" {
+		return nil, status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	summary, err := s.cartService.Merge(ctx, owner.UserID, owner.GuestToken)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	if owner.GuestToken != "" {
+		if err := s.setGuestCookie(ctx, "", -1); err != nil {
+			return nil, err
+		}
+	}
+	return &cartv1.MergeCartResponse{Cart: toProtoCart(summary)}, nil
+}
+
+// resolveOwner reads the user from the bearer token and the guest cart from
+// the cart cookie. Both are returned so MergeCart can combine them; the
+// service prefers the user id when it is set.
+func (s *CartGRPCServer) resolveOwner(ctx context.Context) (domain.CartOwner, error) {
+	var owner domain.CartOwner
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	// Cart calls are public, so the interceptor lets an invalid token
+	// through without a principal; it must not fall back to the guest cart.
+	if authz.BearerToken(ctx) != "" {
+		userID, err := authz.UserID(ctx)
+		if err != nil {
+			return owner, err
+		}
+		owner.UserID = userID
+	}
+
+	if values := md.Get("x-cart-id"); len(values) > 0 {
+		owner.GuestToken = strings.TrimSpace(values[0])
+	}
+	if cookies := md.Get("grpcgateway-cookie"); len(cookies) > 0 {
+		if value := extractCookie(cookies[0], guestCookieName); value != "" {
+			owner.GuestToken = value
+		}
+	}
+	if owner.GuestToken != "" && uuid.Validate(owner.GuestToken) != nil {
+		owner.GuestToken = ""
+	}
+	return owner, nil
+}
+
+// resolveOrIssueOwner is resolveOwner for calls that create a cart: an
+// anonymous caller without a cart cookie gets a fresh guest id.
+func (s *CartGRPCServer) resolveOrIssueOwner(ctx context.Context) (domain.CartOwner, error) {
+	owner, err := s.resolveOwner(ctx)
+	if err != nil || owner.UserID != "" || owner.GuestToken != "" {
+		return owner, err
+	}
+	owner.GuestToken = uuid.NewString()
+	if err := s.setGuestCookie(ctx, owner.GuestToken, guestCookieMaxAge); err != nil {
+		return owner, err
+	}
+	return owner, nil
+}
+
+func (s *CartGRPCServer) setGuestCookie(ctx context.Context, value string, maxAge int) error {
+	cookie := fmt.Sprintf("%s=%s; Path=/; HttpOnly; SameSite=Lax; Max-Age=%d", guestCookieName, value, maxAge)
+	if s.cookieSecure {
+		cookie += "; Secure"
+	}
+	header := metadata.Pairs("Set-Cookie", cookie, "x-cart-id", value)
+	if err := grpc.SendHeader(ctx, header); err != nil {
+		return status.Error(codes.Internal, "failed to send response headers")
+	}
+	return nil
+}
+
+func extractCookie(cookieStr, name string) string {
+	for _, part := range strings.Split(cookieStr, ";") {
+		part = strings.TrimSpace(part)
+		if strings.HasPrefix(part, name+"=") {
+			return strings.TrimPrefix(part, name+"=")
+		}
+	}
+	return ""
+}
+
+func toProtoCart(summary *domain.CartSummary) *cartv1.Cart {
+	out := &cartv1.Cart{
+		Id:            summary.Cart.ID,
+		Lines:         make([]*cartv1.CartLine, 0, len(summary.Lines)),
+		ItemsCount:    summary.ItemsCount,
+		SubtotalCents: summary.SubtotalCents,
+		Valid:         summary.Valid,
+	}
+	if !summary.Cart.UpdatedAt.IsZero() {
+		out.UpdatedAt = summary.Cart.UpdatedAt.UTC().Format(time.RFC3339)
+	}
+	for _, line := range summary.Lines {
+		out.Lines = append(out.Lines, &cartv1.CartLine{
+			ProductId:       line.Item.ProductID,
+			Name:            line.Name,
+			Sku:             line.SKU,
+			Quantity:        line.Item.Quantity,
+			AddedPriceCents: line.Item.PriceCents,
+			PriceCents:      line.PriceCents,
+			LineTotalCents:  line.LineTotalCents,
+			AvailableStock:  line.AvailableStock,
+			Issues:          line.Issues,
+		})
+	}
+	return out
+}
+
+func mapServiceError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, jwt.ErrUnauthorized):
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	case errors.Is(err, jwt.ErrForbidden):
+		return status.Error(codes.PermissionDenied, "forbidden")
+	case errors.Is(err, domain.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrCartNotFound),
+		errors.Is(err, domain.ErrCartItemNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrProductUnavailable),
+		errors.Is(err, domain.ErrInsufficientStock):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
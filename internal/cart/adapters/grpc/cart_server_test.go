@@ -0,0 +1,186 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/cart/domain"
+	cartv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/cart/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	guestID      = "0b6f3f4e-7d8a-4c5b-9e1f-2a3b4c5d6e7f"
+	otherGuestID = "5f0e1d2c-3b4a-4958-8776-655443322110"
+)
+
+// callContext builds the context of an incoming call. A non-nil principal
+// stands for a token the interceptor accepted; a bearer token without one
+// is a token it could not verify.
+func callContext(principal *authz.Principal, pairs ...string) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(pairs...))
+	if principal != nil {
+		ctx = authz.NewContext(ctx, principal)
+	}
+	return ctx
+}
+
+func TestResolveOwner(t *testing.T) {
+	user := &authz.Principal{UserID: "user-1", Role: "user"}
+	apiClient := &authz.Principal{UserID: "client-1", Role: authz.RoleAPIClient}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		want    domain.CartOwner
+		wantErr error
+	}{
+		{
+			name: "anonymous without cart",
+			ctx:  callContext(nil),
+		},
+		{
+			name: "guest by header",
+			ctx:  callContext(nil, "x-cart-id", " "+guestID+" "),
+			want: domain.CartOwner{GuestToken: guestID},
+		},
+		{
+			name: "cookie wins over header",
+			ctx: callContext(nil,
+				"x-cart-id", otherGuestID,
+				"grpcgateway-cookie", "theme=dark; cart_id="+guestID),
+			want: domain.CartOwner{GuestToken: guestID},
+		},
+		{
+			name: "malformed guest id ignored",
+			ctx:  callContext(nil, "x-cart-id", "../../etc/passwd"),
+		},
+		{
+			name: "user keeps the guest cart for merging",
+			ctx:  callContext(user, "authorization", "Bearer valid", "x-cart-id", guestID),
+			want: domain.CartOwner{UserID: "user-1", GuestToken: guestID},
+		},
+		{
+			name:    "rejected token does not fall back to the guest cart",
+			ctx:     callContext(nil, "authorization", "Bearer expired", "x-cart-id", guestID),
+			wantErr: jwt.ErrUnauthorized,
+		},
+		{
+			name:    "API clients have no cart",
+			ctx:     callContext(apiClient, "authorization", "Bearer client", "x-cart-id", guestID),
+			wantErr: jwt.ErrForbidden,
+		},
+	}
+	server := NewCartGRPCServer(nil, false)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			owner, err := server.resolveOwner(tt.ctx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if err == nil && owner != tt.want {
+				t.Fatalf("expected %+v, got %+v", tt.want, owner)
+			}
+		})
+	}
+}
+
+// fakeCartService implements services.CartService for the handlers under
+// test; only Merge is expected to be called.
+type fakeCartService struct {
+	mergedUserID, mergedGuestToken string
+}
+
+func (f *fakeCartService) GetCart(_ context.Context, _ domain.CartOwner) (*domain.CartSummary, error) {
+	return nil, errors.New("GetCart is not expected")
+}
+
+func (f *fakeCartService) AddItem(_ context.Context, _ domain.CartOwner, _ string, _ int32) (*domain.CartSummary, error) {
+	return nil, errors.New("AddItem is not expected")
+}
+
+func (f *fakeCartService) UpdateItem(_ context.Context, _ domain.CartOwner, _ string, _ int32) (*domain.CartSummary, error) {
+	return nil, errors.New("UpdateItem is not expected")
+}
+
+func (f *fakeCartService) RemoveItem(_ context.Context, _ domain.CartOwner, _ string) (*domain.CartSummary, error) {
+	return nil, errors.New("RemoveItem is not expected")
+}
+
+func (f *fakeCartService) Clear(_ context.Context, _ domain.CartOwner) error {
+	return errors.New("Clear is not expected")
+}
+
+func (f *fakeCartService) Merge(_ context.Context, userID, guestToken string) (*domain.CartSummary, error) {
+	f.mergedUserID, f.mergedGuestToken = userID, guestToken
+	return &domain.CartSummary{Cart: domain.Cart{ID: "user-cart"}, Valid: true}, nil
+}
+
+// headerStream captures the headers a handler sends.
+type headerStream struct {
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string {
+	return cartv1.CartService_MergeCart_FullMethodName
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *headerStream) SetTrailer(_ metadata.MD) error {
+	return nil
+}
+
+func TestMergeCartClearsGuestCookie(t *testing.T) {
+	carts := &fakeCartService{}
+	server := NewCartGRPCServer(carts, true)
+	stream := &headerStream{}
+	ctx := grpc.NewContextWithServerTransportStream(
+		callContext(&authz.Principal{UserID: "user-1", Role: "user"},
+			"authorization", "Bearer valid",
+			"grpcgateway-cookie", "cart_id="+guestID),
+		stream,
+	)
+
+	resp, err := server.MergeCart(ctx, &cartv1.MergeCartRequest{})
+	if err != nil {
+		t.Fatalf("MergeCart returned error: %v", err)
+	}
+	if resp.Cart.Id != "user-cart" {
+		t.Fatalf("expected the merged user cart, got %+v", resp.Cart)
+	}
+	if carts.mergedUserID != "user-1" || carts.mergedGuestToken != guestID {
+		t.Fatalf("expected the guest cart to be merged into user-1, got %q/%q", carts.mergedUserID, carts.mergedGuestToken)
+	}
+	cookies := stream.header.Get("Set-Cookie")
+	if len(cookies) != 1 || !strings.HasPrefix(cookies[0], "cart_id=;") || !strings.Contains(cookies[0], "Max-Age=-1") {
+		t.Fatalf("expected the cart cookie to be cleared, got %v", cookies)
+	}
+}
+
+func TestMergeCartRequiresUser(t *testing.T) {
+	carts := &fakeCartService{}
+	server := NewCartGRPCServer(carts, false)
+
+	_, err := server.MergeCart(callContext(nil, "x-cart-id", guestID), &cartv1.MergeCartRequest{})
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated, got %v", err)
+	}
+	if carts.mergedGuestToken != "" {
+		t.Fatal("expected nothing to be merged")
+	}
+}
@@ -0,0 +1,281 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/cart/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/cart/infrastructure/database/postgres"
+	catalogdomain "github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+	catalogdb "github.com/KarpovYuri/caraudio-backend/internal/catalog/infrastructure/database/postgres"
+)
+
+type CartService interface {
+	GetCart(ctx context.Context, owner domain.CartOwner) (*domain.CartSummary, error)
+	AddItem(ctx context.Context, owner domain.CartOwner, productID string, quantity int32) (*domain.CartSummary, error)
+	UpdateItem(ctx context.Context, owner domain.CartOwner, productID string, quantity int32) (*domain.CartSummary, error)
+	RemoveItem(ctx context.Context, owner domain.CartOwner, productID string) (*domain.CartSummary, error)
+	Clear(ctx context.Context, owner domain.CartOwner) error
+	Merge(ctx context.Context, userID, guestToken string) (*domain.CartSummary, error)
+}
+
+type cartService struct {
+	carts    postgres.CartRepository
+	products catalogdb.ProductRepository
+}
+
+func NewCartService(carts postgres.CartRepository, products catalogdb.ProductRepository) CartService {
+	return &cartService{carts: carts, products: products}
+}
+
+func (s *cartService) GetCart(ctx context.Context, owner domain.CartOwner) (*domain.CartSummary, error) {
+	cart, err := s.findCart(ctx, owner)
+	if err != nil {
+		if errors.Is(err, domain.ErrCartNotFound) {
+			return &domain.CartSummary{Valid: true}, nil
+		}
+		return nil, err
+	}
+	return s.summarize(ctx, cart)
+}
+
+func (s *cartService) AddItem(
+	ctx context.Context,
+	owner domain.CartOwner,
+	productID string,
+	quantity int32,
+) (*domain.CartSummary, error) {
+	if quantity <= 0 {
+		return nil, domain.ErrInvalidArgument
+	}
+	cart, err := s.findOrCreateCart(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.carts.GetItem(ctx, cart.ID, productID)
+	if err != nil && !errors.Is(err, domain.ErrCartItemNotFound) {
+		return nil, err
+	}
+	if existing != nil {
+		quantity += existing.Quantity
+	}
+	if err := s.saveItem(ctx, cart.ID, productID, quantity, existing); err != nil {
+		return nil, err
+	}
+	return s.summarize(ctx, cart)
+}
+
+func (s *cartService) UpdateItem(
+	ctx context.Context,
+	owner domain.CartOwner,
+	productID string,
+	quantity int32,
+) (*domain.CartSummary, error) {
+	if quantity == 0 {
+		return s.RemoveItem(ctx, owner, productID)
+	}
+	if quantity < 0 {
+		return nil, domain.ErrInvalidArgument
+	}
+	cart, err := s.findCart(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := s.carts.GetItem(ctx, cart.ID, productID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.saveItem(ctx, cart.ID, productID, quantity, existing); err != nil {
+		return nil, err
+	}
+	return s.summarize(ctx, cart)
+}
+
+func (s *cartService) RemoveItem(
+	ctx context.Context,
+	owner domain.CartOwner,
+	productID string,
+) (*domain.CartSummary, error) {
+	cart, err := s.findCart(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.carts.DeleteItem(ctx, cart.ID, productID); err != nil {
+		return nil, err
+	}
+	return s.summarize(ctx, cart)
+}
+
+func (s *cartService) Clear(ctx context.Context, owner domain.CartOwner) error {
+	cart, err := s.findCart(ctx, owner)
+	if err != nil {
+		if errors.Is(err, domain.ErrCartNotFound) {
+			return nil
+		}
+		return err
+	}
+	return s.carts.ClearItems(ctx, cart.ID)
+}
+
+func (s *cartService) Merge(ctx context.Context, userID, guestToken string) (*domain.CartSummary, error) {
+	if userID == "" {
+		return nil, domain.ErrInvalidArgument
+	}
+	userCart, err := s.findOrCreateCart(ctx, domain.CartOwner{UserID: userID})
+	if err != nil {
+		return nil, err
+	}
+	if guestToken != "" {
+		guestCart, err := s.carts.GetByGuestToken(ctx, guestToken)
+		switch {
+		case errors.Is(err, domain.ErrCartNotFound):
+		case err != nil:
+			return nil, err
+		default:
+			if err := s.carts.Merge(ctx, guestCart.ID, userCart.ID, domain.MaxLineQuantity); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s.summarize(ctx, userCart)
+}
+
+// saveItem checks the product against the catalog and stores the line with
+// the current price, which the customer has now seen.
+func (s *cartService) saveItem(
+	ctx context.Context,
+	cartID, productID string,
+	quantity int32,
+	existing *domain.CartItem,
+) error {
+	if quantity > domain.MaxLineQuantity {
+		return domain.ErrInvalidArgument
+	}
+	product, err := s.products.GetByID(ctx, productID)
+	if err != nil {
+		if errors.Is(err, catalogdomain.ErrProductNotFound) {
+			return domain.ErrProductUnavailable
+		}
+		return err
+	}
+	if !product.IsActive {
+		return domain.ErrProductUnavailable
+	}
+	if quantity > product.Stock {
+		return domain.ErrInsufficientStock
+	}
+
+	now := time.Now()
+	item := &domain.CartItem{
+		CartID:     cartID,
+		ProductID:  productID,
+		Quantity:   quantity,
+		PriceCents: product.PriceCents,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if existing != nil {
+		item.CreatedAt = existing.CreatedAt
+	}
+	return s.carts.UpsertItem(ctx, item)
+}
+
+func (s *cartService) findCart(ctx context.Context, owner domain.CartOwner) (*domain.Cart, error) {
+	switch {
+	case owner.UserID != "":
+		return s.carts.GetByUserID(ctx, owner.UserID)
+	case owner.GuestToken != "":
+		return s.carts.GetByGuestToken(ctx, owner.GuestToken)
+	default:
+		return nil, domain.ErrCartNotFound
+	}
+}
+
+func (s *cartService) findOrCreateCart(ctx context.Context, owner domain.CartOwner) (*domain.Cart, error) {
+	owner.GuestToken = strings.TrimSpace(owner.GuestToken)
+	if owner.UserID == "" && owner.GuestToken == "" {
+		return nil, domain.ErrInvalidArgument
+	}
+	cart, err := s.findCart(ctx, owner)
+	if err == nil || !errors.Is(err, domain.ErrCartNotFound) {
+		return cart, err
+	}
+
+	now := time.Now()
+	cart = &domain.Cart{ID: uuid.NewString(), CreatedAt: now, UpdatedAt: now}
+	if owner.UserID != "" {
+		cart.UserID = &owner.UserID
+	} else {
+		cart.GuestToken = &owner.GuestToken
+	}
+	if err := s.carts.Create(ctx, cart); err != nil {
+		// Another request created the cart first.
+		if existing, findErr := s.findCart(ctx, owner); findErr == nil {
+			return existing, nil
+		}
+		return nil, err
+	}
+	return cart, nil
+}
+
+func (s *cartService) summarize(ctx context.Context, cart *domain.Cart) (*domain.CartSummary, error) {
+	items, err := s.carts.ListItems(ctx, cart.ID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ProductID)
+	}
+	products, err := s.products.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]catalogdomain.Product, len(products))
+	for _, product := range products {
+		byID[product.ID] = product
+	}
+
+	summary := &domain.CartSummary{
+		Cart:  *cart,
+		Lines: make([]domain.CartLine, 0, len(items)),
+		Valid: true,
+	}
+	for _, item := range items {
+		line := domain.CartLine{Item: item, PriceCents: item.PriceCents}
+		product, ok := byID[item.ProductID]
+		if ok {
+			line.Name = product.Name
+			if product.SKU != nil {
+				line.SKU = *product.SKU
+			}
+		}
+		if !ok || !product.IsActive {
+			line.Issues = append(line.Issues, domain.IssueUnavailable)
+			summary.Valid = false
+			summary.Lines = append(summary.Lines, line)
+			continue
+		}
+
+		line.PriceCents = product.PriceCents
+		line.AvailableStock = product.Stock
+		// Price changes are shown to the customer but do not block checkout.
+		if product.PriceCents != item.PriceCents {
+			line.Issues = append(line.Issues, domain.IssuePriceChanged)
+		}
+		if item.Quantity > product.Stock {
+			line.Issues = append(line.Issues, domain.IssueInsufficientStock)
+			summary.Valid = false
+		}
+		line.LineTotalCents = line.PriceCents * int64(item.Quantity)
+		summary.ItemsCount += item.Quantity
+		summary.SubtotalCents += line.LineTotalCents
+		summary.Lines = append(summary.Lines, line)
+	}
+	return summary, nil
+}
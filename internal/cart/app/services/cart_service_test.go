@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/cart/domain"
+	catalogdomain "github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+// memCarts keeps carts in memory; Merge follows the SQL of the postgres
+// repository: quantities are summed up to the limit and the source cart is
+// deleted.
+type memCarts struct {
+	mu     sync.Mutex
+	carts  map[string]domain.Cart
+	items  map[string]map[string]domain.CartItem
+	merges [][2]string
+}
+
+func newMemCarts() *memCarts {
+	return &memCarts{carts: map[string]domain.Cart{}, items: map[string]map[string]domain.CartItem{}}
+}
+
+func (m *memCarts) add(cart domain.Cart, items ...domain.CartItem) {
+	m.carts[cart.ID] = cart
+	m.items[cart.ID] = map[string]domain.CartItem{}
+	for _, item := range items {
+		item.CartID = cart.ID
+		m.items[cart.ID][item.ProductID] = item
+	}
+}
+
+func (m *memCarts) Create(_ context.Context, cart *domain.Cart) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.add(*cart)
+	return nil
+}
+
+func (m *memCarts) GetByUserID(_ context.Context, userID string) (*domain.Cart, error) {
+	return m.find(func(cart domain.Cart) bool { return cart.UserID != nil && *cart.UserID == userID })
+}
+
+func (m *memCarts) GetByGuestToken(_ context.Context, guestToken string) (*domain.Cart, error) {
+	return m.find(func(cart domain.Cart) bool { return cart.GuestToken != nil && *cart.GuestToken == guestToken })
+}
+
+func (m *memCarts) find(match func(domain.Cart) bool) (*domain.Cart, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, cart := range m.carts {
+		if match(cart) {
+			return &cart, nil
+		}
+	}
+	return nil, domain.ErrCartNotFound
+}
+
+func (m *memCarts) Delete(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.carts, id)
+	delete(m.items, id)
+	return nil
+}
+
+func (m *memCarts) ListItems(_ context.Context, cartID string) ([]domain.CartItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	items := make([]domain.CartItem, 0, len(m.items[cartID]))
+	for _, item := range m.items[cartID] {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ProductID < items[j].ProductID })
+	return items, nil
+}
+
+func (m *memCarts) GetItem(_ context.Context, cartID, productID string) (*domain.CartItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	item, ok := m.items[cartID][productID]
+	if !ok {
+		return nil, domain.ErrCartItemNotFound
+	}
+	return &item, nil
+}
+
+func (m *memCarts) UpsertItem(_ context.Context, item *domain.CartItem) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.items[item.CartID][item.ProductID] = *item
+	return nil
+}
+
+func (m *memCarts) DeleteItem(_ context.Context, cartID, productID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.items[cartID][productID]; !ok {
+		return domain.ErrCartItemNotFound
+	}
+	delete(m.items[cartID], productID)
+	return nil
+}
+
+func (m *memCarts) ClearItems(_ context.Context, cartID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.items[cartID] = map[string]domain.CartItem{}
+	return nil
+}
+
+func (m *memCarts) Merge(_ context.Context, fromCartID, toCartID string, maxQuantity int32) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.merges = append(m.merges, [2]string{fromCartID, toCartID})
+	for productID, item := range m.items[fromCartID] {
+		if existing, ok := m.items[toCartID][productID]; ok {
+			item.Quantity = min(existing.Quantity+item.Quantity, maxQuantity)
+			item.PriceCents = existing.PriceCents
+		}
+		item.CartID = toCartID
+		m.items[toCartID][productID] = item
+	}
+	delete(m.carts, fromCartID)
+	delete(m.items, fromCartID)
+	return nil
+}
+
+// fakeProducts serves catalog products by id.
+type fakeProducts struct {
+	products map[string]catalogdomain.Product
+}
+
+func (f *fakeProducts) Create(_ context.Context, _ *catalogdomain.Product) error {
+	return errors.New("Create is not supported")
+}
+
+func (f *fakeProducts) GetByID(_ context.Context, id string) (*catalogdomain.Product, error) {
+	product, ok := f.products[id]
+	if !ok {
+		return nil, catalogdomain.ErrProductNotFound
+	}
+	return &product, nil
+}
+
+func (f *fakeProducts) ListByIDs(_ context.Context, ids []string) ([]catalogdomain.Product, error) {
+	var products []catalogdomain.Product
+	for _, id := range ids {
+		if product, ok := f.products[id]; ok {
+			products = append(products, product)
+		}
+	}
+	return products, nil
+}
+
+func (f *fakeProducts) List(_ context.Context, _ catalogdomain.ProductListFilter) (*catalogdomain.ProductListResult, error) {
+	return &catalogdomain.ProductListResult{}, nil
+}
+
+func (f *fakeProducts) Update(_ context.Context, _ *catalogdomain.Product) error {
+	return errors.New("Update is not supported")
+}
+
+func (f *fakeProducts) UpdatePrice(_ context.Context, _ string, _ int64, _ time.Time) error {
+	return errors.New("UpdatePrice is not supported")
+}
+
+func (f *fakeProducts) Delete(_ context.Context, _ string) error {
+	return errors.New("Delete is not supported")
+}
+
+func (f *fakeProducts) CountBySupplier(_ context.Context, _ int64) (int64, error) {
+	return 0, nil
+}
+
+func (f *fakeProducts) CountByBrand(_ context.Context, _ string) (int64, error) {
+	return 0, nil
+}
+
+func (f *fakeProducts) ReserveStock(_ context.Context, _ []catalogdomain.StockLine) error {
+	return errors.New("ReserveStock is not supported")
+}
+
+func (f *fakeProducts) CommitStock(_ context.Context, _, _ []catalogdomain.StockLine) error {
+	return errors.New("CommitStock is not supported")
+}
+
+func (f *fakeProducts) ReleaseStock(_ context.Context, _ []catalogdomain.StockLine) error {
+	return errors.New("ReleaseStock is not supported")
+}
+
+func newTestCartService() (CartService, *memCarts) {
+	carts := newMemCarts()
+	products := &fakeProducts{products: map[string]catalogdomain.Product{
+		"amp":   {ID: "amp", Name: "Amplifier", PriceCents: 10000, Stock: 5000, IsActive: true},
+		"cable": {ID: "cable", Name: "Cable", PriceCents: 500, Stock: 5000, IsActive: true},
+	}}
+	return NewCartService(carts, products), carts
+}
+
+func TestCartServiceMergeMovesGuestLinesIntoUserCart(t *testing.T) {
+	svc, carts := newTestCartService()
+	userID, guestToken := "user-1", "guest-1"
+	carts.add(domain.Cart{ID: "user-cart", UserID: &userID},
+		domain.CartItem{ProductID: "amp", Quantity: 990, PriceCents: 10000},
+	)
+	carts.add(domain.Cart{ID: "guest-cart", GuestToken: &guestToken},
+		domain.CartItem{ProductID: "amp", Quantity: 20, PriceCents: 9000},
+		domain.CartItem{ProductID: "cable", Quantity: 2, PriceCents: 500},
+	)
+
+	summary, err := svc.Merge(context.Background(), userID, guestToken)
+	if err != nil {
+		t.Fatalf("Merge returned error: %v", err)
+	}
+	if summary.Cart.ID != "user-cart" {
+		t.Fatalf("expected the user cart, got %s", summary.Cart.ID)
+	}
+	if len(carts.merges) != 1 || carts.merges[0] != [2]string{"guest-cart", "user-cart"} {
+		t.Fatalf("expected the guest cart to be merged into the user cart, got %v", carts.merges)
+	}
+	if _, err := carts.GetByGuestToken(context.Background(), guestToken); !errors.Is(err, domain.ErrCartNotFound) {
+		t.Fatalf("expected the guest cart to be gone, got %v", err)
+	}
+	if summary.ItemsCount != domain.MaxLineQuantity+2 || !summary.Valid {
+		t.Fatalf("expected the amplifier line capped at %d plus two cables, got %+v", domain.MaxLineQuantity, summary)
+	}
+}
+
+func TestCartServiceMergeWithoutGuestCart(t *testing.T) {
+	for _, guestToken := range []string{"", "unknown-guest"} {
+		svc, carts := newTestCartService()
+
+		summary, err := svc.Merge(context.Background(), "user-1", guestToken)
+		if err != nil {
+			t.Fatalf("%q: Merge returned error: %v", guestToken, err)
+		}
+		if len(carts.merges) != 0 {
+			t.Fatalf("%q: expected nothing to be merged, got %v", guestToken, carts.merges)
+		}
+		if summary.Cart.UserID == nil || *summary.Cart.UserID != "user-1" || len(summary.Lines) != 0 {
+			t.Fatalf("%q: expected an empty cart for the user, got %+v", guestToken, summary)
+		}
+	}
+}
+
+func TestCartServiceMergeRequiresUser(t *testing.T) {
+	svc, carts := newTestCartService()
+	guestToken := "guest-1"
+	carts.add(domain.Cart{ID: "guest-cart", GuestToken: &guestToken})
+
+	if _, err := svc.Merge(context.Background(), "", guestToken); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument, got %v", err)
+	}
+	if len(carts.carts) != 1 || len(carts.merges) != 0 {
+		t.Fatal("expected the guest cart to be left alone")
+	}
+}
+
+func TestCartServiceFindsCartByOwner(t *testing.T) {
+	svc, carts := newTestCartService()
+	userID, guestToken := "user-1", "guest-1"
+	carts.add(domain.Cart{ID: "user-cart", UserID: &userID}, domain.CartItem{ProductID: "amp", Quantity: 1, PriceCents: 10000})
+	carts.add(domain.Cart{ID: "guest-cart", GuestToken: &guestToken}, domain.CartItem{ProductID: "cable", Quantity: 3, PriceCents: 500})
+
+	tests := []struct {
+		name   string
+		owner  domain.CartOwner
+		wantID string
+	}{
+		{"user", domain.CartOwner{UserID: userID}, "user-cart"},
+		{"guest", domain.CartOwner{GuestToken: guestToken}, "guest-cart"},
+		// Until MergeCart is called, a signed-in caller sees the user cart.
+		{"user with guest cookie", domain.CartOwner{UserID: userID, GuestToken: guestToken}, "user-cart"},
+		{"nobody", domain.CartOwner{}, ""},
+		{"unknown guest", domain.CartOwner{GuestToken: "guest-2"}, ""},
+	}
+	for _, tt := range tests {
+		summary, err := svc.GetCart(context.Background(), tt.owner)
+		if err != nil {
+			t.Fatalf("%s: GetCart returned error: %v", tt.name, err)
+		}
+		if summary.Cart.ID != tt.wantID {
+			t.Fatalf("%s: expected cart %q, got %q", tt.name, tt.wantID, summary.Cart.ID)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+const MaxLineQuantity = 999
+
+const (
+	IssuePriceChanged      = "price_changed"
+	IssueInsufficientStock = "insufficient_stock"
+	IssueUnavailable       = "unavailable"
+)
+
+type Cart struct {
+	ID         string    `db:"id"`
+	UserID     *string   `db:"user_id"`
+	GuestToken *string   `db:"guest_token"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+// CartItem keeps the price the customer saw when adding the product so that
+// later price changes can be pointed out instead of silently applied.
+type CartItem struct {
+	CartID     string    `db:"cart_id"`
+	ProductID  string    `db:"product_id"`
+	Quantity   int32     `db:"quantity"`
+	PriceCents int64     `db:"price_cents"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+// CartOwner identifies a cart: by user id when the caller is authenticated,
+// otherwise by the guest token from the cart cookie.
+type CartOwner struct {
+	UserID     string
+	GuestToken string
+}
+
+type CartLine struct {
+	Item           CartItem
+	Name           string
+	SKU            string
+	PriceCents     int64
+	LineTotalCents int64
+	AvailableStock int32
+	Issues         []string
+}
+
+type CartSummary struct {
+	Cart          Cart
+	Lines         []CartLine
+	ItemsCount    int32
+	SubtotalCents int64
+	Valid         bool
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrCartNotFound       = errors.New("cart not found")
+	ErrCartItemNotFound   = errors.New("cart item not found")
+	ErrProductUnavailable = errors.New("product unavailable")
+	ErrInsufficientStock  = errors.New("insufficient stock")
+)
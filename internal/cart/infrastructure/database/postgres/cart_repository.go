@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/cart/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type CartRepository interface {
+	Create(ctx context.Context, cart *domain.Cart) error
+	GetByUserID(ctx context.Context, userID string) (*domain.Cart, error)
+	GetByGuestToken(ctx context.Context, guestToken string) (*domain.Cart, error)
+	Delete(ctx context.Context, id string) error
+	ListItems(ctx context.Context, cartID string) ([]domain.CartItem, error)
+	GetItem(ctx context.Context, cartID, productID string) (*domain.CartItem, error)
+	UpsertItem(ctx context.Context, item *domain.CartItem) error
+	DeleteItem(ctx context.Context, cartID, productID string) error
+	ClearItems(ctx context.Context, cartID string) error
+	Merge(ctx context.Context, fromCartID, toCartID string, maxQuantity int32) error
+}
+
+type postgresCartRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresCartRepository(db *sqlx.DB) CartRepository {
+	return &postgresCartRepository{db: db}
+}
+
+func (r *postgresCartRepository) Create(ctx context.Context, cart *domain.Cart) error {
+	_, err := r.db.NamedExecContext(ctx,
+		`INSERT INTO carts (id, user_id, guest_token, created_at, updated_at)
+         VALUES (:id, :user_id, :guest_token, :created_at, :updated_at)`, cart)
+	if err != nil {
+		return fmt.Errorf("failed to create cart: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresCartRepository) GetByUserID(ctx context.Context, userID string) (*domain.Cart, error) {
+	return r.get(ctx, `WHERE user_id = $1`, userID)
+}
+
+func (r *postgresCartRepository) GetByGuestToken(ctx context.Context, guestToken string) (*domain.Cart, error) {
+	return r.get(ctx, `WHERE guest_token = $1`, guestToken)
+}
+
+func (r *postgresCartRepository) get(ctx context.Context, where string, arg string) (*domain.Cart, error) {
+	var cart domain.Cart
+	err := r.db.GetContext(ctx, &cart, cartSelectSQL+` `+where, arg)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCartNotFound
+		}
+		return nil, fmt.Errorf("failed to get cart: %w", err)
+	}
+	return &cart, nil
+}
+
+func (r *postgresCartRepository) Delete(ctx context.Context, id string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM carts WHERE id = $1`, id); err != nil {
+		return fmt.Errorf("failed to delete cart: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresCartRepository) ListItems(ctx context.Context, cartID string) ([]domain.CartItem, error) {
+	var items []domain.CartItem
+	err := r.db.SelectContext(ctx, &items,
+		cartItemSelectSQL+` WHERE cart_id = $1 ORDER BY created_at ASC, product_id ASC`, cartID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list cart items: %w", err)
+	}
+	return items, nil
+}
+
+func (r *postgresCartRepository) GetItem(ctx context.Context, cartID, productID string) (*domain.CartItem, error) {
+	var item domain.CartItem
+	err := r.db.GetContext(ctx, &item,
+		cartItemSelectSQL+` WHERE cart_id = $1 AND product_id = $2`, cartID, productID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrCartItemNotFound
+		}
+		return nil, fmt.Errorf("failed to get cart item: %w", err)
+	}
+	return &item, nil
+}
+
+func (r *postgresCartRepository) UpsertItem(ctx context.Context, item *domain.CartItem) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.NamedExecContext(ctx,
+		`INSERT INTO cart_items (cart_id, product_id, quantity, price_cents, created_at, updated_at)
+         VALUES (:cart_id, :product_id, :quantity, :price_cents, :created_at, :updated_at)
+         ON CONFLICT (cart_id, product_id) DO UPDATE SET
+           quantity = EXCLUDED.quantity,
+           price_cents = EXCLUDED.price_cents,
+           updated_at = EXCLUDED.updated_at`, item)
+	if err != nil {
+		return fmt.Errorf("failed to save cart item: %w", err)
+	}
+	if err = touchCart(ctx, tx, item.CartID, item.UpdatedAt); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit cart transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func (r *postgresCartRepository) DeleteItem(ctx context.Context, cartID, productID string) error {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM cart_items WHERE cart_id = $1 AND product_id = $2`, cartID, productID)
+	if err != nil {
+		return fmt.Errorf("failed to delete cart item: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrCartItemNotFound
+	}
+	return nil
+}
+
+func (r *postgresCartRepository) ClearItems(ctx context.Context, cartID string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM cart_items WHERE cart_id = $1`, cartID); err != nil {
+		return fmt.Errorf("failed to clear cart: %w", err)
+	}
+	return nil
+}
+
+// Merge moves every line of fromCartID into toCartID, summing quantities of
+// products present in both, and deletes the source cart.
+func (r *postgresCartRepository) Merge(ctx context.Context, fromCartID, toCartID string, maxQuantity int32) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.ExecContext(ctx,
+		`INSERT INTO cart_items (cart_id, product_id, quantity, price_cents, created_at, updated_at)
+         SELECT $2, product_id, quantity, price_cents, created_at, updated_at
+         FROM cart_items WHERE cart_id = $1
+         ON CONFLICT (cart_id, product_id) DO UPDATE SET
+           quantity = LEAST(cart_items.quantity + EXCLUDED.quantity, $3),
+           updated_at = GREATEST(cart_items.updated_at, EXCLUDED.updated_at)`,
+		fromCartID, toCartID, maxQuantity)
+	if err != nil {
+		return fmt.Errorf("failed to merge cart items: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, `DELETE FROM carts WHERE id = $1`, fromCartID); err != nil {
+		return fmt.Errorf("failed to delete merged cart: %w", err)
+	}
+	if err = touchCart(ctx, tx, toCartID, time.Now()); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit cart transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func touchCart(ctx context.Context, tx *sqlx.Tx, cartID string, at time.Time) error {
+	if _, err := tx.ExecContext(ctx, `UPDATE carts SET updated_at = $2 WHERE id = $1`, cartID, at); err != nil {
+		return fmt.Errorf("failed to update cart: %w", err)
+	}
+	return nil
+}
+
+const cartSelectSQL = `SELECT id, user_id, guest_token, created_at, updated_at FROM carts`
+
+const cartItemSelectSQL = `SELECT cart_id, product_id, quantity, price_cents, created_at, updated_at FROM cart_items`
@@ -1,33 +1,94 @@
 package grpc
 
 import (
-	"context"
-	"strings"
-
+	catalogv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/catalog/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
 	"github.com/KarpovYuri/caraudio-backend/pkg/jwt"
-	"google.golang.org/grpc/metadata"
 )
 
-func extractBearerToken(ctx context.Context) string {
-	md, ok := metadata.FromIncomingContext(ctx)
-	if !ok {
-		return ""
-	}
-	values := md.Get("authorization")
-	if len(values) == 0 {
-		return ""
-	}
-	value := strings.TrimSpace(values[0])
-	if len(value) > 7 && strings.EqualFold(value[:7], "bearer ") {
-		return strings.TrimSpace(value[7:])
-	}
-	return value
-}
+// Policy declares who may call each catalog RPC; cmd/catalog-service
+// enforces it with authz.UnaryServerInterceptor.
+var Policy = authz.Policy{
+	catalogv1.CatalogService_ListSuppliers_FullMethodName:  authz.Public,
+	catalogv1.CatalogService_GetSupplier_FullMethodName:    authz.Permission(jwt.PermCatalogSuppliersWrite),
+	catalogv1.CatalogService_CreateSupplier_FullMethodName: authz.Permission(jwt.PermCatalogSuppliersWrite),
+	catalogv1.CatalogService_UpdateSupplier_FullMethodName: authz.Permission(jwt.PermCatalogSuppliersWrite),
+	catalogv1.CatalogService_DeleteSupplier_FullMethodName: authz.Permission(jwt.PermCatalogSuppliersWrite),
 
-func requireAdmin(ctx context.Context, jwtSecret string) error {
-	return jwt.ValidateAdmin(extractBearerToken(ctx), jwtSecret)
-}
+	catalogv1.CatalogService_ListSupplierCategoryMappings_FullMethodName:  authz.Permission(jwt.PermCatalogSuppliersWrite),
+	catalogv1.CatalogService_GetSupplierCategoryMapping_FullMethodName:    authz.Permission(jwt.PermCatalogSuppliersWrite),
+	catalogv1.CatalogService_CreateSupplierCategoryMapping_FullMethodName: authz.Permission(jwt.PermCatalogSuppliersWrite),
+	catalogv1.CatalogService_UpdateSupplierCategoryMapping_FullMethodName: authz.Permission(jwt.PermCatalogSuppliersWrite),
+	catalogv1.CatalogService_DeleteSupplierCategoryMapping_FullMethodName: authz.Permission(jwt.PermCatalogSuppliersWrite),
+	catalogv1.CatalogService_ListSupplierProductMappings_FullMethodName:   authz.Permission(jwt.PermCatalogSuppliersWrite),
+	catalogv1.CatalogService_GetSupplierProductMapping_FullMethodName:     authz.Permission(jwt.PermCatalogSuppliersWrite),
+	catalogv1.CatalogService_CreateSupplierProductMapping_FullMethodName:  authz.Permission(jwt.PermCatalogSuppliersWrite),
+	catalogv1.CatalogService_UpdateSupplierProductMapping_FullMethodName:  authz.Permission(jwt.PermCatalogSuppliersWrite),
+	catalogv1.CatalogService_DeleteSupplierProductMapping_FullMethodName:  authz.Permission(jwt.PermCatalogSuppliersWrite),
+
+	catalogv1.CatalogService_ListCategories_FullMethodName:         authz.Public,
+	catalogv1.CatalogService_GetCategory_FullMethodName:            authz.Public,
+	catalogv1.CatalogService_CreateCategory_FullMethodName:         authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_UpdateCategory_FullMethodName:         authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_DeleteCategory_FullMethodName:         authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_ListProducts_FullMethodName:           authz.Public,
+	catalogv1.CatalogService_GetProduct_FullMethodName:             authz.Public,
+	catalogv1.CatalogService_CreateProduct_FullMethodName:          authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_UpdateProduct_FullMethodName:          authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_DeleteProduct_FullMethodName:          authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_ListProductImages_FullMethodName:      authz.Public,
+	catalogv1.CatalogService_GetProductImage_FullMethodName:        authz.Public,
+	catalogv1.CatalogService_CreateProductImage_FullMethodName:     authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_UpdateProductImage_FullMethodName:     authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_DeleteProductImage_FullMethodName:     authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_ListProductAttributes_FullMethodName:  authz.Public,
+	catalogv1.CatalogService_GetProductAttribute_FullMethodName:    authz.Public,
+	catalogv1.CatalogService_CreateProductAttribute_FullMethodName: authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_UpdateProductAttribute_FullMethodName: authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_DeleteProductAttribute_FullMethodName: authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_ListProductRelations_FullMethodName:   authz.Public,
+	catalogv1.CatalogService_CreateProductRelation_FullMethodName:  authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_UpdateProductRelation_FullMethodName:  authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_DeleteProductRelation_FullMethodName:  authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_GetBundle_FullMethodName:              authz.Public,
+	catalogv1.CatalogService_CreateBundle_FullMethodName:           authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_UpdateBundle_FullMethodName:           authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_ListBrands_FullMethodName:             authz.Public,
+	catalogv1.CatalogService_GetBrand_FullMethodName:               authz.Public,
+	catalogv1.CatalogService_CreateBrand_FullMethodName:            authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_UpdateBrand_FullMethodName:            authz.Permission(jwt.PermCatalogProductsWrite),
+	catalogv1.CatalogService_DeleteBrand_FullMethodName:            authz.Permission(jwt.PermCatalogProductsWrite),
+
+	catalogv1.CatalogService_UpdateProductPrice_FullMethodName: authz.Permission(jwt.PermCatalogPricesWrite),
 
-func isAdmin(ctx context.Context, jwtSecret string) bool {
-	return requireAdmin(ctx, jwtSecret) == nil
+	catalogv1.CatalogService_ReserveStock_FullMethodName: authz.Permission(jwt.PermCatalogStockWrite),
+	catalogv1.CatalogService_CommitStock_FullMethodName:  authz.Permission(jwt.PermCatalogStockWrite),
+	catalogv1.CatalogService_ReleaseStock_FullMethodName: authz.Permission(jwt.PermCatalogStockWrite),
+
+	catalogv1.CatalogService_ListProductReviews_FullMethodName:      authz.Public,
+	catalogv1.CatalogService_CreateProductReview_FullMethodName:     authz.Authenticated,
+	catalogv1.CatalogService_ListReviews_FullMethodName:             authz.Permission(jwt.PermCatalogModerate),
+	catalogv1.CatalogService_ModerateReview_FullMethodName:          authz.Permission(jwt.PermCatalogModerate),
+	catalogv1.CatalogService_DeleteReview_FullMethodName:            authz.Permission(jwt.PermCatalogModerate),
+	catalogv1.CatalogService_ListProductQuestions_FullMethodName:    authz.Public,
+	catalogv1.CatalogService_AskProductQuestion_FullMethodName:      authz.Authenticated,
+	catalogv1.CatalogService_ListQuestions_FullMethodName:           authz.Permission(jwt.PermCatalogModerate),
+	catalogv1.CatalogService_AnswerProductQuestion_FullMethodName:   authz.Permission(jwt.PermCatalogModerate),
+	catalogv1.CatalogService_ModerateProductQuestion_FullMethodName: authz.Permission(jwt.PermCatalogModerate),
+	catalogv1.CatalogService_DeleteProductQuestion_FullMethodName:   authz.Permission(jwt.PermCatalogModerate),
+	catalogv1.CatalogService_ModerateProductAnswer_FullMethodName:   authz.Permission(jwt.PermCatalogModerate),
+	catalogv1.CatalogService_DeleteProductAnswer_FullMethodName:     authz.Permission(jwt.PermCatalogModerate),
+
+	catalogv1.CatalogService_ListProductListItems_FullMethodName:  authz.Authenticated,
+	catalogv1.CatalogService_AddProductListItem_FullMethodName:    authz.Authenticated,
+	catalogv1.CatalogService_RemoveProductListItem_FullMethodName: authz.Authenticated,
+	// Comparing the saved list needs a user; comparing given ids does not.
+	catalogv1.CatalogService_CompareProducts_FullMethodName: authz.Public,
 }
+
+// Public reads show inactive or unmoderated content to callers holding
+// these permissions.
+const (
+	hiddenProductsPermission = jwt.PermCatalogProductsWrite
+	hiddenFeedbackPermission = jwt.PermCatalogModerate
+)
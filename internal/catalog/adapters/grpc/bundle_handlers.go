@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+	catalogv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/catalog/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// --- Bundles ---
+
+func (s *CatalogGRPCServer) GetBundle(
+	ctx context.Context,
+	req *catalogv1.GetBundleRequest,
+) (*catalogv1.GetBundleResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "bundle id is required")
+	}
+	admin := authz.HasPermission(ctx, hiddenProductsPermission)
+	details, err := s.catalogService.GetBundle(ctx, req.Id, admin)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.GetBundleResponse{Bundle: toProtoBundle(details)}, nil
+}
+
+func (s *CatalogGRPCServer) CreateBundle(
+	ctx context.Context,
+	req *catalogv1.CreateBundleRequest,
+) (*catalogv1.CreateBundleResponse, error) {
+	details, err := s.catalogService.CreateBundle(ctx, domain.BundleInput{
+		CategoryID:      req.CategoryId,
+		BrandID:         req.BrandId,
+		Name:            req.Name,
+		Description:     req.Description,
+		SKU:             req.Sku,
+		IsActive:        req.IsActive,
+		PricingMode:     req.PricingMode,
+		PriceCents:      req.PriceCents,
+		DiscountPercent: req.DiscountPercent,
+		Items:           fromProtoBundleItems(req.Items),
+	})
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.CreateBundleResponse{Bundle: toProtoBundle(details)}, nil
+}
+
+func (s *CatalogGRPCServer) UpdateBundle(
+	ctx context.Context,
+	req *catalogv1.UpdateBundleRequest,
+) (*catalogv1.UpdateBundleResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "bundle id is required")
+	}
+	details, err := s.catalogService.UpdateBundle(ctx, req.Id, domain.BundleInput{
+		CategoryID:      req.CategoryId,
+		BrandID:         req.BrandId,
+		Name:            req.Name,
+		Description:     req.Description,
+		SKU:             req.Sku,
+		IsActive:        req.IsActive,
+		PricingMode:     req.PricingMode,
+		PriceCents:      req.PriceCents,
+		DiscountPercent: req.DiscountPercent,
+		Items:           fromProtoBundleItems(req.Items),
+	})
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.UpdateBundleResponse{Bundle: toProtoBundle(details)}, nil
+}
+
+func fromProtoBundleItems(items []*catalogv1.BundleItemInput) []domain.BundleItemInput {
+	out := make([]domain.BundleItemInput, 0, len(items))
+	for _, item := range items {
+		out = append(out, domain.BundleItemInput{ProductID: item.ProductId, Quantity: item.Quantity})
+	}
+	return out
+}
+
+func toProtoBundle(details *domain.BundleDetails) *catalogv1.Bundle {
+	components := make(map[string]*domain.Product, len(details.Components))
+	for i := range details.Components {
+		components[details.Components[i].ID] = &details.Components[i]
+	}
+	out := &catalogv1.Bundle{
+		Product:         toProtoProduct(&details.Product),
+		PricingMode:     details.Bundle.PricingMode,
+		DiscountPercent: details.Bundle.DiscountPercent,
+		Items:           make([]*catalogv1.BundleItem, 0, len(details.Bundle.Items)),
+	}
+	for _, item := range details.Bundle.Items {
+		protoItem := &catalogv1.BundleItem{
+			ProductId: item.ComponentProductID,
+			Quantity:  item.Quantity,
+		}
+		if component, ok := components[item.ComponentProductID]; ok {
+			protoItem.Product = toProtoProduct(component)
+		}
+		out.Items = append(out.Items, protoItem)
+	}
+	return out
+}
+
+// --- Stock ---
+
+func (s *CatalogGRPCServer) ReserveStock(
+	ctx context.Context,
+	req *catalogv1.ReserveStockRequest,
+) (*catalogv1.ReserveStockResponse, error) {
+	if err := s.catalogService.ReserveStock(ctx, fromProtoStockLines(req.Lines)); err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.ReserveStockResponse{Success: true}, nil
+}
+
+func (s *CatalogGRPCServer) CommitStock(
+	ctx context.Context,
+	req *catalogv1.CommitStockRequest,
+) (*catalogv1.CommitStockResponse, error) {
+	if err := s.catalogService.CommitStock(ctx, fromProtoStockLines(req.Lines)); err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.CommitStockResponse{Success: true}, nil
+}
+
+func (s *CatalogGRPCServer) ReleaseStock(
+	ctx context.Context,
+	req *catalogv1.ReleaseStockRequest,
+) (*catalogv1.ReleaseStockResponse, error) {
+	if err := s.catalogService.ReleaseStock(ctx, fromProtoStockLines(req.Lines)); err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.ReleaseStockResponse{Success: true}, nil
+}
+
+func fromProtoStockLines(lines []*catalogv1.StockLine) []domain.StockLine {
+	out := make([]domain.StockLine, 0, len(lines))
+	for _, line := range lines {
+		out = append(out, domain.StockLine{ProductID: line.ProductId, Quantity: line.Quantity})
+	}
+	return out
+}
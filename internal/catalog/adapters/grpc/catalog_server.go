@@ -7,6 +7,8 @@ import (
 	"github.com/KarpovYuri/caraudio-backend/internal/catalog/app/services"
 	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
 	catalogv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/catalog/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"github.com/KarpovYuri/caraudio-backend/pkg/jwt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -19,18 +21,13 @@ const (
 type CatalogGRPCServer struct {
 	catalogv1.UnimplementedCatalogServiceServer
 	catalogService  services.CatalogService
-	jwtSecret       string
 	defaultPageSize int32
 	maxPageSize     int32
 }
 
-func NewCatalogGRPCServer(
-	catalogService services.CatalogService,
-	jwtSecret string,
-) *CatalogGRPCServer {
+func NewCatalogGRPCServer(catalogService services.CatalogService) *CatalogGRPCServer {
 	return &CatalogGRPCServer{
 		catalogService:  catalogService,
-		jwtSecret:       jwtSecret,
 		defaultPageSize: defaultListPageSize,
 		maxPageSize:     maxListPageSize,
 	}
@@ -69,9 +66,6 @@ func (s *CatalogGRPCServer) CreateCategory(
 	ctx context.Context,
 	req *catalogv1.CreateCategoryRequest,
 ) (*catalogv1.CreateCategoryResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	category, err := s.catalogService.CreateCategory(ctx, req.Name, req.Slug, req.ParentId)
 	if err != nil {
 		return nil, mapServiceError(err)
@@ -83,9 +77,6 @@ func (s *CatalogGRPCServer) UpdateCategory(
 	ctx context.Context,
 	req *catalogv1.UpdateCategoryRequest,
 ) (*catalogv1.UpdateCategoryResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "category id is required")
 	}
@@ -100,9 +91,6 @@ func (s *CatalogGRPCServer) DeleteCategory(
 	ctx context.Context,
 	req *catalogv1.DeleteCategoryRequest,
 ) (*catalogv1.DeleteCategoryResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "category id is required")
 	}
@@ -122,8 +110,8 @@ func (s *CatalogGRPCServer) ListProducts(
 
 	activeOnly := true
 	if req.IncludeInactive {
-		if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-			return nil, mapServiceError(err)
+		if !authz.HasPermission(ctx, hiddenProductsPermission) {
+			return nil, mapServiceError(jwt.ErrForbidden)
 		}
 		activeOnly = false
 	}
@@ -133,8 +121,10 @@ func (s *CatalogGRPCServer) ListProducts(
 		BrandID:    req.BrandId,
 		SupplierID: req.SupplierId,
 		ActiveOnly: activeOnly,
+		Sort:       req.Sort,
 		Page:       page,
 		PageSize:   pageSize,
+		PageToken:  req.PageToken,
 	})
 	if err != nil {
 		return nil, mapServiceError(err)
@@ -146,10 +136,11 @@ func (s *CatalogGRPCServer) ListProducts(
 	}
 
 	return &catalogv1.ListProductsResponse{
-		Products: products,
-		Total:    result.Total,
-		Page:     page,
-		PageSize: pageSize,
+		Products:      products,
+		Total:         result.Total,
+		Page:          page,
+		PageSize:      pageSize,
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
@@ -160,7 +151,7 @@ func (s *CatalogGRPCServer) GetProduct(
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "product id is required")
 	}
-	admin := isAdmin(ctx, s.jwtSecret)
+	admin := authz.HasPermission(ctx, hiddenProductsPermission)
 	product, err := s.catalogService.GetProduct(ctx, req.Id)
 	if err != nil {
 		if admin {
@@ -173,6 +164,11 @@ func (s *CatalogGRPCServer) GetProduct(
 	protoProduct := toProtoProduct(product)
 	attachProductImages(ctx, s, req.Id, admin, protoProduct)
 	attachProductAttributes(ctx, s, req.Id, admin, protoProduct)
+	if hasInclude(req.Include, includeRelations) {
+		if err := attachProductRelations(ctx, s, req.Id, admin, protoProduct); err != nil {
+			return nil, mapServiceError(err)
+		}
+	}
 	return &catalogv1.GetProductResponse{Product: protoProduct}, nil
 }
 
@@ -180,9 +176,6 @@ func (s *CatalogGRPCServer) CreateProduct(
 	ctx context.Context,
 	req *catalogv1.CreateProductRequest,
 ) (*catalogv1.CreateProductResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.Name == "" {
 		return nil, status.Error(codes.InvalidArgument, "product name is required")
 	}
@@ -208,9 +201,6 @@ func (s *CatalogGRPCServer) UpdateProduct(
 	ctx context.Context,
 	req *catalogv1.UpdateProductRequest,
 ) (*catalogv1.UpdateProductResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "product id is required")
 	}
@@ -233,13 +223,24 @@ func (s *CatalogGRPCServer) UpdateProduct(
 	return &catalogv1.UpdateProductResponse{Product: toProtoProduct(product)}, nil
 }
 
+func (s *CatalogGRPCServer) UpdateProductPrice(
+	ctx context.Context,
+	req *catalogv1.UpdateProductPriceRequest,
+) (*catalogv1.UpdateProductPriceResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+	product, err := s.catalogService.UpdateProductPrice(ctx, req.Id, req.PriceCents)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.UpdateProductPriceResponse{Product: toProtoProduct(product)}, nil
+}
+
 func (s *CatalogGRPCServer) DeleteProduct(
 	ctx context.Context,
 	req *catalogv1.DeleteProductRequest,
 ) (*catalogv1.DeleteProductResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "product id is required")
 	}
@@ -271,6 +272,9 @@ func toProtoProduct(product *domain.Product) *catalogv1.Product {
 		PriceCents:  product.PriceCents,
 		Stock:       product.Stock,
 		IsActive:    product.IsActive,
+		Kind:        product.Kind,
+		RatingAvg:   product.RatingAvg,
+		RatingCount: product.RatingCount,
 		CreatedAt:   product.CreatedAt.UTC().Format(time.RFC3339),
 		UpdatedAt:   product.UpdatedAt.UTC().Format(time.RFC3339),
 	}
@@ -15,7 +15,8 @@ func mapServiceError(err error) error {
 		return status.Error(codes.Unauthenticated, "unauthorized")
 	case errors.Is(err, pkgjwt.ErrForbidden):
 		return status.Error(codes.PermissionDenied, "forbidden")
-	case errors.Is(err, domain.ErrInvalidArgument):
+	case errors.Is(err, domain.ErrInvalidArgument),
+		errors.Is(err, domain.ErrInvalidPageToken):
 		return status.Error(codes.InvalidArgument, err.Error())
 	case errors.Is(err, domain.ErrCategoryNotFound),
 		errors.Is(err, domain.ErrProductNotFound),
@@ -23,13 +24,23 @@ func mapServiceError(err error) error {
 		errors.Is(err, domain.ErrProductImageNotFound),
 		errors.Is(err, domain.ErrBrandNotFound),
 		errors.Is(err, domain.ErrProductAttributeNotFound),
-		errors.Is(err, domain.ErrSupplierMappingNotFound):
+		errors.Is(err, domain.ErrSupplierMappingNotFound),
+		errors.Is(err, domain.ErrProductRelationNotFound),
+		errors.Is(err, domain.ErrBundleNotFound),
+		errors.Is(err, domain.ErrProductReviewNotFound),
+		errors.Is(err, domain.ErrProductQuestionNotFound),
+		errors.Is(err, domain.ErrProductAnswerNotFound),
+		errors.Is(err, domain.ErrProductListItemNotFound):
 		return status.Error(codes.NotFound, err.Error())
 	case errors.Is(err, domain.ErrAlreadyExists):
 		return status.Error(codes.AlreadyExists, err.Error())
 	case errors.Is(err, domain.ErrCategoryHasProducts),
 		errors.Is(err, domain.ErrSupplierHasProducts),
-		errors.Is(err, domain.ErrBrandHasProducts):
+		errors.Is(err, domain.ErrBrandHasProducts),
+		errors.Is(err, domain.ErrProductInBundle),
+		errors.Is(err, domain.ErrInsufficientStock),
+		errors.Is(err, domain.ErrStockNotReserved),
+		errors.Is(err, domain.ErrProductListFull):
 		return status.Error(codes.FailedPrecondition, err.Error())
 	default:
 		return status.Error(codes.Internal, "internal server error")
@@ -6,6 +6,7 @@ import (
 
 	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
 	catalogv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/catalog/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -17,7 +18,7 @@ func (s *CatalogGRPCServer) ListBrands(
 	req *catalogv1.ListBrandsRequest,
 ) (*catalogv1.ListBrandsResponse, error) {
 	activeOnly := true
-	if req.IncludeInactive && isAdmin(ctx, s.jwtSecret) {
+	if req.IncludeInactive && authz.HasPermission(ctx, hiddenProductsPermission) {
 		activeOnly = false
 	}
 	brands, err := s.catalogService.ListBrands(ctx, activeOnly)
@@ -38,7 +39,7 @@ func (s *CatalogGRPCServer) GetBrand(
 	if req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "brand id is required")
 	}
-	admin := isAdmin(ctx, s.jwtSecret)
+	admin := authz.HasPermission(ctx, hiddenProductsPermission)
 	var brand *domain.Brand
 	var err error
 	if admin {
@@ -56,9 +57,6 @@ func (s *CatalogGRPCServer) CreateBrand(
 	ctx context.Context,
 	req *catalogv1.CreateBrandRequest,
 ) (*catalogv1.CreateBrandResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	brand, err := s.catalogService.CreateBrand(ctx, domain.BrandInput{
 		Name: req.Name, Slug: req.Slug, Description: req.Description, IsActive: req.IsActive,
 	})
@@ -72,9 +70,6 @@ func (s *CatalogGRPCServer) UpdateBrand(
 	ctx context.Context,
 	req *catalogv1.UpdateBrandRequest,
 ) (*catalogv1.UpdateBrandResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	brand, err := s.catalogService.UpdateBrand(ctx, req.Id, domain.BrandInput{
 		Name: req.Name, Slug: req.Slug, Description: req.Description, IsActive: req.IsActive,
 	})
@@ -88,9 +83,6 @@ func (s *CatalogGRPCServer) DeleteBrand(
 	ctx context.Context,
 	req *catalogv1.DeleteBrandRequest,
 ) (*catalogv1.DeleteBrandResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if err := s.catalogService.DeleteBrand(ctx, req.Id); err != nil {
 		return nil, mapServiceError(err)
 	}
@@ -118,7 +110,7 @@ func (s *CatalogGRPCServer) ListProductAttributes(
 	if req.ProductId == "" {
 		return nil, status.Error(codes.InvalidArgument, "product id is required")
 	}
-	admin := isAdmin(ctx, s.jwtSecret)
+	admin := authz.HasPermission(ctx, hiddenProductsPermission)
 	attrs, err := s.catalogService.ListProductAttributes(ctx, req.ProductId, admin)
 	if err != nil {
 		return nil, mapServiceError(err)
@@ -134,7 +126,7 @@ func (s *CatalogGRPCServer) GetProductAttribute(
 	ctx context.Context,
 	req *catalogv1.GetProductAttributeRequest,
 ) (*catalogv1.GetProductAttributeResponse, error) {
-	admin := isAdmin(ctx, s.jwtSecret)
+	admin := authz.HasPermission(ctx, hiddenProductsPermission)
 	attr, err := s.catalogService.GetProductAttribute(ctx, req.ProductId, req.Id, admin)
 	if err != nil {
 		return nil, mapServiceError(err)
@@ -146,9 +138,6 @@ func (s *CatalogGRPCServer) CreateProductAttribute(
 	ctx context.Context,
 	req *catalogv1.CreateProductAttributeRequest,
 ) (*catalogv1.CreateProductAttributeResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	attr, err := s.catalogService.CreateProductAttribute(ctx, req.ProductId, domain.ProductAttributeInput{
 		Name: req.Name, Value: req.Value, SortOrder: req.SortOrder,
 	})
@@ -162,9 +151,6 @@ func (s *CatalogGRPCServer) UpdateProductAttribute(
 	ctx context.Context,
 	req *catalogv1.UpdateProductAttributeRequest,
 ) (*catalogv1.UpdateProductAttributeResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	attr, err := s.catalogService.UpdateProductAttribute(ctx, req.ProductId, req.Id, domain.ProductAttributeInput{
 		Name: req.Name, Value: req.Value, SortOrder: req.SortOrder,
 	})
@@ -178,9 +164,6 @@ func (s *CatalogGRPCServer) DeleteProductAttribute(
 	ctx context.Context,
 	req *catalogv1.DeleteProductAttributeRequest,
 ) (*catalogv1.DeleteProductAttributeResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if err := s.catalogService.DeleteProductAttribute(ctx, req.ProductId, req.Id); err != nil {
 		return nil, mapServiceError(err)
 	}
@@ -216,29 +199,33 @@ func (s *CatalogGRPCServer) ListSupplierCategoryMappings(
 	ctx context.Context,
 	req *catalogv1.ListSupplierCategoryMappingsRequest,
 ) (*catalogv1.ListSupplierCategoryMappingsResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
-	list, err := s.catalogService.ListSupplierCategoryMappings(ctx, domain.SupplierCategoryMappingFilter{
+	page, pageSize := s.catalogService.NormalizePagination(
+		req.Page, req.PageSize, s.defaultPageSize, s.maxPageSize,
+	)
+	result, err := s.catalogService.ListSupplierCategoryMappings(ctx, domain.SupplierCategoryMappingFilter{
 		SupplierID: req.SupplierId, CategoryID: req.CategoryId,
+		Page: page, PageSize: pageSize, PageToken: req.PageToken,
 	})
 	if err != nil {
 		return nil, mapServiceError(err)
 	}
-	out := make([]*catalogv1.SupplierCategoryMapping, 0, len(list))
-	for i := range list {
-		out = append(out, toProtoSupplierCategoryMapping(&list[i]))
+	out := make([]*catalogv1.SupplierCategoryMapping, 0, len(result.Mappings))
+	for i := range result.Mappings {
+		out = append(out, toProtoSupplierCategoryMapping(&result.Mappings[i]))
 	}
-	return &catalogv1.ListSupplierCategoryMappingsResponse{Mappings: out}, nil
+	return &catalogv1.ListSupplierCategoryMappingsResponse{
+		Mappings:      out,
+		Total:         result.Total,
+		Page:          page,
+		PageSize:      pageSize,
+		NextPageToken: result.NextPageToken,
+	}, nil
 }
 
 func (s *CatalogGRPCServer) GetSupplierCategoryMapping(
 	ctx context.Context,
 	req *catalogv1.GetSupplierCategoryMappingRequest,
 ) (*catalogv1.GetSupplierCategoryMappingResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	m, err := s.catalogService.GetSupplierCategoryMapping(ctx, req.Id)
 	if err != nil {
 		return nil, mapServiceError(err)
@@ -250,9 +237,6 @@ func (s *CatalogGRPCServer) CreateSupplierCategoryMapping(
 	ctx context.Context,
 	req *catalogv1.CreateSupplierCategoryMappingRequest,
 ) (*catalogv1.CreateSupplierCategoryMappingResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	m, err := s.catalogService.CreateSupplierCategoryMapping(ctx, domain.SupplierCategoryMappingInput{
 		CategoryID: req.CategoryId, SupplierID: req.SupplierId,
 		ExternalID: req.ExternalId, ExternalName: req.ExternalName, Notes: req.Notes,
@@ -267,9 +251,6 @@ func (s *CatalogGRPCServer) UpdateSupplierCategoryMapping(
 	ctx context.Context,
 	req *catalogv1.UpdateSupplierCategoryMappingRequest,
 ) (*catalogv1.UpdateSupplierCategoryMappingResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	m, err := s.catalogService.UpdateSupplierCategoryMapping(ctx, req.Id, domain.SupplierCategoryMappingInput{
 		CategoryID: req.CategoryId, SupplierID: req.SupplierId,
 		ExternalID: req.ExternalId, ExternalName: req.ExternalName, Notes: req.Notes,
@@ -284,9 +265,6 @@ func (s *CatalogGRPCServer) DeleteSupplierCategoryMapping(
 	ctx context.Context,
 	req *catalogv1.DeleteSupplierCategoryMappingRequest,
 ) (*catalogv1.DeleteSupplierCategoryMappingResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if err := s.catalogService.DeleteSupplierCategoryMapping(ctx, req.Id); err != nil {
 		return nil, mapServiceError(err)
 	}
@@ -308,29 +286,33 @@ func (s *CatalogGRPCServer) ListSupplierProductMappings(
 	ctx context.Context,
 	req *catalogv1.ListSupplierProductMappingsRequest,
 ) (*catalogv1.ListSupplierProductMappingsResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
-	list, err := s.catalogService.ListSupplierProductMappings(ctx, domain.SupplierProductMappingFilter{
+	page, pageSize := s.catalogService.NormalizePagination(
+		req.Page, req.PageSize, s.defaultPageSize, s.maxPageSize,
+	)
+	result, err := s.catalogService.ListSupplierProductMappings(ctx, domain.SupplierProductMappingFilter{
 		SupplierID: req.SupplierId, ProductID: req.ProductId,
+		Page: page, PageSize: pageSize, PageToken: req.PageToken,
 	})
 	if err != nil {
 		return nil, mapServiceError(err)
 	}
-	out := make([]*catalogv1.SupplierProductMapping, 0, len(list))
-	for i := range list {
-		out = append(out, toProtoSupplierProductMapping(&list[i]))
+	out := make([]*catalogv1.SupplierProductMapping, 0, len(result.Mappings))
+	for i := range result.Mappings {
+		out = append(out, toProtoSupplierProductMapping(&result.Mappings[i]))
 	}
-	return &catalogv1.ListSupplierProductMappingsResponse{Mappings: out}, nil
+	return &catalogv1.ListSupplierProductMappingsResponse{
+		Mappings:      out,
+		Total:         result.Total,
+		Page:          page,
+		PageSize:      pageSize,
+		NextPageToken: result.NextPageToken,
+	}, nil
 }
 
 func (s *CatalogGRPCServer) GetSupplierProductMapping(
 	ctx context.Context,
 	req *catalogv1.GetSupplierProductMappingRequest,
 ) (*catalogv1.GetSupplierProductMappingResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	m, err := s.catalogService.GetSupplierProductMapping(ctx, req.Id)
 	if err != nil {
 		return nil, mapServiceError(err)
@@ -342,9 +324,6 @@ func (s *CatalogGRPCServer) CreateSupplierProductMapping(
 	ctx context.Context,
 	req *catalogv1.CreateSupplierProductMappingRequest,
 ) (*catalogv1.CreateSupplierProductMappingResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	m, err := s.catalogService.CreateSupplierProductMapping(ctx, domain.SupplierProductMappingInput{
 		ProductID: req.ProductId, SupplierID: req.SupplierId,
 		ExternalID: req.ExternalId, ExternalSKU: req.ExternalSku,
@@ -360,9 +339,6 @@ func (s *CatalogGRPCServer) UpdateSupplierProductMapping(
 	ctx context.Context,
 	req *catalogv1.UpdateSupplierProductMappingRequest,
 ) (*catalogv1.UpdateSupplierProductMappingResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	m, err := s.catalogService.UpdateSupplierProductMapping(ctx, req.Id, domain.SupplierProductMappingInput{
 		ProductID: req.ProductId, SupplierID: req.SupplierId,
 		ExternalID: req.ExternalId, ExternalSKU: req.ExternalSku,
@@ -378,9 +354,6 @@ func (s *CatalogGRPCServer) DeleteSupplierProductMapping(
 	ctx context.Context,
 	req *catalogv1.DeleteSupplierProductMappingRequest,
 ) (*catalogv1.DeleteSupplierProductMappingResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if err := s.catalogService.DeleteSupplierProductMapping(ctx, req.Id); err != nil {
 		return nil, mapServiceError(err)
 	}
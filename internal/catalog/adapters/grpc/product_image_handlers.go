@@ -6,6 +6,7 @@ import (
 
 	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
 	catalogv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/catalog/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -17,7 +18,7 @@ func (s *CatalogGRPCServer) ListProductImages(
 	if req.ProductId == "" {
 		return nil, status.Error(codes.InvalidArgument, "product id is required")
 	}
-	admin := isAdmin(ctx, s.jwtSecret)
+	admin := authz.HasPermission(ctx, hiddenProductsPermission)
 
 	images, err := s.catalogService.ListProductImages(ctx, req.ProductId, admin)
 	if err != nil {
@@ -38,7 +39,7 @@ func (s *CatalogGRPCServer) GetProductImage(
 	if req.ProductId == "" || req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "product id and image id are required")
 	}
-	admin := isAdmin(ctx, s.jwtSecret)
+	admin := authz.HasPermission(ctx, hiddenProductsPermission)
 
 	image, err := s.catalogService.GetProductImage(ctx, req.ProductId, req.Id, admin)
 	if err != nil {
@@ -51,9 +52,6 @@ func (s *CatalogGRPCServer) CreateProductImage(
 	ctx context.Context,
 	req *catalogv1.CreateProductImageRequest,
 ) (*catalogv1.CreateProductImageResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	productID := req.ProductId
 	if productID == "" {
 		return nil, status.Error(codes.InvalidArgument, "product id is required")
@@ -78,9 +76,6 @@ func (s *CatalogGRPCServer) UpdateProductImage(
 	ctx context.Context,
 	req *catalogv1.UpdateProductImageRequest,
 ) (*catalogv1.UpdateProductImageResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.ProductId == "" || req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "product id and image id are required")
 	}
@@ -104,9 +99,6 @@ func (s *CatalogGRPCServer) DeleteProductImage(
 	ctx context.Context,
 	req *catalogv1.DeleteProductImageRequest,
 ) (*catalogv1.DeleteProductImageResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.ProductId == "" || req.Id == "" {
 		return nil, status.Error(codes.InvalidArgument, "product id and image id are required")
 	}
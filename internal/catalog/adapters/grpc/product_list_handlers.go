@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+	catalogv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/catalog/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *CatalogGRPCServer) ListProductListItems(
+	ctx context.Context,
+	req *catalogv1.ListProductListItemsRequest,
+) (*catalogv1.ListProductListItemsResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	entries, err := s.catalogService.ListProductListItems(ctx, userID, req.List)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	items := make([]*catalogv1.ProductListItem, 0, len(entries))
+	for i := range entries {
+		items = append(items, &catalogv1.ProductListItem{
+			Product: toProtoListProduct(&entries[i]),
+			AddedAt: entries[i].Item.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return &catalogv1.ListProductListItemsResponse{Items: items}, nil
+}
+
+func (s *CatalogGRPCServer) AddProductListItem(
+	ctx context.Context,
+	req *catalogv1.AddProductListItemRequest,
+) (*catalogv1.AddProductListItemResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+	if _, err := s.catalogService.AddProductListItem(ctx, userID, req.List, req.ProductId); err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.AddProductListItemResponse{Success: true}, nil
+}
+
+func (s *CatalogGRPCServer) RemoveProductListItem(
+	ctx context.Context,
+	req *catalogv1.RemoveProductListItemRequest,
+) (*catalogv1.RemoveProductListItemResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	if err := s.catalogService.RemoveProductListItem(ctx, userID, req.List, req.ProductId); err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.RemoveProductListItemResponse{Success: true}, nil
+}
+
+func (s *CatalogGRPCServer) CompareProducts(
+	ctx context.Context,
+	req *catalogv1.CompareProductsRequest,
+) (*catalogv1.CompareProductsResponse, error) {
+	userID := ""
+	if len(req.ProductIds) == 0 {
+		var err error
+		if userID, err = authz.UserID(ctx); err != nil {
+			return nil, mapServiceError(err)
+		}
+	}
+	comparison, err := s.catalogService.CompareProducts(ctx, userID, req.ProductIds)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	resp := &catalogv1.CompareProductsResponse{
+		Products: make([]*catalogv1.Product, 0, len(comparison.Products)),
+		Rows:     make([]*catalogv1.ComparisonRow, 0, len(comparison.Rows)),
+	}
+	for i := range comparison.Products {
+		resp.Products = append(resp.Products, toProtoListProduct(&comparison.Products[i]))
+	}
+	for _, row := range comparison.Rows {
+		if req.DifferencesOnly && !row.Differs {
+			continue
+		}
+		resp.Rows = append(resp.Rows, &catalogv1.ComparisonRow{
+			Name:    row.Name,
+			Values:  row.Values,
+			Differs: row.Differs,
+		})
+	}
+	return resp, nil
+}
+
+func toProtoListProduct(entry *domain.ProductListEntry) *catalogv1.Product {
+	product := toProtoProduct(&entry.Product)
+	if entry.PrimaryImage != nil {
+		product.Images = []*catalogv1.ProductImage{toProtoProductImage(entry.PrimaryImage)}
+	}
+	return product
+}
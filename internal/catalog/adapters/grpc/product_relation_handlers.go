@@ -0,0 +1,123 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+	catalogv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/catalog/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const includeRelations = "relations"
+
+func (s *CatalogGRPCServer) ListProductRelations(
+	ctx context.Context,
+	req *catalogv1.ListProductRelationsRequest,
+) (*catalogv1.ListProductRelationsResponse, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+	admin := authz.HasPermission(ctx, hiddenProductsPermission)
+	relations, err := s.catalogService.ListProductRelations(ctx, req.ProductId, admin)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	out := make([]*catalogv1.ProductRelation, 0, len(relations))
+	for i := range relations {
+		out = append(out, toProtoProductRelation(&relations[i]))
+	}
+	return &catalogv1.ListProductRelationsResponse{Relations: out}, nil
+}
+
+func (s *CatalogGRPCServer) CreateProductRelation(
+	ctx context.Context,
+	req *catalogv1.CreateProductRelationRequest,
+) (*catalogv1.CreateProductRelationResponse, error) {
+	if req.ProductId == "" || req.RelatedProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product id and related product id are required")
+	}
+	relation, err := s.catalogService.CreateProductRelation(ctx, req.ProductId, domain.ProductRelationInput{
+		RelatedProductID: req.RelatedProductId,
+		Type:             req.Type,
+		SortOrder:        req.SortOrder,
+		Bidirectional:    req.Bidirectional,
+	})
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.CreateProductRelationResponse{Relation: toProtoProductRelation(relation)}, nil
+}
+
+func (s *CatalogGRPCServer) UpdateProductRelation(
+	ctx context.Context,
+	req *catalogv1.UpdateProductRelationRequest,
+) (*catalogv1.UpdateProductRelationResponse, error) {
+	relation, err := s.catalogService.UpdateProductRelation(ctx, req.ProductId, req.Id, domain.ProductRelationInput{
+		Type:      req.Type,
+		SortOrder: req.SortOrder,
+	})
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.UpdateProductRelationResponse{Relation: toProtoProductRelation(relation)}, nil
+}
+
+func (s *CatalogGRPCServer) DeleteProductRelation(
+	ctx context.Context,
+	req *catalogv1.DeleteProductRelationRequest,
+) (*catalogv1.DeleteProductRelationResponse, error) {
+	if err := s.catalogService.DeleteProductRelation(ctx, req.ProductId, req.Id, req.Bidirectional); err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.DeleteProductRelationResponse{Success: true}, nil
+}
+
+func toProtoProductRelation(relation *domain.ProductRelation) *catalogv1.ProductRelation {
+	return &catalogv1.ProductRelation{
+		Id:               relation.ID,
+		ProductId:        relation.ProductID,
+		RelatedProductId: relation.RelatedProductID,
+		Type:             relation.Type,
+		SortOrder:        relation.SortOrder,
+		CreatedAt:        relation.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:        relation.UpdatedAt.UTC().Format(time.RFC3339),
+		Bidirectional:    relation.IsBidirectional(),
+	}
+}
+
+func toProtoRelatedProduct(related *domain.RelatedProduct) *catalogv1.RelatedProduct {
+	product := toProtoProduct(&related.Product)
+	if related.PrimaryImage != nil {
+		product.Images = []*catalogv1.ProductImage{toProtoProductImage(related.PrimaryImage)}
+	}
+	return &catalogv1.RelatedProduct{
+		Relation: toProtoProductRelation(&related.Relation),
+		Product:  product,
+	}
+}
+
+func hasInclude(include []string, name string) bool {
+	for _, value := range include {
+		if value == name {
+			return true
+		}
+	}
+	return false
+}
+
+// attachProductRelations fails the request rather than leaving Relations
+// empty: the caller asked for them explicitly.
+func attachProductRelations(ctx context.Context, s *CatalogGRPCServer, productID string, admin bool, proto *catalogv1.Product) error {
+	related, err := s.catalogService.ListRelatedProducts(ctx, productID, admin)
+	if err != nil {
+		return err
+	}
+	proto.Relations = make([]*catalogv1.RelatedProduct, 0, len(related))
+	for i := range related {
+		proto.Relations = append(proto.Relations, toProtoRelatedProduct(&related[i]))
+	}
+	return nil
+}
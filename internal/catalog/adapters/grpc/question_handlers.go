@@ -0,0 +1,194 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+	catalogv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/catalog/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *CatalogGRPCServer) ListProductQuestions(
+	ctx context.Context,
+	req *catalogv1.ListProductQuestionsRequest,
+) (*catalogv1.ListProductQuestionsResponse, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+	page, pageSize := s.catalogService.NormalizePagination(
+		req.Page, req.PageSize, s.defaultPageSize, s.maxPageSize,
+	)
+	admin := authz.HasPermission(ctx, hiddenFeedbackPermission)
+	filter := domain.ProductQuestionFilter{
+		ProductID: req.ProductId,
+		Page:      page,
+		PageSize:  pageSize,
+		PageToken: req.PageToken,
+	}
+	if admin {
+		filter.Status = req.Status
+	}
+	result, err := s.catalogService.ListProductQuestions(ctx, filter, admin)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.ListProductQuestionsResponse{
+		Questions:     toProtoQuestions(result.Questions),
+		Total:         result.Total,
+		Page:          page,
+		PageSize:      pageSize,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (s *CatalogGRPCServer) ListQuestions(
+	ctx context.Context,
+	req *catalogv1.ListQuestionsRequest,
+) (*catalogv1.ListQuestionsResponse, error) {
+	page, pageSize := s.catalogService.NormalizePagination(
+		req.Page, req.PageSize, s.defaultPageSize, s.maxPageSize,
+	)
+	result, err := s.catalogService.ListProductQuestions(ctx, domain.ProductQuestionFilter{
+		ProductID: req.ProductId,
+		Status:    req.Status,
+		Page:      page,
+		PageSize:  pageSize,
+		PageToken: req.PageToken,
+	}, true)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.ListQuestionsResponse{
+		Questions:     toProtoQuestions(result.Questions),
+		Total:         result.Total,
+		Page:          page,
+		PageSize:      pageSize,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (s *CatalogGRPCServer) AskProductQuestion(
+	ctx context.Context,
+	req *catalogv1.AskProductQuestionRequest,
+) (*catalogv1.AskProductQuestionResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+	question, err := s.catalogService.AskProductQuestion(ctx, userID, req.ProductId, req.Text)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.AskProductQuestionResponse{Question: toProtoQuestion(question)}, nil
+}
+
+func (s *CatalogGRPCServer) AnswerProductQuestion(
+	ctx context.Context,
+	req *catalogv1.AnswerProductQuestionRequest,
+) (*catalogv1.AnswerProductQuestionResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	answer, err := s.catalogService.AnswerProductQuestion(ctx, userID, req.ProductId, req.QuestionId, req.Text)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.AnswerProductQuestionResponse{Answer: toProtoAnswer(answer)}, nil
+}
+
+func (s *CatalogGRPCServer) ModerateProductQuestion(
+	ctx context.Context,
+	req *catalogv1.ModerateProductQuestionRequest,
+) (*catalogv1.ModerateProductQuestionResponse, error) {
+	question, err := s.catalogService.ModerateProductQuestion(ctx, req.ProductId, req.Id, req.Status, req.ModerationNote)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.ModerateProductQuestionResponse{Question: toProtoQuestion(question)}, nil
+}
+
+func (s *CatalogGRPCServer) DeleteProductQuestion(
+	ctx context.Context,
+	req *catalogv1.DeleteProductQuestionRequest,
+) (*catalogv1.DeleteProductQuestionResponse, error) {
+	if err := s.catalogService.DeleteProductQuestion(ctx, req.ProductId, req.Id); err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.DeleteProductQuestionResponse{Success: true}, nil
+}
+
+func (s *CatalogGRPCServer) ModerateProductAnswer(
+	ctx context.Context,
+	req *catalogv1.ModerateProductAnswerRequest,
+) (*catalogv1.ModerateProductAnswerResponse, error) {
+	answer, err := s.catalogService.ModerateProductAnswer(
+		ctx, req.ProductId, req.QuestionId, req.Id, req.Status, req.ModerationNote,
+	)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.ModerateProductAnswerResponse{Answer: toProtoAnswer(answer)}, nil
+}
+
+func (s *CatalogGRPCServer) DeleteProductAnswer(
+	ctx context.Context,
+	req *catalogv1.DeleteProductAnswerRequest,
+) (*catalogv1.DeleteProductAnswerResponse, error) {
+	if err := s.catalogService.DeleteProductAnswer(ctx, req.ProductId, req.QuestionId, req.Id); err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.DeleteProductAnswerResponse{Success: true}, nil
+}
+
+func toProtoQuestions(questions []domain.ProductQuestion) []*catalogv1.Question {
+	out := make([]*catalogv1.Question, 0, len(questions))
+	for i := range questions {
+		out = append(out, toProtoQuestion(&questions[i]))
+	}
+	return out
+}
+
+func toProtoQuestion(question *domain.ProductQuestion) *catalogv1.Question {
+	out := &catalogv1.Question{
+		Id:             question.ID,
+		ProductId:      question.ProductID,
+		UserId:         question.UserID,
+		Text:           question.Body,
+		Status:         question.Status,
+		ModerationNote: question.ModerationNote,
+		CreatedAt:      question.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:      question.UpdatedAt.UTC().Format(time.RFC3339),
+		Answers:        make([]*catalogv1.Answer, 0, len(question.Answers)),
+	}
+	if question.ModeratedAt != nil {
+		out.ModeratedAt = question.ModeratedAt.UTC().Format(time.RFC3339)
+	}
+	for i := range question.Answers {
+		out.Answers = append(out.Answers, toProtoAnswer(&question.Answers[i]))
+	}
+	return out
+}
+
+func toProtoAnswer(answer *domain.ProductAnswer) *catalogv1.Answer {
+	out := &catalogv1.Answer{
+		Id:             answer.ID,
+		QuestionId:     answer.QuestionID,
+		UserId:         answer.UserID,
+		Text:           answer.Body,
+		Status:         answer.Status,
+		ModerationNote: answer.ModerationNote,
+		CreatedAt:      answer.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:      answer.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if answer.ModeratedAt != nil {
+		out.ModeratedAt = answer.ModeratedAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
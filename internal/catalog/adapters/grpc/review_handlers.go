@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+	catalogv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/catalog/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *CatalogGRPCServer) ListProductReviews(
+	ctx context.Context,
+	req *catalogv1.ListProductReviewsRequest,
+) (*catalogv1.ListProductReviewsResponse, error) {
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+	page, pageSize := s.catalogService.NormalizePagination(
+		req.Page, req.PageSize, s.defaultPageSize, s.maxPageSize,
+	)
+	admin := authz.HasPermission(ctx, hiddenFeedbackPermission)
+	filter := domain.ProductReviewFilter{
+		ProductID: req.ProductId,
+		Page:      page,
+		PageSize:  pageSize,
+		PageToken: req.PageToken,
+	}
+	if admin {
+		filter.Status = req.Status
+	}
+	result, err := s.catalogService.ListProductReviews(ctx, filter, admin)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.ListProductReviewsResponse{
+		Reviews:       toProtoReviews(result.Reviews),
+		Total:         result.Total,
+		Page:          page,
+		PageSize:      pageSize,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (s *CatalogGRPCServer) CreateProductReview(
+	ctx context.Context,
+	req *catalogv1.CreateProductReviewRequest,
+) (*catalogv1.CreateProductReviewResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	if req.ProductId == "" {
+		return nil, status.Error(codes.InvalidArgument, "product id is required")
+	}
+	review, err := s.catalogService.CreateProductReview(ctx, userID, req.ProductId, domain.ProductReviewInput{
+		Rating: req.Rating,
+		Pros:   req.Pros,
+		Cons:   req.Cons,
+		Body:   req.Text,
+	})
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.CreateProductReviewResponse{Review: toProtoReview(review)}, nil
+}
+
+func (s *CatalogGRPCServer) ListReviews(
+	ctx context.Context,
+	req *catalogv1.ListReviewsRequest,
+) (*catalogv1.ListReviewsResponse, error) {
+	page, pageSize := s.catalogService.NormalizePagination(
+		req.Page, req.PageSize, s.defaultPageSize, s.maxPageSize,
+	)
+	result, err := s.catalogService.ListProductReviews(ctx, domain.ProductReviewFilter{
+		ProductID: req.ProductId,
+		UserID:    req.UserId,
+		Status:    req.Status,
+		Page:      page,
+		PageSize:  pageSize,
+		PageToken: req.PageToken,
+	}, true)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.ListReviewsResponse{
+		Reviews:       toProtoReviews(result.Reviews),
+		Total:         result.Total,
+		Page:          page,
+		PageSize:      pageSize,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (s *CatalogGRPCServer) ModerateReview(
+	ctx context.Context,
+	req *catalogv1.ModerateReviewRequest,
+) (*catalogv1.ModerateReviewResponse, error) {
+	review, err := s.catalogService.ModerateProductReview(ctx, req.Id, req.Status, req.ModerationNote)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.ModerateReviewResponse{Review: toProtoReview(review)}, nil
+}
+
+func (s *CatalogGRPCServer) DeleteReview(
+	ctx context.Context,
+	req *catalogv1.DeleteReviewRequest,
+) (*catalogv1.DeleteReviewResponse, error) {
+	if err := s.catalogService.DeleteProductReview(ctx, req.Id); err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &catalogv1.DeleteReviewResponse{Success: true}, nil
+}
+
+func toProtoReviews(reviews []domain.ProductReview) []*catalogv1.Review {
+	out := make([]*catalogv1.Review, 0, len(reviews))
+	for i := range reviews {
+		out = append(out, toProtoReview(&reviews[i]))
+	}
+	return out
+}
+
+func toProtoReview(review *domain.ProductReview) *catalogv1.Review {
+	out := &catalogv1.Review{
+		Id:             review.ID,
+		ProductId:      review.ProductID,
+		UserId:         review.UserID,
+		Rating:         review.Rating,
+		Pros:           review.Pros,
+		Cons:           review.Cons,
+		Text:           review.Body,
+		Status:         review.Status,
+		ModerationNote: review.ModerationNote,
+		CreatedAt:      review.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:      review.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if review.ModeratedAt != nil {
+		out.ModeratedAt = review.ModeratedAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
@@ -14,22 +14,30 @@ func (s *CatalogGRPCServer) ListSuppliers(
 	ctx context.Context,
 	req *catalogv1.ListSuppliersRequest,
 ) (*catalogv1.ListSuppliersResponse, error) {
-	//if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-	//	return nil, mapServiceError(err)
-	//}
+	page, pageSize := s.catalogService.NormalizePagination(
+		req.Page, req.PageSize, s.defaultPageSize, s.maxPageSize,
+	)
 
-	result, err := s.catalogService.ListSuppliers(ctx)
+	result, err := s.catalogService.ListSuppliers(ctx, domain.SupplierListFilter{
+		Page:      page,
+		PageSize:  pageSize,
+		PageToken: req.PageToken,
+	})
 	if err != nil {
 		return nil, mapServiceError(err)
 	}
 
-	suppliers := make([]*catalogv1.Supplier, 0, len(result))
-	for i := range result {
-		suppliers = append(suppliers, toProtoSupplier(&result[i]))
+	suppliers := make([]*catalogv1.Supplier, 0, len(result.Suppliers))
+	for i := range result.Suppliers {
+		suppliers = append(suppliers, toProtoSupplier(&result.Suppliers[i]))
 	}
 
 	return &catalogv1.ListSuppliersResponse{
-		Suppliers: suppliers,
+		Suppliers:     suppliers,
+		Total:         result.Total,
+		Page:          page,
+		PageSize:      pageSize,
+		NextPageToken: result.NextPageToken,
 	}, nil
 }
 
@@ -37,9 +45,6 @@ func (s *CatalogGRPCServer) GetSupplier(
 	ctx context.Context,
 	req *catalogv1.GetSupplierRequest,
 ) (*catalogv1.GetSupplierResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.Id == 0 {
 		return nil, status.Error(codes.InvalidArgument, "supplier id is required")
 	}
@@ -54,9 +59,6 @@ func (s *CatalogGRPCServer) CreateSupplier(
 	ctx context.Context,
 	req *catalogv1.CreateSupplierRequest,
 ) (*catalogv1.CreateSupplierResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.Name == "" {
 		return nil, status.Error(codes.InvalidArgument, "supplier name is required")
 	}
@@ -71,9 +73,6 @@ func (s *CatalogGRPCServer) UpdateSupplier(
 	ctx context.Context,
 	req *catalogv1.UpdateSupplierRequest,
 ) (*catalogv1.UpdateSupplierResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.Id == 0 {
 		return nil, status.Error(codes.InvalidArgument, "supplier id is required")
 	}
@@ -91,9 +90,6 @@ func (s *CatalogGRPCServer) DeleteSupplier(
 	ctx context.Context,
 	req *catalogv1.DeleteSupplierRequest,
 ) (*catalogv1.DeleteSupplierResponse, error) {
-	if err := requireAdmin(ctx, s.jwtSecret); err != nil {
-		return nil, mapServiceError(err)
-	}
 	if req.Id == 0 {
 		return nil, status.Error(codes.InvalidArgument, "supplier id is required")
 	}
@@ -0,0 +1,268 @@
+package services
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+func (s *catalogService) GetBundle(
+	ctx context.Context,
+	productID string,
+	adminAccess bool,
+) (*domain.BundleDetails, error) {
+	product, err := s.products.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if product.Kind != domain.ProductKindBundle {
+		return nil, domain.ErrBundleNotFound
+	}
+	if !adminAccess && !product.IsActive {
+		return nil, domain.ErrProductNotFound
+	}
+
+	bundle, err := s.bundles.GetByProductID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(bundle.Items))
+	for _, item := range bundle.Items {
+		ids = append(ids, item.ComponentProductID)
+	}
+	components, err := s.products.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.BundleDetails{Product: *product, Bundle: *bundle, Components: components}, nil
+}
+
+func (s *catalogService) CreateBundle(
+	ctx context.Context,
+	input domain.BundleInput,
+) (*domain.BundleDetails, error) {
+	if err := s.validateBundleInput(ctx, "", &input); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	product := &domain.Product{
+		ID:          uuid.NewString(),
+		CategoryID:  stringPtrOrNil(input.CategoryID),
+		BrandID:     stringPtrOrNil(input.BrandID),
+		Kind:        domain.ProductKindBundle,
+		Name:        input.Name,
+		Description: input.Description,
+		PriceCents:  input.PriceCents,
+		SKU:         stringPtrOrNil(strings.TrimSpace(input.SKU)),
+		IsActive:    input.IsActive,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	bundle := newBundle(product.ID, input, now)
+	bundle.CreatedAt = now
+
+	if err := s.bundles.Create(ctx, product, bundle); err != nil {
+		return nil, err
+	}
+	return s.GetBundle(ctx, product.ID, true)
+}
+
+func (s *catalogService) UpdateBundle(
+	ctx context.Context,
+	productID string,
+	input domain.BundleInput,
+) (*domain.BundleDetails, error) {
+	existing, err := s.products.GetByID(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.Kind != domain.ProductKindBundle {
+		return nil, domain.ErrBundleNotFound
+	}
+	if err := s.validateBundleInput(ctx, productID, &input); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	product := &domain.Product{
+		ID:          productID,
+		CategoryID:  stringPtrOrNil(input.CategoryID),
+		BrandID:     stringPtrOrNil(input.BrandID),
+		Name:        input.Name,
+		Description: input.Description,
+		PriceCents:  input.PriceCents,
+		SKU:         stringPtrOrNil(strings.TrimSpace(input.SKU)),
+		IsActive:    input.IsActive,
+		UpdatedAt:   now,
+	}
+	if err := s.bundles.Update(ctx, product, newBundle(productID, input, now)); err != nil {
+		return nil, err
+	}
+	return s.GetBundle(ctx, productID, true)
+}
+
+func (s *catalogService) ReserveStock(ctx context.Context, lines []domain.StockLine) error {
+	expanded, err := s.expandStockLines(ctx, lines)
+	if err != nil {
+		return err
+	}
+	return s.products.ReserveStock(ctx, expanded)
+}
+
+func (s *catalogService) CommitStock(ctx context.Context, lines []domain.StockLine) error {
+	expanded, err := s.expandStockLines(ctx, lines)
+	if err != nil {
+		return err
+	}
+	// Popularity counts what customers ordered, so a bundle sale counts for
+	// the bundle rather than its components.
+	return s.products.CommitStock(ctx, expanded, lines)
+}
+
+func (s *catalogService) ReleaseStock(ctx context.Context, lines []domain.StockLine) error {
+	expanded, err := s.expandStockLines(ctx, lines)
+	if err != nil {
+		return err
+	}
+	return s.products.ReleaseStock(ctx, expanded)
+}
+
+func (s *catalogService) validateBundleInput(
+	ctx context.Context,
+	bundleID string,
+	input *domain.BundleInput,
+) error {
+	input.Name = strings.TrimSpace(input.Name)
+	if input.Name == "" || len(input.Items) == 0 {
+		return domain.ErrInvalidArgument
+	}
+	switch input.PricingMode {
+	case domain.BundlePricingFixed:
+		if input.PriceCents < 0 {
+			return domain.ErrInvalidArgument
+		}
+		input.DiscountPercent = 0
+	case domain.BundlePricingDiscount:
+		if input.DiscountPercent < 0 || input.DiscountPercent > 100 {
+			return domain.ErrInvalidArgument
+		}
+		// Derived from the components when the bundle is saved.
+		input.PriceCents = 0
+	default:
+		return domain.ErrInvalidArgument
+	}
+
+	ids := make([]string, 0, len(input.Items))
+	seen := make(map[string]struct{}, len(input.Items))
+	for i := range input.Items {
+		item := &input.Items[i]
+		item.ProductID = strings.TrimSpace(item.ProductID)
+		if item.ProductID == "" || item.ProductID == bundleID || item.Quantity <= 0 {
+			return domain.ErrInvalidArgument
+		}
+		if _, ok := seen[item.ProductID]; ok {
+			return domain.ErrInvalidArgument
+		}
+		seen[item.ProductID] = struct{}{}
+		ids = append(ids, item.ProductID)
+	}
+	components, err := s.products.ListByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if len(components) != len(ids) {
+		return domain.ErrProductNotFound
+	}
+	for _, component := range components {
+		// Nested bundles would make stock reservations recursive.
+		if component.Kind != domain.ProductKindSimple {
+			return domain.ErrInvalidArgument
+		}
+	}
+
+	if input.CategoryID != "" {
+		if _, err := s.categories.GetByID(ctx, input.CategoryID); err != nil {
+			return err
+		}
+	}
+	return s.validateBrandID(ctx, input.BrandID)
+}
+
+func newBundle(productID string, input domain.BundleInput, now time.Time) *domain.Bundle {
+	bundle := &domain.Bundle{
+		ProductID:       productID,
+		PricingMode:     input.PricingMode,
+		DiscountPercent: input.DiscountPercent,
+		UpdatedAt:       now,
+		Items:           make([]domain.BundleItem, 0, len(input.Items)),
+	}
+	for i, item := range input.Items {
+		bundle.Items = append(bundle.Items, domain.BundleItem{
+			BundleID:           productID,
+			ComponentProductID: item.ProductID,
+			Quantity:           item.Quantity,
+			SortOrder:          int32(i),
+		})
+	}
+	return bundle
+}
+
+// expandStockLines replaces bundle lines with their components, merges lines
+// for the same product and sorts them by product id.
+func (s *catalogService) expandStockLines(
+	ctx context.Context,
+	lines []domain.StockLine,
+) ([]domain.StockLine, error) {
+	if len(lines) == 0 {
+		return nil, domain.ErrInvalidArgument
+	}
+	quantities := make(map[string]int32, len(lines))
+	ids := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line.ProductID == "" || line.Quantity <= 0 {
+			return nil, domain.ErrInvalidArgument
+		}
+		if _, ok := quantities[line.ProductID]; !ok {
+			ids = append(ids, line.ProductID)
+		}
+		quantities[line.ProductID] += line.Quantity
+	}
+
+	products, err := s.products.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	if len(products) != len(ids) {
+		return nil, domain.ErrProductNotFound
+	}
+
+	expanded := make(map[string]int32, len(ids))
+	bundleIDs := make([]string, 0)
+	for _, product := range products {
+		if product.Kind == domain.ProductKindBundle {
+			bundleIDs = append(bundleIDs, product.ID)
+			continue
+		}
+		expanded[product.ID] += quantities[product.ID]
+	}
+	items, err := s.bundles.ListItemsByBundleIDs(ctx, bundleIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		expanded[item.ComponentProductID] += item.Quantity * quantities[item.BundleID]
+	}
+
+	out := make([]domain.StockLine, 0, len(expanded))
+	for productID, quantity := range expanded {
+		out = append(out, domain.StockLine{ProductID: productID, Quantity: quantity})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ProductID < out[j].ProductID })
+	return out, nil
+}
@@ -0,0 +1,277 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+// newBundleCatalog returns a catalog with two simple products and a bundle
+// of two amplifiers and one cable.
+func newBundleCatalog() (*catalogService, *memProducts, *memBundles) {
+	products := newMemProducts(
+		domain.Product{ID: "amp", Kind: domain.ProductKindSimple, Name: "Amplifier", PriceCents: 10000, Stock: 9, IsActive: true},
+		domain.Product{ID: "cable", Kind: domain.ProductKindSimple, Name: "Cable", PriceCents: 500, Stock: 40, IsActive: true},
+		domain.Product{ID: "kit", Kind: domain.ProductKindBundle, Name: "Amplifier kit", IsActive: true},
+	)
+	bundles := newMemBundles(products, domain.Bundle{
+		ProductID:       "kit",
+		PricingMode:     domain.BundlePricingDiscount,
+		DiscountPercent: 10,
+		Items: []domain.BundleItem{
+			{BundleID: "kit", ComponentProductID: "amp", Quantity: 2},
+			{BundleID: "kit", ComponentProductID: "cable", Quantity: 1, SortOrder: 1},
+		},
+	})
+	return &catalogService{products: products, bundles: bundles}, products, bundles
+}
+
+func TestCatalogServiceStockCallsExpandBundles(t *testing.T) {
+	ctx := context.Background()
+	lines := []domain.StockLine{
+		{ProductID: "kit", Quantity: 3},
+		{ProductID: "amp", Quantity: 1},
+		{ProductID: "kit", Quantity: 1},
+	}
+	// Four kits and a loose amplifier, merged and sorted by product id.
+	want := []domain.StockLine{
+		{ProductID: "amp", Quantity: 9},
+		{ProductID: "cable", Quantity: 4},
+	}
+
+	tests := []struct {
+		name string
+		call func(s *catalogService) error
+		got  func(p *memProducts) []domain.StockLine
+	}{
+		{
+			name: "reserve",
+			call: func(s *catalogService) error { return s.ReserveStock(ctx, lines) },
+			got:  func(p *memProducts) []domain.StockLine { return p.reserved[0] },
+		},
+		{
+			name: "commit",
+			call: func(s *catalogService) error { return s.CommitStock(ctx, lines) },
+			got:  func(p *memProducts) []domain.StockLine { return p.commits[0].lines },
+		},
+		{
+			name: "release",
+			call: func(s *catalogService) error { return s.ReleaseStock(ctx, lines) },
+			got:  func(p *memProducts) []domain.StockLine { return p.released[0] },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, products, _ := newBundleCatalog()
+			if err := tt.call(svc); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := tt.got(products); !reflect.DeepEqual(got, want) {
+				t.Fatalf("expected component lines %v, got %v", want, got)
+			}
+		})
+	}
+}
+
+func TestCatalogServiceCommitStockCountsBundlesAsSold(t *testing.T) {
+	svc, products, _ := newBundleCatalog()
+	lines := []domain.StockLine{{ProductID: "kit", Quantity: 2}}
+
+	if err := svc.CommitStock(context.Background(), lines); err != nil {
+		t.Fatalf("CommitStock returned error: %v", err)
+	}
+	if got := products.commits[0].sold; !reflect.DeepEqual(got, lines) {
+		t.Fatalf("expected the ordered lines to count as sold, got %v", got)
+	}
+}
+
+func TestCatalogServiceRejectsInvalidStockLines(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []domain.StockLine
+		want  error
+	}{
+		{"no lines", nil, domain.ErrInvalidArgument},
+		{"no product", []domain.StockLine{{Quantity: 1}}, domain.ErrInvalidArgument},
+		{"zero quantity", []domain.StockLine{{ProductID: "amp"}}, domain.ErrInvalidArgument},
+		{"negative quantity", []domain.StockLine{{ProductID: "kit", Quantity: -1}}, domain.ErrInvalidArgument},
+		{"unknown product", []domain.StockLine{{ProductID: "amp", Quantity: 1}, {ProductID: "gone", Quantity: 1}}, domain.ErrProductNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, products, _ := newBundleCatalog()
+			if err := svc.ReserveStock(context.Background(), tt.lines); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+			if len(products.reserved) != 0 {
+				t.Fatalf("expected nothing to be reserved, got %v", products.reserved)
+			}
+		})
+	}
+}
+
+func TestCatalogServiceRefreshesBundlesWhenComponentsChange(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		call func(s *catalogService) error
+	}{
+		{
+			name: "product update",
+			call: func(s *catalogService) error {
+				_, err := s.UpdateProduct(ctx, "amp", "", "", "Amplifier", "", 0, 12000, "", 0, true)
+				return err
+			},
+		},
+		{
+			name: "price update",
+			call: func(s *catalogService) error {
+				_, err := s.UpdateProductPrice(ctx, "amp", 12000)
+				return err
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, bundles := newBundleCatalog()
+			if err := tt.call(svc); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if want := [][]string{{"amp"}}; !reflect.DeepEqual(bundles.refreshed, want) {
+				t.Fatalf("expected bundles of the component to be refreshed, got %v", bundles.refreshed)
+			}
+		})
+	}
+}
+
+func TestCatalogServiceCreateBundlePricing(t *testing.T) {
+	tests := []struct {
+		name         string
+		mode         string
+		price        int64
+		discount     int32
+		wantPrice    int64
+		wantDiscount int32
+	}{
+		// The price of a discounted bundle is derived from the components
+		// when it is saved; whatever the client sent is dropped.
+		{"discount", domain.BundlePricingDiscount, 99999, 15, 0, 15},
+		{"fixed", domain.BundlePricingFixed, 18000, 15, 18000, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _ := newBundleCatalog()
+			details, err := svc.CreateBundle(context.Background(), domain.BundleInput{
+				Name:            "  Starter kit ",
+				PricingMode:     tt.mode,
+				PriceCents:      tt.price,
+				DiscountPercent: tt.discount,
+				IsActive:        true,
+				Items: []domain.BundleItemInput{
+					{ProductID: " cable ", Quantity: 2},
+					{ProductID: "amp", Quantity: 1},
+				},
+			})
+			if err != nil {
+				t.Fatalf("CreateBundle returned error: %v", err)
+			}
+			if details.Product.Kind != domain.ProductKindBundle || details.Product.Name != "Starter kit" {
+				t.Fatalf("unexpected bundle product %+v", details.Product)
+			}
+			if details.Product.PriceCents != tt.wantPrice || details.Bundle.DiscountPercent != tt.wantDiscount {
+				t.Fatalf("expected price %d and discount %d, got %d and %d",
+					tt.wantPrice, tt.wantDiscount, details.Product.PriceCents, details.Bundle.DiscountPercent)
+			}
+			want := []domain.BundleItem{
+				{BundleID: details.Product.ID, ComponentProductID: "cable", Quantity: 2},
+				{BundleID: details.Product.ID, ComponentProductID: "amp", Quantity: 1, SortOrder: 1},
+			}
+			if !reflect.DeepEqual(details.Bundle.Items, want) {
+				t.Fatalf("expected items %v, got %v", want, details.Bundle.Items)
+			}
+			if len(details.Components) != 2 {
+				t.Fatalf("expected both components, got %v", details.Components)
+			}
+		})
+	}
+}
+
+func TestCatalogServiceValidatesBundleInput(t *testing.T) {
+	valid := func() domain.BundleInput {
+		return domain.BundleInput{
+			Name:        "Starter kit",
+			PricingMode: domain.BundlePricingFixed,
+			PriceCents:  18000,
+			Items:       []domain.BundleItemInput{{ProductID: "amp", Quantity: 1}},
+		}
+	}
+	tests := []struct {
+		name   string
+		mutate func(*domain.BundleInput)
+		want   error
+	}{
+		{"blank name", func(in *domain.BundleInput) { in.Name = "  " }, domain.ErrInvalidArgument},
+		{"no items", func(in *domain.BundleInput) { in.Items = nil }, domain.ErrInvalidArgument},
+		{"unknown pricing", func(in *domain.BundleInput) { in.PricingMode = "free" }, domain.ErrInvalidArgument},
+		{"negative price", func(in *domain.BundleInput) { in.PriceCents = -1 }, domain.ErrInvalidArgument},
+		{"discount over 100", func(in *domain.BundleInput) {
+			in.PricingMode = domain.BundlePricingDiscount
+			in.DiscountPercent = 101
+		}, domain.ErrInvalidArgument},
+		{"zero quantity", func(in *domain.BundleInput) { in.Items[0].Quantity = 0 }, domain.ErrInvalidArgument},
+		{"duplicate component", func(in *domain.BundleInput) {
+			in.Items = append(in.Items, domain.BundleItemInput{ProductID: " amp", Quantity: 2})
+		}, domain.ErrInvalidArgument},
+		{"nested bundle", func(in *domain.BundleInput) { in.Items[0].ProductID = "kit" }, domain.ErrInvalidArgument},
+		{"unknown component", func(in *domain.BundleInput) { in.Items[0].ProductID = "gone" }, domain.ErrProductNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, products, _ := newBundleCatalog()
+			input := valid()
+			tt.mutate(&input)
+			if _, err := svc.CreateBundle(context.Background(), input); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+			if len(products.products) != 3 {
+				t.Fatal("expected no bundle to be created")
+			}
+		})
+	}
+}
+
+func TestCatalogServiceUpdateBundleRejectsItself(t *testing.T) {
+	svc, _, _ := newBundleCatalog()
+	_, err := svc.UpdateBundle(context.Background(), "kit", domain.BundleInput{
+		Name:        "Amplifier kit",
+		PricingMode: domain.BundlePricingFixed,
+		Items: []domain.BundleItemInput{
+			{ProductID: "amp", Quantity: 1},
+			{ProductID: "kit", Quantity: 1},
+		},
+	})
+	if !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument, got %v", err)
+	}
+
+	if _, err := svc.UpdateBundle(context.Background(), "amp", domain.BundleInput{}); !errors.Is(err, domain.ErrBundleNotFound) {
+		t.Fatalf("expected a simple product to be refused, got %v", err)
+	}
+}
+
+func TestCatalogServiceDeleteProductKeepsBundleComponents(t *testing.T) {
+	svc, products, _ := newBundleCatalog()
+
+	if err := svc.DeleteProduct(context.Background(), "cable"); !errors.Is(err, domain.ErrProductInBundle) {
+		t.Fatalf("expected ErrProductInBundle, got %v", err)
+	}
+	if _, ok := products.products["cable"]; !ok {
+		t.Fatal("expected the component to be kept")
+	}
+	if err := svc.DeleteProduct(context.Background(), "kit"); err != nil {
+		t.Fatalf("expected the bundle itself to be deletable, got %v", err)
+	}
+}
@@ -11,7 +11,7 @@ import (
 )
 
 type CatalogService interface {
-	ListSuppliers(ctx context.Context) ([]domain.Supplier, error)
+	ListSuppliers(ctx context.Context, filter domain.SupplierListFilter) (*domain.SupplierListResult, error)
 	GetSupplier(ctx context.Context, id int64) (*domain.Supplier, error)
 	CreateSupplier(ctx context.Context, input domain.SupplierInput) (*domain.Supplier, error)
 	UpdateSupplier(ctx context.Context, id int64, input domain.SupplierInput) (*domain.Supplier, error)
@@ -44,6 +44,9 @@ type CatalogService interface {
 		stock int32,
 		isActive bool,
 	) (*domain.Product, error)
+	// UpdateProductPrice changes only the price, for roles that may not
+	// edit the rest of the product.
+	UpdateProductPrice(ctx context.Context, id string, priceCents int64) (*domain.Product, error)
 	DeleteProduct(ctx context.Context, id string) error
 
 	ListProductImages(ctx context.Context, productID string, adminAccess bool) ([]domain.ProductImage, error)
@@ -65,13 +68,45 @@ type CatalogService interface {
 	UpdateProductAttribute(ctx context.Context, productID, attrID string, input domain.ProductAttributeInput) (*domain.ProductAttribute, error)
 	DeleteProductAttribute(ctx context.Context, productID, attrID string) error
 
-	ListSupplierCategoryMappings(ctx context.Context, filter domain.SupplierCategoryMappingFilter) ([]domain.SupplierCategoryMapping, error)
+	ListProductRelations(ctx context.Context, productID string, adminAccess bool) ([]domain.ProductRelation, error)
+	ListRelatedProducts(ctx context.Context, productID string, adminAccess bool) ([]domain.RelatedProduct, error)
+	CreateProductRelation(ctx context.Context, productID string, input domain.ProductRelationInput) (*domain.ProductRelation, error)
+	UpdateProductRelation(ctx context.Context, productID, relationID string, input domain.ProductRelationInput) (*domain.ProductRelation, error)
+	DeleteProductRelation(ctx context.Context, productID, relationID string, bidirectional bool) error
+
+	GetBundle(ctx context.Context, productID string, adminAccess bool) (*domain.BundleDetails, error)
+	CreateBundle(ctx context.Context, input domain.BundleInput) (*domain.BundleDetails, error)
+	UpdateBundle(ctx context.Context, productID string, input domain.BundleInput) (*domain.BundleDetails, error)
+
+	ReserveStock(ctx context.Context, lines []domain.StockLine) error
+	CommitStock(ctx context.Context, lines []domain.StockLine) error
+	ReleaseStock(ctx context.Context, lines []domain.StockLine) error
+
+	ListProductReviews(ctx context.Context, filter domain.ProductReviewFilter, adminAccess bool) (*domain.ProductReviewListResult, error)
+	CreateProductReview(ctx context.Context, userID, productID string, input domain.ProductReviewInput) (*domain.ProductReview, error)
+	ModerateProductReview(ctx context.Context, id, status, note string) (*domain.ProductReview, error)
+	DeleteProductReview(ctx context.Context, id string) error
+
+	ListProductQuestions(ctx context.Context, filter domain.ProductQuestionFilter, adminAccess bool) (*domain.ProductQuestionListResult, error)
+	AskProductQuestion(ctx context.Context, userID, productID, body string) (*domain.ProductQuestion, error)
+	AnswerProductQuestion(ctx context.Context, userID, productID, questionID, body string) (*domain.ProductAnswer, error)
+	ModerateProductQuestion(ctx context.Context, productID, questionID, status, note string) (*domain.ProductQuestion, error)
+	ModerateProductAnswer(ctx context.Context, productID, questionID, answerID, status, note string) (*domain.ProductAnswer, error)
+	DeleteProductQuestion(ctx context.Context, productID, questionID string) error
+	DeleteProductAnswer(ctx context.Context, productID, questionID, answerID string) error
+
+	ListProductListItems(ctx context.Context, userID, listType string) ([]domain.ProductListEntry, error)
+	AddProductListItem(ctx context.Context, userID, listType, productID string) (*domain.ProductListItem, error)
+	RemoveProductListItem(ctx context.Context, userID, listType, productID string) error
+	CompareProducts(ctx context.Context, userID string, productIDs []string) (*domain.ProductComparison, error)
+
+	ListSupplierCategoryMappings(ctx context.Context, filter domain.SupplierCategoryMappingFilter) (*domain.SupplierCategoryMappingListResult, error)
 	GetSupplierCategoryMapping(ctx context.Context, id string) (*domain.SupplierCategoryMapping, error)
 	CreateSupplierCategoryMapping(ctx context.Context, input domain.SupplierCategoryMappingInput) (*domain.SupplierCategoryMapping, error)
 	UpdateSupplierCategoryMapping(ctx context.Context, id string, input domain.SupplierCategoryMappingInput) (*domain.SupplierCategoryMapping, error)
 	DeleteSupplierCategoryMapping(ctx context.Context, id string) error
 
-	ListSupplierProductMappings(ctx context.Context, filter domain.SupplierProductMappingFilter) ([]domain.SupplierProductMapping, error)
+	ListSupplierProductMappings(ctx context.Context, filter domain.SupplierProductMappingFilter) (*domain.SupplierProductMappingListResult, error)
 	GetSupplierProductMapping(ctx context.Context, id string) (*domain.SupplierProductMapping, error)
 	CreateSupplierProductMapping(ctx context.Context, input domain.SupplierProductMappingInput) (*domain.SupplierProductMapping, error)
 	UpdateSupplierProductMapping(ctx context.Context, id string, input domain.SupplierProductMappingInput) (*domain.SupplierProductMapping, error)
@@ -89,6 +124,12 @@ type catalogService struct {
 	productAttributes postgres.ProductAttributeRepository
 	categoryMappings  postgres.SupplierCategoryMappingRepository
 	productMappings   postgres.SupplierProductMappingRepository
+	productRelations  postgres.ProductRelationRepository
+	bundles           postgres.BundleRepository
+	productReviews    postgres.ProductReviewRepository
+	productQuestions  postgres.ProductQuestionRepository
+	questionNotifier  QuestionNotifier
+	productLists      postgres.ProductListRepository
 }
 
 func NewCatalogService(
@@ -100,6 +141,12 @@ func NewCatalogService(
 	productAttributes postgres.ProductAttributeRepository,
 	categoryMappings postgres.SupplierCategoryMappingRepository,
 	productMappings postgres.SupplierProductMappingRepository,
+	productRelations postgres.ProductRelationRepository,
+	bundles postgres.BundleRepository,
+	productReviews postgres.ProductReviewRepository,
+	productQuestions postgres.ProductQuestionRepository,
+	questionNotifier QuestionNotifier,
+	productLists postgres.ProductListRepository,
 ) CatalogService {
 	return &catalogService{
 		suppliers:         suppliers,
@@ -110,6 +157,12 @@ func NewCatalogService(
 		productAttributes: productAttributes,
 		categoryMappings:  categoryMappings,
 		productMappings:   productMappings,
+		productRelations:  productRelations,
+		bundles:           bundles,
+		productReviews:    productReviews,
+		productQuestions:  productQuestions,
+		questionNotifier:  questionNotifier,
+		productLists:      productLists,
 	}
 }
 
@@ -257,6 +310,7 @@ func (s *catalogService) CreateProduct(
 		CategoryID:  stringPtrOrNil(categoryID),
 		BrandID:     stringPtrOrNil(brandID),
 		SupplierID:  int64PtrOrNil(supplierID),
+		Kind:        domain.ProductKindSimple,
 		Name:        name,
 		Description: description,
 		PriceCents:  priceCents,
@@ -324,10 +378,35 @@ func (s *catalogService) UpdateProduct(
 	if err := s.products.Update(ctx, product); err != nil {
 		return nil, err
 	}
+	// Bundles built from this product (or the product itself, if it is a
+	// bundle) derive their stock and price from the components.
+	if err := s.bundles.Refresh(ctx, []string{id}); err != nil {
+		return nil, err
+	}
+	return s.products.GetByID(ctx, id)
+}
+
+func (s *catalogService) UpdateProductPrice(ctx context.Context, id string, priceCents int64) (*domain.Product, error) {
+	if priceCents < 0 {
+		return nil, domain.ErrInvalidArgument
+	}
+	if err := s.products.UpdatePrice(ctx, id, priceCents, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.bundles.Refresh(ctx, []string{id}); err != nil {
+		return nil, err
+	}
 	return s.products.GetByID(ctx, id)
 }
 
 func (s *catalogService) DeleteProduct(ctx context.Context, id string) error {
+	count, err := s.bundles.CountByComponent(ctx, id)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return domain.ErrProductInBundle
+	}
 	return s.products.Delete(ctx, id)
 }
 
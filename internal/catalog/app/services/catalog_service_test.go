@@ -0,0 +1,208 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+// memProducts keeps products in memory and records the stock calls it gets.
+type memProducts struct {
+	mu       sync.Mutex
+	products map[string]domain.Product
+	reserved [][]domain.StockLine
+	released [][]domain.StockLine
+	commits  []stockCommit
+}
+
+type stockCommit struct {
+	lines, sold []domain.StockLine
+}
+
+func newMemProducts(products ...domain.Product) *memProducts {
+	m := &memProducts{products: map[string]domain.Product{}}
+	for _, product := range products {
+		m.products[product.ID] = product
+	}
+	return m
+}
+
+func (m *memProducts) Create(_ context.Context, product *domain.Product) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.products[product.ID] = *product
+	return nil
+}
+
+func (m *memProducts) GetByID(_ context.Context, id string) (*domain.Product, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	product, ok := m.products[id]
+	if !ok {
+		return nil, domain.ErrProductNotFound
+	}
+	return &product, nil
+}
+
+func (m *memProducts) ListByIDs(_ context.Context, ids []string) ([]domain.Product, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var products []domain.Product
+	for _, id := range ids {
+		if product, ok := m.products[id]; ok {
+			products = append(products, product)
+		}
+	}
+	return products, nil
+}
+
+func (m *memProducts) List(_ context.Context, _ domain.ProductListFilter) (*domain.ProductListResult, error) {
+	return nil, errors.New("List is not supported")
+}
+
+func (m *memProducts) Update(_ context.Context, product *domain.Product) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	existing, ok := m.products[product.ID]
+	if !ok {
+		return domain.ErrProductNotFound
+	}
+	product.Kind = existing.Kind
+	product.CreatedAt = existing.CreatedAt
+	m.products[product.ID] = *product
+	return nil
+}
+
+func (m *memProducts) UpdatePrice(_ context.Context, id string, priceCents int64, updatedAt time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	product, ok := m.products[id]
+	if !ok {
+		return domain.ErrProductNotFound
+	}
+	product.PriceCents = priceCents
+	product.UpdatedAt = updatedAt
+	m.products[id] = product
+	return nil
+}
+
+func (m *memProducts) Delete(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.products[id]; !ok {
+		return domain.ErrProductNotFound
+	}
+	delete(m.products, id)
+	return nil
+}
+
+func (m *memProducts) CountBySupplier(_ context.Context, _ int64) (int64, error) {
+	return 0, nil
+}
+
+func (m *memProducts) CountByBrand(_ context.Context, _ string) (int64, error) {
+	return 0, nil
+}
+
+func (m *memProducts) ReserveStock(_ context.Context, lines []domain.StockLine) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.reserved = append(m.reserved, lines)
+	return nil
+}
+
+func (m *memProducts) CommitStock(_ context.Context, lines, sold []domain.StockLine) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.commits = append(m.commits, stockCommit{lines: lines, sold: sold})
+	return nil
+}
+
+func (m *memProducts) ReleaseStock(_ context.Context, lines []domain.StockLine) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.released = append(m.released, lines)
+	return nil
+}
+
+// memBundles keeps bundles in memory. Stock and price are derived by the
+// database, so Refresh only records which products it was asked about.
+type memBundles struct {
+	mu        sync.Mutex
+	bundles   map[string]domain.Bundle
+	products  *memProducts
+	refreshed [][]string
+}
+
+func newMemBundles(products *memProducts, bundles ...domain.Bundle) *memBundles {
+	m := &memBundles{bundles: map[string]domain.Bundle{}, products: products}
+	for _, bundle := range bundles {
+		m.bundles[bundle.ProductID] = bundle
+	}
+	return m
+}
+
+func (m *memBundles) Create(ctx context.Context, product *domain.Product, bundle *domain.Bundle) error {
+	if err := m.products.Create(ctx, product); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.bundles[bundle.ProductID] = *bundle
+	return nil
+}
+
+func (m *memBundles) GetByProductID(_ context.Context, productID string) (*domain.Bundle, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	bundle, ok := m.bundles[productID]
+	if !ok {
+		return nil, domain.ErrBundleNotFound
+	}
+	return &bundle, nil
+}
+
+func (m *memBundles) ListItemsByBundleIDs(_ context.Context, bundleIDs []string) ([]domain.BundleItem, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var items []domain.BundleItem
+	for _, id := range bundleIDs {
+		items = append(items, m.bundles[id].Items...)
+	}
+	return items, nil
+}
+
+func (m *memBundles) Update(ctx context.Context, product *domain.Product, bundle *domain.Bundle) error {
+	if err := m.products.Update(ctx, product); err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	bundle.CreatedAt = m.bundles[bundle.ProductID].CreatedAt
+	m.bundles[bundle.ProductID] = *bundle
+	return nil
+}
+
+func (m *memBundles) CountByComponent(_ context.Context, productID string) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var count int64
+	for _, bundle := range m.bundles {
+		for _, item := range bundle.Items {
+			if item.ComponentProductID == productID {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
+
+func (m *memBundles) Refresh(_ context.Context, productIDs []string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.refreshed = append(m.refreshed, productIDs)
+	return nil
+}
@@ -13,7 +13,7 @@ import (
 func (s *catalogService) ListSupplierCategoryMappings(
 	ctx context.Context,
 	filter domain.SupplierCategoryMappingFilter,
-) ([]domain.SupplierCategoryMapping, error) {
+) (*domain.SupplierCategoryMappingListResult, error) {
 	return s.categoryMappings.List(ctx, filter)
 }
 
@@ -83,7 +83,7 @@ func (s *catalogService) DeleteSupplierCategoryMapping(ctx context.Context, id s
 func (s *catalogService) ListSupplierProductMappings(
 	ctx context.Context,
 	filter domain.SupplierProductMappingFilter,
-) ([]domain.SupplierProductMapping, error) {
+) (*domain.SupplierProductMappingListResult, error) {
 	return s.productMappings.List(ctx, filter)
 }
 
@@ -0,0 +1,205 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+func (s *catalogService) ListProductListItems(
+	ctx context.Context,
+	userID, listType string,
+) ([]domain.ProductListEntry, error) {
+	if userID == "" || !domain.IsValidProductList(listType) {
+		return nil, domain.ErrInvalidArgument
+	}
+	items, err := s.productLists.List(ctx, userID, listType)
+	if err != nil {
+		return nil, err
+	}
+	// Products switched off after they were saved stay on the list so the
+	// customer can see they are no longer sold.
+	return s.loadProductListEntries(ctx, items, true)
+}
+
+func (s *catalogService) AddProductListItem(
+	ctx context.Context,
+	userID, listType, productID string,
+) (*domain.ProductListItem, error) {
+	if userID == "" || !domain.IsValidProductList(listType) {
+		return nil, domain.ErrInvalidArgument
+	}
+	if err := s.ensureProductVisible(ctx, productID, false); err != nil {
+		return nil, err
+	}
+	maxItems := 0
+	if listType == domain.ProductListCompare {
+		maxItems = domain.MaxCompareProducts
+	}
+
+	return s.productLists.Add(ctx, &domain.ProductListItem{
+		UserID:    userID,
+		ListType:  listType,
+		ProductID: productID,
+		CreatedAt: time.Now(),
+	}, maxItems)
+}
+
+func (s *catalogService) RemoveProductListItem(ctx context.Context, userID, listType, productID string) error {
+	if userID == "" || !domain.IsValidProductList(listType) {
+		return domain.ErrInvalidArgument
+	}
+	return s.productLists.Remove(ctx, userID, listType, productID)
+}
+
+// CompareProducts lines up the attributes of the given products, or of the
+// user's comparison list when no ids are given, into rows by attribute name.
+func (s *catalogService) CompareProducts(
+	ctx context.Context,
+	userID string,
+	productIDs []string,
+) (*domain.ProductComparison, error) {
+	var items []domain.ProductListItem
+	fromList := len(productIDs) == 0
+	if fromList {
+		if userID == "" {
+			return nil, domain.ErrInvalidArgument
+		}
+		saved, err := s.productLists.List(ctx, userID, domain.ProductListCompare)
+		if err != nil {
+			return nil, err
+		}
+		// The list is newest first; compare in the order products were added.
+		for i := len(saved) - 1; i >= 0; i-- {
+			items = append(items, saved[i])
+		}
+	} else {
+		seen := make(map[string]struct{}, len(productIDs))
+		for _, id := range productIDs {
+			id = strings.TrimSpace(id)
+			if _, ok := seen[id]; ok || id == "" {
+				continue
+			}
+			if uuid.Validate(id) != nil {
+				return nil, domain.ErrProductNotFound
+			}
+			seen[id] = struct{}{}
+			items = append(items, domain.ProductListItem{ProductID: id})
+		}
+	}
+	if len(items) > domain.MaxCompareProducts {
+		return nil, domain.ErrInvalidArgument
+	}
+
+	entries, err := s.loadProductListEntries(ctx, items, false)
+	if err != nil {
+		return nil, err
+	}
+	if !fromList && len(entries) != len(items) {
+		return nil, domain.ErrProductNotFound
+	}
+
+	ids := make([]string, 0, len(entries))
+	for i := range entries {
+		ids = append(ids, entries[i].Product.ID)
+	}
+	attrs, err := s.productAttributes.ListByProductIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ProductComparison{Products: entries, Rows: buildComparisonRows(ids, attrs)}, nil
+}
+
+// buildComparisonRows groups attributes by name, ignoring case and
+// surrounding spaces. Rows follow the attribute sort order; a product with
+// several values for one name gets them joined.
+func buildComparisonRows(productIDs []string, attrs []domain.ProductAttribute) []domain.ComparisonRow {
+	column := make(map[string]int, len(productIDs))
+	for i, id := range productIDs {
+		column[id] = i
+	}
+
+	var rows []domain.ComparisonRow
+	rowByKey := make(map[string]int)
+	for _, attr := range attrs {
+		col, ok := column[attr.ProductID]
+		if !ok {
+			continue
+		}
+		name := strings.TrimSpace(attr.Name)
+		key := strings.ToLower(name)
+		idx, ok := rowByKey[key]
+		if !ok {
+			idx = len(rows)
+			rowByKey[key] = idx
+			rows = append(rows, domain.ComparisonRow{Name: name, Values: make([]string, len(productIDs))})
+		}
+		value := strings.TrimSpace(attr.Value)
+		if current := rows[idx].Values[col]; current != "" {
+			value = current + ", " + value
+		}
+		rows[idx].Values[col] = value
+	}
+
+	for i := range rows {
+		for _, value := range rows[i].Values[1:] {
+			if !strings.EqualFold(value, rows[i].Values[0]) {
+				rows[i].Differs = true
+				break
+			}
+		}
+	}
+	return rows
+}
+
+// loadProductListEntries attaches products and their primary images to the
+// items, keeping the order of items and dropping products that no longer
+// exist. Inactive products are dropped unless includeInactive is set.
+func (s *catalogService) loadProductListEntries(
+	ctx context.Context,
+	items []domain.ProductListItem,
+	includeInactive bool,
+) ([]domain.ProductListEntry, error) {
+	if len(items) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ProductID)
+	}
+	products, err := s.products.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	images, err := s.productImages.ListPrimaryByProductIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	productsByID := make(map[string]domain.Product, len(products))
+	for _, product := range products {
+		productsByID[product.ID] = product
+	}
+	imagesByProductID := make(map[string]domain.ProductImage, len(images))
+	for _, image := range images {
+		imagesByProductID[image.ProductID] = image
+	}
+
+	entries := make([]domain.ProductListEntry, 0, len(items))
+	for _, item := range items {
+		product, ok := productsByID[item.ProductID]
+		if !ok || (!includeInactive && !product.IsActive) {
+			continue
+		}
+		entry := domain.ProductListEntry{Item: item, Product: product}
+		if image, ok := imagesByProductID[product.ID]; ok {
+			entry.PrimaryImage = &image
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
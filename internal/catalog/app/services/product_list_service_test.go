@@ -0,0 +1,104 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+func TestBuildComparisonRows(t *testing.T) {
+	attr := func(productID, name, value string) domain.ProductAttribute {
+		return domain.ProductAttribute{ProductID: productID, Name: name, Value: value}
+	}
+
+	tests := []struct {
+		name  string
+		attrs []domain.ProductAttribute
+		want  []domain.ComparisonRow
+	}{
+		{
+			name:  "no attributes",
+			attrs: nil,
+			want:  nil,
+		},
+		{
+			name: "names folded by case and spaces",
+			attrs: []domain.ProductAttribute{
+				attr("a", "Power", "50 W"),
+				attr("b", "  power ", "50 W"),
+				attr("c", "POWER", "75 W"),
+			},
+			want: []domain.ComparisonRow{
+				{Name: "Power", Values: []string{"50 W", "50 W", "75 W"}, Differs: true},
+			},
+		},
+		{
+			name: "values equal apart from case and spaces",
+			attrs: []domain.ProductAttribute{
+				attr("a", "Colour", "Black"),
+				attr("b", "Colour", " black "),
+				attr("c", "Colour", "BLACK"),
+			},
+			want: []domain.ComparisonRow{
+				{Name: "Colour", Values: []string{"Black", "black", "BLACK"}},
+			},
+		},
+		{
+			name: "duplicate names joined per product",
+			attrs: []domain.ProductAttribute{
+				attr("a", "Inputs", "USB"),
+				attr("a", "inputs", "AUX"),
+				attr("b", "Inputs", "USB"),
+				attr("c", "Inputs", "USB"),
+			},
+			want: []domain.ComparisonRow{
+				{Name: "Inputs", Values: []string{"USB, AUX", "USB", "USB"}, Differs: true},
+			},
+		},
+		{
+			name: "missing value differs",
+			attrs: []domain.ProductAttribute{
+				attr("a", "Bluetooth", "yes"),
+				attr("b", "Bluetooth", "yes"),
+			},
+			want: []domain.ComparisonRow{
+				{Name: "Bluetooth", Values: []string{"yes", "yes", ""}, Differs: true},
+			},
+		},
+		{
+			name: "rows keep the attribute order",
+			attrs: []domain.ProductAttribute{
+				attr("b", "Size", "1 DIN"),
+				attr("a", "Power", "50 W"),
+				attr("a", "Size", "1 DIN"),
+				attr("c", "Size", "1 DIN"),
+			},
+			want: []domain.ComparisonRow{
+				{Name: "Size", Values: []string{"1 DIN", "1 DIN", "1 DIN"}},
+				{Name: "Power", Values: []string{"50 W", "", ""}, Differs: true},
+			},
+		},
+		{
+			name: "attributes of other products ignored",
+			attrs: []domain.ProductAttribute{
+				attr("a", "Power", "50 W"),
+				attr("b", "Power", "50 W"),
+				attr("c", "Power", "50 W"),
+				attr("removed", "Power", "90 W"),
+				attr("removed", "Weight", "2 kg"),
+			},
+			want: []domain.ComparisonRow{
+				{Name: "Power", Values: []string{"50 W", "50 W", "50 W"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := buildComparisonRows([]string{"a", "b", "c"}, tt.attrs)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
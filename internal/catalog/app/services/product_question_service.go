@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+// QuestionNotifier is told about Q&A events that need a human: new questions
+// for the staff to answer, and published answers for the customer who asked.
+type QuestionNotifier interface {
+	QuestionCreated(ctx context.Context, question *domain.ProductQuestion) error
+	QuestionAnswered(ctx context.Context, question *domain.ProductQuestion, answer *domain.ProductAnswer) error
+}
+
+func (s *catalogService) ListProductQuestions(
+	ctx context.Context,
+	filter domain.ProductQuestionFilter,
+	adminAccess bool,
+) (*domain.ProductQuestionListResult, error) {
+	if filter.Status != "" && !domain.IsValidReviewStatus(filter.Status) {
+		return nil, domain.ErrInvalidArgument
+	}
+	answerStatus := ""
+	if !adminAccess {
+		if filter.ProductID == "" {
+			return nil, domain.ErrInvalidArgument
+		}
+		if err := s.ensureProductVisible(ctx, filter.ProductID, false); err != nil {
+			return nil, err
+		}
+		filter.Status = domain.ReviewStatusApproved
+		filter.AnsweredOnly = true
+		answerStatus = domain.ReviewStatusApproved
+	}
+
+	result, err := s.productQuestions.ListQuestions(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(result.Questions))
+	for i := range result.Questions {
+		ids = append(ids, result.Questions[i].ID)
+	}
+	answers, err := s.productQuestions.ListAnswersByQuestionIDs(ctx, ids, answerStatus)
+	if err != nil {
+		return nil, err
+	}
+	byQuestion := make(map[string][]domain.ProductAnswer, len(ids))
+	for _, answer := range answers {
+		byQuestion[answer.QuestionID] = append(byQuestion[answer.QuestionID], answer)
+	}
+	for i := range result.Questions {
+		result.Questions[i].Answers = byQuestion[result.Questions[i].ID]
+	}
+	return result, nil
+}
+
+func (s *catalogService) AskProductQuestion(
+	ctx context.Context,
+	userID, productID, body string,
+) (*domain.ProductQuestion, error) {
+	body = strings.TrimSpace(body)
+	if userID == "" || body == "" {
+		return nil, domain.ErrInvalidArgument
+	}
+	if err := s.ensureProductVisible(ctx, productID, false); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	question := &domain.ProductQuestion{
+		ID:        uuid.NewString(),
+		ProductID: productID,
+		UserID:    userID,
+		Body:      body,
+		Status:    domain.ReviewStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.productQuestions.CreateQuestion(ctx, question); err != nil {
+		return nil, err
+	}
+	if s.questionNotifier != nil {
+		if err := s.questionNotifier.QuestionCreated(ctx, question); err != nil {
+			slog.WarnContext(ctx, "failed to notify about new question", "question_id", question.ID, "error", err)
+		}
+	}
+	return question, nil
+}
+
+func (s *catalogService) AnswerProductQuestion(
+	ctx context.Context,
+	userID, productID, questionID, body string,
+) (*domain.ProductAnswer, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, domain.ErrInvalidArgument
+	}
+	if _, err := s.getProductQuestion(ctx, productID, questionID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	answer := &domain.ProductAnswer{
+		ID:         uuid.NewString(),
+		QuestionID: questionID,
+		UserID:     userID,
+		Body:       body,
+		Status:     domain.ReviewStatusPending,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if err := s.productQuestions.CreateAnswer(ctx, answer); err != nil {
+		return nil, err
+	}
+	return answer, nil
+}
+
+func (s *catalogService) ModerateProductQuestion(
+	ctx context.Context,
+	productID, questionID, status, note string,
+) (*domain.ProductQuestion, error) {
+	if !domain.IsValidReviewStatus(status) {
+		return nil, domain.ErrInvalidArgument
+	}
+	question, err := s.getProductQuestion(ctx, productID, questionID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	question.Status = status
+	question.ModerationNote = strings.TrimSpace(note)
+	question.ModeratedAt = &now
+	question.UpdatedAt = now
+	if err := s.productQuestions.UpdateQuestionStatus(ctx, question); err != nil {
+		return nil, err
+	}
+	return question, nil
+}
+
+func (s *catalogService) ModerateProductAnswer(
+	ctx context.Context,
+	productID, questionID, answerID, status, note string,
+) (*domain.ProductAnswer, error) {
+	if !domain.IsValidReviewStatus(status) {
+		return nil, domain.ErrInvalidArgument
+	}
+	question, err := s.getProductQuestion(ctx, productID, questionID)
+	if err != nil {
+		return nil, err
+	}
+	answer, err := s.productQuestions.GetAnswer(ctx, answerID)
+	if err != nil {
+		return nil, err
+	}
+	if answer.QuestionID != questionID {
+		return nil, domain.ErrProductAnswerNotFound
+	}
+
+	published := status == domain.ReviewStatusApproved && answer.Status != domain.ReviewStatusApproved
+	now := time.Now()
+	answer.Status = status
+	answer.ModerationNote = strings.TrimSpace(note)
+	answer.ModeratedAt = &now
+	answer.UpdatedAt = now
+	if err := s.productQuestions.UpdateAnswerStatus(ctx, answer); err != nil {
+		return nil, err
+	}
+	if published && s.questionNotifier != nil {
+		if err := s.questionNotifier.QuestionAnswered(ctx, question, answer); err != nil {
+			slog.WarnContext(ctx, "failed to notify about answered question", "question_id", question.ID, "error", err)
+		}
+	}
+	return answer, nil
+}
+
+func (s *catalogService) DeleteProductQuestion(ctx context.Context, productID, questionID string) error {
+	if _, err := s.getProductQuestion(ctx, productID, questionID); err != nil {
+		return err
+	}
+	return s.productQuestions.DeleteQuestion(ctx, questionID)
+}
+
+func (s *catalogService) DeleteProductAnswer(ctx context.Context, productID, questionID, answerID string) error {
+	if _, err := s.getProductQuestion(ctx, productID, questionID); err != nil {
+		return err
+	}
+	answer, err := s.productQuestions.GetAnswer(ctx, answerID)
+	if err != nil {
+		return err
+	}
+	if answer.QuestionID != questionID {
+		return domain.ErrProductAnswerNotFound
+	}
+	return s.productQuestions.DeleteAnswer(ctx, answerID)
+}
+
+func (s *catalogService) getProductQuestion(
+	ctx context.Context,
+	productID, questionID string,
+) (*domain.ProductQuestion, error) {
+	question, err := s.productQuestions.GetQuestion(ctx, questionID)
+	if err != nil {
+		return nil, err
+	}
+	if question.ProductID != productID {
+		return nil, domain.ErrProductQuestionNotFound
+	}
+	return question, nil
+}
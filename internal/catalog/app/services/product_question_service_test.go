@@ -0,0 +1,328 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+// memQuestions keeps questions and answers in memory. ListQuestions applies
+// the status and AnsweredOnly filters like the postgres repository.
+type memQuestions struct {
+	mu        sync.Mutex
+	questions map[string]domain.ProductQuestion
+	answers   map[string]domain.ProductAnswer
+	filters   []domain.ProductQuestionFilter
+}
+
+func newMemQuestions() *memQuestions {
+	return &memQuestions{questions: map[string]domain.ProductQuestion{}, answers: map[string]domain.ProductAnswer{}}
+}
+
+func (m *memQuestions) CreateQuestion(_ context.Context, question *domain.ProductQuestion) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.questions[question.ID] = *question
+	return nil
+}
+
+func (m *memQuestions) GetQuestion(_ context.Context, id string) (*domain.ProductQuestion, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	question, ok := m.questions[id]
+	if !ok {
+		return nil, domain.ErrProductQuestionNotFound
+	}
+	return &question, nil
+}
+
+func (m *memQuestions) ListQuestions(
+	_ context.Context,
+	filter domain.ProductQuestionFilter,
+) (*domain.ProductQuestionListResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.filters = append(m.filters, filter)
+	result := &domain.ProductQuestionListResult{}
+	for _, question := range m.questions {
+		if filter.ProductID != "" && question.ProductID != filter.ProductID {
+			continue
+		}
+		if filter.Status != "" && question.Status != filter.Status {
+			continue
+		}
+		if filter.AnsweredOnly && !m.hasApprovedAnswer(question.ID) {
+			continue
+		}
+		result.Questions = append(result.Questions, question)
+	}
+	result.Total = int32(len(result.Questions))
+	return result, nil
+}
+
+func (m *memQuestions) hasApprovedAnswer(questionID string) bool {
+	for _, answer := range m.answers {
+		if answer.QuestionID == questionID && answer.Status == domain.ReviewStatusApproved {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *memQuestions) UpdateQuestionStatus(_ context.Context, question *domain.ProductQuestion) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.questions[question.ID] = *question
+	return nil
+}
+
+func (m *memQuestions) DeleteQuestion(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.questions[id]; !ok {
+		return domain.ErrProductQuestionNotFound
+	}
+	delete(m.questions, id)
+	for answerID, answer := range m.answers {
+		if answer.QuestionID == id {
+			delete(m.answers, answerID)
+		}
+	}
+	return nil
+}
+
+func (m *memQuestions) CreateAnswer(_ context.Context, answer *domain.ProductAnswer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.answers[answer.ID] = *answer
+	return nil
+}
+
+func (m *memQuestions) GetAnswer(_ context.Context, id string) (*domain.ProductAnswer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	answer, ok := m.answers[id]
+	if !ok {
+		return nil, domain.ErrProductAnswerNotFound
+	}
+	return &answer, nil
+}
+
+func (m *memQuestions) ListAnswersByQuestionIDs(
+	_ context.Context,
+	questionIDs []string,
+	status string,
+) ([]domain.ProductAnswer, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var answers []domain.ProductAnswer
+	for _, id := range questionIDs {
+		for _, answer := range m.answers {
+			if answer.QuestionID == id && (status == "" || answer.Status == status) {
+				answers = append(answers, answer)
+			}
+		}
+	}
+	return answers, nil
+}
+
+func (m *memQuestions) UpdateAnswerStatus(_ context.Context, answer *domain.ProductAnswer) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.answers[answer.ID] = *answer
+	return nil
+}
+
+func (m *memQuestions) DeleteAnswer(_ context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.answers[id]; !ok {
+		return domain.ErrProductAnswerNotFound
+	}
+	delete(m.answers, id)
+	return nil
+}
+
+type fakeQuestionNotifier struct {
+	created  []string
+	answered []string
+	err      error
+}
+
+func (f *fakeQuestionNotifier) QuestionCreated(_ context.Context, question *domain.ProductQuestion) error {
+	f.created = append(f.created, question.ID)
+	return f.err
+}
+
+func (f *fakeQuestionNotifier) QuestionAnswered(
+	_ context.Context,
+	question *domain.ProductQuestion,
+	answer *domain.ProductAnswer,
+) error {
+	f.answered = append(f.answered, question.ID+"/"+answer.ID)
+	return f.err
+}
+
+func newQuestionCatalog() (*catalogService, *memQuestions, *fakeQuestionNotifier) {
+	products := newMemProducts(
+		domain.Product{ID: "amp", Kind: domain.ProductKindSimple, IsActive: true},
+		domain.Product{ID: "hidden", Kind: domain.ProductKindSimple},
+	)
+	questions := newMemQuestions()
+	questions.questions["q-1"] = domain.ProductQuestion{ID: "q-1", ProductID: "amp", Status: domain.ReviewStatusApproved}
+	questions.questions["q-2"] = domain.ProductQuestion{ID: "q-2", ProductID: "amp", Status: domain.ReviewStatusApproved}
+	questions.questions["q-3"] = domain.ProductQuestion{ID: "q-3", ProductID: "amp", Status: domain.ReviewStatusPending}
+	questions.answers["a-1"] = domain.ProductAnswer{ID: "a-1", QuestionID: "q-1", Status: domain.ReviewStatusApproved}
+	questions.answers["a-2"] = domain.ProductAnswer{ID: "a-2", QuestionID: "q-1", Status: domain.ReviewStatusPending}
+	questions.answers["a-3"] = domain.ProductAnswer{ID: "a-3", QuestionID: "q-2", Status: domain.ReviewStatusPending}
+	notifier := &fakeQuestionNotifier{}
+	svc := &catalogService{products: products, productQuestions: questions, questionNotifier: notifier}
+	return svc, questions, notifier
+}
+
+func TestCatalogServicePublicQuestionsShowOnlyApprovedAnswers(t *testing.T) {
+	svc, questions, _ := newQuestionCatalog()
+
+	result, err := svc.ListProductQuestions(context.Background(), domain.ProductQuestionFilter{
+		ProductID: "amp",
+		Status:    domain.ReviewStatusPending,
+	}, false)
+	if err != nil {
+		t.Fatalf("ListProductQuestions returned error: %v", err)
+	}
+	filter := questions.filters[0]
+	if filter.Status != domain.ReviewStatusApproved || !filter.AnsweredOnly {
+		t.Fatalf("expected the public filter to be enforced, got %+v", filter)
+	}
+	if len(result.Questions) != 1 || result.Questions[0].ID != "q-1" {
+		t.Fatalf("expected only the answered question q-1, got %+v", result.Questions)
+	}
+	if answers := result.Questions[0].Answers; len(answers) != 1 || answers[0].ID != "a-1" {
+		t.Fatalf("expected only the approved answer a-1, got %+v", answers)
+	}
+
+	if _, err := svc.ListProductQuestions(context.Background(), domain.ProductQuestionFilter{}, false); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Fatalf("expected a product to be required, got %v", err)
+	}
+	_, err = svc.ListProductQuestions(context.Background(), domain.ProductQuestionFilter{ProductID: "hidden"}, false)
+	if !errors.Is(err, domain.ErrProductNotFound) {
+		t.Fatalf("expected an inactive product to be hidden, got %v", err)
+	}
+}
+
+func TestCatalogServiceAdminQuestionsShowEverything(t *testing.T) {
+	svc, _, _ := newQuestionCatalog()
+
+	result, err := svc.ListProductQuestions(context.Background(), domain.ProductQuestionFilter{ProductID: "amp"}, true)
+	if err != nil {
+		t.Fatalf("ListProductQuestions returned error: %v", err)
+	}
+	answers := map[string]int{}
+	for _, question := range result.Questions {
+		answers[question.ID] = len(question.Answers)
+	}
+	if want := map[string]int{"q-1": 2, "q-2": 1, "q-3": 0}; !reflect.DeepEqual(answers, want) {
+		t.Fatalf("expected answers per question %v, got %v", want, answers)
+	}
+}
+
+func TestCatalogServiceNotifiesWhenAnswerIsPublished(t *testing.T) {
+	tests := []struct {
+		name     string
+		answerID string
+		status   string
+		want     []string
+	}{
+		{"pending answer approved", "a-3", domain.ReviewStatusApproved, []string{"q-2/a-3"}},
+		{"pending answer rejected", "a-3", domain.ReviewStatusRejected, nil},
+		// Re-approving, e.g. to change the note, does not notify again.
+		{"approved answer approved again", "a-1", domain.ReviewStatusApproved, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, questions, notifier := newQuestionCatalog()
+			questionID := questions.answers[tt.answerID].QuestionID
+
+			answer, err := svc.ModerateProductAnswer(context.Background(), "amp", questionID, tt.answerID, tt.status, " checked ")
+			if err != nil {
+				t.Fatalf("ModerateProductAnswer returned error: %v", err)
+			}
+			if answer.Status != tt.status || answer.ModerationNote != "checked" || answer.ModeratedAt == nil {
+				t.Fatalf("unexpected moderated answer %+v", answer)
+			}
+			if !reflect.DeepEqual(notifier.answered, tt.want) {
+				t.Fatalf("expected notifications %v, got %v", tt.want, notifier.answered)
+			}
+		})
+	}
+}
+
+func TestCatalogServiceNotificationFailureKeepsQuestion(t *testing.T) {
+	svc, questions, notifier := newQuestionCatalog()
+	notifier.err = errors.New("smtp unavailable")
+
+	question, err := svc.AskProductQuestion(context.Background(), "user-1", "amp", "  Does it fit a 2 DIN slot? ")
+	if err != nil {
+		t.Fatalf("AskProductQuestion returned error: %v", err)
+	}
+	if question.Body != "Does it fit a 2 DIN slot?" || question.Status != domain.ReviewStatusPending {
+		t.Fatalf("unexpected question %+v", question)
+	}
+	if _, ok := questions.questions[question.ID]; !ok || len(notifier.created) != 1 {
+		t.Fatal("expected the question to be stored and the staff to be notified")
+	}
+}
+
+func TestCatalogServiceQuestionPathsMustMatch(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		call func(s *catalogService) error
+		want error
+	}{
+		{
+			name: "question of another product",
+			call: func(s *catalogService) error { return s.DeleteProductQuestion(ctx, "hidden", "q-1") },
+			want: domain.ErrProductQuestionNotFound,
+		},
+		{
+			name: "answer of another question",
+			call: func(s *catalogService) error { return s.DeleteProductAnswer(ctx, "amp", "q-2", "a-1") },
+			want: domain.ErrProductAnswerNotFound,
+		},
+		{
+			name: "moderating an answer of another question",
+			call: func(s *catalogService) error {
+				_, err := s.ModerateProductAnswer(ctx, "amp", "q-2", "a-1", domain.ReviewStatusRejected, "")
+				return err
+			},
+			want: domain.ErrProductAnswerNotFound,
+		},
+		{
+			name: "answering a question of another product",
+			call: func(s *catalogService) error {
+				_, err := s.AnswerProductQuestion(ctx, "staff-1", "hidden", "q-1", "Yes.")
+				return err
+			},
+			want: domain.ErrProductQuestionNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, questions, _ := newQuestionCatalog()
+			if err := tt.call(svc); !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+			if len(questions.questions) != 3 || len(questions.answers) != 3 {
+				t.Fatal("expected nothing to change")
+			}
+			if questions.answers["a-1"].Status != domain.ReviewStatusApproved {
+				t.Fatal("expected a-1 to stay approved")
+			}
+		})
+	}
+}
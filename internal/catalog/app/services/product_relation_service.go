@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+func (s *catalogService) ListProductRelations(
+	ctx context.Context,
+	productID string,
+	adminAccess bool,
+) ([]domain.ProductRelation, error) {
+	if err := s.ensureProductVisible(ctx, productID, adminAccess); err != nil {
+		return nil, err
+	}
+	return s.productRelations.ListByProductID(ctx, productID)
+}
+
+func (s *catalogService) ListRelatedProducts(
+	ctx context.Context,
+	productID string,
+	adminAccess bool,
+) ([]domain.RelatedProduct, error) {
+	relations, err := s.ListProductRelations(ctx, productID, adminAccess)
+	if err != nil {
+		return nil, err
+	}
+	if len(relations) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(relations))
+	for i := range relations {
+		ids = append(ids, relations[i].RelatedProductID)
+	}
+	products, err := s.products.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	images, err := s.productImages.ListPrimaryByProductIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	productsByID := make(map[string]domain.Product, len(products))
+	for _, product := range products {
+		productsByID[product.ID] = product
+	}
+	imagesByProductID := make(map[string]domain.ProductImage, len(images))
+	for _, image := range images {
+		imagesByProductID[image.ProductID] = image
+	}
+
+	out := make([]domain.RelatedProduct, 0, len(relations))
+	for _, relation := range relations {
+		product, ok := productsByID[relation.RelatedProductID]
+		if !ok || (!adminAccess && !product.IsActive) {
+			continue
+		}
+		related := domain.RelatedProduct{Relation: relation, Product: product}
+		if image, ok := imagesByProductID[product.ID]; ok {
+			related.PrimaryImage = &image
+		}
+		out = append(out, related)
+	}
+	return out, nil
+}
+
+func (s *catalogService) CreateProductRelation(
+	ctx context.Context,
+	productID string,
+	input domain.ProductRelationInput,
+) (*domain.ProductRelation, error) {
+	if _, err := s.products.GetByID(ctx, productID); err != nil {
+		return nil, err
+	}
+	relatedID := strings.TrimSpace(input.RelatedProductID)
+	if relatedID == "" || relatedID == productID {
+		return nil, domain.ErrInvalidArgument
+	}
+	if !domain.IsValidRelationType(input.Type) || input.SortOrder < 0 {
+		return nil, domain.ErrInvalidArgument
+	}
+	if input.Bidirectional && input.Type != domain.RelationAnalogue {
+		return nil, domain.ErrInvalidArgument
+	}
+	if _, err := s.products.GetByID(ctx, relatedID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	relation := &domain.ProductRelation{
+		ID:               uuid.NewString(),
+		ProductID:        productID,
+		RelatedProductID: relatedID,
+		Type:             input.Type,
+		SortOrder:        input.SortOrder,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	relations := []*domain.ProductRelation{relation}
+	if input.Bidirectional {
+		pairID := uuid.NewString()
+		relation.PairID = &pairID
+		relations = append(relations, &domain.ProductRelation{
+			ID:               uuid.NewString(),
+			ProductID:        relatedID,
+			RelatedProductID: productID,
+			Type:             input.Type,
+			SortOrder:        input.SortOrder,
+			PairID:           &pairID,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		})
+	}
+
+	if err := s.productRelations.Create(ctx, relations...); err != nil {
+		return nil, err
+	}
+	return relation, nil
+}
+
+func (s *catalogService) UpdateProductRelation(
+	ctx context.Context,
+	productID, relationID string,
+	input domain.ProductRelationInput,
+) (*domain.ProductRelation, error) {
+	existing, err := s.productRelations.GetByID(ctx, relationID)
+	if err != nil {
+		return nil, err
+	}
+	if existing.ProductID != productID {
+		return nil, domain.ErrProductRelationNotFound
+	}
+	if !domain.IsValidRelationType(input.Type) || input.SortOrder < 0 {
+		return nil, domain.ErrInvalidArgument
+	}
+
+	now := time.Now()
+	relations := []*domain.ProductRelation{existing}
+	if existing.IsBidirectional() {
+		// A bidirectional analogue stays one: the type cannot change and
+		// the other row of the pair takes the new sort order with it.
+		if input.Type != existing.Type {
+			return nil, domain.ErrInvalidArgument
+		}
+		pair, err := s.productRelations.GetPair(ctx, existing)
+		switch {
+		case err == nil:
+			pair.SortOrder = input.SortOrder
+			pair.UpdatedAt = now
+			relations = append(relations, pair)
+		case !errors.Is(err, domain.ErrProductRelationNotFound):
+			return nil, err
+		}
+	}
+
+	existing.Type = input.Type
+	existing.SortOrder = input.SortOrder
+	existing.UpdatedAt = now
+	if err := s.productRelations.Update(ctx, relations...); err != nil {
+		return nil, err
+	}
+	return s.productRelations.GetByID(ctx, relationID)
+}
+
+func (s *catalogService) DeleteProductRelation(
+	ctx context.Context,
+	productID, relationID string,
+	bidirectional bool,
+) error {
+	relation, err := s.productRelations.GetByID(ctx, relationID)
+	if err != nil {
+		return err
+	}
+	if relation.ProductID != productID {
+		return domain.ErrProductRelationNotFound
+	}
+	return s.productRelations.Delete(ctx, relationID, bidirectional)
+}
@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+// memRelations keeps product relations in memory, with the pair handling of
+// the postgres repository.
+type memRelations struct {
+	mu        sync.Mutex
+	relations map[string]domain.ProductRelation
+}
+
+func newMemRelations(relations ...domain.ProductRelation) *memRelations {
+	m := &memRelations{relations: map[string]domain.ProductRelation{}}
+	for _, relation := range relations {
+		m.relations[relation.ID] = relation
+	}
+	return m
+}
+
+func (m *memRelations) Create(_ context.Context, relations ...*domain.ProductRelation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, relation := range relations {
+		m.relations[relation.ID] = *relation
+	}
+	return nil
+}
+
+func (m *memRelations) GetByID(_ context.Context, id string) (*domain.ProductRelation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	relation, ok := m.relations[id]
+	if !ok {
+		return nil, domain.ErrProductRelationNotFound
+	}
+	return &relation, nil
+}
+
+func (m *memRelations) ListByProductID(_ context.Context, productID string) ([]domain.ProductRelation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var relations []domain.ProductRelation
+	for _, relation := range m.relations {
+		if relation.ProductID == productID {
+			relations = append(relations, relation)
+		}
+	}
+	return relations, nil
+}
+
+func (m *memRelations) GetPair(_ context.Context, relation *domain.ProductRelation) (*domain.ProductRelation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if relation.PairID == nil {
+		return nil, domain.ErrProductRelationNotFound
+	}
+	for _, pair := range m.relations {
+		if pair.ID != relation.ID && pair.PairID != nil && *pair.PairID == *relation.PairID {
+			return &pair, nil
+		}
+	}
+	return nil, domain.ErrProductRelationNotFound
+}
+
+func (m *memRelations) Update(_ context.Context, relations ...*domain.ProductRelation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, relation := range relations {
+		if _, ok := m.relations[relation.ID]; !ok {
+			return domain.ErrProductRelationNotFound
+		}
+	}
+	for _, relation := range relations {
+		m.relations[relation.ID] = *relation
+	}
+	return nil
+}
+
+func (m *memRelations) Delete(_ context.Context, id string, withPair bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	relation, ok := m.relations[id]
+	if !ok {
+		return domain.ErrProductRelationNotFound
+	}
+	delete(m.relations, id)
+	if relation.PairID == nil {
+		return nil
+	}
+	for pairID, pair := range m.relations {
+		if pair.PairID == nil || *pair.PairID != *relation.PairID {
+			continue
+		}
+		if withPair {
+			delete(m.relations, pairID)
+		} else {
+			pair.PairID = nil
+			m.relations[pairID] = pair
+		}
+	}
+	return nil
+}
+
+func newRelationCatalog(relations ...domain.ProductRelation) (*catalogService, *memRelations) {
+	products := newMemProducts(
+		domain.Product{ID: "amp-a", Kind: domain.ProductKindSimple, IsActive: true},
+		domain.Product{ID: "amp-b", Kind: domain.ProductKindSimple, IsActive: true},
+	)
+	store := newMemRelations(relations...)
+	return &catalogService{products: products, productRelations: store}, store
+}
+
+func TestCatalogServiceBidirectionalRelationIsPaired(t *testing.T) {
+	svc, store := newRelationCatalog()
+
+	relation, err := svc.CreateProductRelation(context.Background(), "amp-a", domain.ProductRelationInput{
+		RelatedProductID: "amp-b",
+		Type:             domain.RelationAnalogue,
+		SortOrder:        2,
+		Bidirectional:    true,
+	})
+	if err != nil {
+		t.Fatalf("CreateProductRelation returned error: %v", err)
+	}
+	pair, err := store.GetPair(context.Background(), relation)
+	if err != nil {
+		t.Fatalf("expected the reverse relation to be paired, got %v", err)
+	}
+	if pair.ProductID != "amp-b" || pair.RelatedProductID != "amp-a" || pair.SortOrder != 2 {
+		t.Fatalf("unexpected reverse relation %+v", pair)
+	}
+
+	_, err = svc.CreateProductRelation(context.Background(), "amp-a", domain.ProductRelationInput{
+		RelatedProductID: "amp-b",
+		Type:             domain.RelationAccessory,
+		Bidirectional:    true,
+	})
+	if !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Fatalf("expected only analogues to be bidirectional, got %v", err)
+	}
+}
+
+func TestCatalogServiceUpdatePairedRelation(t *testing.T) {
+	pairID := "pair-1"
+	svc, store := newRelationCatalog(
+		domain.ProductRelation{ID: "ab", ProductID: "amp-a", RelatedProductID: "amp-b", Type: domain.RelationAnalogue, PairID: &pairID},
+		domain.ProductRelation{ID: "ba", ProductID: "amp-b", RelatedProductID: "amp-a", Type: domain.RelationAnalogue, PairID: &pairID},
+	)
+
+	if _, err := svc.UpdateProductRelation(context.Background(), "amp-a", "ab", domain.ProductRelationInput{
+		Type: domain.RelationUpsell,
+	}); !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Fatalf("expected the type of a paired relation to be fixed, got %v", err)
+	}
+
+	updated, err := svc.UpdateProductRelation(context.Background(), "amp-a", "ab", domain.ProductRelationInput{
+		Type:      domain.RelationAnalogue,
+		SortOrder: 7,
+	})
+	if err != nil {
+		t.Fatalf("UpdateProductRelation returned error: %v", err)
+	}
+	if updated.SortOrder != 7 || store.relations["ba"].SortOrder != 7 {
+		t.Fatalf("expected both rows to move to 7, got %d and %d", updated.SortOrder, store.relations["ba"].SortOrder)
+	}
+}
+
+func TestCatalogServiceReverseRelationsMadeByHandStayIndependent(t *testing.T) {
+	svc, store := newRelationCatalog(
+		domain.ProductRelation{ID: "ab", ProductID: "amp-a", RelatedProductID: "amp-b", Type: domain.RelationAnalogue, SortOrder: 1},
+		domain.ProductRelation{ID: "ba", ProductID: "amp-b", RelatedProductID: "amp-a", Type: domain.RelationAnalogue, SortOrder: 1},
+	)
+
+	updated, err := svc.UpdateProductRelation(context.Background(), "amp-a", "ab", domain.ProductRelationInput{
+		Type:      domain.RelationUpsell,
+		SortOrder: 5,
+	})
+	if err != nil {
+		t.Fatalf("UpdateProductRelation returned error: %v", err)
+	}
+	if updated.Type != domain.RelationUpsell || store.relations["ba"].Type != domain.RelationAnalogue || store.relations["ba"].SortOrder != 1 {
+		t.Fatalf("expected only ab to change, got %+v and %+v", updated, store.relations["ba"])
+	}
+
+	if err := svc.DeleteProductRelation(context.Background(), "amp-a", "ab", true); err != nil {
+		t.Fatalf("DeleteProductRelation returned error: %v", err)
+	}
+	if _, ok := store.relations["ba"]; !ok {
+		t.Fatal("expected the unpaired reverse relation to be kept")
+	}
+}
+
+func TestCatalogServiceDeletePairedRelation(t *testing.T) {
+	pairID := "pair-1"
+	paired := func() []domain.ProductRelation {
+		return []domain.ProductRelation{
+			{ID: "ab", ProductID: "amp-a", RelatedProductID: "amp-b", Type: domain.RelationAnalogue, PairID: &pairID},
+			{ID: "ba", ProductID: "amp-b", RelatedProductID: "amp-a", Type: domain.RelationAnalogue, PairID: &pairID},
+		}
+	}
+
+	svc, store := newRelationCatalog(paired()...)
+	if err := svc.DeleteProductRelation(context.Background(), "amp-a", "ab", true); err != nil {
+		t.Fatalf("DeleteProductRelation returned error: %v", err)
+	}
+	if len(store.relations) != 0 {
+		t.Fatalf("expected both rows to be deleted, got %v", store.relations)
+	}
+
+	svc, store = newRelationCatalog(paired()...)
+	if err := svc.DeleteProductRelation(context.Background(), "amp-a", "ab", false); err != nil {
+		t.Fatalf("DeleteProductRelation returned error: %v", err)
+	}
+	kept, ok := store.relations["ba"]
+	if !ok || kept.IsBidirectional() {
+		t.Fatalf("expected ba to be kept as a one-way relation, got %+v", kept)
+	}
+
+	svc, store = newRelationCatalog(paired()...)
+	if err := svc.DeleteProductRelation(context.Background(), "amp-b", "ab", true); !errors.Is(err, domain.ErrProductRelationNotFound) {
+		t.Fatalf("expected a relation of another product to be refused, got %v", err)
+	}
+	if len(store.relations) != 2 {
+		t.Fatal("expected nothing to be deleted")
+	}
+}
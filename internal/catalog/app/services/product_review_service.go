@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+func (s *catalogService) ListProductReviews(
+	ctx context.Context,
+	filter domain.ProductReviewFilter,
+	adminAccess bool,
+) (*domain.ProductReviewListResult, error) {
+	if filter.Status != "" && !domain.IsValidReviewStatus(filter.Status) {
+		return nil, domain.ErrInvalidArgument
+	}
+	if !adminAccess {
+		if filter.ProductID == "" {
+			return nil, domain.ErrInvalidArgument
+		}
+		if err := s.ensureProductVisible(ctx, filter.ProductID, false); err != nil {
+			return nil, err
+		}
+		filter.Status = domain.ReviewStatusApproved
+		filter.UserID = ""
+	}
+	return s.productReviews.List(ctx, filter)
+}
+
+func (s *catalogService) CreateProductReview(
+	ctx context.Context,
+	userID, productID string,
+	input domain.ProductReviewInput,
+) (*domain.ProductReview, error) {
+	if userID == "" {
+		return nil, domain.ErrInvalidArgument
+	}
+	if input.Rating < 1 || input.Rating > 5 {
+		return nil, domain.ErrInvalidArgument
+	}
+	if err := s.ensureProductVisible(ctx, productID, false); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	review := &domain.ProductReview{
+		ID:        uuid.NewString(),
+		ProductID: productID,
+		UserID:    userID,
+		Rating:    input.Rating,
+		Pros:      strings.TrimSpace(input.Pros),
+		Cons:      strings.TrimSpace(input.Cons),
+		Body:      strings.TrimSpace(input.Body),
+		Status:    domain.ReviewStatusPending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.productReviews.Create(ctx, review); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func (s *catalogService) ModerateProductReview(
+	ctx context.Context,
+	id, status, note string,
+) (*domain.ProductReview, error) {
+	if !domain.IsValidReviewStatus(status) {
+		return nil, domain.ErrInvalidArgument
+	}
+	review, err := s.productReviews.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	review.Status = status
+	review.ModerationNote = strings.TrimSpace(note)
+	review.ModeratedAt = &now
+	review.UpdatedAt = now
+	if err := s.productReviews.UpdateStatus(ctx, review); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func (s *catalogService) DeleteProductReview(ctx context.Context, id string) error {
+	return s.productReviews.Delete(ctx, id)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,15 +14,25 @@ import (
 )
 
 type Config struct {
-	GRPCPort         string         `mapstructure:"grpc_port"`
-	HTTPPort         string         `mapstructure:"http_port"`
-	JWTSecret        string         `mapstructure:"jwt_secret"`
-	AllowedOrigins   []string       `mapstructure:"allowed_origins"`
-	HTTPReadTimeout  time.Duration  `mapstructure:"http_read_timeout"`
-	HTTPWriteTimeout time.Duration  `mapstructure:"http_write_timeout"`
-	HTTPIdleTimeout  time.Duration  `mapstructure:"http_idle_timeout"`
-	ShutdownTimeout  time.Duration  `mapstructure:"shutdown_timeout"`
-	Database         DatabaseConfig `mapstructure:"database"`
+	GRPCPort                string         `mapstructure:"grpc_port"`
+	HTTPPort                string         `mapstructure:"http_port"`
+	JWKSURL                 string         `mapstructure:"jwks_url"`
+	JWKSFile                string         `mapstructure:"jwks_file"`
+	JWKSCacheTTL            time.Duration  `mapstructure:"jwks_cache_ttl"`
+	JWTIssuer               string         `mapstructure:"jwt_issuer"`
+	JWTAudience             string         `mapstructure:"jwt_audience"`
+	JWTLeeway               time.Duration  `mapstructure:"jwt_leeway"`
+	AuthGRPCAddr            string         `mapstructure:"auth_grpc_addr"`
+	RevocationsRefreshEvery time.Duration  `mapstructure:"revocations_refresh_every"`
+	ClientID                string         `mapstructure:"client_id"`
+	ClientSecret            string         `mapstructure:"client_secret"`
+	AllowedOrigins          []string       `mapstructure:"allowed_origins"`
+	CookieSecure            bool           `mapstructure:"cookie_secure"`
+	HTTPReadTimeout         time.Duration  `mapstructure:"http_read_timeout"`
+	HTTPWriteTimeout        time.Duration  `mapstructure:"http_write_timeout"`
+	HTTPIdleTimeout         time.Duration  `mapstructure:"http_idle_timeout"`
+	ShutdownTimeout         time.Duration  `mapstructure:"shutdown_timeout"`
+	Database                DatabaseConfig `mapstructure:"database"`
 }
 
 type DatabaseConfig struct {
@@ -78,11 +89,41 @@ func applyEnvOverrides(cfg *Config) {
 	if v := os.Getenv("CATALOG_DATABASE_HOST"); v != "" {
 		cfg.Database.Host = v
 	}
-	if v := os.Getenv("CATALOG_JWT_SECRET"); v != "" {
-		cfg.JWTSecret = v
+	if v := os.Getenv("CATALOG_JWKS_URL"); v != "" {
+		cfg.JWKSURL = v
 	}
-	if v := os.Getenv("AUTH_JWT_SECRET"); v != "" && cfg.JWTSecret == "" {
-		cfg.JWTSecret = v
+	if v := os.Getenv("CATALOG_JWKS_FILE"); v != "" {
+		cfg.JWKSFile = v
+	}
+	if v := os.Getenv("CATALOG_JWT_ISSUER"); v != "" {
+		cfg.JWTIssuer = v
+	}
+	if v := os.Getenv("CATALOG_JWT_AUDIENCE"); v != "" {
+		cfg.JWTAudience = v
+	}
+	if v := os.Getenv("CATALOG_JWT_LEEWAY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.JWTLeeway = d
+		}
+	}
+	if v := os.Getenv("CATALOG_JWKS_CACHE_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.JWKSCacheTTL = d
+		}
+	}
+	if v := os.Getenv("CATALOG_AUTH_GRPC_ADDR"); v != "" {
+		cfg.AuthGRPCAddr = v
+	}
+	if v := os.Getenv("CATALOG_REVOCATIONS_REFRESH_EVERY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.RevocationsRefreshEvery = d
+		}
+	}
+	if v := os.Getenv("CATALOG_CLIENT_ID"); v != "" {
+		cfg.ClientID = v
+	}
+	if v := os.Getenv("CATALOG_CLIENT_SECRET"); v != "" {
+		cfg.ClientSecret = v
 	}
 	if v := os.Getenv("CATALOG_GRPC_PORT"); v != "" {
 		cfg.GRPCPort = v
@@ -93,6 +134,11 @@ func applyEnvOverrides(cfg *Config) {
 	if v := os.Getenv("CATALOG_ALLOWED_ORIGINS"); v != "" {
 		cfg.AllowedOrigins = parseCommaSeparatedList(v)
 	}
+	if v := os.Getenv("CATALOG_COOKIE_SECURE"); v != "" {
+		if b, err := strconv.ParseBool(v); err == nil {
+			cfg.CookieSecure = b
+		}
+	}
 	if v := os.Getenv("CATALOG_HTTP_READ_TIMEOUT"); v != "" {
 		if d, err := time.ParseDuration(v); err == nil {
 			cfg.HTTPReadTimeout = d
@@ -116,8 +162,8 @@ func applyEnvOverrides(cfg *Config) {
 }
 
 func validate(cfg *Config) error {
-	if cfg.JWTSecret == "" {
-		return errors.New("CATALOG_JWT_SECRET or AUTH_JWT_SECRET is required")
+	if cfg.JWKSURL == "" && cfg.JWKSFile == "" {
+		return errors.New("CATALOG_JWKS_URL or CATALOG_JWKS_FILE is required")
 	}
 	if cfg.Database.User == "" {
 		return errors.New("CATALOG_DATABASE_USER is required")
@@ -137,6 +183,9 @@ func validate(cfg *Config) error {
 	if cfg.HTTPPort == "" {
 		return errors.New("CATALOG_HTTP_PORT is required")
 	}
+	if cfg.ClientID == "" || cfg.ClientSecret == "" {
+		return errors.New("CATALOG_CLIENT_ID and CATALOG_CLIENT_SECRET are required")
+	}
 	if len(cfg.AllowedOrigins) == 0 {
 		return errors.New("CATALOG_ALLOWED_ORIGINS is required")
 	}
@@ -152,6 +201,21 @@ func validate(cfg *Config) error {
 	if cfg.ShutdownTimeout <= 0 {
 		cfg.ShutdownTimeout = 15 * time.Second
 	}
+	if cfg.JWTIssuer == "" {
+		cfg.JWTIssuer = "caraudio-auth"
+	}
+	if cfg.JWTAudience == "" {
+		cfg.JWTAudience = "caraudio-api"
+	}
+	if cfg.JWTLeeway < 0 {
+		cfg.JWTLeeway = 0
+	}
+	if cfg.AuthGRPCAddr == "" {
+		cfg.AuthGRPCAddr = "localhost:50051"
+	}
+	if cfg.RevocationsRefreshEvery <= 0 {
+		cfg.RevocationsRefreshEvery = 15 * time.Second
+	}
 	if cfg.Database.Port == 0 {
 		cfg.Database.Port = 5432
 	}
@@ -0,0 +1,58 @@
+package domain
+
+import "time"
+
+const (
+	BundlePricingFixed    = "fixed"
+	BundlePricingDiscount = "discount"
+)
+
+// Bundle describes a product of kind "bundle". Its stock is derived from the
+// components and, in discount mode, so is its price; both are kept on the
+// products row so that bundles list, sort and filter like normal products.
+type Bundle struct {
+	ProductID       string       `db:"product_id"`
+	PricingMode     string       `db:"pricing_mode"`
+	DiscountPercent int32        `db:"discount_percent"`
+	CreatedAt       time.Time    `db:"created_at"`
+	UpdatedAt       time.Time    `db:"updated_at"`
+	Items           []BundleItem `db:"-"`
+}
+
+type BundleItem struct {
+	BundleID           string `db:"bundle_id"`
+	ComponentProductID string `db:"component_product_id"`
+	Quantity           int32  `db:"quantity"`
+	SortOrder          int32  `db:"sort_order"`
+}
+
+type BundleItemInput struct {
+	ProductID string
+	Quantity  int32
+}
+
+type BundleInput struct {
+	CategoryID      string
+	BrandID         string
+	Name            string
+	Description     string
+	SKU             string
+	IsActive        bool
+	PricingMode     string
+	PriceCents      int64
+	DiscountPercent int32
+	Items           []BundleItemInput
+}
+
+type BundleDetails struct {
+	Product    Product
+	Bundle     Bundle
+	Components []Product
+}
+
+// StockLine is a quantity of a product to reserve, commit or release. Lines
+// for bundles are expanded into their components before touching stock.
+type StockLine struct {
+	ProductID string
+	Quantity  int32
+}
@@ -16,4 +16,15 @@ var (
 	ErrProductAttributeNotFound = errors.New("product attribute not found")
 	ErrSupplierMappingNotFound  = errors.New("supplier mapping not found")
 	ErrCategoryHasProducts      = errors.New("category has products")
+	ErrInvalidPageToken         = errors.New("invalid page token")
+	ErrProductRelationNotFound  = errors.New("product relation not found")
+	ErrBundleNotFound           = errors.New("bundle not found")
+	ErrProductInBundle          = errors.New("product is a bundle component")
+	ErrInsufficientStock        = errors.New("insufficient stock")
+	ErrStockNotReserved         = errors.New("stock not reserved")
+	ErrProductReviewNotFound    = errors.New("product review not found")
+	ErrProductQuestionNotFound  = errors.New("product question not found")
+	ErrProductAnswerNotFound    = errors.New("product answer not found")
+	ErrProductListItemNotFound  = errors.New("product list item not found")
+	ErrProductListFull          = errors.New("product list is full")
 )
@@ -45,9 +45,27 @@ type SupplierProductMappingInput struct {
 type SupplierCategoryMappingFilter struct {
 	SupplierID int64
 	CategoryID string
+	Page       int32
+	PageSize   int32
+	PageToken  string
 }
 
 type SupplierProductMappingFilter struct {
 	SupplierID int64
 	ProductID  string
+	Page       int32
+	PageSize   int32
+	PageToken  string
+}
+
+type SupplierCategoryMappingListResult struct {
+	Mappings      []SupplierCategoryMapping
+	Total         int32
+	NextPageToken string
+}
+
+type SupplierProductMappingListResult struct {
+	Mappings      []SupplierProductMapping
+	Total         int32
+	NextPageToken string
 }
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// PageCursor is the decoded form of an opaque page token. Keys holds the
+// values of the ordering columns of the last returned row, ending with its id
+// so that rows with equal sort values are never skipped or repeated. Sort
+// records the ordering the token was issued for.
+type PageCursor struct {
+	Sort string   `json:"s,omitempty"`
+	Keys []string `json:"k"`
+}
+
+func EncodePageCursor(cursor PageCursor) string {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodePageCursor(token string) (PageCursor, error) {
+	var cursor PageCursor
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return cursor, ErrInvalidPageToken
+	}
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return cursor, ErrInvalidPageToken
+	}
+	if len(cursor.Keys) == 0 {
+		return cursor, ErrInvalidPageToken
+	}
+	return cursor, nil
+}
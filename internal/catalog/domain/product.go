@@ -3,30 +3,53 @@ package domain
 import "time"
 
 type Product struct {
-	ID          string    `db:"id"`
-	CategoryID  *string   `db:"category_id"`
-	BrandID     *string   `db:"brand_id"`
-	SupplierID  *int64    `db:"supplier_id"`
-	Name        string    `db:"name"`
-	Description string    `db:"description"`
-	PriceCents  int64     `db:"price_cents"`
-	SKU         *string   `db:"sku"`
-	Stock       int32     `db:"stock"`
-	IsActive    bool      `db:"is_active"`
+	ID          string  `db:"id"`
+	CategoryID  *string `db:"category_id"`
+	BrandID     *string `db:"brand_id"`
+	SupplierID  *int64  `db:"supplier_id"`
+	Kind        string  `db:"kind"`
+	Name        string  `db:"name"`
+	Description string  `db:"description"`
+	PriceCents  int64   `db:"price_cents"`
+	SKU         *string `db:"sku"`
+	Stock       int32   `db:"stock"`
+	Reserved    int32   `db:"reserved_stock"`
+	IsActive    bool    `db:"is_active"`
+	// Popularity is the number of units sold in paid orders.
+	Popularity  int64     `db:"popularity"`
+	RatingAvg   float64   `db:"rating_avg"`
+	RatingCount int32     `db:"rating_count"`
 	CreatedAt   time.Time `db:"created_at"`
 	UpdatedAt   time.Time `db:"updated_at"`
 }
 
+const (
+	ProductKindSimple = "simple"
+	ProductKindBundle = "bundle"
+)
+
+const (
+	ProductSortNewest     = "newest"
+	ProductSortPriceAsc   = "price_asc"
+	ProductSortPriceDesc  = "price_desc"
+	ProductSortName       = "name"
+	ProductSortPopularity = "popularity"
+	ProductSortInStock    = "in_stock"
+)
+
 type ProductListFilter struct {
 	CategoryID string
 	BrandID    string
 	SupplierID int64
 	ActiveOnly bool
+	Sort       string
 	Page       int32
 	PageSize   int32
+	PageToken  string
 }
 
 type ProductListResult struct {
-	Products []Product
-	Total    int32
+	Products      []Product
+	Total         int32
+	NextPageToken string
 }
@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+const (
+	ProductListWishlist = "wishlist"
+	ProductListCompare  = "compare"
+)
+
+// MaxCompareProducts bounds the comparison list; wider tables are not
+// readable anyway.
+const MaxCompareProducts = 6
+
+type ProductListItem struct {
+	UserID    string    `db:"user_id"`
+	ListType  string    `db:"list_type"`
+	ProductID string    `db:"product_id"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+type ProductListEntry struct {
+	Item         ProductListItem
+	Product      Product
+	PrimaryImage *ProductImage
+}
+
+// ComparisonRow holds one attribute across the compared products; Values
+// is aligned with ProductComparison.Products and empty where a product does
+// not have the attribute.
+type ComparisonRow struct {
+	Name    string
+	Values  []string
+	Differs bool
+}
+
+type ProductComparison struct {
+	Products []ProductListEntry
+	Rows     []ComparisonRow
+}
+
+func IsValidProductList(listType string) bool {
+	return listType == ProductListWishlist || listType == ProductListCompare
+}
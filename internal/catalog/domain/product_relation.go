@@ -0,0 +1,51 @@
+package domain
+
+import "time"
+
+const (
+	RelationAccessory  = "accessory"
+	RelationAnalogue   = "analogue"
+	RelationBundlePart = "bundle_part"
+	RelationUpsell     = "upsell"
+)
+
+type ProductRelation struct {
+	ID               string `db:"id"`
+	ProductID        string `db:"product_id"`
+	RelatedProductID string `db:"related_product_id"`
+	Type             string `db:"relation_type"`
+	SortOrder        int32  `db:"sort_order"`
+	// PairID is shared by the two rows of a bidirectional relation and nil
+	// for one-way relations.
+	PairID    *string   `db:"pair_id"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+func (r *ProductRelation) IsBidirectional() bool {
+	return r.PairID != nil
+}
+
+type ProductRelationInput struct {
+	RelatedProductID string
+	Type             string
+	SortOrder        int32
+	Bidirectional    bool
+}
+
+// RelatedProduct is a relation resolved to the product it points at, with the
+// primary image only, as shown in "buy together" blocks on the product page.
+type RelatedProduct struct {
+	Relation     ProductRelation
+	Product      Product
+	PrimaryImage *ProductImage
+}
+
+func IsValidRelationType(relationType string) bool {
+	switch relationType {
+	case RelationAccessory, RelationAnalogue, RelationBundlePart, RelationUpsell:
+		return true
+	default:
+		return false
+	}
+}
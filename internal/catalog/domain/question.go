@@ -0,0 +1,44 @@
+package domain
+
+import "time"
+
+// Questions and answers share the review moderation statuses.
+type ProductQuestion struct {
+	ID             string          `db:"id"`
+	ProductID      string          `db:"product_id"`
+	UserID         string          `db:"user_id"`
+	Body           string          `db:"body"`
+	Status         string          `db:"status"`
+	ModerationNote string          `db:"moderation_note"`
+	ModeratedAt    *time.Time      `db:"moderated_at"`
+	CreatedAt      time.Time       `db:"created_at"`
+	UpdatedAt      time.Time       `db:"updated_at"`
+	Answers        []ProductAnswer `db:"-"`
+}
+
+type ProductAnswer struct {
+	ID             string     `db:"id"`
+	QuestionID     string     `db:"question_id"`
+	UserID         string     `db:"user_id"`
+	Body           string     `db:"body"`
+	Status         string     `db:"status"`
+	ModerationNote string     `db:"moderation_note"`
+	ModeratedAt    *time.Time `db:"moderated_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
+
+type ProductQuestionFilter struct {
+	ProductID    string
+	Status       string
+	AnsweredOnly bool
+	Page         int32
+	PageSize     int32
+	PageToken    string
+}
+
+type ProductQuestionListResult struct {
+	Questions     []ProductQuestion
+	Total         int32
+	NextPageToken string
+}
@@ -0,0 +1,55 @@
+package domain
+
+import "time"
+
+const (
+	ReviewStatusPending  = "pending"
+	ReviewStatusApproved = "approved"
+	ReviewStatusRejected = "rejected"
+)
+
+type ProductReview struct {
+	ID             string     `db:"id"`
+	ProductID      string     `db:"product_id"`
+	UserID         string     `db:"user_id"`
+	Rating         int32      `db:"rating"`
+	Pros           string     `db:"pros"`
+	Cons           string     `db:"cons"`
+	Body           string     `db:"body"`
+	Status         string     `db:"status"`
+	ModerationNote string     `db:"moderation_note"`
+	ModeratedAt    *time.Time `db:"moderated_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
+
+type ProductReviewInput struct {
+	Rating int32
+	Pros   string
+	Cons   string
+	Body   string
+}
+
+type ProductReviewFilter struct {
+	ProductID string
+	UserID    string
+	Status    string
+	Page      int32
+	PageSize  int32
+	PageToken string
+}
+
+type ProductReviewListResult struct {
+	Reviews       []ProductReview
+	Total         int32
+	NextPageToken string
+}
+
+func IsValidReviewStatus(status string) bool {
+	switch status {
+	case ReviewStatusPending, ReviewStatusApproved, ReviewStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
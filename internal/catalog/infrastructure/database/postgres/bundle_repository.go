@@ -0,0 +1,231 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type BundleRepository interface {
+	Create(ctx context.Context, product *domain.Product, bundle *domain.Bundle) error
+	GetByProductID(ctx context.Context, productID string) (*domain.Bundle, error)
+	ListItemsByBundleIDs(ctx context.Context, bundleIDs []string) ([]domain.BundleItem, error)
+	Update(ctx context.Context, product *domain.Product, bundle *domain.Bundle) error
+	CountByComponent(ctx context.Context, productID string) (int64, error)
+	Refresh(ctx context.Context, productIDs []string) error
+}
+
+type postgresBundleRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresBundleRepository(db *sqlx.DB) BundleRepository {
+	return &postgresBundleRepository{db: db}
+}
+
+func (r *postgresBundleRepository) Create(
+	ctx context.Context,
+	product *domain.Product,
+	bundle *domain.Bundle,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.NamedExecContext(ctx, productInsertSQL, product); err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to create bundle product: %w", err)
+	}
+	_, err = tx.NamedExecContext(ctx,
+		`INSERT INTO product_bundles (product_id, pricing_mode, discount_percent, created_at, updated_at)
+         VALUES (:product_id, :pricing_mode, :discount_percent, :created_at, :updated_at)`, bundle)
+	if err != nil {
+		return fmt.Errorf("failed to create bundle: %w", err)
+	}
+	if err = insertBundleItems(ctx, tx, bundle.Items); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, refreshBundlesSQL, pq.Array([]string{product.ID})); err != nil {
+		return fmt.Errorf("failed to refresh bundles: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit bundle transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func (r *postgresBundleRepository) GetByProductID(
+	ctx context.Context,
+	productID string,
+) (*domain.Bundle, error) {
+	var bundle domain.Bundle
+	err := r.db.GetContext(ctx, &bundle,
+		`SELECT product_id, pricing_mode, discount_percent, created_at, updated_at
+         FROM product_bundles WHERE product_id = $1`, productID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrBundleNotFound
+		}
+		return nil, fmt.Errorf("failed to get bundle: %w", err)
+	}
+	items, err := r.ListItemsByBundleIDs(ctx, []string{productID})
+	if err != nil {
+		return nil, err
+	}
+	bundle.Items = items
+	return &bundle, nil
+}
+
+func (r *postgresBundleRepository) ListItemsByBundleIDs(
+	ctx context.Context,
+	bundleIDs []string,
+) ([]domain.BundleItem, error) {
+	if len(bundleIDs) == 0 {
+		return nil, nil
+	}
+	var items []domain.BundleItem
+	err := r.db.SelectContext(ctx, &items,
+		`SELECT bundle_id, component_product_id, quantity, sort_order
+         FROM bundle_items WHERE bundle_id = ANY($1)
+         ORDER BY bundle_id ASC, sort_order ASC`, pq.Array(bundleIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list bundle items: %w", err)
+	}
+	return items, nil
+}
+
+func (r *postgresBundleRepository) Update(
+	ctx context.Context,
+	product *domain.Product,
+	bundle *domain.Bundle,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	result, err := tx.NamedExecContext(ctx,
+		`UPDATE products SET
+           category_id = :category_id,
+           brand_id = :brand_id,
+           name = :name,
+           description = :description,
+           price_cents = :price_cents,
+           sku = :sku,
+           is_active = :is_active,
+           updated_at = :updated_at
+         WHERE id = :id AND kind = 'bundle'`, product)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to update bundle product: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrBundleNotFound
+	}
+
+	_, err = tx.NamedExecContext(ctx,
+		`UPDATE product_bundles SET
+           pricing_mode = :pricing_mode,
+           discount_percent = :discount_percent,
+           updated_at = :updated_at
+         WHERE product_id = :product_id`, bundle)
+	if err != nil {
+		return fmt.Errorf("failed to update bundle: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, `DELETE FROM bundle_items WHERE bundle_id = $1`, bundle.ProductID); err != nil {
+		return fmt.Errorf("failed to delete bundle items: %w", err)
+	}
+	if err = insertBundleItems(ctx, tx, bundle.Items); err != nil {
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, refreshBundlesSQL, pq.Array([]string{product.ID})); err != nil {
+		return fmt.Errorf("failed to refresh bundles: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit bundle transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func (r *postgresBundleRepository) CountByComponent(ctx context.Context, productID string) (int64, error) {
+	var count int64
+	err := r.db.GetContext(ctx, &count,
+		`SELECT COUNT(*) FROM bundle_items WHERE component_product_id = $1`, productID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count bundles by component: %w", err)
+	}
+	return count, nil
+}
+
+func (r *postgresBundleRepository) Refresh(ctx context.Context, productIDs []string) error {
+	if _, err := r.db.ExecContext(ctx, refreshBundlesSQL, pq.Array(productIDs)); err != nil {
+		return fmt.Errorf("failed to refresh bundles: %w", err)
+	}
+	return nil
+}
+
+func insertBundleItems(ctx context.Context, tx *sqlx.Tx, items []domain.BundleItem) error {
+	for i := range items {
+		_, err := tx.NamedExecContext(ctx,
+			`INSERT INTO bundle_items (bundle_id, component_product_id, quantity, sort_order)
+             VALUES (:bundle_id, :component_product_id, :quantity, :sort_order)`, &items[i])
+		if err != nil {
+			if isUniqueViolation(err) {
+				return domain.ErrAlreadyExists
+			}
+			return fmt.Errorf("failed to create bundle item: %w", err)
+		}
+	}
+	return nil
+}
+
+// refreshBundlesSQL recomputes stock and, in discount mode, price of every
+// bundle that is either listed in $1 or has a component listed in $1. A
+// bundle is available as many times as its scarcest component allows;
+// inactive components make the whole bundle unavailable.
+const refreshBundlesSQL = `UPDATE products p SET
+    stock = d.stock,
+    price_cents = CASE
+        WHEN b.pricing_mode = 'discount' THEN d.components_price * (100 - b.discount_percent) / 100
+        ELSE p.price_cents
+    END
+  FROM product_bundles b
+  JOIN (
+    SELECT bi.bundle_id,
+           MIN(CASE WHEN c.is_active THEN c.stock / bi.quantity ELSE 0 END) AS stock,
+           SUM(c.price_cents * bi.quantity) AS components_price
+    FROM bundle_items bi
+    JOIN products c ON c.id = bi.component_product_id
+    GROUP BY bi.bundle_id
+  ) d ON d.bundle_id = b.product_id
+  WHERE p.id = b.product_id
+    AND (b.product_id = ANY($1)
+      OR b.product_id IN (SELECT bundle_id FROM bundle_items WHERE component_product_id = ANY($1)))`
@@ -0,0 +1,174 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var errDeadlock = errors.New("deadlock detected")
+
+// fakeResult is what fakeDB answers to a statement: rows for queries, the
+// number of affected rows for everything else, or an error.
+type fakeResult struct {
+	columns  []string
+	rows     [][]driver.Value
+	affected int64
+	err      error
+}
+
+type fakeStatement struct {
+	query string
+	args  []driver.Value
+}
+
+// fakeDB is a database/sql driver that records the statements it is given,
+// including BEGIN, COMMIT and ROLLBACK, and answers them through respond.
+// It lets the repositories be tested for what they send and in which
+// transaction, without a PostgreSQL server.
+type fakeDB struct {
+	mu         sync.Mutex
+	statements []fakeStatement
+	respond    func(query string, args []driver.Value) fakeResult
+}
+
+func newFakeDB(t *testing.T, respond func(query string, args []driver.Value) fakeResult) (*sqlx.DB, *fakeDB) {
+	t.Helper()
+	fake := &fakeDB{respond: respond}
+	db := sql.OpenDB(fake)
+	db.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = db.Close() })
+	return sqlx.NewDb(db, "postgres"), fake
+}
+
+// log returns the recorded statements, each shortened to its first line.
+func (f *fakeDB) log() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	log := make([]string, 0, len(f.statements))
+	for _, statement := range f.statements {
+		first, _, _ := strings.Cut(strings.TrimSpace(statement.query), "\n")
+		log = append(log, strings.TrimSpace(first))
+	}
+	return log
+}
+
+// find returns the first recorded statement containing fragment.
+func (f *fakeDB) find(fragment string) (fakeStatement, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, statement := range f.statements {
+		if strings.Contains(statement.query, fragment) {
+			return statement, true
+		}
+	}
+	return fakeStatement{}, false
+}
+
+func (f *fakeDB) run(query string, named []driver.NamedValue) fakeResult {
+	args := make([]driver.Value, 0, len(named))
+	for _, arg := range named {
+		args = append(args, arg.Value)
+	}
+	f.mu.Lock()
+	f.statements = append(f.statements, fakeStatement{query: query, args: args})
+	f.mu.Unlock()
+	if f.respond == nil {
+		return fakeResult{}
+	}
+	return f.respond(query, args)
+}
+
+func (f *fakeDB) Connect(_ context.Context) (driver.Conn, error) {
+	return &fakeConn{db: f}, nil
+}
+
+func (f *fakeDB) Driver() driver.Driver {
+	return fakeDriver{}
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(_ string) (driver.Conn, error) {
+	return nil, errors.New("fakeDB is opened through its connector")
+}
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(_ string) (driver.Stmt, error) {
+	return nil, errors.New("fakeDB does not prepare statements")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return c.BeginTx(context.Background(), driver.TxOptions{})
+}
+
+func (c *fakeConn) BeginTx(_ context.Context, _ driver.TxOptions) (driver.Tx, error) {
+	if result := c.db.run("BEGIN", nil); result.err != nil {
+		return nil, result.err
+	}
+	return &fakeTx{db: c.db}, nil
+}
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	result := c.db.run(query, args)
+	if result.err != nil {
+		return nil, result.err
+	}
+	return driver.RowsAffected(result.affected), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	result := c.db.run(query, args)
+	if result.err != nil {
+		return nil, result.err
+	}
+	return &fakeRows{columns: result.columns, rows: result.rows}, nil
+}
+
+type fakeTx struct {
+	db *fakeDB
+}
+
+func (tx *fakeTx) Commit() error {
+	return tx.db.run("COMMIT", nil).err
+}
+
+func (tx *fakeTx) Rollback() error {
+	return tx.db.run("ROLLBACK", nil).err
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
@@ -14,7 +14,7 @@ import (
 type SupplierCategoryMappingRepository interface {
 	Create(ctx context.Context, m *domain.SupplierCategoryMapping) error
 	GetByID(ctx context.Context, id string) (*domain.SupplierCategoryMapping, error)
-	List(ctx context.Context, filter domain.SupplierCategoryMappingFilter) ([]domain.SupplierCategoryMapping, error)
+	List(ctx context.Context, filter domain.SupplierCategoryMappingFilter) (*domain.SupplierCategoryMappingListResult, error)
 	Update(ctx context.Context, m *domain.SupplierCategoryMapping) error
 	Delete(ctx context.Context, id string) error
 }
@@ -22,7 +22,7 @@ type SupplierCategoryMappingRepository interface {
 type SupplierProductMappingRepository interface {
 	Create(ctx context.Context, m *domain.SupplierProductMapping) error
 	GetByID(ctx context.Context, id string) (*domain.SupplierProductMapping, error)
-	List(ctx context.Context, filter domain.SupplierProductMappingFilter) ([]domain.SupplierProductMapping, error)
+	List(ctx context.Context, filter domain.SupplierProductMappingFilter) (*domain.SupplierProductMappingListResult, error)
 	Update(ctx context.Context, m *domain.SupplierProductMapping) error
 	Delete(ctx context.Context, id string) error
 }
@@ -72,15 +72,41 @@ func (r *postgresSupplierCategoryMappingRepository) GetByID(
 func (r *postgresSupplierCategoryMappingRepository) List(
 	ctx context.Context,
 	filter domain.SupplierCategoryMappingFilter,
-) ([]domain.SupplierCategoryMapping, error) {
+) (*domain.SupplierCategoryMappingListResult, error) {
 	where, args := buildMappingWhere(filter.SupplierID, filter.CategoryID, "supplier_id", "category_id")
-	query := supplierCategoryMappingSelectSQL + where + ` ORDER BY created_at DESC`
+
+	cursorMode := filter.PageToken != ""
+	var total int32
+	if cursorMode {
+		createdAt, id, err := decodeTimeCursor(filter.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, createdAt, id)
+		where = appendWhere(where, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	} else {
+		if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM supplier_category_mappings`+where, args...); err != nil {
+			return nil, fmt.Errorf("failed to count supplier category mappings: %w", err)
+		}
+	}
+
+	args = append(args, filter.PageSize+1, pageOffset(filter.Page, filter.PageSize, cursorMode))
+	query := supplierCategoryMappingSelectSQL + where +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d", len(args)-1, len(args))
 
 	var list []domain.SupplierCategoryMapping
 	if err := r.db.SelectContext(ctx, &list, query, args...); err != nil {
 		return nil, fmt.Errorf("failed to list supplier category mappings: %w", err)
 	}
-	return list, nil
+
+	result := &domain.SupplierCategoryMappingListResult{Total: total}
+	if len(list) > int(filter.PageSize) {
+		list = list[:filter.PageSize]
+		last := list[len(list)-1]
+		result.NextPageToken = encodeTimeCursor(last.CreatedAt, last.ID)
+	}
+	result.Mappings = list
+	return result, nil
 }
 
 func (r *postgresSupplierCategoryMappingRepository) Update(
@@ -163,15 +189,41 @@ func (r *postgresSupplierProductMappingRepository) GetByID(
 func (r *postgresSupplierProductMappingRepository) List(
 	ctx context.Context,
 	filter domain.SupplierProductMappingFilter,
-) ([]domain.SupplierProductMapping, error) {
+) (*domain.SupplierProductMappingListResult, error) {
 	where, args := buildMappingWhere(filter.SupplierID, filter.ProductID, "supplier_id", "product_id")
-	query := supplierProductMappingSelectSQL + where + ` ORDER BY created_at DESC`
+
+	cursorMode := filter.PageToken != ""
+	var total int32
+	if cursorMode {
+		createdAt, id, err := decodeTimeCursor(filter.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, createdAt, id)
+		where = appendWhere(where, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	} else {
+		if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM supplier_product_mappings`+where, args...); err != nil {
+			return nil, fmt.Errorf("failed to count supplier product mappings: %w", err)
+		}
+	}
+
+	args = append(args, filter.PageSize+1, pageOffset(filter.Page, filter.PageSize, cursorMode))
+	query := supplierProductMappingSelectSQL + where +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d", len(args)-1, len(args))
 
 	var list []domain.SupplierProductMapping
 	if err := r.db.SelectContext(ctx, &list, query, args...); err != nil {
 		return nil, fmt.Errorf("failed to list supplier product mappings: %w", err)
 	}
-	return list, nil
+
+	result := &domain.SupplierProductMappingListResult{Total: total}
+	if len(list) > int(filter.PageSize) {
+		list = list[:filter.PageSize]
+		last := list[len(list)-1]
+		result.NextPageToken = encodeTimeCursor(last.CreatedAt, last.ID)
+	}
+	result.Mappings = list
+	return result, nil
 }
 
 func (r *postgresSupplierProductMappingRepository) Update(
@@ -226,6 +278,13 @@ func buildMappingWhere(supplierID int64, entityID, supplierCol, entityCol string
 	return " WHERE " + strings.Join(where, " AND "), args
 }
 
+func appendWhere(where, condition string) string {
+	if where == "" {
+		return " WHERE " + condition
+	}
+	return where + " AND " + condition
+}
+
 const supplierCategoryMappingSelectSQL = `SELECT id, category_id, supplier_id, external_id, external_name, notes, created_at, updated_at FROM supplier_category_mappings`
 
 const supplierProductMappingSelectSQL = `SELECT id, product_id, supplier_id, external_id, external_sku, external_name, notes, created_at, updated_at FROM supplier_product_mappings`
@@ -0,0 +1,96 @@
+package postgres
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+	"github.com/google/uuid"
+)
+
+func pageOffset(page, pageSize int32, cursorMode bool) int32 {
+	if cursorMode || page < 1 {
+		return 0
+	}
+	return (page - 1) * pageSize
+}
+
+func encodeTimeCursor(createdAt time.Time, id string) string {
+	return domain.EncodePageCursor(domain.PageCursor{
+		Keys: []string{createdAt.UTC().Format(time.RFC3339Nano), id},
+	})
+}
+
+func decodeTimeCursor(token string) (time.Time, string, error) {
+	cursor, err := domain.DecodePageCursor(token)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	if len(cursor.Keys) != 2 {
+		return time.Time{}, "", domain.ErrInvalidPageToken
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, cursor.Keys[0])
+	if err != nil {
+		return time.Time{}, "", domain.ErrInvalidPageToken
+	}
+	if err := uuid.Validate(cursor.Keys[1]); err != nil {
+		return time.Time{}, "", domain.ErrInvalidPageToken
+	}
+	return createdAt, cursor.Keys[1], nil
+}
+
+func encodeNameCursor(name string, id int64) string {
+	return domain.EncodePageCursor(domain.PageCursor{
+		Keys: []string{name, strconv.FormatInt(id, 10)},
+	})
+}
+
+func decodeNameCursor(token string) (string, int64, error) {
+	cursor, err := domain.DecodePageCursor(token)
+	if err != nil {
+		return "", 0, err
+	}
+	if len(cursor.Keys) != 2 {
+		return "", 0, domain.ErrInvalidPageToken
+	}
+	id, err := strconv.ParseInt(cursor.Keys[1], 10, 64)
+	if err != nil {
+		return "", 0, domain.ErrInvalidPageToken
+	}
+	return cursor.Keys[0], id, nil
+}
+
+func parseCursorTime(value string) (interface{}, error) {
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil, domain.ErrInvalidPageToken
+	}
+	return parsed, nil
+}
+
+func parseCursorUUID(value string) (interface{}, error) {
+	if err := uuid.Validate(value); err != nil {
+		return nil, domain.ErrInvalidPageToken
+	}
+	return value, nil
+}
+
+func parseCursorInt(value string) (interface{}, error) {
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, domain.ErrInvalidPageToken
+	}
+	return parsed, nil
+}
+
+func parseCursorBool(value string) (interface{}, error) {
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, domain.ErrInvalidPageToken
+	}
+	return parsed, nil
+}
+
+func parseCursorString(value string) (interface{}, error) {
+	return value, nil
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+func rawToken(json string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(json))
+}
+
+func TestTimeCursorRoundTrip(t *testing.T) {
+	createdAt := time.Date(2025, 3, 14, 15, 9, 26, 535897932, time.FixedZone("MSK", 3*60*60))
+	id := "8f14e45f-ceea-467f-a9d5-0f1b3c2d4e5a"
+
+	gotTime, gotID, err := decodeTimeCursor(encodeTimeCursor(createdAt, id))
+	if err != nil {
+		t.Fatalf("decodeTimeCursor returned error: %v", err)
+	}
+	if !gotTime.Equal(createdAt) || gotID != id {
+		t.Fatalf("expected %v/%s, got %v/%s", createdAt, id, gotTime, gotID)
+	}
+}
+
+func TestNameCursorRoundTrip(t *testing.T) {
+	for _, name := range []string{"Acme", "", "Ünïcode, \"quoted\" & spaced"} {
+		gotName, gotID, err := decodeNameCursor(encodeNameCursor(name, 42))
+		if err != nil {
+			t.Fatalf("%q: decodeNameCursor returned error: %v", name, err)
+		}
+		if gotName != name || gotID != 42 {
+			t.Fatalf("expected %q/42, got %q/%d", name, gotName, gotID)
+		}
+	}
+}
+
+func TestCursorDecodingRejectsTamperedTokens(t *testing.T) {
+	validTime := encodeTimeCursor(time.Now(), "8f14e45f-ceea-467f-a9d5-0f1b3c2d4e5a")
+	validName := encodeNameCursor("Acme", 7)
+
+	tests := []struct {
+		name   string
+		token  string
+		decode func(string) error
+	}{
+		{"time: not base64", "%%%", decodeTime},
+		{"time: truncated", validTime[:len(validTime)-4], decodeTime},
+		{"time: not JSON", rawToken("created_at=yesterday"), decodeTime},
+		{"time: no keys", rawToken(`{"k":[]}`), decodeTime},
+		{"time: extra key", rawToken(`{"k":["2025-01-01T00:00:00Z","8f14e45f-ceea-467f-a9d5-0f1b3c2d4e5a","x"]}`), decodeTime},
+		{"time: bad timestamp", rawToken(`{"k":["yesterday","8f14e45f-ceea-467f-a9d5-0f1b3c2d4e5a"]}`), decodeTime},
+		{"time: injected id", rawToken(`{"k":["2025-01-01T00:00:00Z","1 OR 1=1"]}`), decodeTime},
+		{"time: name cursor", validName, decodeTime},
+		{"name: not base64", "%%%", decodeName},
+		{"name: single key", rawToken(`{"k":["Acme"]}`), decodeName},
+		{"name: non-numeric id", rawToken(`{"k":["Acme","seven"]}`), decodeName},
+		{"name: time cursor", validTime, decodeName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.decode(tt.token); !errors.Is(err, domain.ErrInvalidPageToken) {
+				t.Fatalf("expected ErrInvalidPageToken, got %v", err)
+			}
+		})
+	}
+}
+
+func decodeTime(token string) error {
+	_, _, err := decodeTimeCursor(token)
+	return err
+}
+
+func decodeName(token string) error {
+	_, _, err := decodeNameCursor(token)
+	return err
+}
+
+func TestPageOffset(t *testing.T) {
+	tests := []struct {
+		page, pageSize int32
+		cursorMode     bool
+		want           int32
+	}{
+		{1, 20, false, 0},
+		{3, 20, false, 40},
+		{0, 20, false, 0},
+		{-2, 20, false, 0},
+		{3, 20, true, 0},
+	}
+	for _, tt := range tests {
+		if got := pageOffset(tt.page, tt.pageSize, tt.cursorMode); got != tt.want {
+			t.Fatalf("pageOffset(%d, %d, %v) = %d, want %d", tt.page, tt.pageSize, tt.cursorMode, got, tt.want)
+		}
+	}
+}
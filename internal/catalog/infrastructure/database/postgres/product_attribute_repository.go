@@ -8,12 +8,14 @@ import (
 
 	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type ProductAttributeRepository interface {
 	Create(ctx context.Context, attr *domain.ProductAttribute) error
 	GetByID(ctx context.Context, id string) (*domain.ProductAttribute, error)
 	ListByProductID(ctx context.Context, productID string) ([]domain.ProductAttribute, error)
+	ListByProductIDs(ctx context.Context, productIDs []string) ([]domain.ProductAttribute, error)
 	Update(ctx context.Context, attr *domain.ProductAttribute) error
 	Delete(ctx context.Context, id string) error
 }
@@ -61,6 +63,23 @@ func (r *postgresProductAttributeRepository) ListByProductID(
 	return attrs, nil
 }
 
+func (r *postgresProductAttributeRepository) ListByProductIDs(
+	ctx context.Context,
+	productIDs []string,
+) ([]domain.ProductAttribute, error) {
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+	var attrs []domain.ProductAttribute
+	err := r.db.SelectContext(ctx, &attrs,
+		productAttributeSelectSQL+` WHERE product_id = ANY($1) ORDER BY sort_order ASC, name ASC`,
+		pq.Array(productIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list product attributes: %w", err)
+	}
+	return attrs, nil
+}
+
 func (r *postgresProductAttributeRepository) Update(ctx context.Context, attr *domain.ProductAttribute) error {
 	result, err := r.db.NamedExecContext(ctx,
 		`UPDATE product_attributes SET name = :name, value = :value, sort_order = :sort_order,
@@ -8,12 +8,14 @@ import (
 
 	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type ProductImageRepository interface {
 	Create(ctx context.Context, image *domain.ProductImage) error
 	GetByID(ctx context.Context, id string) (*domain.ProductImage, error)
 	ListByProductID(ctx context.Context, productID string) ([]domain.ProductImage, error)
+	ListPrimaryByProductIDs(ctx context.Context, productIDs []string) ([]domain.ProductImage, error)
 	Update(ctx context.Context, image *domain.ProductImage) error
 	Delete(ctx context.Context, id string) error
 	UnsetPrimaryForProduct(ctx context.Context, productID string, exceptID string) error
@@ -68,6 +70,22 @@ func (r *postgresProductImageRepository) ListByProductID(
 	return images, nil
 }
 
+func (r *postgresProductImageRepository) ListPrimaryByProductIDs(
+	ctx context.Context,
+	productIDs []string,
+) ([]domain.ProductImage, error) {
+	if len(productIDs) == 0 {
+		return nil, nil
+	}
+	var images []domain.ProductImage
+	err := r.db.SelectContext(ctx, &images,
+		productImageSelectSQL+` WHERE product_id = ANY($1) AND is_primary = TRUE`, pq.Array(productIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list primary product images: %w", err)
+	}
+	return images, nil
+}
+
 func (r *postgresProductImageRepository) Update(ctx context.Context, image *domain.ProductImage) error {
 	result, err := r.db.NamedExecContext(ctx,
 		`UPDATE product_images SET
@@ -0,0 +1,135 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type ProductListRepository interface {
+	// Add stores the item and returns it, or returns the stored item when
+	// the product is already on the list. A positive maxItems bounds the
+	// list (ErrProductListFull); concurrent additions to one list are
+	// serialized so that the bound holds.
+	Add(ctx context.Context, item *domain.ProductListItem, maxItems int) (*domain.ProductListItem, error)
+	Remove(ctx context.Context, userID, listType, productID string) error
+	List(ctx context.Context, userID, listType string) ([]domain.ProductListItem, error)
+	Count(ctx context.Context, userID, listType string) (int32, error)
+}
+
+type postgresProductListRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresProductListRepository(db *sqlx.DB) ProductListRepository {
+	return &postgresProductListRepository{db: db}
+}
+
+func (r *postgresProductListRepository) Add(
+	ctx context.Context,
+	item *domain.ProductListItem,
+	maxItems int,
+) (*domain.ProductListItem, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Row locks cannot cover items that do not exist yet, so the list as a
+	// whole is locked for the rest of the transaction.
+	if _, err = tx.ExecContext(ctx,
+		`SELECT pg_advisory_xact_lock(hashtext($1), hashtext($2))`,
+		item.UserID, item.ListType); err != nil {
+		return nil, fmt.Errorf("failed to lock product list: %w", err)
+	}
+
+	// A product already on the list keeps its original position.
+	var existing domain.ProductListItem
+	err = tx.GetContext(ctx, &existing,
+		`SELECT user_id, list_type, product_id, created_at FROM product_list_items
+         WHERE user_id = $1 AND list_type = $2 AND product_id = $3`,
+		item.UserID, item.ListType, item.ProductID)
+	if err == nil {
+		return &existing, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return nil, fmt.Errorf("failed to get product list item: %w", err)
+	}
+
+	if maxItems > 0 {
+		count, err := countProductListItems(ctx, tx, item.UserID, item.ListType)
+		if err != nil {
+			return nil, err
+		}
+		if count >= int32(maxItems) {
+			return nil, domain.ErrProductListFull
+		}
+	}
+
+	if _, err = tx.NamedExecContext(ctx,
+		`INSERT INTO product_list_items (user_id, list_type, product_id, created_at)
+         VALUES (:user_id, :list_type, :product_id, :created_at)`, item); err != nil {
+		return nil, fmt.Errorf("failed to add product list item: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit product list transaction: %w", err)
+	}
+	tx = nil
+	return item, nil
+}
+
+func (r *postgresProductListRepository) Remove(ctx context.Context, userID, listType, productID string) error {
+	result, err := r.db.ExecContext(ctx,
+		`DELETE FROM product_list_items WHERE user_id = $1 AND list_type = $2 AND product_id = $3`,
+		userID, listType, productID)
+	if err != nil {
+		return fmt.Errorf("failed to remove product list item: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrProductListItemNotFound
+	}
+	return nil
+}
+
+func (r *postgresProductListRepository) List(
+	ctx context.Context,
+	userID, listType string,
+) ([]domain.ProductListItem, error) {
+	var items []domain.ProductListItem
+	err := r.db.SelectContext(ctx, &items,
+		`SELECT user_id, list_type, product_id, created_at FROM product_list_items
+         WHERE user_id = $1 AND list_type = $2 ORDER BY created_at DESC, product_id ASC`,
+		userID, listType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list product list items: %w", err)
+	}
+	return items, nil
+}
+
+func (r *postgresProductListRepository) Count(ctx context.Context, userID, listType string) (int32, error) {
+	return countProductListItems(ctx, r.db, userID, listType)
+}
+
+func countProductListItems(ctx context.Context, q sqlx.QueryerContext, userID, listType string) (int32, error) {
+	var count int32
+	err := sqlx.GetContext(ctx, q, &count,
+		`SELECT COUNT(*) FROM product_list_items WHERE user_id = $1 AND list_type = $2`, userID, listType)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count product list items: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,257 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+)
+
+type ProductQuestionRepository interface {
+	CreateQuestion(ctx context.Context, question *domain.ProductQuestion) error
+	GetQuestion(ctx context.Context, id string) (*domain.ProductQuestion, error)
+	ListQuestions(ctx context.Context, filter domain.ProductQuestionFilter) (*domain.ProductQuestionListResult, error)
+	UpdateQuestionStatus(ctx context.Context, question *domain.ProductQuestion) error
+	DeleteQuestion(ctx context.Context, id string) error
+
+	CreateAnswer(ctx context.Context, answer *domain.ProductAnswer) error
+	GetAnswer(ctx context.Context, id string) (*domain.ProductAnswer, error)
+	ListAnswersByQuestionIDs(ctx context.Context, questionIDs []string, status string) ([]domain.ProductAnswer, error)
+	UpdateAnswerStatus(ctx context.Context, answer *domain.ProductAnswer) error
+	DeleteAnswer(ctx context.Context, id string) error
+}
+
+type postgresProductQuestionRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresProductQuestionRepository(db *sqlx.DB) ProductQuestionRepository {
+	return &postgresProductQuestionRepository{db: db}
+}
+
+func (r *postgresProductQuestionRepository) CreateQuestion(
+	ctx context.Context,
+	question *domain.ProductQuestion,
+) error {
+	_, err := r.db.NamedExecContext(ctx,
+		`INSERT INTO product_questions (
+           id, product_id, user_id, body, status, moderation_note, created_at, updated_at
+         ) VALUES (
+           :id, :product_id, :user_id, :body, :status, :moderation_note, :created_at, :updated_at
+         )`, question)
+	if err != nil {
+		return fmt.Errorf("failed to create product question: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresProductQuestionRepository) GetQuestion(
+	ctx context.Context,
+	id string,
+) (*domain.ProductQuestion, error) {
+	var question domain.ProductQuestion
+	err := r.db.GetContext(ctx, &question, productQuestionSelectSQL+` WHERE id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrProductQuestionNotFound
+		}
+		return nil, fmt.Errorf("failed to get product question: %w", err)
+	}
+	return &question, nil
+}
+
+func (r *postgresProductQuestionRepository) ListQuestions(
+	ctx context.Context,
+	filter domain.ProductQuestionFilter,
+) (*domain.ProductQuestionListResult, error) {
+	where := ""
+	args := make([]interface{}, 0, 6)
+	if filter.ProductID != "" {
+		args = append(args, filter.ProductID)
+		where = appendWhere(where, fmt.Sprintf("product_id = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where = appendWhere(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filter.AnsweredOnly {
+		where = appendWhere(where, `EXISTS (
+            SELECT 1 FROM product_answers a
+            WHERE a.question_id = product_questions.id AND a.status = 'approved')`)
+	}
+
+	cursorMode := filter.PageToken != ""
+	var total int32
+	if cursorMode {
+		createdAt, id, err := decodeTimeCursor(filter.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, createdAt, id)
+		where = appendWhere(where, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	} else {
+		if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM product_questions`+where, args...); err != nil {
+			return nil, fmt.Errorf("failed to count product questions: %w", err)
+		}
+	}
+
+	args = append(args, filter.PageSize+1, pageOffset(filter.Page, filter.PageSize, cursorMode))
+	query := productQuestionSelectSQL + where +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	var list []domain.ProductQuestion
+	if err := r.db.SelectContext(ctx, &list, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list product questions: %w", err)
+	}
+
+	result := &domain.ProductQuestionListResult{Total: total}
+	if len(list) > int(filter.PageSize) {
+		list = list[:filter.PageSize]
+		last := list[len(list)-1]
+		result.NextPageToken = encodeTimeCursor(last.CreatedAt, last.ID)
+	}
+	result.Questions = list
+	return result, nil
+}
+
+func (r *postgresProductQuestionRepository) UpdateQuestionStatus(
+	ctx context.Context,
+	question *domain.ProductQuestion,
+) error {
+	result, err := r.db.NamedExecContext(ctx,
+		`UPDATE product_questions SET
+           status = :status,
+           moderation_note = :moderation_note,
+           moderated_at = :moderated_at,
+           updated_at = :updated_at
+         WHERE id = :id`, question)
+	if err != nil {
+		return fmt.Errorf("failed to update product question: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrProductQuestionNotFound
+	}
+	return nil
+}
+
+func (r *postgresProductQuestionRepository) DeleteQuestion(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM product_questions WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete product question: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrProductQuestionNotFound
+	}
+	return nil
+}
+
+func (r *postgresProductQuestionRepository) CreateAnswer(
+	ctx context.Context,
+	answer *domain.ProductAnswer,
+) error {
+	_, err := r.db.NamedExecContext(ctx,
+		`INSERT INTO product_answers (
+           id, question_id, user_id, body, status, moderation_note, created_at, updated_at
+         ) VALUES (
+           :id, :question_id, :user_id, :body, :status, :moderation_note, :created_at, :updated_at
+         )`, answer)
+	if err != nil {
+		return fmt.Errorf("failed to create product answer: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresProductQuestionRepository) GetAnswer(
+	ctx context.Context,
+	id string,
+) (*domain.ProductAnswer, error) {
+	var answer domain.ProductAnswer
+	err := r.db.GetContext(ctx, &answer, productAnswerSelectSQL+` WHERE id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrProductAnswerNotFound
+		}
+		return nil, fmt.Errorf("failed to get product answer: %w", err)
+	}
+	return &answer, nil
+}
+
+// ListAnswersByQuestionIDs returns answers of all given questions; an empty
+// status returns answers in any moderation state.
+func (r *postgresProductQuestionRepository) ListAnswersByQuestionIDs(
+	ctx context.Context,
+	questionIDs []string,
+	status string,
+) ([]domain.ProductAnswer, error) {
+	if len(questionIDs) == 0 {
+		return nil, nil
+	}
+	query := productAnswerSelectSQL + ` WHERE question_id = ANY($1)`
+	args := []interface{}{pq.Array(questionIDs)}
+	if status != "" {
+		args = append(args, status)
+		query += ` AND status = $2`
+	}
+	query += ` ORDER BY created_at ASC, id ASC`
+
+	var answers []domain.ProductAnswer
+	if err := r.db.SelectContext(ctx, &answers, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list product answers: %w", err)
+	}
+	return answers, nil
+}
+
+func (r *postgresProductQuestionRepository) UpdateAnswerStatus(
+	ctx context.Context,
+	answer *domain.ProductAnswer,
+) error {
+	result, err := r.db.NamedExecContext(ctx,
+		`UPDATE product_answers SET
+           status = :status,
+           moderation_note = :moderation_note,
+           moderated_at = :moderated_at,
+           updated_at = :updated_at
+         WHERE id = :id`, answer)
+	if err != nil {
+		return fmt.Errorf("failed to update product answer: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrProductAnswerNotFound
+	}
+	return nil
+}
+
+func (r *postgresProductQuestionRepository) DeleteAnswer(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM product_answers WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete product answer: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrProductAnswerNotFound
+	}
+	return nil
+}
+
+const productQuestionSelectSQL = `SELECT id, product_id, user_id, body, status, moderation_note, moderated_at, created_at, updated_at FROM product_questions`
+
+const productAnswerSelectSQL = `SELECT id, question_id, user_id, body, status, moderation_note, moderated_at, created_at, updated_at FROM product_answers`
@@ -0,0 +1,204 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type ProductRelationRepository interface {
+	Create(ctx context.Context, relations ...*domain.ProductRelation) error
+	GetByID(ctx context.Context, id string) (*domain.ProductRelation, error)
+	ListByProductID(ctx context.Context, productID string) ([]domain.ProductRelation, error)
+	// GetPair returns the other row of a bidirectional relation.
+	GetPair(ctx context.Context, relation *domain.ProductRelation) (*domain.ProductRelation, error)
+	// Update saves all the given relations in one transaction.
+	Update(ctx context.Context, relations ...*domain.ProductRelation) error
+	// Delete removes the relation together with the other row of its pair
+	// when withPair is set. Otherwise that row is kept as a one-way
+	// relation.
+	Delete(ctx context.Context, id string, withPair bool) error
+}
+
+type postgresProductRelationRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresProductRelationRepository(db *sqlx.DB) ProductRelationRepository {
+	return &postgresProductRelationRepository{db: db}
+}
+
+func (r *postgresProductRelationRepository) Create(
+	ctx context.Context,
+	relations ...*domain.ProductRelation,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, relation := range relations {
+		_, err = tx.NamedExecContext(ctx,
+			`INSERT INTO product_relations (
+               id, product_id, related_product_id, relation_type, sort_order, pair_id, created_at, updated_at
+             ) VALUES (
+               :id, :product_id, :related_product_id, :relation_type, :sort_order, :pair_id, :created_at, :updated_at
+             )`, relation)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return domain.ErrAlreadyExists
+			}
+			return fmt.Errorf("failed to create product relation: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product relation transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func (r *postgresProductRelationRepository) GetByID(
+	ctx context.Context,
+	id string,
+) (*domain.ProductRelation, error) {
+	var relation domain.ProductRelation
+	err := r.db.GetContext(ctx, &relation, productRelationSelectSQL+` WHERE id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrProductRelationNotFound
+		}
+		return nil, fmt.Errorf("failed to get product relation: %w", err)
+	}
+	return &relation, nil
+}
+
+func (r *postgresProductRelationRepository) ListByProductID(
+	ctx context.Context,
+	productID string,
+) ([]domain.ProductRelation, error) {
+	var relations []domain.ProductRelation
+	err := r.db.SelectContext(ctx, &relations,
+		productRelationSelectSQL+` WHERE product_id = $1 ORDER BY relation_type ASC, sort_order ASC, created_at ASC`,
+		productID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list product relations: %w", err)
+	}
+	return relations, nil
+}
+
+func (r *postgresProductRelationRepository) GetPair(
+	ctx context.Context,
+	relation *domain.ProductRelation,
+) (*domain.ProductRelation, error) {
+	if relation.PairID == nil {
+		return nil, domain.ErrProductRelationNotFound
+	}
+	var pair domain.ProductRelation
+	err := r.db.GetContext(ctx, &pair,
+		productRelationSelectSQL+` WHERE pair_id = $1 AND id <> $2`, *relation.PairID, relation.ID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrProductRelationNotFound
+		}
+		return nil, fmt.Errorf("failed to get paired product relation: %w", err)
+	}
+	return &pair, nil
+}
+
+func (r *postgresProductRelationRepository) Update(
+	ctx context.Context,
+	relations ...*domain.ProductRelation,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	for _, relation := range relations {
+		result, err := tx.NamedExecContext(ctx,
+			`UPDATE product_relations SET
+               relation_type = :relation_type,
+               sort_order = :sort_order,
+               updated_at = :updated_at
+             WHERE id = :id`, relation)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return domain.ErrAlreadyExists
+			}
+			return fmt.Errorf("failed to update product relation: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rows == 0 {
+			return domain.ErrProductRelationNotFound
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product relation transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func (r *postgresProductRelationRepository) Delete(ctx context.Context, id string, withPair bool) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var pairID *string
+	err = tx.GetContext(ctx, &pairID, `DELETE FROM product_relations WHERE id = $1 RETURNING pair_id`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrProductRelationNotFound
+		}
+		return fmt.Errorf("failed to delete product relation: %w", err)
+	}
+	switch {
+	case pairID == nil:
+	case withPair:
+		if _, err = tx.ExecContext(ctx, `DELETE FROM product_relations WHERE pair_id = $1`, *pairID); err != nil {
+			return fmt.Errorf("failed to delete paired product relation: %w", err)
+		}
+	default:
+		_, err = tx.ExecContext(ctx,
+			`UPDATE product_relations SET pair_id = NULL, updated_at = $2 WHERE pair_id = $1`,
+			*pairID, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to detach paired product relation: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product relation transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+const productRelationSelectSQL = `SELECT id, product_id, related_product_id, relation_type, sort_order, pair_id, created_at, updated_at FROM product_relations`
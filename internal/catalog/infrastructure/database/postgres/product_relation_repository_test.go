@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+func TestProductRelationRepositoryDelete(t *testing.T) {
+	const pairID = "4a7c2b1e-9d3f-4e6a-8b5c-0f1e2d3c4b5a"
+	returning := func(pair driver.Value) func(string, []driver.Value) fakeResult {
+		return func(query string, _ []driver.Value) fakeResult {
+			if strings.Contains(query, "RETURNING pair_id") {
+				return fakeResult{columns: []string{"pair_id"}, rows: [][]driver.Value{{pair}}}
+			}
+			return fakeResult{affected: 1}
+		}
+	}
+
+	tests := []struct {
+		name     string
+		withPair bool
+		respond  func(string, []driver.Value) fakeResult
+		wantErr  error
+		wantLog  []string
+	}{
+		{
+			name:     "one-way relation",
+			withPair: true,
+			respond:  returning(nil),
+			wantLog:  []string{"BEGIN", "DELETE FROM product_relations WHERE id = $1 RETURNING pair_id", "COMMIT"},
+		},
+		{
+			name:     "pair deleted together",
+			withPair: true,
+			respond:  returning(pairID),
+			wantLog: []string{
+				"BEGIN",
+				"DELETE FROM product_relations WHERE id = $1 RETURNING pair_id",
+				"DELETE FROM product_relations WHERE pair_id = $1",
+				"COMMIT",
+			},
+		},
+		{
+			name:     "other row of the pair kept as one-way",
+			withPair: false,
+			respond:  returning(pairID),
+			wantLog: []string{
+				"BEGIN",
+				"DELETE FROM product_relations WHERE id = $1 RETURNING pair_id",
+				"UPDATE product_relations SET pair_id = NULL, updated_at = $2 WHERE pair_id = $1",
+				"COMMIT",
+			},
+		},
+		{
+			name:     "failure on the other row keeps both",
+			withPair: true,
+			respond: func(query string, args []driver.Value) fakeResult {
+				if strings.Contains(query, "WHERE pair_id = $1") {
+					return fakeResult{err: errDeadlock}
+				}
+				return returning(pairID)(query, args)
+			},
+			wantErr: errDeadlock,
+			wantLog: []string{
+				"BEGIN",
+				"DELETE FROM product_relations WHERE id = $1 RETURNING pair_id",
+				"DELETE FROM product_relations WHERE pair_id = $1",
+				"ROLLBACK",
+			},
+		},
+		{
+			name:     "missing relation",
+			withPair: true,
+			respond: func(string, []driver.Value) fakeResult {
+				return fakeResult{columns: []string{"pair_id"}}
+			},
+			wantErr: domain.ErrProductRelationNotFound,
+			wantLog: []string{"BEGIN", "DELETE FROM product_relations WHERE id = $1 RETURNING pair_id", "ROLLBACK"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, fake := newFakeDB(t, tt.respond)
+			err := NewPostgresProductRelationRepository(db).Delete(context.Background(), "relation-1", tt.withPair)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if got := fake.log(); !reflect.DeepEqual(got, tt.wantLog) {
+				t.Fatalf("expected statements %q, got %q", tt.wantLog, got)
+			}
+			if statement, ok := fake.find("WHERE pair_id = $1"); ok && statement.args[0] != pairID {
+				t.Fatalf("expected the pair %s to be used, got %v", pairID, statement.args)
+			}
+		})
+	}
+}
+
+func TestProductRelationRepositoryGetPairOfOneWayRelation(t *testing.T) {
+	db, fake := newFakeDB(t, nil)
+
+	_, err := NewPostgresProductRelationRepository(db).GetPair(context.Background(), &domain.ProductRelation{ID: "relation-1"})
+	if !errors.Is(err, domain.ErrProductRelationNotFound) {
+		t.Fatalf("expected ErrProductRelationNotFound, got %v", err)
+	}
+	if log := fake.log(); len(log) != 0 {
+		t.Fatalf("expected no query for a one-way relation, got %q", log)
+	}
+}
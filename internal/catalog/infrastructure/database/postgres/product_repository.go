@@ -5,20 +5,30 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 )
 
 type ProductRepository interface {
 	Create(ctx context.Context, product *domain.Product) error
 	GetByID(ctx context.Context, id string) (*domain.Product, error)
+	ListByIDs(ctx context.Context, ids []string) ([]domain.Product, error)
 	List(ctx context.Context, filter domain.ProductListFilter) (*domain.ProductListResult, error)
 	Update(ctx context.Context, product *domain.Product) error
+	UpdatePrice(ctx context.Context, id string, priceCents int64, updatedAt time.Time) error
 	Delete(ctx context.Context, id string) error
 	CountBySupplier(ctx context.Context, supplierID int64) (int64, error)
 	CountByBrand(ctx context.Context, brandID string) (int64, error)
+	ReserveStock(ctx context.Context, lines []domain.StockLine) error
+	// CommitStock settles reservations of the stock lines and counts the sold
+	// lines, as ordered, towards the popularity of their products.
+	CommitStock(ctx context.Context, lines, sold []domain.StockLine) error
+	ReleaseStock(ctx context.Context, lines []domain.StockLine) error
 }
 
 type postgresProductRepository struct {
@@ -30,12 +40,7 @@ func NewPostgresProductRepository(db *sqlx.DB) ProductRepository {
 }
 
 func (r *postgresProductRepository) Create(ctx context.Context, product *domain.Product) error {
-	query := `INSERT INTO products (
-	            id, category_id, brand_id, supplier_id, name, description, price_cents, sku, stock, is_active, created_at, updated_at
-	          ) VALUES (
-	            :id, :category_id, :brand_id, :supplier_id, :name, :description, :price_cents, :sku, :stock, :is_active, :created_at, :updated_at
-	          )`
-	_, err := r.db.NamedExecContext(ctx, query, product)
+	_, err := r.db.NamedExecContext(ctx, productInsertSQL, product)
 	if err != nil {
 		if isUniqueViolation(err) {
 			return domain.ErrAlreadyExists
@@ -57,6 +62,17 @@ func (r *postgresProductRepository) GetByID(ctx context.Context, id string) (*do
 	return &product, nil
 }
 
+func (r *postgresProductRepository) ListByIDs(ctx context.Context, ids []string) ([]domain.Product, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	var products []domain.Product
+	if err := r.db.SelectContext(ctx, &products, productSelectSQL+` WHERE id = ANY($1)`, pq.Array(ids)); err != nil {
+		return nil, fmt.Errorf("failed to list products by ids: %w", err)
+	}
+	return products, nil
+}
+
 func (r *postgresProductRepository) List(
 	ctx context.Context,
 	filter domain.ProductListFilter,
@@ -80,28 +96,60 @@ func (r *postgresProductRepository) List(
 		where = append(where, "is_active = TRUE")
 	}
 
+	sortName := filter.Sort
+	if sortName == "" {
+		sortName = domain.ProductSortNewest
+	}
+	sortColumns, ok := productSorts[sortName]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown sort %q, use one of %s",
+			domain.ErrInvalidArgument, sortName, strings.Join(productSortNames, ", "))
+	}
+
+	cursorMode := filter.PageToken != ""
+	if cursorMode {
+		values, err := decodeProductCursor(filter.PageToken, sortName, sortColumns)
+		if err != nil {
+			return nil, err
+		}
+		var condition string
+		condition, args = productKeysetCondition(sortColumns, values, args)
+		where = append(where, condition)
+	}
+
 	whereSQL := ""
 	if len(where) > 0 {
 		whereSQL = " WHERE " + strings.Join(where, " AND ")
 	}
 
-	countQuery := `SELECT COUNT(*) FROM products` + whereSQL
+	// The total is only computed in offset mode: keyset pages are meant for
+	// infinite scrolling where a full COUNT(*) on every request is wasted work.
 	var total int32
-	if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
-		return nil, fmt.Errorf("failed to count products: %w", err)
+	if !cursorMode {
+		countQuery := `SELECT COUNT(*) FROM products` + whereSQL
+		if err := r.db.GetContext(ctx, &total, countQuery, args...); err != nil {
+			return nil, fmt.Errorf("failed to count products: %w", err)
+		}
 	}
 
-	offset := (filter.Page - 1) * filter.PageSize
-	args = append(args, filter.PageSize, offset)
-	listQuery := productSelectSQL + whereSQL +
-		fmt.Sprintf(" ORDER BY created_at DESC LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+	offset := pageOffset(filter.Page, filter.PageSize, cursorMode)
+	args = append(args, filter.PageSize+1, offset)
+	listQuery := productSelectSQL + whereSQL + productOrderBy(sortColumns) +
+		fmt.Sprintf(" LIMIT $%d OFFSET $%d", len(args)-1, len(args))
 
 	var products []domain.Product
 	if err := r.db.SelectContext(ctx, &products, listQuery, args...); err != nil {
 		return nil, fmt.Errorf("failed to list products: %w", err)
 	}
 
-	return &domain.ProductListResult{Products: products, Total: total}, nil
+	result := &domain.ProductListResult{Total: total}
+	if len(products) > int(filter.PageSize) {
+		products = products[:filter.PageSize]
+		last := products[len(products)-1]
+		result.NextPageToken = encodeProductCursor(&last, sortName, sortColumns)
+	}
+	result.Products = products
+	return result, nil
 }
 
 func (r *postgresProductRepository) Update(ctx context.Context, product *domain.Product) error {
@@ -134,6 +182,29 @@ func (r *postgresProductRepository) Update(ctx context.Context, product *domain.
 	return nil
 }
 
+func (r *postgresProductRepository) UpdatePrice(
+	ctx context.Context,
+	id string,
+	priceCents int64,
+	updatedAt time.Time,
+) error {
+	result, err := r.db.ExecContext(ctx,
+		`UPDATE products SET price_cents = $2, updated_at = $3 WHERE id = $1`,
+		id, priceCents, updatedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update product price: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrProductNotFound
+	}
+	return nil
+}
+
 func (r *postgresProductRepository) Delete(ctx context.Context, id string) error {
 	result, err := r.db.ExecContext(ctx, `DELETE FROM products WHERE id = $1`, id)
 	if err != nil {
@@ -168,4 +239,231 @@ func (r *postgresProductRepository) CountBySupplier(ctx context.Context, supplie
 	return count, nil
 }
 
-const productSelectSQL = `SELECT id, category_id, brand_id, supplier_id, name, description, price_cents, sku, stock, is_active, created_at, updated_at FROM products`
+func (r *postgresProductRepository) ReserveStock(ctx context.Context, lines []domain.StockLine) error {
+	return r.applyStock(ctx, lines,
+		`UPDATE products SET stock = stock - $2, reserved_stock = reserved_stock + $2, updated_at = NOW()
+         WHERE id = $1 AND kind = 'simple' AND stock >= $2`,
+		domain.ErrInsufficientStock, nil)
+}
+
+func (r *postgresProductRepository) CommitStock(ctx context.Context, lines, sold []domain.StockLine) error {
+	return r.applyStock(ctx, lines,
+		`UPDATE products SET reserved_stock = reserved_stock - $2, updated_at = NOW()
+         WHERE id = $1 AND kind = 'simple' AND reserved_stock >= $2`,
+		domain.ErrStockNotReserved, sold)
+}
+
+func (r *postgresProductRepository) ReleaseStock(ctx context.Context, lines []domain.StockLine) error {
+	return r.applyStock(ctx, lines,
+		`UPDATE products SET stock = stock + $2, reserved_stock = reserved_stock - $2, updated_at = NOW()
+         WHERE id = $1 AND kind = 'simple' AND reserved_stock >= $2`,
+		domain.ErrStockNotReserved, nil)
+}
+
+// applyStock runs query for every line in one transaction and refreshes the
+// derived stock of bundles built from the touched products. Callers pass lines
+// sorted by product id so concurrent calls lock rows in the same order. The
+// quantities of sold are added to the popularity of their products.
+func (r *postgresProductRepository) applyStock(
+	ctx context.Context,
+	lines []domain.StockLine,
+	query string,
+	shortErr error,
+	sold []domain.StockLine,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	ids := make([]string, 0, len(lines))
+	for _, line := range lines {
+		result, err := tx.ExecContext(ctx, query, line.ProductID, line.Quantity)
+		if err != nil {
+			return fmt.Errorf("failed to update product stock: %w", err)
+		}
+		rows, err := result.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("failed to get rows affected: %w", err)
+		}
+		if rows == 0 {
+			return shortErr
+		}
+		ids = append(ids, line.ProductID)
+	}
+
+	if _, err = tx.ExecContext(ctx, refreshBundlesSQL, pq.Array(ids)); err != nil {
+		return fmt.Errorf("failed to refresh bundles: %w", err)
+	}
+	if len(sold) > 0 {
+		soldIDs := make([]string, 0, len(sold))
+		quantities := make([]int32, 0, len(sold))
+		for _, line := range sold {
+			soldIDs = append(soldIDs, line.ProductID)
+			quantities = append(quantities, line.Quantity)
+		}
+		_, err = tx.ExecContext(ctx,
+			`UPDATE products SET popularity = popularity + sold.quantity
+             FROM (SELECT id, SUM(quantity) AS quantity FROM unnest($1::uuid[], $2::int[]) AS s(id, quantity)
+                   GROUP BY id) AS sold
+             WHERE products.id = sold.id`,
+			pq.Array(soldIDs), pq.Array(quantities))
+		if err != nil {
+			return fmt.Errorf("failed to update product popularity: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit stock transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+type productSortColumn struct {
+	expr       string
+	descending bool
+	value      func(product *domain.Product) string
+	parse      func(value string) (interface{}, error)
+}
+
+func (c productSortColumn) desc() productSortColumn {
+	c.descending = true
+	return c
+}
+
+var (
+	productByID = productSortColumn{
+		expr:  "id",
+		value: func(p *domain.Product) string { return p.ID },
+		parse: parseCursorUUID,
+	}
+	productByCreatedAt = productSortColumn{
+		expr:  "created_at",
+		value: func(p *domain.Product) string { return p.CreatedAt.UTC().Format(time.RFC3339Nano) },
+		parse: parseCursorTime,
+	}
+	productByPrice = productSortColumn{
+		expr:  "price_cents",
+		value: func(p *domain.Product) string { return strconv.FormatInt(p.PriceCents, 10) },
+		parse: parseCursorInt,
+	}
+	productByName = productSortColumn{
+		expr:  "name",
+		value: func(p *domain.Product) string { return p.Name },
+		parse: parseCursorString,
+	}
+	productByPopularity = productSortColumn{
+		expr:  "popularity",
+		value: func(p *domain.Product) string { return strconv.FormatInt(p.Popularity, 10) },
+		parse: parseCursorInt,
+	}
+	productByInStock = productSortColumn{
+		expr:  "(stock > 0)",
+		value: func(p *domain.Product) string { return strconv.FormatBool(p.Stock > 0) },
+		parse: parseCursorBool,
+	}
+)
+
+// productSorts is the whitelist of orderings accepted by List. Every ordering
+// ends with the primary key so that the result is deterministic and can be
+// continued with a keyset cursor.
+var productSorts = map[string][]productSortColumn{
+	domain.ProductSortNewest:     {productByCreatedAt.desc(), productByID.desc()},
+	domain.ProductSortPriceAsc:   {productByPrice, productByID},
+	domain.ProductSortPriceDesc:  {productByPrice.desc(), productByID.desc()},
+	domain.ProductSortName:       {productByName, productByID},
+	domain.ProductSortPopularity: {productByPopularity.desc(), productByCreatedAt.desc(), productByID.desc()},
+	domain.ProductSortInStock:    {productByInStock.desc(), productByCreatedAt.desc(), productByID.desc()},
+}
+
+// productSortNames lists the keys of productSorts in the order they are
+// reported to clients.
+var productSortNames = []string{
+	domain.ProductSortNewest,
+	domain.ProductSortPriceAsc,
+	domain.ProductSortPriceDesc,
+	domain.ProductSortName,
+	domain.ProductSortPopularity,
+	domain.ProductSortInStock,
+}
+
+func productOrderBy(columns []productSortColumn) string {
+	parts := make([]string, 0, len(columns))
+	for _, column := range columns {
+		direction := " ASC"
+		if column.descending {
+			direction = " DESC"
+		}
+		parts = append(parts, column.expr+direction)
+	}
+	return " ORDER BY " + strings.Join(parts, ", ")
+}
+
+// productKeysetCondition builds the "rows after the cursor" predicate. Row
+// value comparison cannot be used because the columns may be ordered in
+// different directions, so the predicate is expanded into
+// (a > x) OR (a = x AND b > y) OR ...
+func productKeysetCondition(
+	columns []productSortColumn,
+	values []interface{},
+	args []interface{},
+) (string, []interface{}) {
+	base := len(args)
+	args = append(args, values...)
+
+	alternatives := make([]string, 0, len(columns))
+	for i, column := range columns {
+		terms := make([]string, 0, i+1)
+		for j := 0; j < i; j++ {
+			terms = append(terms, fmt.Sprintf("%s = $%d", columns[j].expr, base+j+1))
+		}
+		op := ">"
+		if column.descending {
+			op = "<"
+		}
+		terms = append(terms, fmt.Sprintf("%s %s $%d", column.expr, op, base+i+1))
+		alternatives = append(alternatives, "("+strings.Join(terms, " AND ")+")")
+	}
+	return "(" + strings.Join(alternatives, " OR ") + ")", args
+}
+
+func encodeProductCursor(product *domain.Product, sortName string, columns []productSortColumn) string {
+	keys := make([]string, 0, len(columns))
+	for _, column := range columns {
+		keys = append(keys, column.value(product))
+	}
+	return domain.EncodePageCursor(domain.PageCursor{Sort: sortName, Keys: keys})
+}
+
+func decodeProductCursor(token, sortName string, columns []productSortColumn) ([]interface{}, error) {
+	cursor, err := domain.DecodePageCursor(token)
+	if err != nil {
+		return nil, err
+	}
+	if cursor.Sort != sortName || len(cursor.Keys) != len(columns) {
+		return nil, domain.ErrInvalidPageToken
+	}
+	values := make([]interface{}, 0, len(columns))
+	for i, column := range columns {
+		value, err := column.parse(cursor.Keys[i])
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+const productSelectSQL = `SELECT id, category_id, brand_id, supplier_id, kind, name, description, price_cents, sku, stock, reserved_stock, is_active, popularity, rating_avg, rating_count, created_at, updated_at FROM products`
+
+const productInsertSQL = `INSERT INTO products (
+	  id, category_id, brand_id, supplier_id, kind, name, description, price_cents, sku, stock, is_active, created_at, updated_at
+	) VALUES (
+	  :id, :category_id, :brand_id, :supplier_id, :kind, :name, :description, :price_cents, :sku, :stock, :is_active, :created_at, :updated_at
+	)`
@@ -0,0 +1,191 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+	"github.com/jmoiron/sqlx"
+)
+
+type ProductReviewRepository interface {
+	Create(ctx context.Context, review *domain.ProductReview) error
+	GetByID(ctx context.Context, id string) (*domain.ProductReview, error)
+	List(ctx context.Context, filter domain.ProductReviewFilter) (*domain.ProductReviewListResult, error)
+	UpdateStatus(ctx context.Context, review *domain.ProductReview) error
+	Delete(ctx context.Context, id string) error
+}
+
+type postgresProductReviewRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresProductReviewRepository(db *sqlx.DB) ProductReviewRepository {
+	return &postgresProductReviewRepository{db: db}
+}
+
+func (r *postgresProductReviewRepository) Create(ctx context.Context, review *domain.ProductReview) error {
+	_, err := r.db.NamedExecContext(ctx,
+		`INSERT INTO product_reviews (
+           id, product_id, user_id, rating, pros, cons, body, status, moderation_note, created_at, updated_at
+         ) VALUES (
+           :id, :product_id, :user_id, :rating, :pros, :cons, :body, :status, :moderation_note, :created_at, :updated_at
+         )`, review)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to create product review: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresProductReviewRepository) GetByID(ctx context.Context, id string) (*domain.ProductReview, error) {
+	var review domain.ProductReview
+	err := r.db.GetContext(ctx, &review, productReviewSelectSQL+` WHERE id = $1`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrProductReviewNotFound
+		}
+		return nil, fmt.Errorf("failed to get product review: %w", err)
+	}
+	return &review, nil
+}
+
+func (r *postgresProductReviewRepository) List(
+	ctx context.Context,
+	filter domain.ProductReviewFilter,
+) (*domain.ProductReviewListResult, error) {
+	where := ""
+	args := make([]interface{}, 0, 6)
+	if filter.ProductID != "" {
+		args = append(args, filter.ProductID)
+		where = appendWhere(where, fmt.Sprintf("product_id = $%d", len(args)))
+	}
+	if filter.UserID != "" {
+		args = append(args, filter.UserID)
+		where = appendWhere(where, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where = appendWhere(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+
+	cursorMode := filter.PageToken != ""
+	var total int32
+	if cursorMode {
+		createdAt, id, err := decodeTimeCursor(filter.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, createdAt, id)
+		where = appendWhere(where, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	} else {
+		if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM product_reviews`+where, args...); err != nil {
+			return nil, fmt.Errorf("failed to count product reviews: %w", err)
+		}
+	}
+
+	args = append(args, filter.PageSize+1, pageOffset(filter.Page, filter.PageSize, cursorMode))
+	query := productReviewSelectSQL + where +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	var list []domain.ProductReview
+	if err := r.db.SelectContext(ctx, &list, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list product reviews: %w", err)
+	}
+
+	result := &domain.ProductReviewListResult{Total: total}
+	if len(list) > int(filter.PageSize) {
+		list = list[:filter.PageSize]
+		last := list[len(list)-1]
+		result.NextPageToken = encodeTimeCursor(last.CreatedAt, last.ID)
+	}
+	result.Reviews = list
+	return result, nil
+}
+
+// UpdateStatus stores the moderation decision and recomputes the product
+// rating in the same transaction, so only approved reviews are ever counted.
+func (r *postgresProductReviewRepository) UpdateStatus(ctx context.Context, review *domain.ProductReview) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	result, err := tx.NamedExecContext(ctx,
+		`UPDATE product_reviews SET
+           status = :status,
+           moderation_note = :moderation_note,
+           moderated_at = :moderated_at,
+           updated_at = :updated_at
+         WHERE id = :id`, review)
+	if err != nil {
+		return fmt.Errorf("failed to update product review: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrProductReviewNotFound
+	}
+	if _, err = tx.ExecContext(ctx, refreshProductRatingSQL, review.ProductID); err != nil {
+		return fmt.Errorf("failed to refresh product rating: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product review transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func (r *postgresProductReviewRepository) Delete(ctx context.Context, id string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var productID string
+	err = tx.GetContext(ctx, &productID, `DELETE FROM product_reviews WHERE id = $1 RETURNING product_id`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrProductReviewNotFound
+		}
+		return fmt.Errorf("failed to delete product review: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, refreshProductRatingSQL, productID); err != nil {
+		return fmt.Errorf("failed to refresh product rating: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit product review transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+const productReviewSelectSQL = `SELECT id, product_id, user_id, rating, pros, cons, body, status, moderation_note, moderated_at, created_at, updated_at FROM product_reviews`
+
+const refreshProductRatingSQL = `UPDATE products SET
+    rating_count = r.count,
+    rating_avg = r.avg
+  FROM (
+    SELECT COUNT(*) AS count, COALESCE(ROUND(AVG(rating), 2), 0) AS avg
+    FROM product_reviews
+    WHERE product_id = $1 AND status = 'approved'
+  ) r
+  WHERE products.id = $1`
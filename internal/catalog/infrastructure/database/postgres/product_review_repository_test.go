@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+func TestProductReviewRepositoryRecomputesRating(t *testing.T) {
+	moderatedAt := time.Now()
+	review := &domain.ProductReview{
+		ID:          "review-1",
+		ProductID:   "product-1",
+		Status:      domain.ReviewStatusApproved,
+		ModeratedAt: &moderatedAt,
+		UpdatedAt:   moderatedAt,
+	}
+
+	tests := []struct {
+		name    string
+		call    func(repo ProductReviewRepository) error
+		respond func(query string, args []driver.Value) fakeResult
+		wantErr error
+		wantLog []string
+	}{
+		{
+			name: "moderation",
+			call: func(repo ProductReviewRepository) error { return repo.UpdateStatus(context.Background(), review) },
+			respond: func(string, []driver.Value) fakeResult {
+				return fakeResult{affected: 1}
+			},
+			wantLog: []string{"BEGIN", "UPDATE product_reviews SET", "UPDATE products SET", "COMMIT"},
+		},
+		{
+			name: "moderation of a missing review",
+			call: func(repo ProductReviewRepository) error { return repo.UpdateStatus(context.Background(), review) },
+			respond: func(string, []driver.Value) fakeResult {
+				return fakeResult{affected: 0}
+			},
+			wantErr: domain.ErrProductReviewNotFound,
+			wantLog: []string{"BEGIN", "UPDATE product_reviews SET", "ROLLBACK"},
+		},
+		{
+			name: "moderation when the rating cannot be refreshed",
+			call: func(repo ProductReviewRepository) error { return repo.UpdateStatus(context.Background(), review) },
+			respond: func(query string, _ []driver.Value) fakeResult {
+				if query == refreshProductRatingSQL {
+					return fakeResult{err: errDeadlock}
+				}
+				return fakeResult{affected: 1}
+			},
+			wantErr: errDeadlock,
+			wantLog: []string{"BEGIN", "UPDATE product_reviews SET", "UPDATE products SET", "ROLLBACK"},
+		},
+		{
+			name: "delete",
+			call: func(repo ProductReviewRepository) error { return repo.Delete(context.Background(), "review-1") },
+			respond: func(query string, _ []driver.Value) fakeResult {
+				if strings.HasPrefix(query, "DELETE") {
+					return fakeResult{columns: []string{"product_id"}, rows: [][]driver.Value{{"product-1"}}}
+				}
+				return fakeResult{affected: 1}
+			},
+			wantLog: []string{"BEGIN", "DELETE FROM product_reviews WHERE id = $1 RETURNING product_id", "UPDATE products SET", "COMMIT"},
+		},
+		{
+			name: "delete of a missing review",
+			call: func(repo ProductReviewRepository) error { return repo.Delete(context.Background(), "review-1") },
+			respond: func(query string, _ []driver.Value) fakeResult {
+				return fakeResult{columns: []string{"product_id"}}
+			},
+			wantErr: domain.ErrProductReviewNotFound,
+			wantLog: []string{"BEGIN", "DELETE FROM product_reviews WHERE id = $1 RETURNING product_id", "ROLLBACK"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, fake := newFakeDB(t, tt.respond)
+			err := tt.call(NewPostgresProductReviewRepository(db))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("expected %v, got %v", tt.wantErr, err)
+			}
+			if got := fake.log(); !reflect.DeepEqual(got, tt.wantLog) {
+				t.Fatalf("expected statements %q, got %q", tt.wantLog, got)
+			}
+			if refresh, ok := fake.find(refreshProductRatingSQL); ok {
+				if !reflect.DeepEqual(refresh.args, []driver.Value{"product-1"}) {
+					t.Fatalf("expected the rating of product-1 to be refreshed, got %v", refresh.args)
+				}
+			}
+		})
+	}
+}
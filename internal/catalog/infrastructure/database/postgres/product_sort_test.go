@@ -0,0 +1,188 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+func TestProductSortsEndWithPrimaryKey(t *testing.T) {
+	if len(productSortNames) != len(productSorts) {
+		t.Fatalf("productSortNames lists %d sorts, productSorts has %d", len(productSortNames), len(productSorts))
+	}
+	for _, name := range productSortNames {
+		columns, ok := productSorts[name]
+		if !ok {
+			t.Fatalf("%s: listed but not in productSorts", name)
+		}
+		last := columns[len(columns)-1]
+		if last.expr != "id" {
+			t.Fatalf("%s: expected the id tie-breaker last, got %s", name, last.expr)
+		}
+		// The tie-breaker follows the direction of the leading column, so
+		// that equal keys keep a stable order within either direction.
+		if last.descending != columns[0].descending {
+			t.Fatalf("%s: id tie-breaker runs against the leading column", name)
+		}
+	}
+}
+
+func TestProductListRejectsUnknownSort(t *testing.T) {
+	// The sort is checked before the database is queried.
+	repo := &postgresProductRepository{}
+	_, err := repo.List(context.Background(), domain.ProductListFilter{Sort: "cheapest", PageSize: 20})
+	if !errors.Is(err, domain.ErrInvalidArgument) {
+		t.Fatalf("expected ErrInvalidArgument, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `"cheapest"`) || !strings.Contains(err.Error(), strings.Join(productSortNames, ", ")) {
+		t.Fatalf("expected the sort and the allowed values in %q", err)
+	}
+}
+
+func TestProductOrderBy(t *testing.T) {
+	tests := []struct {
+		sort string
+		want string
+	}{
+		{domain.ProductSortNewest, " ORDER BY created_at DESC, id DESC"},
+		{domain.ProductSortPriceAsc, " ORDER BY price_cents ASC, id ASC"},
+		{domain.ProductSortName, " ORDER BY name ASC, id ASC"},
+		{domain.ProductSortPopularity, " ORDER BY popularity DESC, created_at DESC, id DESC"},
+		{domain.ProductSortInStock, " ORDER BY (stock > 0) DESC, created_at DESC, id DESC"},
+	}
+	for _, tt := range tests {
+		if got := productOrderBy(productSorts[tt.sort]); got != tt.want {
+			t.Fatalf("%s: got %q, want %q", tt.sort, got, tt.want)
+		}
+	}
+}
+
+func TestProductKeysetCondition(t *testing.T) {
+	tests := []struct {
+		name    string
+		columns []productSortColumn
+		args    []interface{}
+		want    string
+	}{
+		{
+			name:    "ascending",
+			columns: productSorts[domain.ProductSortPriceAsc],
+			want:    "((price_cents > $1) OR (price_cents = $1 AND id > $2))",
+		},
+		{
+			name:    "three columns after filter arguments",
+			columns: productSorts[domain.ProductSortPopularity],
+			args:    []interface{}{"category", "brand"},
+			want: "((popularity < $3) OR (popularity = $3 AND created_at < $4) OR " +
+				"(popularity = $3 AND created_at = $4 AND id < $5))",
+		},
+		{
+			name:    "mixed directions",
+			columns: []productSortColumn{productByName, productByID.desc()},
+			args:    []interface{}{int64(7)},
+			want:    "((name > $2) OR (name = $2 AND id < $3))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := make([]interface{}, len(tt.columns))
+			for i := range values {
+				values[i] = i
+			}
+			got, args := productKeysetCondition(tt.columns, values, tt.args)
+			if got != tt.want {
+				t.Fatalf("got %q, want %q", got, tt.want)
+			}
+			if want := append(append([]interface{}{}, tt.args...), values...); !reflect.DeepEqual(args, want) {
+				t.Fatalf("got arguments %v, want %v", args, want)
+			}
+		})
+	}
+}
+
+func TestProductCursorRoundTrip(t *testing.T) {
+	product := &domain.Product{
+		ID:         "8f14e45f-ceea-467f-a9d5-0f1b3c2d4e5a",
+		Name:       "Head unit, 2 DIN",
+		PriceCents: 1999900,
+		Stock:      3,
+		Popularity: 42,
+		CreatedAt:  time.Date(2025, 3, 14, 15, 9, 26, 535897932, time.UTC),
+	}
+	tests := []struct {
+		sort string
+		want []interface{}
+	}{
+		{domain.ProductSortNewest, []interface{}{product.CreatedAt, product.ID}},
+		{domain.ProductSortPriceDesc, []interface{}{int64(1999900), product.ID}},
+		{domain.ProductSortName, []interface{}{product.Name, product.ID}},
+		{domain.ProductSortPopularity, []interface{}{int64(42), product.CreatedAt, product.ID}},
+		{domain.ProductSortInStock, []interface{}{true, product.CreatedAt, product.ID}},
+	}
+	for _, tt := range tests {
+		columns := productSorts[tt.sort]
+		got, err := decodeProductCursor(encodeProductCursor(product, tt.sort, columns), tt.sort, columns)
+		if err != nil {
+			t.Fatalf("%s: decodeProductCursor returned error: %v", tt.sort, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Fatalf("%s: got %v, want %v", tt.sort, got, tt.want)
+		}
+	}
+}
+
+func TestProductCursorRejectsOtherSorts(t *testing.T) {
+	product := &domain.Product{
+		ID:        "8f14e45f-ceea-467f-a9d5-0f1b3c2d4e5a",
+		Name:      "Amplifier",
+		CreatedAt: time.Now(),
+	}
+	newest := productSorts[domain.ProductSortNewest]
+	popularity := productSorts[domain.ProductSortPopularity]
+
+	tests := []struct {
+		name    string
+		token   string
+		sort    string
+		columns []productSortColumn
+	}{
+		{
+			// Same key count and key types: only the recorded sort tells
+			// them apart.
+			name:    "price_desc cursor used with price_asc",
+			token:   encodeProductCursor(product, domain.ProductSortPriceDesc, productSorts[domain.ProductSortPriceDesc]),
+			sort:    domain.ProductSortPriceAsc,
+			columns: productSorts[domain.ProductSortPriceAsc],
+		},
+		{
+			name:    "newest cursor used with popularity",
+			token:   encodeProductCursor(product, domain.ProductSortNewest, newest),
+			sort:    domain.ProductSortPopularity,
+			columns: popularity,
+		},
+		{
+			name:    "relabelled sort",
+			token:   domain.EncodePageCursor(domain.PageCursor{Sort: domain.ProductSortPopularity, Keys: []string{"42", product.ID}}),
+			sort:    domain.ProductSortPopularity,
+			columns: popularity,
+		},
+		{
+			name:    "key of the wrong type",
+			token:   domain.EncodePageCursor(domain.PageCursor{Sort: domain.ProductSortPriceAsc, Keys: []string{"cheap", product.ID}}),
+			sort:    domain.ProductSortPriceAsc,
+			columns: productSorts[domain.ProductSortPriceAsc],
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := decodeProductCursor(tt.token, tt.sort, tt.columns); !errors.Is(err, domain.ErrInvalidPageToken) {
+				t.Fatalf("expected ErrInvalidPageToken, got %v", err)
+			}
+		})
+	}
+}
@@ -13,7 +13,7 @@ import (
 type SupplierRepository interface {
 	Create(ctx context.Context, supplier *domain.Supplier) error
 	GetByID(ctx context.Context, id int64) (*domain.Supplier, error)
-	List(ctx context.Context) ([]domain.Supplier, error)
+	List(ctx context.Context, filter domain.SupplierListFilter) (*domain.SupplierListResult, error)
 	Update(ctx context.Context, supplier *domain.Supplier) error
 	Delete(ctx context.Context, id int64) error
 }
@@ -51,14 +51,45 @@ func (r *postgresSupplierRepository) GetByID(ctx context.Context, id int64) (*do
 	return &supplier, nil
 }
 
-func (r *postgresSupplierRepository) List(ctx context.Context) ([]domain.Supplier, error) {
-	query := supplierSelectSQL + " ORDER BY name ASC"
+func (r *postgresSupplierRepository) List(
+	ctx context.Context,
+	filter domain.SupplierListFilter,
+) (*domain.SupplierListResult, error) {
+	whereSQL := ""
+	args := make([]interface{}, 0, 4)
+
+	cursorMode := filter.PageToken != ""
+	var total int32
+	if cursorMode {
+		name, id, err := decodeNameCursor(filter.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, name, id)
+		whereSQL = " WHERE (name, id) > ($1, $2)"
+	} else {
+		if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM suppliers`); err != nil {
+			return nil, fmt.Errorf("failed to count suppliers: %w", err)
+		}
+	}
+
+	args = append(args, filter.PageSize+1, pageOffset(filter.Page, filter.PageSize, cursorMode))
+	query := supplierSelectSQL + whereSQL +
+		fmt.Sprintf(" ORDER BY name ASC, id ASC LIMIT $%d OFFSET $%d", len(args)-1, len(args))
 
 	var suppliers []domain.Supplier
-	if err := r.db.SelectContext(ctx, &suppliers, query); err != nil {
+	if err := r.db.SelectContext(ctx, &suppliers, query, args...); err != nil {
 		return nil, fmt.Errorf("failed to list suppliers: %w", err)
 	}
-	return suppliers, nil
+
+	result := &domain.SupplierListResult{Total: total}
+	if len(suppliers) > int(filter.PageSize) {
+		suppliers = suppliers[:filter.PageSize]
+		last := suppliers[len(suppliers)-1]
+		result.NextPageToken = encodeNameCursor(last.Name, last.ID)
+	}
+	result.Suppliers = suppliers
+	return result, nil
 }
 
 func (r *postgresSupplierRepository) Update(ctx context.Context, supplier *domain.Supplier) error {
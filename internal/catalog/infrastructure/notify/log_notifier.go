@@ -0,0 +1,41 @@
+package notify
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+)
+
+// LogQuestionNotifier writes Q&A events to the service log. It is the default
+// until questions are routed to a mailbox or a messenger channel.
+type LogQuestionNotifier struct {
+	logger *slog.Logger
+}
+
+func NewLogQuestionNotifier(logger *slog.Logger) *LogQuestionNotifier {
+	return &LogQuestionNotifier{logger: logger}
+}
+
+func (n *LogQuestionNotifier) QuestionCreated(ctx context.Context, question *domain.ProductQuestion) error {
+	n.logger.InfoContext(ctx, "new product question",
+		"question_id", question.ID,
+		"product_id", question.ProductID,
+		"user_id", question.UserID,
+	)
+	return nil
+}
+
+func (n *LogQuestionNotifier) QuestionAnswered(
+	ctx context.Context,
+	question *domain.ProductQuestion,
+	answer *domain.ProductAnswer,
+) error {
+	n.logger.InfoContext(ctx, "product question answered",
+		"question_id", question.ID,
+		"answer_id", answer.ID,
+		"product_id", question.ProductID,
+		"asked_by", question.UserID,
+	)
+	return nil
+}
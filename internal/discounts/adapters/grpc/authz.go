@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"context"
+
+	discountsv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/discounts/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+)
+
+// Policy declares who may call each discount RPC.
+var Policy = authz.Policy{
+	discountsv1.DiscountService_ListDiscounts_FullMethodName:      authz.Permission(jwt.PermDiscountsManage),
+	discountsv1.DiscountService_GetDiscount_FullMethodName:        authz.Permission(jwt.PermDiscountsManage),
+	discountsv1.DiscountService_CreateDiscount_FullMethodName:     authz.Permission(jwt.PermDiscountsManage),
+	discountsv1.DiscountService_UpdateDiscount_FullMethodName:     authz.Permission(jwt.PermDiscountsManage),
+	discountsv1.DiscountService_DeleteDiscount_FullMethodName:     authz.Permission(jwt.PermDiscountsManage),
+	discountsv1.DiscountService_EvaluateDiscounts_FullMethodName:  authz.Public,
+	discountsv1.DiscountService_RedeemPromoCode_FullMethodName:    authz.Permission(jwt.PermDiscountsRedeem),
+	discountsv1.DiscountService_ReleaseRedemptions_FullMethodName: authz.Permission(jwt.PermDiscountsRedeem),
+}
+
+// optionalUser returns the id of the signed-in user, or "" for anonymous
+// callers and API clients.
+func optionalUser(ctx context.Context) string {
+	principal, ok := authz.FromContext(ctx)
+	if !ok || principal.IsAPIClient() {
+		return ""
+	}
+	return principal.UserID
+}
@@ -0,0 +1,273 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/discounts/app/services"
+	"github.com/KarpovYuri/caraudio-backend/internal/discounts/domain"
+	discountsv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/discounts/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	discountTypeAutomatic = "automatic"
+	discountTypePromoCode = "promo_code"
+)
+
+type DiscountGRPCServer struct {
+	discountsv1.UnimplementedDiscountServiceServer
+	discountService services.DiscountService
+}
+
+func NewDiscountGRPCServer(discountService services.DiscountService) *DiscountGRPCServer {
+	return &DiscountGRPCServer{discountService: discountService}
+}
+
+func (s *DiscountGRPCServer) ListDiscounts(
+	ctx context.Context,
+	req *discountsv1.ListDiscountsRequest,
+) (*discountsv1.ListDiscountsResponse, error) {
+	filter := domain.DiscountFilter{ActiveOnly: req.ActiveOnly}
+	switch req.Type {
+	case "":
+	case discountTypeAutomatic:
+		promo := false
+		filter.PromoCodes = &promo
+	case discountTypePromoCode:
+		promo := true
+		filter.PromoCodes = &promo
+	default:
+		return nil, status.Error(codes.InvalidArgument, "type must be automatic or promo_code")
+	}
+	discounts, err := s.discountService.ListDiscounts(ctx, filter)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	out := make([]*discountsv1.Discount, 0, len(discounts))
+	for i := range discounts {
+		out = append(out, toProtoDiscount(&discounts[i]))
+	}
+	return &discountsv1.ListDiscountsResponse{Discounts: out}, nil
+}
+
+func (s *DiscountGRPCServer) GetDiscount(
+	ctx context.Context,
+	req *discountsv1.GetDiscountRequest,
+) (*discountsv1.GetDiscountResponse, error) {
+	discount, err := s.discountService.GetDiscount(ctx, req.Id)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &discountsv1.GetDiscountResponse{Discount: toProtoDiscount(discount)}, nil
+}
+
+func (s *DiscountGRPCServer) CreateDiscount(
+	ctx context.Context,
+	req *discountsv1.CreateDiscountRequest,
+) (*discountsv1.CreateDiscountResponse, error) {
+	input := domain.DiscountInput{
+		Name:             req.Name,
+		Code:             req.Code,
+		Kind:             req.Kind,
+		Value:            req.Value,
+		ScopeType:        req.ScopeType,
+		ScopeID:          req.ScopeId,
+		MinSubtotalCents: req.MinSubtotalCents,
+		Stackable:        req.Stackable,
+		Priority:         req.Priority,
+		MaxUses:          req.MaxUses,
+		MaxUsesPerUser:   req.MaxUsesPerUser,
+		IsActive:         req.IsActive,
+	}
+	if err := parseWindow(&input, req.StartsAt, req.EndsAt); err != nil {
+		return nil, err
+	}
+	discount, err := s.discountService.CreateDiscount(ctx, input)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &discountsv1.CreateDiscountResponse{Discount: toProtoDiscount(discount)}, nil
+}
+
+func (s *DiscountGRPCServer) UpdateDiscount(
+	ctx context.Context,
+	req *discountsv1.UpdateDiscountRequest,
+) (*discountsv1.UpdateDiscountResponse, error) {
+	input := domain.DiscountInput{
+		Name:             req.Name,
+		Code:             req.Code,
+		Kind:             req.Kind,
+		Value:            req.Value,
+		ScopeType:        req.ScopeType,
+		ScopeID:          req.ScopeId,
+		MinSubtotalCents: req.MinSubtotalCents,
+		Stackable:        req.Stackable,
+		Priority:         req.Priority,
+		MaxUses:          req.MaxUses,
+		MaxUsesPerUser:   req.MaxUsesPerUser,
+		IsActive:         req.IsActive,
+	}
+	if err := parseWindow(&input, req.StartsAt, req.EndsAt); err != nil {
+		return nil, err
+	}
+	discount, err := s.discountService.UpdateDiscount(ctx, req.Id, input)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &discountsv1.UpdateDiscountResponse{Discount: toProtoDiscount(discount)}, nil
+}
+
+func (s *DiscountGRPCServer) DeleteDiscount(
+	ctx context.Context,
+	req *discountsv1.DeleteDiscountRequest,
+) (*discountsv1.DeleteDiscountResponse, error) {
+	if err := s.discountService.DeleteDiscount(ctx, req.Id); err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &discountsv1.DeleteDiscountResponse{Success: true}, nil
+}
+
+func (s *DiscountGRPCServer) EvaluateDiscounts(
+	ctx context.Context,
+	req *discountsv1.EvaluateDiscountsRequest,
+) (*discountsv1.EvaluateDiscountsResponse, error) {
+	userID := optionalUser(ctx)
+	lines := make([]domain.EvaluationLine, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		lines = append(lines, domain.EvaluationLine{ProductID: line.ProductId, Quantity: line.Quantity})
+	}
+	evaluation, err := s.discountService.EvaluateDiscounts(ctx, userID, lines, req.PromoCodes)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+
+	resp := &discountsv1.EvaluateDiscountsResponse{
+		SubtotalCents: evaluation.SubtotalCents,
+		DiscountCents: evaluation.DiscountCents,
+		TotalCents:    evaluation.TotalCents,
+		Applied:       make([]*discountsv1.AppliedDiscount, 0, len(evaluation.Applied)),
+		Rejected:      make([]*discountsv1.RejectedDiscount, 0, len(evaluation.Rejected)),
+	}
+	for _, applied := range evaluation.Applied {
+		resp.Applied = append(resp.Applied, &discountsv1.AppliedDiscount{
+			DiscountId:  applied.DiscountID,
+			Name:        applied.Name,
+			Code:        applied.Code,
+			AmountCents: applied.AmountCents,
+			Reason:      applied.Reason,
+			ProductIds:  applied.ProductIDs,
+		})
+	}
+	for _, rejected := range evaluation.Rejected {
+		resp.Rejected = append(resp.Rejected, &discountsv1.RejectedDiscount{
+			DiscountId: rejected.DiscountID,
+			Name:       rejected.Name,
+			Code:       rejected.Code,
+			Reason:     rejected.Reason,
+		})
+	}
+	return resp, nil
+}
+
+func (s *DiscountGRPCServer) RedeemPromoCode(
+	ctx context.Context,
+	req *discountsv1.RedeemPromoCodeRequest,
+) (*discountsv1.RedeemPromoCodeResponse, error) {
+	if err := s.discountService.RedeemPromoCode(ctx, req.UserId, req.Code, req.OrderId); err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &discountsv1.RedeemPromoCodeResponse{Success: true}, nil
+}
+
+func (s *DiscountGRPCServer) ReleaseRedemptions(
+	ctx context.Context,
+	req *discountsv1.ReleaseRedemptionsRequest,
+) (*discountsv1.ReleaseRedemptionsResponse, error) {
+	released, err := s.discountService.ReleaseRedemptions(ctx, req.OrderId)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &discountsv1.ReleaseRedemptionsResponse{Released: int32(released)}, nil
+}
+
+func parseWindow(input *domain.DiscountInput, startsAt, endsAt string) error {
+	if startsAt != "" {
+		t, err := time.Parse(time.RFC3339, startsAt)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "starts_at must be RFC 3339")
+		}
+		input.StartsAt = &t
+	}
+	if endsAt != "" {
+		t, err := time.Parse(time.RFC3339, endsAt)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, "ends_at must be RFC 3339")
+		}
+		input.EndsAt = &t
+	}
+	return nil
+}
+
+func toProtoDiscount(discount *domain.Discount) *discountsv1.Discount {
+	out := &discountsv1.Discount{
+		Id:               discount.ID,
+		Name:             discount.Name,
+		Kind:             discount.Kind,
+		Value:            discount.Value,
+		ScopeType:        discount.ScopeType,
+		MinSubtotalCents: discount.MinSubtotalCents,
+		Stackable:        discount.Stackable,
+		Priority:         discount.Priority,
+		UsedCount:        discount.UsedCount,
+		IsActive:         discount.IsActive,
+		CreatedAt:        discount.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:        discount.UpdatedAt.UTC().Format(time.RFC3339),
+	}
+	if discount.Code != nil {
+		out.Code = *discount.Code
+	}
+	if discount.ScopeID != nil {
+		out.ScopeId = *discount.ScopeID
+	}
+	if discount.MaxUses != nil {
+		out.MaxUses = *discount.MaxUses
+	}
+	if discount.MaxUsesPerUser != nil {
+		out.MaxUsesPerUser = *discount.MaxUsesPerUser
+	}
+	if discount.StartsAt != nil {
+		out.StartsAt = discount.StartsAt.UTC().Format(time.RFC3339)
+	}
+	if discount.EndsAt != nil {
+		out.EndsAt = discount.EndsAt.UTC().Format(time.RFC3339)
+	}
+	return out
+}
+
+func mapServiceError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, jwt.ErrUnauthorized):
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	case errors.Is(err, jwt.ErrForbidden):
+		return status.Error(codes.PermissionDenied, "forbidden")
+	case errors.Is(err, domain.ErrInvalidArgument):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrDiscountNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrPromoCodeExists),
+		errors.Is(err, domain.ErrRedemptionConflict):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrPromoCodeUnavailable),
+		errors.Is(err, domain.ErrPromoCodeUsageLimit):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
@@ -0,0 +1,219 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/discounts/domain"
+)
+
+const (
+	reasonNoEligibleItems   = "no items in the cart qualify"
+	reasonBelowMinimum      = "cart subtotal is below the minimum of %s"
+	reasonNothingLeft       = "nothing left to discount after other discounts"
+	reasonNotCombinable     = "cannot be combined with %q"
+	reasonBetterCombination = "a better combination of discounts applies"
+)
+
+// pricedLine is a cart line with what the rules need to know about its
+// product. categoryIDs holds the product's category and all its ancestors.
+type pricedLine struct {
+	productID   string
+	brandID     string
+	categoryIDs map[string]struct{}
+	totalCents  int64
+}
+
+type discountOutcome struct {
+	applied  []domain.AppliedDiscount
+	rejected []domain.RejectedDiscount
+	total    int64
+}
+
+// evaluate picks the discounts to apply. Stackable discounts are combined
+// with each other; a non-stackable discount is only ever applied alone. The
+// customer gets whichever is larger: all stackable discounts together or the
+// best single non-stackable one.
+func evaluate(lines []pricedLine, candidates []domain.Discount) discountOutcome {
+	var (
+		stackable []domain.Discount
+		exclusive []domain.Discount
+		rejected  []domain.RejectedDiscount
+	)
+	for _, d := range candidates {
+		if reason := qualify(lines, &d); reason != "" {
+			// Automatic rules that do not match the cart are not worth
+			// explaining; promo codes the customer typed in are.
+			if d.Code != nil {
+				rejected = append(rejected, rejectDiscount(&d, reason))
+			}
+			continue
+		}
+		if d.Stackable {
+			stackable = append(stackable, d)
+		} else {
+			exclusive = append(exclusive, d)
+		}
+	}
+
+	best := applyDiscounts(lines, stackable)
+	var winner *domain.Discount
+	for i := range exclusive {
+		outcome := applyDiscounts(lines, exclusive[i:i+1])
+		if outcome.total > best.total {
+			best = outcome
+			winner = &exclusive[i]
+		}
+	}
+
+	if winner != nil {
+		reason := fmt.Sprintf(reasonNotCombinable, winner.Name)
+		for i := range stackable {
+			rejected = append(rejected, rejectDiscount(&stackable[i], reason))
+		}
+		for i := range exclusive {
+			if exclusive[i].ID != winner.ID {
+				rejected = append(rejected, rejectDiscount(&exclusive[i], reason))
+			}
+		}
+	} else {
+		for i := range exclusive {
+			rejected = append(rejected, rejectDiscount(&exclusive[i], reasonBetterCombination))
+		}
+	}
+	best.rejected = append(best.rejected, rejected...)
+	return best
+}
+
+// applyDiscounts applies the discounts by priority, each one to what is left
+// of the line totals after the ones before it, so the total discount never
+// exceeds the cart.
+func applyDiscounts(lines []pricedLine, discounts []domain.Discount) discountOutcome {
+	ordered := append([]domain.Discount(nil), discounts...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].Priority != ordered[j].Priority {
+			return ordered[i].Priority > ordered[j].Priority
+		}
+		return ordered[i].ID < ordered[j].ID
+	})
+
+	remaining := make([]int64, len(lines))
+	for i, line := range lines {
+		remaining[i] = line.totalCents
+	}
+
+	var outcome discountOutcome
+	for i := range ordered {
+		d := &ordered[i]
+		left := int64(0)
+		if d.Kind == domain.DiscountKindFixed {
+			left = d.Value
+		}
+		applied := domain.AppliedDiscount{DiscountID: d.ID, Name: d.Name, Reason: describeDiscount(d)}
+		if d.Code != nil {
+			applied.Code = *d.Code
+		}
+		for j := range lines {
+			if !matchesScope(d, &lines[j]) || remaining[j] == 0 {
+				continue
+			}
+			var cut int64
+			if d.Kind == domain.DiscountKindPercent {
+				cut = remaining[j] * d.Value / 100
+			} else {
+				cut = min(remaining[j], left)
+				left -= cut
+			}
+			if cut == 0 {
+				continue
+			}
+			remaining[j] -= cut
+			applied.AmountCents += cut
+			applied.ProductIDs = append(applied.ProductIDs, lines[j].productID)
+		}
+		if applied.AmountCents == 0 {
+			outcome.rejected = append(outcome.rejected, rejectDiscount(d, reasonNothingLeft))
+			continue
+		}
+		outcome.applied = append(outcome.applied, applied)
+		outcome.total += applied.AmountCents
+	}
+	return outcome
+}
+
+// qualify returns why the discount does not apply to the cart at all, or an
+// empty string if it does. The minimum subtotal is checked against the
+// undiscounted total of the lines in scope.
+func qualify(lines []pricedLine, d *domain.Discount) string {
+	var eligible int64
+	matched := false
+	for i := range lines {
+		if matchesScope(d, &lines[i]) {
+			matched = true
+			eligible += lines[i].totalCents
+		}
+	}
+	if !matched {
+		return reasonNoEligibleItems
+	}
+	if eligible < d.MinSubtotalCents {
+		return fmt.Sprintf(reasonBelowMinimum, formatCents(d.MinSubtotalCents))
+	}
+	return ""
+}
+
+func matchesScope(d *domain.Discount, line *pricedLine) bool {
+	if d.ScopeType == domain.DiscountScopeAll {
+		return true
+	}
+	if d.ScopeID == nil {
+		return false
+	}
+	switch d.ScopeType {
+	case domain.DiscountScopeBrand:
+		return line.brandID == *d.ScopeID
+	case domain.DiscountScopeCategory:
+		_, ok := line.categoryIDs[*d.ScopeID]
+		return ok
+	case domain.DiscountScopeProduct:
+		return line.productID == *d.ScopeID
+	default:
+		return false
+	}
+}
+
+func describeDiscount(d *domain.Discount) string {
+	var target string
+	switch d.ScopeType {
+	case domain.DiscountScopeBrand:
+		target = "items of the brand"
+	case domain.DiscountScopeCategory:
+		target = "items in the category"
+	case domain.DiscountScopeProduct:
+		target = "the product"
+	default:
+		target = "the order"
+	}
+	var reason string
+	if d.Kind == domain.DiscountKindPercent {
+		reason = fmt.Sprintf("%d%% off %s", d.Value, target)
+	} else {
+		reason = fmt.Sprintf("%s off %s", formatCents(d.Value), target)
+	}
+	if d.MinSubtotalCents > 0 {
+		reason += fmt.Sprintf(" over %s", formatCents(d.MinSubtotalCents))
+	}
+	return reason
+}
+
+func rejectDiscount(d *domain.Discount, reason string) domain.RejectedDiscount {
+	rejected := domain.RejectedDiscount{DiscountID: d.ID, Name: d.Name, Reason: reason}
+	if d.Code != nil {
+		rejected.Code = *d.Code
+	}
+	return rejected
+}
+
+func formatCents(cents int64) string {
+	return fmt.Sprintf("%d.%02d", cents/100, cents%100)
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/discounts/domain"
+)
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func testLines() []pricedLine {
+	return []pricedLine{
+		{productID: "head-unit", brandID: "pioneer", categoryIDs: map[string]struct{}{"head-units": {}, "audio": {}}, totalCents: 2000000},
+		{productID: "cable", brandID: "generic", categoryIDs: map[string]struct{}{"cables": {}}, totalCents: 50000},
+	}
+}
+
+func TestEvaluateScopesPercentDiscountToBrand(t *testing.T) {
+	outcome := evaluate(testLines(), []domain.Discount{{
+		ID: "d1", Name: "Pioneer week", Kind: domain.DiscountKindPercent, Value: 10,
+		ScopeType: domain.DiscountScopeBrand, ScopeID: ptr("pioneer"), Stackable: true,
+	}})
+	if outcome.total != 200000 {
+		t.Fatalf("expected 200000 off, got %d", outcome.total)
+	}
+	if len(outcome.applied) != 1 || len(outcome.applied[0].ProductIDs) != 1 || outcome.applied[0].ProductIDs[0] != "head-unit" {
+		t.Fatalf("unexpected applied discounts: %+v", outcome.applied)
+	}
+	if outcome.applied[0].Reason != "10% off items of the brand" {
+		t.Fatalf("unexpected reason: %q", outcome.applied[0].Reason)
+	}
+}
+
+func TestEvaluateMatchesParentCategory(t *testing.T) {
+	outcome := evaluate(testLines(), []domain.Discount{{
+		ID: "d1", Name: "Audio", Kind: domain.DiscountKindFixed, Value: 1000,
+		ScopeType: domain.DiscountScopeCategory, ScopeID: ptr("audio"),
+	}})
+	if outcome.total != 1000 {
+		t.Fatalf("expected 1000 off, got %d", outcome.total)
+	}
+}
+
+func TestEvaluateStacksStackableDiscountsSequentially(t *testing.T) {
+	outcome := evaluate(testLines(), []domain.Discount{
+		{ID: "d1", Name: "10% off", Kind: domain.DiscountKindPercent, Value: 10, ScopeType: domain.DiscountScopeAll, Stackable: true, Priority: 2},
+		{ID: "d2", Name: "500 off", Kind: domain.DiscountKindFixed, Value: 50000, ScopeType: domain.DiscountScopeAll,
+			MinSubtotalCents: 1000000, Stackable: true, Priority: 1},
+	})
+	// 10% of 20 500.00 first, then 500.00 off what is left.
+	if outcome.total != 205000+50000 {
+		t.Fatalf("expected 255000 off, got %d", outcome.total)
+	}
+	if len(outcome.applied) != 2 || outcome.applied[0].DiscountID != "d1" {
+		t.Fatalf("expected both discounts by priority, got %+v", outcome.applied)
+	}
+}
+
+func TestEvaluatePicksBestOfExclusiveAndStack(t *testing.T) {
+	stackable := domain.Discount{ID: "s1", Name: "Small", Kind: domain.DiscountKindFixed, Value: 1000, ScopeType: domain.DiscountScopeAll, Stackable: true}
+	exclusive := domain.Discount{ID: "e1", Name: "Big", Code: ptr("BIG"), Kind: domain.DiscountKindPercent, Value: 5, ScopeType: domain.DiscountScopeAll}
+
+	outcome := evaluate(testLines(), []domain.Discount{stackable, exclusive})
+	if len(outcome.applied) != 1 || outcome.applied[0].DiscountID != "e1" {
+		t.Fatalf("expected the exclusive discount to win, got %+v", outcome.applied)
+	}
+	if len(outcome.rejected) != 1 || outcome.rejected[0].DiscountID != "s1" ||
+		outcome.rejected[0].Reason != `cannot be combined with "Big"` {
+		t.Fatalf("unexpected rejections: %+v", outcome.rejected)
+	}
+
+	stackable.Value = 500000
+	outcome = evaluate(testLines(), []domain.Discount{stackable, exclusive})
+	if len(outcome.applied) != 1 || outcome.applied[0].DiscountID != "s1" {
+		t.Fatalf("expected the stackable discount to win, got %+v", outcome.applied)
+	}
+	if len(outcome.rejected) != 1 || outcome.rejected[0].Code != "BIG" {
+		t.Fatalf("unexpected rejections: %+v", outcome.rejected)
+	}
+}
+
+func TestEvaluateExplainsOnlyPromoCodesThatDoNotQualify(t *testing.T) {
+	outcome := evaluate(testLines(), []domain.Discount{
+		{ID: "rule", Name: "Alpine", Kind: domain.DiscountKindPercent, Value: 10, ScopeType: domain.DiscountScopeBrand, ScopeID: ptr("alpine")},
+		{ID: "code", Name: "Big order", Code: ptr("BIG"), Kind: domain.DiscountKindFixed, Value: 100000,
+			ScopeType: domain.DiscountScopeAll, MinSubtotalCents: 5000000},
+	})
+	if outcome.total != 0 || len(outcome.applied) != 0 {
+		t.Fatalf("expected nothing to apply, got %+v", outcome.applied)
+	}
+	if len(outcome.rejected) != 1 || outcome.rejected[0].Reason != "cart subtotal is below the minimum of 50000.00" {
+		t.Fatalf("unexpected rejections: %+v", outcome.rejected)
+	}
+}
+
+func TestEvaluateNeverExceedsCartTotal(t *testing.T) {
+	outcome := evaluate(testLines(), []domain.Discount{
+		{ID: "d1", Name: "Huge", Kind: domain.DiscountKindFixed, Value: 10000000, ScopeType: domain.DiscountScopeAll, Stackable: true},
+		{ID: "d2", Name: "Percent", Kind: domain.DiscountKindPercent, Value: 50, ScopeType: domain.DiscountScopeAll, Stackable: true},
+	})
+	if outcome.total != 2050000 {
+		t.Fatalf("expected discount capped at cart total, got %d", outcome.total)
+	}
+	if len(outcome.rejected) != 1 || outcome.rejected[0].Reason != reasonNothingLeft {
+		t.Fatalf("unexpected rejections: %+v", outcome.rejected)
+	}
+}
@@ -0,0 +1,350 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	catalogdomain "github.com/KarpovYuri/caraudio-backend/internal/catalog/domain"
+	catalogdb "github.com/KarpovYuri/caraudio-backend/internal/catalog/infrastructure/database/postgres"
+	"github.com/KarpovYuri/caraudio-backend/internal/discounts/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/discounts/infrastructure/database/postgres"
+)
+
+const (
+	reasonCodeNotFound     = "promo code not found"
+	reasonCodeNotActive    = "promo code is not active"
+	reasonCodeUsedUp       = "promo code usage limit reached"
+	reasonCodeUserLimit    = "promo code has already been used the maximum number of times"
+	reasonCodeSignInNeeded = "sign in to use this promo code"
+)
+
+type DiscountService interface {
+	ListDiscounts(ctx context.Context, filter domain.DiscountFilter) ([]domain.Discount, error)
+	GetDiscount(ctx context.Context, id string) (*domain.Discount, error)
+	CreateDiscount(ctx context.Context, input domain.DiscountInput) (*domain.Discount, error)
+	UpdateDiscount(ctx context.Context, id string, input domain.DiscountInput) (*domain.Discount, error)
+	DeleteDiscount(ctx context.Context, id string) error
+	EvaluateDiscounts(ctx context.Context, userID string, lines []domain.EvaluationLine, codes []string) (*domain.Evaluation, error)
+	RedeemPromoCode(ctx context.Context, userID, code, orderID string) error
+	ReleaseRedemptions(ctx context.Context, orderID string) (int64, error)
+}
+
+type discountService struct {
+	discounts  postgres.DiscountRepository
+	products   catalogdb.ProductRepository
+	categories catalogdb.CategoryRepository
+}
+
+func NewDiscountService(
+	discounts postgres.DiscountRepository,
+	products catalogdb.ProductRepository,
+	categories catalogdb.CategoryRepository,
+) DiscountService {
+	return &discountService{discounts: discounts, products: products, categories: categories}
+}
+
+func (s *discountService) ListDiscounts(ctx context.Context, filter domain.DiscountFilter) ([]domain.Discount, error) {
+	return s.discounts.List(ctx, filter)
+}
+
+func (s *discountService) GetDiscount(ctx context.Context, id string) (*domain.Discount, error) {
+	return s.discounts.GetByID(ctx, id)
+}
+
+func (s *discountService) CreateDiscount(ctx context.Context, input domain.DiscountInput) (*domain.Discount, error) {
+	now := time.Now()
+	discount := &domain.Discount{ID: uuid.NewString(), CreatedAt: now}
+	if err := applyDiscountInput(discount, input, now); err != nil {
+		return nil, err
+	}
+	if err := s.discounts.Create(ctx, discount); err != nil {
+		return nil, err
+	}
+	return discount, nil
+}
+
+func (s *discountService) UpdateDiscount(
+	ctx context.Context,
+	id string,
+	input domain.DiscountInput,
+) (*domain.Discount, error) {
+	discount, err := s.discounts.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := applyDiscountInput(discount, input, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.discounts.Update(ctx, discount); err != nil {
+		return nil, err
+	}
+	return discount, nil
+}
+
+func (s *discountService) DeleteDiscount(ctx context.Context, id string) error {
+	return s.discounts.Delete(ctx, id)
+}
+
+// EvaluateDiscounts prices the lines at current catalog prices and works
+// out which automatic rules and entered promo codes apply. Unavailable
+// products are left out; the cart reports them separately.
+func (s *discountService) EvaluateDiscounts(
+	ctx context.Context,
+	userID string,
+	lines []domain.EvaluationLine,
+	codes []string,
+) (*domain.Evaluation, error) {
+	if len(lines) == 0 {
+		return nil, domain.ErrInvalidArgument
+	}
+	for _, line := range lines {
+		if line.ProductID == "" || line.Quantity <= 0 {
+			return nil, domain.ErrInvalidArgument
+		}
+	}
+	priced, err := s.priceLines(ctx, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	candidates, err := s.discounts.ListAutomatic(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	promo, rejected, err := s.resolvePromoCodes(ctx, userID, codes, now)
+	if err != nil {
+		return nil, err
+	}
+	candidates = append(candidates, promo...)
+
+	outcome := evaluate(priced, candidates)
+	evaluation := &domain.Evaluation{
+		Applied:  outcome.applied,
+		Rejected: append(rejected, outcome.rejected...),
+	}
+	for _, line := range priced {
+		evaluation.SubtotalCents += line.totalCents
+	}
+	evaluation.DiscountCents = outcome.total
+	evaluation.TotalCents = evaluation.SubtotalCents - outcome.total
+	return evaluation, nil
+}
+
+// RedeemPromoCode records that an order used the promo code. The order
+// service calls it once it has placed the order for userID; the limits are
+// enforced by the repository under a row lock.
+func (s *discountService) RedeemPromoCode(ctx context.Context, userID, code, orderID string) error {
+	code = normalizeCode(code)
+	if uuid.Validate(userID) != nil || code == "" || uuid.Validate(orderID) != nil {
+		return domain.ErrInvalidArgument
+	}
+	found, err := s.discounts.ListByCodes(ctx, []string{code})
+	if err != nil {
+		return err
+	}
+	if len(found) == 0 || !found[0].ActiveAt(time.Now()) {
+		return domain.ErrPromoCodeUnavailable
+	}
+	return s.discounts.Redeem(ctx, &domain.Redemption{
+		DiscountID: found[0].ID,
+		UserID:     userID,
+		OrderID:    orderID,
+		CreatedAt:  time.Now(),
+	})
+}
+
+// ReleaseRedemptions gives back the promo code uses of a cancelled order so
+// that limited codes are not used up by orders that were never fulfilled.
+// Releasing an order twice is a no-op.
+func (s *discountService) ReleaseRedemptions(ctx context.Context, orderID string) (int64, error) {
+	if uuid.Validate(orderID) != nil {
+		return 0, domain.ErrInvalidArgument
+	}
+	return s.discounts.ReleaseByOrder(ctx, orderID)
+}
+
+// resolvePromoCodes splits the entered codes into those that may be applied
+// and those rejected up front because of their window or usage limits.
+func (s *discountService) resolvePromoCodes(
+	ctx context.Context,
+	userID string,
+	codes []string,
+	now time.Time,
+) ([]domain.Discount, []domain.RejectedDiscount, error) {
+	normalized := make([]string, 0, len(codes))
+	seen := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		code = normalizeCode(code)
+		if _, ok := seen[code]; ok || code == "" {
+			continue
+		}
+		seen[code] = struct{}{}
+		normalized = append(normalized, code)
+	}
+	found, err := s.discounts.ListByCodes(ctx, normalized)
+	if err != nil {
+		return nil, nil, err
+	}
+	byCode := make(map[string]domain.Discount, len(found))
+	limited := make([]string, 0, len(found))
+	for _, d := range found {
+		byCode[*d.Code] = d
+		if d.MaxUsesPerUser != nil {
+			limited = append(limited, d.ID)
+		}
+	}
+	userUses, err := s.discounts.CountUserRedemptions(ctx, limited, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		accepted []domain.Discount
+		rejected []domain.RejectedDiscount
+	)
+	for _, code := range normalized {
+		d, ok := byCode[code]
+		reason := ""
+		switch {
+		case !ok:
+			rejected = append(rejected, domain.RejectedDiscount{Code: code, Reason: reasonCodeNotFound})
+			continue
+		case !d.ActiveAt(now):
+			reason = reasonCodeNotActive
+		case d.MaxUses != nil && d.UsedCount >= *d.MaxUses:
+			reason = reasonCodeUsedUp
+		case d.MaxUsesPerUser != nil && userID == "":
+			reason = reasonCodeSignInNeeded
+		case d.MaxUsesPerUser != nil && userUses[d.ID] >= *d.MaxUsesPerUser:
+			reason = reasonCodeUserLimit
+		}
+		if reason != "" {
+			rejected = append(rejected, rejectDiscount(&d, reason))
+			continue
+		}
+		accepted = append(accepted, d)
+	}
+	return accepted, rejected, nil
+}
+
+func (s *discountService) priceLines(ctx context.Context, lines []domain.EvaluationLine) ([]pricedLine, error) {
+	quantities := make(map[string]int64, len(lines))
+	ids := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if _, ok := quantities[line.ProductID]; !ok {
+			ids = append(ids, line.ProductID)
+		}
+		quantities[line.ProductID] += int64(line.Quantity)
+	}
+	products, err := s.products.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := s.categories.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	parents := make(map[string]string, len(categories))
+	for _, category := range categories {
+		if category.ParentID != nil {
+			parents[category.ID] = *category.ParentID
+		}
+	}
+
+	byID := make(map[string]catalogdomain.Product, len(products))
+	for _, product := range products {
+		byID[product.ID] = product
+	}
+	priced := make([]pricedLine, 0, len(ids))
+	for _, id := range ids {
+		product, ok := byID[id]
+		if !ok || !product.IsActive {
+			continue
+		}
+		line := pricedLine{
+			productID:   product.ID,
+			categoryIDs: make(map[string]struct{}),
+			totalCents:  product.PriceCents * quantities[id],
+		}
+		if product.BrandID != nil {
+			line.brandID = *product.BrandID
+		}
+		if product.CategoryID != nil {
+			// Walk up the tree; the depth bound guards against cycles.
+			categoryID := *product.CategoryID
+			for depth := 0; categoryID != "" && depth <= len(categories); depth++ {
+				line.categoryIDs[categoryID] = struct{}{}
+				categoryID = parents[categoryID]
+			}
+		}
+		priced = append(priced, line)
+	}
+	return priced, nil
+}
+
+func applyDiscountInput(discount *domain.Discount, input domain.DiscountInput, now time.Time) error {
+	input.Name = strings.TrimSpace(input.Name)
+	input.ScopeID = strings.TrimSpace(input.ScopeID)
+	if input.Name == "" || !domain.IsValidDiscountKind(input.Kind) || input.Value <= 0 {
+		return domain.ErrInvalidArgument
+	}
+	if input.Kind == domain.DiscountKindPercent && input.Value > 100 {
+		return domain.ErrInvalidArgument
+	}
+	if input.ScopeType == "" {
+		input.ScopeType = domain.DiscountScopeAll
+	}
+	if !domain.IsValidDiscountScope(input.ScopeType) {
+		return domain.ErrInvalidArgument
+	}
+	if (input.ScopeType == domain.DiscountScopeAll) != (input.ScopeID == "") {
+		return domain.ErrInvalidArgument
+	}
+	if input.ScopeID != "" && uuid.Validate(input.ScopeID) != nil {
+		return domain.ErrInvalidArgument
+	}
+	if input.MinSubtotalCents < 0 || input.MaxUses < 0 || input.MaxUsesPerUser < 0 {
+		return domain.ErrInvalidArgument
+	}
+	if input.StartsAt != nil && input.EndsAt != nil && !input.EndsAt.After(*input.StartsAt) {
+		return domain.ErrInvalidArgument
+	}
+
+	discount.Name = input.Name
+	discount.Code = nil
+	if code := normalizeCode(input.Code); code != "" {
+		discount.Code = &code
+	}
+	discount.Kind = input.Kind
+	discount.Value = input.Value
+	discount.ScopeType = input.ScopeType
+	discount.ScopeID = nil
+	if input.ScopeID != "" {
+		discount.ScopeID = &input.ScopeID
+	}
+	discount.MinSubtotalCents = input.MinSubtotalCents
+	discount.Stackable = input.Stackable
+	discount.Priority = input.Priority
+	discount.MaxUses = optionalLimit(input.MaxUses)
+	discount.MaxUsesPerUser = optionalLimit(input.MaxUsesPerUser)
+	discount.StartsAt = input.StartsAt
+	discount.EndsAt = input.EndsAt
+	discount.IsActive = input.IsActive
+	discount.UpdatedAt = now
+	return nil
+}
+
+func optionalLimit(value int32) *int32 {
+	if value <= 0 {
+		return nil
+	}
+	return &value
+}
+
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
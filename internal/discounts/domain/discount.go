@@ -0,0 +1,130 @@
+package domain
+
+import "time"
+
+const (
+	DiscountKindPercent = "percent"
+	DiscountKindFixed   = "fixed"
+)
+
+const (
+	DiscountScopeAll      = "all"
+	DiscountScopeBrand    = "brand"
+	DiscountScopeCategory = "category"
+	DiscountScopeProduct  = "product"
+)
+
+// Discount is either an automatic rule (Code is nil) or a promo code the
+// customer has to enter. Value is a percentage for percent discounts and an
+// amount in cents for fixed ones.
+type Discount struct {
+	ID               string     `db:"id"`
+	Name             string     `db:"name"`
+	Code             *string    `db:"code"`
+	Kind             string     `db:"kind"`
+	Value            int64      `db:"value"`
+	ScopeType        string     `db:"scope_type"`
+	ScopeID          *string    `db:"scope_id"`
+	MinSubtotalCents int64      `db:"min_subtotal_cents"`
+	Stackable        bool       `db:"stackable"`
+	Priority         int32      `db:"priority"`
+	MaxUses          *int32     `db:"max_uses"`
+	MaxUsesPerUser   *int32     `db:"max_uses_per_user"`
+	UsedCount        int32      `db:"used_count"`
+	StartsAt         *time.Time `db:"starts_at"`
+	EndsAt           *time.Time `db:"ends_at"`
+	IsActive         bool       `db:"is_active"`
+	CreatedAt        time.Time  `db:"created_at"`
+	UpdatedAt        time.Time  `db:"updated_at"`
+}
+
+type DiscountInput struct {
+	Name             string
+	Code             string
+	Kind             string
+	Value            int64
+	ScopeType        string
+	ScopeID          string
+	MinSubtotalCents int64
+	Stackable        bool
+	Priority         int32
+	MaxUses          int32
+	MaxUsesPerUser   int32
+	StartsAt         *time.Time
+	EndsAt           *time.Time
+	IsActive         bool
+}
+
+type DiscountFilter struct {
+	// PromoCodes selects promo codes when true, automatic rules when false
+	// and both when nil.
+	PromoCodes *bool
+	ActiveOnly bool
+}
+
+type Redemption struct {
+	ID         int64     `db:"id"`
+	DiscountID string    `db:"discount_id"`
+	UserID     string    `db:"user_id"`
+	OrderID    string    `db:"order_id"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+type EvaluationLine struct {
+	ProductID string
+	Quantity  int32
+}
+
+type AppliedDiscount struct {
+	DiscountID  string
+	Name        string
+	Code        string
+	AmountCents int64
+	Reason      string
+	ProductIDs  []string
+}
+
+// RejectedDiscount explains why an entered promo code, or a rule that
+// matched the cart, did not end up in the result.
+type RejectedDiscount struct {
+	DiscountID string
+	Name       string
+	Code       string
+	Reason     string
+}
+
+type Evaluation struct {
+	SubtotalCents int64
+	DiscountCents int64
+	TotalCents    int64
+	Applied       []AppliedDiscount
+	Rejected      []RejectedDiscount
+}
+
+func IsValidDiscountKind(kind string) bool {
+	return kind == DiscountKindPercent || kind == DiscountKindFixed
+}
+
+func IsValidDiscountScope(scope string) bool {
+	switch scope {
+	case DiscountScopeAll, DiscountScopeBrand, DiscountScopeCategory, DiscountScopeProduct:
+		return true
+	default:
+		return false
+	}
+}
+
+// ActiveAt reports whether the discount is switched on and inside its date
+// window.
+func (d *Discount) ActiveAt(at time.Time) bool {
+	if !d.IsActive {
+		return false
+	}
+	if d.StartsAt != nil && at.Before(*d.StartsAt) {
+		return false
+	}
+	if d.EndsAt != nil && !at.Before(*d.EndsAt) {
+		return false
+	}
+	return true
+}
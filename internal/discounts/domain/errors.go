@@ -0,0 +1,12 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidArgument      = errors.New("invalid argument")
+	ErrDiscountNotFound     = errors.New("discount not found")
+	ErrPromoCodeExists      = errors.New("promo code already exists")
+	ErrPromoCodeUnavailable = errors.New("promo code is not available")
+	ErrPromoCodeUsageLimit  = errors.New("promo code usage limit reached")
+	ErrRedemptionConflict   = errors.New("promo code already redeemed by the order for another user")
+)
@@ -0,0 +1,272 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/discounts/domain"
+)
+
+type DiscountRepository interface {
+	Create(ctx context.Context, discount *domain.Discount) error
+	GetByID(ctx context.Context, id string) (*domain.Discount, error)
+	ListByCodes(ctx context.Context, codes []string) ([]domain.Discount, error)
+	List(ctx context.Context, filter domain.DiscountFilter) ([]domain.Discount, error)
+	ListAutomatic(ctx context.Context, at time.Time) ([]domain.Discount, error)
+	Update(ctx context.Context, discount *domain.Discount) error
+	Delete(ctx context.Context, id string) error
+	CountUserRedemptions(ctx context.Context, discountIDs []string, userID string) (map[string]int32, error)
+	Redeem(ctx context.Context, redemption *domain.Redemption) error
+	// ReleaseByOrder deletes the redemptions of an order and gives the uses
+	// back to their discounts, returning how many were released.
+	ReleaseByOrder(ctx context.Context, orderID string) (int64, error)
+}
+
+type postgresDiscountRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresDiscountRepository(db *sqlx.DB) DiscountRepository {
+	return &postgresDiscountRepository{db: db}
+}
+
+func (r *postgresDiscountRepository) Create(ctx context.Context, discount *domain.Discount) error {
+	_, err := r.db.NamedExecContext(ctx,
+		`INSERT INTO discounts (
+           id, name, code, kind, value, scope_type, scope_id, min_subtotal_cents, stackable, priority,
+           max_uses, max_uses_per_user, used_count, starts_at, ends_at, is_active, created_at, updated_at
+         ) VALUES (
+           :id, :name, :code, :kind, :value, :scope_type, :scope_id, :min_subtotal_cents, :stackable, :priority,
+           :max_uses, :max_uses_per_user, :used_count, :starts_at, :ends_at, :is_active, :created_at, :updated_at
+         )`, discount)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrPromoCodeExists
+		}
+		return fmt.Errorf("failed to create discount: %w", err)
+	}
+	return nil
+}
+
+func (r *postgresDiscountRepository) GetByID(ctx context.Context, id string) (*domain.Discount, error) {
+	var discount domain.Discount
+	if err := r.db.GetContext(ctx, &discount, discountSelectSQL+` WHERE id = $1`, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrDiscountNotFound
+		}
+		return nil, fmt.Errorf("failed to get discount: %w", err)
+	}
+	return &discount, nil
+}
+
+func (r *postgresDiscountRepository) ListByCodes(ctx context.Context, codes []string) ([]domain.Discount, error) {
+	if len(codes) == 0 {
+		return nil, nil
+	}
+	var list []domain.Discount
+	if err := r.db.SelectContext(ctx, &list, discountSelectSQL+` WHERE code = ANY($1)`, pq.Array(codes)); err != nil {
+		return nil, fmt.Errorf("failed to list discounts by code: %w", err)
+	}
+	return list, nil
+}
+
+func (r *postgresDiscountRepository) List(ctx context.Context, filter domain.DiscountFilter) ([]domain.Discount, error) {
+	where := ""
+	if filter.PromoCodes != nil {
+		if *filter.PromoCodes {
+			where = appendWhere(where, "code IS NOT NULL")
+		} else {
+			where = appendWhere(where, "code IS NULL")
+		}
+	}
+	if filter.ActiveOnly {
+		where = appendWhere(where, "is_active = TRUE")
+	}
+	var list []domain.Discount
+	if err := r.db.SelectContext(ctx, &list, discountSelectSQL+where+` ORDER BY priority DESC, created_at DESC`); err != nil {
+		return nil, fmt.Errorf("failed to list discounts: %w", err)
+	}
+	return list, nil
+}
+
+func (r *postgresDiscountRepository) ListAutomatic(ctx context.Context, at time.Time) ([]domain.Discount, error) {
+	var list []domain.Discount
+	err := r.db.SelectContext(ctx, &list, discountSelectSQL+`
+         WHERE code IS NULL AND is_active = TRUE
+           AND (starts_at IS NULL OR starts_at <= $1)
+           AND (ends_at IS NULL OR ends_at > $1)
+           AND (max_uses IS NULL OR used_count < max_uses)`, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list automatic discounts: %w", err)
+	}
+	return list, nil
+}
+
+func (r *postgresDiscountRepository) Update(ctx context.Context, discount *domain.Discount) error {
+	result, err := r.db.NamedExecContext(ctx,
+		`UPDATE discounts SET
+           name = :name, code = :code, kind = :kind, value = :value,
+           scope_type = :scope_type, scope_id = :scope_id, min_subtotal_cents = :min_subtotal_cents,
+           stackable = :stackable, priority = :priority, max_uses = :max_uses,
+           max_uses_per_user = :max_uses_per_user, starts_at = :starts_at, ends_at = :ends_at,
+           is_active = :is_active, updated_at = :updated_at
+         WHERE id = :id`, discount)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrPromoCodeExists
+		}
+		return fmt.Errorf("failed to update discount: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrDiscountNotFound
+	}
+	return nil
+}
+
+func (r *postgresDiscountRepository) Delete(ctx context.Context, id string) error {
+	result, err := r.db.ExecContext(ctx, `DELETE FROM discounts WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete discount: %w", err)
+	}
+	rows, _ := result.RowsAffected()
+	if rows == 0 {
+		return domain.ErrDiscountNotFound
+	}
+	return nil
+}
+
+func (r *postgresDiscountRepository) CountUserRedemptions(
+	ctx context.Context,
+	discountIDs []string,
+	userID string,
+) (map[string]int32, error) {
+	counts := make(map[string]int32, len(discountIDs))
+	if len(discountIDs) == 0 || userID == "" {
+		return counts, nil
+	}
+	var rows []struct {
+		DiscountID string `db:"discount_id"`
+		Count      int32  `db:"count"`
+	}
+	err := r.db.SelectContext(ctx, &rows,
+		`SELECT discount_id, COUNT(*) AS count FROM discount_redemptions
+         WHERE discount_id = ANY($1) AND user_id = $2 GROUP BY discount_id`,
+		pq.Array(discountIDs), userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count discount redemptions: %w", err)
+	}
+	for _, row := range rows {
+		counts[row.DiscountID] = row.Count
+	}
+	return counts, nil
+}
+
+// Redeem records one use of a discount for an order. The discount row is
+// locked while the limits are checked so concurrent checkouts cannot exceed
+// them. Redeeming the same order twice is a no-op; an order redeemed for
+// another user is refused.
+func (r *postgresDiscountRepository) Redeem(ctx context.Context, redemption *domain.Redemption) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var discount domain.Discount
+	if err = tx.GetContext(ctx, &discount, discountSelectSQL+` WHERE id = $1 FOR UPDATE`, redemption.DiscountID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return domain.ErrDiscountNotFound
+		}
+		return fmt.Errorf("failed to lock discount: %w", err)
+	}
+
+	var redeemedBy string
+	err = tx.GetContext(ctx, &redeemedBy,
+		`SELECT user_id FROM discount_redemptions WHERE discount_id = $1 AND order_id = $2`,
+		redemption.DiscountID, redemption.OrderID)
+	switch {
+	case err == nil:
+		if redeemedBy != redemption.UserID {
+			return domain.ErrRedemptionConflict
+		}
+		return nil
+	case !errors.Is(err, sql.ErrNoRows):
+		return fmt.Errorf("failed to check discount redemption: %w", err)
+	}
+
+	if discount.MaxUses != nil && discount.UsedCount >= *discount.MaxUses {
+		return domain.ErrPromoCodeUsageLimit
+	}
+	if discount.MaxUsesPerUser != nil {
+		var used int32
+		err = tx.GetContext(ctx, &used,
+			`SELECT COUNT(*) FROM discount_redemptions WHERE discount_id = $1 AND user_id = $2`,
+			redemption.DiscountID, redemption.UserID)
+		if err != nil {
+			return fmt.Errorf("failed to count discount redemptions: %w", err)
+		}
+		if used >= *discount.MaxUsesPerUser {
+			return domain.ErrPromoCodeUsageLimit
+		}
+	}
+
+	err = tx.GetContext(ctx, &redemption.ID,
+		`INSERT INTO discount_redemptions (discount_id, user_id, order_id, created_at)
+         VALUES ($1, $2, $3, $4) RETURNING id`,
+		redemption.DiscountID, redemption.UserID, redemption.OrderID, redemption.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record discount redemption: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx,
+		`UPDATE discounts SET used_count = used_count + 1 WHERE id = $1`, redemption.DiscountID); err != nil {
+		return fmt.Errorf("failed to update discount usage: %w", err)
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit discount transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+// ReleaseByOrder runs as one statement, so the usage counters cannot drift
+// from the redemptions; a second call finds nothing left to release.
+func (r *postgresDiscountRepository) ReleaseByOrder(ctx context.Context, orderID string) (int64, error) {
+	var released int64
+	err := r.db.GetContext(ctx, &released,
+		`WITH released AS (
+           DELETE FROM discount_redemptions WHERE order_id = $1 RETURNING discount_id
+         ), counted AS (
+           SELECT discount_id, COUNT(*) AS uses FROM released GROUP BY discount_id
+         ), updated AS (
+           UPDATE discounts d SET used_count = GREATEST(d.used_count - c.uses, 0)
+           FROM counted c WHERE d.id = c.discount_id
+         )
+         SELECT COUNT(*) FROM released`, orderID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to release discount redemptions: %w", err)
+	}
+	return released, nil
+}
+
+func appendWhere(where, condition string) string {
+	if where == "" {
+		return " WHERE " + condition
+	}
+	return where + " AND " + condition
+}
+
+const discountSelectSQL = `SELECT id, name, code, kind, value, scope_type, scope_id, min_subtotal_cents, stackable,
+  priority, max_uses, max_uses_per_user, used_count, starts_at, ends_at, is_active, created_at, updated_at
+  FROM discounts`
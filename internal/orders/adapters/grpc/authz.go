@@ -0,0 +1,19 @@
+package grpc
+
+import (
+	ordersv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/orders/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+)
+
+// Policy declares who may call each order RPC; cmd/order-service enforces
+// it with authz.UnaryServerInterceptor.
+var Policy = authz.Policy{
+	ordersv1.OrderService_CreateOrder_FullMethodName:  authz.Authenticated,
+	ordersv1.OrderService_ListMyOrders_FullMethodName: authz.Authenticated,
+	ordersv1.OrderService_GetMyOrder_FullMethodName:   authz.Authenticated,
+
+	ordersv1.OrderService_ListOrders_FullMethodName:      authz.Permission(jwt.PermOrdersManage),
+	ordersv1.OrderService_GetOrder_FullMethodName:        authz.Permission(jwt.PermOrdersManage),
+	ordersv1.OrderService_TransitionOrder_FullMethodName: authz.Permission(jwt.PermOrdersManage),
+}
@@ -0,0 +1,277 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/orders/app/services"
+	"github.com/KarpovYuri/caraudio-backend/internal/orders/domain"
+	ordersv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/orders/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+	"github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultListPageSize int32 = 20
+	maxListPageSize     int32 = 100
+)
+
+type OrderGRPCServer struct {
+	ordersv1.UnimplementedOrderServiceServer
+	orderService    services.OrderService
+	defaultPageSize int32
+	maxPageSize     int32
+}
+
+func NewOrderGRPCServer(orderService services.OrderService) *OrderGRPCServer {
+	return &OrderGRPCServer{
+		orderService:    orderService,
+		defaultPageSize: defaultListPageSize,
+		maxPageSize:     maxListPageSize,
+	}
+}
+
+func (s *OrderGRPCServer) CreateOrder(
+	ctx context.Context,
+	req *ordersv1.CreateOrderRequest,
+) (*ordersv1.CreateOrderResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	input := domain.OrderInput{
+		UserID:          userID,
+		Lines:           make([]domain.OrderLineInput, 0, len(req.Lines)),
+		DeliveryMethod:  req.DeliveryMethod,
+		DeliveryAddress: req.DeliveryAddress,
+		CustomerName:    req.CustomerName,
+		CustomerPhone:   req.CustomerPhone,
+		CustomerEmail:   req.CustomerEmail,
+		Comment:         req.Comment,
+		PromoCodes:      req.PromoCodes,
+	}
+	for _, line := range req.Lines {
+		input.Lines = append(input.Lines, domain.OrderLineInput{
+			ProductID: line.ProductId,
+			Quantity:  line.Quantity,
+		})
+	}
+	order, err := s.orderService.CreateOrder(ctx, input)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &ordersv1.CreateOrderResponse{Order: toProtoOrder(order)}, nil
+}
+
+func (s *OrderGRPCServer) ListMyOrders(
+	ctx context.Context,
+	req *ordersv1.ListMyOrdersRequest,
+) (*ordersv1.ListMyOrdersResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	page, pageSize := s.orderService.NormalizePagination(
+		req.Page, req.PageSize, s.defaultPageSize, s.maxPageSize,
+	)
+	result, err := s.orderService.ListOrders(ctx, domain.OrderFilter{
+		UserID:    userID,
+		Status:    req.Status,
+		Page:      page,
+		PageSize:  pageSize,
+		PageToken: req.PageToken,
+	})
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &ordersv1.ListMyOrdersResponse{
+		Orders:        toProtoOrders(result.Orders),
+		Total:         result.Total,
+		Page:          page,
+		PageSize:      pageSize,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (s *OrderGRPCServer) GetMyOrder(
+	ctx context.Context,
+	req *ordersv1.GetMyOrderRequest,
+) (*ordersv1.GetMyOrderResponse, error) {
+	userID, err := authz.UserID(ctx)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	order, err := s.orderService.GetUserOrder(ctx, userID, req.Id)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &ordersv1.GetMyOrderResponse{Order: toProtoOrder(order)}, nil
+}
+
+func (s *OrderGRPCServer) ListOrders(
+	ctx context.Context,
+	req *ordersv1.ListOrdersRequest,
+) (*ordersv1.ListOrdersResponse, error) {
+	page, pageSize := s.orderService.NormalizePagination(
+		req.Page, req.PageSize, s.defaultPageSize, s.maxPageSize,
+	)
+	filter := domain.OrderFilter{
+		UserID:         req.UserId,
+		Status:         req.Status,
+		PaymentStatus:  req.PaymentStatus,
+		DeliveryMethod: req.DeliveryMethod,
+		Page:           page,
+		PageSize:       pageSize,
+		PageToken:      req.PageToken,
+	}
+	var err error
+	if filter.CreatedFrom, err = parseOptionalTime(req.CreatedFrom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "created_from must be RFC 3339")
+	}
+	if filter.CreatedTo, err = parseOptionalTime(req.CreatedTo); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "created_to must be RFC 3339")
+	}
+	result, err := s.orderService.ListOrders(ctx, filter)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &ordersv1.ListOrdersResponse{
+		Orders:        toProtoOrders(result.Orders),
+		Total:         result.Total,
+		Page:          page,
+		PageSize:      pageSize,
+		NextPageToken: result.NextPageToken,
+	}, nil
+}
+
+func (s *OrderGRPCServer) GetOrder(
+	ctx context.Context,
+	req *ordersv1.GetOrderRequest,
+) (*ordersv1.GetOrderResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	order, err := s.orderService.GetOrder(ctx, req.Id)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &ordersv1.GetOrderResponse{Order: toProtoOrder(order)}, nil
+}
+
+func (s *OrderGRPCServer) TransitionOrder(
+	ctx context.Context,
+	req *ordersv1.TransitionOrderRequest,
+) (*ordersv1.TransitionOrderResponse, error) {
+	if req.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	// The interceptor let the call through with orders.manage, so there
+	// is a principal: a user or an API client.
+	principal, _ := authz.FromContext(ctx)
+	order, err := s.orderService.TransitionOrder(ctx, req.Id, req.Status, principal.UserID, req.Comment)
+	if err != nil {
+		return nil, mapServiceError(err)
+	}
+	return &ordersv1.TransitionOrderResponse{Order: toProtoOrder(order)}, nil
+}
+
+func parseOptionalTime(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func toProtoOrders(orders []domain.Order) []*ordersv1.Order {
+	out := make([]*ordersv1.Order, 0, len(orders))
+	for i := range orders {
+		out = append(out, toProtoOrder(&orders[i]))
+	}
+	return out
+}
+
+func toProtoOrder(order *domain.Order) *ordersv1.Order {
+	out := &ordersv1.Order{
+		Id:              order.ID,
+		UserId:          order.UserID,
+		Status:          order.Status,
+		PaymentStatus:   order.PaymentStatus,
+		DeliveryMethod:  order.DeliveryMethod,
+		DeliveryAddress: order.DeliveryAddress,
+		CustomerName:    order.CustomerName,
+		CustomerPhone:   order.CustomerPhone,
+		CustomerEmail:   order.CustomerEmail,
+		Comment:         order.Comment,
+		Lines:           make([]*ordersv1.OrderLine, 0, len(order.Lines)),
+		ItemsCount:      order.ItemsCount,
+		SubtotalCents:   order.SubtotalCents,
+		DiscountCents:   order.DiscountCents,
+		TotalCents:      order.TotalCents,
+		CreatedAt:       order.CreatedAt.UTC().Format(time.RFC3339),
+		UpdatedAt:       order.UpdatedAt.UTC().Format(time.RFC3339),
+		Discounts:       make([]*ordersv1.OrderDiscount, 0, len(order.Discounts)),
+		History:         make([]*ordersv1.OrderStatusChange, 0, len(order.History)),
+	}
+	for _, line := range order.Lines {
+		out.Lines = append(out.Lines, &ordersv1.OrderLine{
+			ProductId:      line.ProductID,
+			Sku:            line.SKU,
+			Name:           line.Name,
+			PriceCents:     line.PriceCents,
+			Quantity:       line.Quantity,
+			LineTotalCents: line.LineTotalCents,
+		})
+	}
+	for _, discount := range order.Discounts {
+		out.Discounts = append(out.Discounts, &ordersv1.OrderDiscount{
+			DiscountId:  discount.DiscountID,
+			Name:        discount.Name,
+			Code:        discount.Code,
+			AmountCents: discount.AmountCents,
+		})
+	}
+	for _, change := range order.History {
+		out.History = append(out.History, &ordersv1.OrderStatusChange{
+			FromStatus: change.FromStatus,
+			ToStatus:   change.ToStatus,
+			ChangedBy:  change.ChangedBy,
+			Comment:    change.Comment,
+			CreatedAt:  change.CreatedAt.UTC().Format(time.RFC3339),
+		})
+	}
+	return out
+}
+
+func mapServiceError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, jwt.ErrUnauthorized):
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	case errors.Is(err, jwt.ErrForbidden):
+		return status.Error(codes.PermissionDenied, "forbidden")
+	case errors.Is(err, domain.ErrInvalidArgument),
+		errors.Is(err, domain.ErrInvalidPageToken):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, domain.ErrOrderNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalidTransition),
+		errors.Is(err, domain.ErrProductUnavailable),
+		errors.Is(err, domain.ErrInsufficientStock),
+		errors.Is(err, domain.ErrPromoCodeRejected):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, "internal server error")
+	}
+}
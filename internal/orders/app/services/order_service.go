@@ -0,0 +1,479 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/orders/domain"
+	"github.com/KarpovYuri/caraudio-backend/internal/orders/infrastructure/database/postgres"
+)
+
+// expireBatchSize bounds the orders cancelled by one ExpireReservations run.
+const expireBatchSize = 100
+
+// ProductCatalog looks up the current state of a product when it is ordered
+// and holds its stock while the order is open.
+type ProductCatalog interface {
+	GetProduct(ctx context.Context, id string) (*domain.ProductSnapshot, error)
+	// ReserveStock takes the quantities out of sale. CommitStock settles the
+	// reservation once the order is paid; ReleaseStock puts it back on sale.
+	ReserveStock(ctx context.Context, lines []domain.OrderLineInput) error
+	CommitStock(ctx context.Context, lines []domain.OrderLineInput) error
+	ReleaseStock(ctx context.Context, lines []domain.OrderLineInput) error
+}
+
+// Discounts prices orders with the discount rules and records the promo
+// codes orders use. ReleaseRedemptions gives back every code an order used
+// and may be called again for the same order.
+type Discounts interface {
+	EvaluateDiscounts(ctx context.Context, lines []domain.OrderLineInput, codes []string) (*domain.DiscountEvaluation, error)
+	RedeemPromoCode(ctx context.Context, userID, code, orderID string) error
+	ReleaseRedemptions(ctx context.Context, orderID string) error
+}
+
+type OrderService interface {
+	CreateOrder(ctx context.Context, input domain.OrderInput) (*domain.Order, error)
+	GetOrder(ctx context.Context, id string) (*domain.Order, error)
+	GetUserOrder(ctx context.Context, userID, id string) (*domain.Order, error)
+	ListOrders(ctx context.Context, filter domain.OrderFilter) (*domain.OrderListResult, error)
+	TransitionOrder(ctx context.Context, id, toStatus, actorID, comment string) (*domain.Order, error)
+	// ExpireReservations cancels unpaid orders placed before createdBefore,
+	// returning their stock to sale and their promo codes, and reports how
+	// many it cancelled.
+	ExpireReservations(ctx context.Context, createdBefore time.Time) (int, error)
+	NormalizePagination(page, pageSize, defaultSize, maxSize int32) (int32, int32)
+}
+
+type orderService struct {
+	orders    postgres.OrderRepository
+	products  ProductCatalog
+	discounts Discounts
+}
+
+func NewOrderService(orders postgres.OrderRepository, products ProductCatalog, discounts Discounts) OrderService {
+	return &orderService{orders: orders, products: products, discounts: discounts}
+}
+
+// CreateOrder prices every line from the catalog, applies the discount rules
+// and stores the result on the order; what the customer pays never changes
+// afterwards. The stock is reserved until the order is paid or cancelled.
+func (s *orderService) CreateOrder(ctx context.Context, input domain.OrderInput) (*domain.Order, error) {
+	input.CustomerName = strings.TrimSpace(input.CustomerName)
+	input.CustomerPhone = strings.TrimSpace(input.CustomerPhone)
+	input.CustomerEmail = strings.TrimSpace(input.CustomerEmail)
+	input.DeliveryAddress = strings.TrimSpace(input.DeliveryAddress)
+	input.PromoCodes = normalizePromoCodes(input.PromoCodes)
+	if err := validateOrderInput(input); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	order := &domain.Order{
+		ID:              uuid.NewString(),
+		UserID:          input.UserID,
+		Status:          domain.OrderStatusNew,
+		PaymentStatus:   domain.PaymentStatusPending,
+		DeliveryMethod:  input.DeliveryMethod,
+		DeliveryAddress: input.DeliveryAddress,
+		CustomerName:    input.CustomerName,
+		CustomerPhone:   input.CustomerPhone,
+		CustomerEmail:   input.CustomerEmail,
+		Comment:         strings.TrimSpace(input.Comment),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+		Lines:           make([]domain.OrderLine, 0, len(input.Lines)),
+	}
+	lines := mergeOrderLines(input.Lines)
+	for _, in := range lines {
+		if in.Quantity > domain.MaxLineQuantity {
+			return nil, domain.ErrInvalidArgument
+		}
+		product, err := s.products.GetProduct(ctx, in.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		if !product.IsActive {
+			return nil, domain.ErrProductUnavailable
+		}
+		if in.Quantity > product.Stock {
+			return nil, domain.ErrInsufficientStock
+		}
+		line := domain.OrderLine{
+			OrderID:        order.ID,
+			ProductID:      product.ID,
+			SKU:            product.SKU,
+			Name:           product.Name,
+			PriceCents:     product.PriceCents,
+			Quantity:       in.Quantity,
+			LineTotalCents: product.PriceCents * int64(in.Quantity),
+		}
+		order.ItemsCount += line.Quantity
+		order.SubtotalCents += line.LineTotalCents
+		order.Lines = append(order.Lines, line)
+	}
+	if err := s.applyDiscounts(ctx, order, lines, input.PromoCodes); err != nil {
+		return nil, err
+	}
+
+	if err := s.products.ReserveStock(ctx, lines); err != nil {
+		return nil, err
+	}
+	order.StockReserved = true
+
+	change := &domain.OrderStatusChange{
+		OrderID:   order.ID,
+		ToStatus:  domain.OrderStatusNew,
+		ChangedBy: input.UserID,
+		CreatedAt: now,
+	}
+	if err := s.orders.Create(ctx, order, change); err != nil {
+		s.settleStock(ctx, order.ID, lines, s.products.ReleaseStock)
+		return nil, err
+	}
+	order.History = []domain.OrderStatusChange{*change}
+
+	if err := s.redeemPromoCodes(ctx, order); err != nil {
+		// Someone else took the last use of a code between pricing and
+		// placing the order; it must not go through at the discounted price.
+		// Cancelling gives back the codes redeemed before the failing one.
+		if cancelErr := s.transition(ctx, order, domain.OrderStatusCancelled, domain.SystemActor,
+			"promo code could not be redeemed"); cancelErr != nil {
+			slog.ErrorContext(ctx, "failed to cancel order after promo code redemption failed",
+				"order_id", order.ID, "error", cancelErr)
+		}
+		return nil, err
+	}
+	return order, nil
+}
+
+// applyDiscounts prices the order with the discount rules, as the cart
+// showed it to the customer. Promo codes that do not qualify are ignored.
+func (s *orderService) applyDiscounts(
+	ctx context.Context,
+	order *domain.Order,
+	lines []domain.OrderLineInput,
+	codes []string,
+) error {
+	evaluation, err := s.discounts.EvaluateDiscounts(ctx, lines, codes)
+	if err != nil {
+		return err
+	}
+	order.DiscountCents = min(evaluation.DiscountCents, order.SubtotalCents)
+	order.TotalCents = order.SubtotalCents - order.DiscountCents
+	for _, applied := range evaluation.Applied {
+		applied.OrderID = order.ID
+		order.Discounts = append(order.Discounts, applied)
+	}
+	return nil
+}
+
+// redeemPromoCodes records the use of every promo code the order was priced
+// with; the discount service enforces their usage limits.
+func (s *orderService) redeemPromoCodes(ctx context.Context, order *domain.Order) error {
+	for _, discount := range order.Discounts {
+		if discount.Code == "" {
+			continue
+		}
+		if err := s.discounts.RedeemPromoCode(ctx, order.UserID, discount.Code, order.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *orderService) GetOrder(ctx context.Context, id string) (*domain.Order, error) {
+	order, err := s.orders.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.loadDetails(ctx, order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+// GetUserOrder hides orders of other customers behind ErrOrderNotFound.
+func (s *orderService) GetUserOrder(ctx context.Context, userID, id string) (*domain.Order, error) {
+	order, err := s.orders.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if userID == "" || order.UserID != userID {
+		return nil, domain.ErrOrderNotFound
+	}
+	if err := s.loadDetails(ctx, order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func (s *orderService) ListOrders(ctx context.Context, filter domain.OrderFilter) (*domain.OrderListResult, error) {
+	if filter.Status != "" && !domain.IsValidOrderStatus(filter.Status) {
+		return nil, domain.ErrInvalidArgument
+	}
+	if filter.PaymentStatus != "" && !domain.IsValidPaymentStatus(filter.PaymentStatus) {
+		return nil, domain.ErrInvalidArgument
+	}
+	if filter.DeliveryMethod != "" && !domain.IsValidDeliveryMethod(filter.DeliveryMethod) {
+		return nil, domain.ErrInvalidArgument
+	}
+	result, err := s.orders.List(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(result.Orders))
+	for i := range result.Orders {
+		ids = append(ids, result.Orders[i].ID)
+	}
+	lines, err := s.orders.ListLines(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byOrder := make(map[string][]domain.OrderLine, len(ids))
+	for _, line := range lines {
+		byOrder[line.OrderID] = append(byOrder[line.OrderID], line)
+	}
+	for i := range result.Orders {
+		result.Orders[i].Lines = byOrder[result.Orders[i].ID]
+	}
+	return result, nil
+}
+
+// TransitionOrder moves the order along the status graph and keeps the
+// payment status in step with it.
+func (s *orderService) TransitionOrder(
+	ctx context.Context,
+	id, toStatus, actorID, comment string,
+) (*domain.Order, error) {
+	if !domain.IsValidOrderStatus(toStatus) {
+		return nil, domain.ErrInvalidArgument
+	}
+	order, err := s.orders.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.transition(ctx, order, toStatus, actorID, comment); err != nil {
+		return nil, err
+	}
+	if err := s.loadDetails(ctx, order); err != nil {
+		return nil, err
+	}
+	return order, nil
+}
+
+func (s *orderService) ExpireReservations(ctx context.Context, createdBefore time.Time) (int, error) {
+	orders, err := s.orders.ListExpiredReservations(ctx, createdBefore, expireBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	expired := 0
+	for i := range orders {
+		err := s.transition(ctx, &orders[i], domain.OrderStatusCancelled, domain.SystemActor, "reservation expired")
+		if errors.Is(err, domain.ErrInvalidTransition) {
+			// Paid or cancelled since it was listed.
+			continue
+		}
+		if err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// transition stores the status change and settles the order's reserved
+// stock: payment turns the reservation into a sale and cancelling an unpaid
+// order puts the stock back on sale. Goods of a paid order that is
+// cancelled are restocked by hand, like returned ones. Cancelling any order
+// gives back the promo codes it used.
+func (s *orderService) transition(
+	ctx context.Context,
+	order *domain.Order,
+	toStatus, actorID, comment string,
+) error {
+	if !domain.CanTransition(order.Status, toStatus) {
+		return domain.ErrInvalidTransition
+	}
+
+	now := time.Now()
+	change := &domain.OrderStatusChange{
+		OrderID:    order.ID,
+		FromStatus: order.Status,
+		ToStatus:   toStatus,
+		ChangedBy:  actorID,
+		Comment:    strings.TrimSpace(comment),
+		CreatedAt:  now,
+	}
+	order.PaymentStatus = domain.PaymentStatusAfter(order.PaymentStatus, toStatus)
+	order.Status = toStatus
+	order.UpdatedAt = now
+	// The status is written first: the conditional update lets only one of
+	// two concurrent transitions through, so stock is settled once.
+	if err := s.orders.UpdateStatus(ctx, order, change); err != nil {
+		if errors.Is(err, domain.ErrOrderConflict) {
+			return domain.ErrInvalidTransition
+		}
+		return err
+	}
+	if toStatus == domain.OrderStatusCancelled {
+		s.releasePromoCodes(ctx, order)
+	}
+	if !order.StockReserved {
+		return nil
+	}
+
+	var settle func(context.Context, []domain.OrderLineInput) error
+	switch {
+	case toStatus == domain.OrderStatusPaid:
+		settle = s.products.CommitStock
+	case toStatus == domain.OrderStatusCancelled && change.FromStatus != domain.OrderStatusPaid:
+		settle = s.products.ReleaseStock
+	default:
+		return nil
+	}
+	lines := order.Lines
+	if lines == nil {
+		var err error
+		if lines, err = s.orders.ListLines(ctx, []string{order.ID}); err != nil {
+			slog.ErrorContext(ctx, "failed to load order lines to settle stock", "order_id", order.ID, "error", err)
+			return nil
+		}
+	}
+	s.settleStock(ctx, order.ID, stockLines(lines), settle)
+	return nil
+}
+
+// releasePromoCodes gives back the promo codes of a cancelled order. Like a
+// stock change it cannot be undone on the order side, so a failure is
+// logged; the release can be repeated by hand.
+func (s *orderService) releasePromoCodes(ctx context.Context, order *domain.Order) {
+	discounts := order.Discounts
+	if discounts == nil {
+		var err error
+		if discounts, err = s.orders.ListDiscounts(ctx, order.ID); err != nil {
+			slog.ErrorContext(ctx, "failed to load order discounts to release promo codes", "order_id", order.ID, "error", err)
+			return
+		}
+	}
+	if !slices.ContainsFunc(discounts, func(d domain.OrderDiscount) bool { return d.Code != "" }) {
+		return
+	}
+	if err := s.discounts.ReleaseRedemptions(ctx, order.ID); err != nil {
+		slog.ErrorContext(ctx, "failed to release order promo codes", "order_id", order.ID, "error", err)
+	}
+}
+
+// settleStock applies a stock change for an order whose own state is
+// already stored. A failure cannot be undone on the order side, so it is
+// logged for the stock to be corrected by hand.
+func (s *orderService) settleStock(
+	ctx context.Context,
+	orderID string,
+	lines []domain.OrderLineInput,
+	settle func(context.Context, []domain.OrderLineInput) error,
+) {
+	if err := settle(ctx, lines); err != nil {
+		slog.ErrorContext(ctx, "failed to settle order stock", "order_id", orderID, "error", err)
+	}
+}
+
+func (s *orderService) NormalizePagination(page, pageSize, defaultSize, maxSize int32) (int32, int32) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultSize
+	}
+	if pageSize > maxSize {
+		pageSize = maxSize
+	}
+	return page, pageSize
+}
+
+func (s *orderService) loadDetails(ctx context.Context, order *domain.Order) error {
+	lines, err := s.orders.ListLines(ctx, []string{order.ID})
+	if err != nil {
+		return err
+	}
+	history, err := s.orders.ListHistory(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+	discounts, err := s.orders.ListDiscounts(ctx, order.ID)
+	if err != nil {
+		return err
+	}
+	order.Lines = lines
+	order.Discounts = discounts
+	order.History = history
+	return nil
+}
+
+func validateOrderInput(input domain.OrderInput) error {
+	if input.UserID == "" || len(input.Lines) == 0 {
+		return domain.ErrInvalidArgument
+	}
+	if input.CustomerName == "" || input.CustomerPhone == "" {
+		return domain.ErrInvalidArgument
+	}
+	if !domain.IsValidDeliveryMethod(input.DeliveryMethod) {
+		return domain.ErrInvalidArgument
+	}
+	if input.DeliveryMethod != domain.DeliveryMethodPickup && input.DeliveryAddress == "" {
+		return domain.ErrInvalidArgument
+	}
+	for _, line := range input.Lines {
+		if line.ProductID == "" || line.Quantity <= 0 {
+			return domain.ErrInvalidArgument
+		}
+	}
+	if len(input.PromoCodes) > domain.MaxPromoCodes {
+		return domain.ErrInvalidArgument
+	}
+	return nil
+}
+
+// normalizePromoCodes drops blank and repeated codes; codes are matched
+// case-insensitively.
+func normalizePromoCodes(codes []string) []string {
+	normalized := make([]string, 0, len(codes))
+	for _, code := range codes {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if code == "" || slices.Contains(normalized, code) {
+			continue
+		}
+		normalized = append(normalized, code)
+	}
+	return normalized
+}
+
+func stockLines(lines []domain.OrderLine) []domain.OrderLineInput {
+	out := make([]domain.OrderLineInput, 0, len(lines))
+	for _, line := range lines {
+		out = append(out, domain.OrderLineInput{ProductID: line.ProductID, Quantity: line.Quantity})
+	}
+	return out
+}
+
+// mergeOrderLines sums quantities of repeated products, keeping the order in
+// which they first appear.
+func mergeOrderLines(lines []domain.OrderLineInput) []domain.OrderLineInput {
+	merged := make([]domain.OrderLineInput, 0, len(lines))
+	index := make(map[string]int, len(lines))
+	for _, line := range lines {
+		if i, ok := index[line.ProductID]; ok {
+			merged[i].Quantity += line.Quantity
+			continue
+		}
+		index[line.ProductID] = len(merged)
+		merged = append(merged, line)
+	}
+	return merged
+}
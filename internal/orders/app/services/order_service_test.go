@@ -0,0 +1,634 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/orders/domain"
+)
+
+type fakeOrderRepo struct {
+	createFn        func(ctx context.Context, order *domain.Order, change *domain.OrderStatusChange) error
+	getByIDFn       func(ctx context.Context, id string) (*domain.Order, error)
+	listLinesFn     func(ctx context.Context, orderIDs []string) ([]domain.OrderLine, error)
+	listDiscountsFn func(ctx context.Context, orderID string) ([]domain.OrderDiscount, error)
+	listExpiredFn   func(ctx context.Context, createdBefore time.Time, limit int) ([]domain.Order, error)
+	updateStatusFn  func(ctx context.Context, order *domain.Order, change *domain.OrderStatusChange) error
+}
+
+func (f *fakeOrderRepo) Create(ctx context.Context, order *domain.Order, change *domain.OrderStatusChange) error {
+	if f.createFn == nil {
+		return nil
+	}
+	return f.createFn(ctx, order, change)
+}
+
+func (f *fakeOrderRepo) GetByID(ctx context.Context, id string) (*domain.Order, error) {
+	if f.getByIDFn == nil {
+		return nil, errors.New("getByIDFn is not set")
+	}
+	return f.getByIDFn(ctx, id)
+}
+
+func (f *fakeOrderRepo) List(_ context.Context, _ domain.OrderFilter) (*domain.OrderListResult, error) {
+	return &domain.OrderListResult{}, nil
+}
+
+func (f *fakeOrderRepo) ListLines(ctx context.Context, orderIDs []string) ([]domain.OrderLine, error) {
+	if f.listLinesFn == nil {
+		return nil, nil
+	}
+	return f.listLinesFn(ctx, orderIDs)
+}
+
+func (f *fakeOrderRepo) ListDiscounts(ctx context.Context, orderID string) ([]domain.OrderDiscount, error) {
+	if f.listDiscountsFn == nil {
+		return nil, nil
+	}
+	return f.listDiscountsFn(ctx, orderID)
+}
+
+func (f *fakeOrderRepo) ListHistory(_ context.Context, _ string) ([]domain.OrderStatusChange, error) {
+	return nil, nil
+}
+
+func (f *fakeOrderRepo) ListExpiredReservations(
+	ctx context.Context,
+	createdBefore time.Time,
+	limit int,
+) ([]domain.Order, error) {
+	if f.listExpiredFn == nil {
+		return nil, nil
+	}
+	return f.listExpiredFn(ctx, createdBefore, limit)
+}
+
+func (f *fakeOrderRepo) UpdateStatus(ctx context.Context, order *domain.Order, change *domain.OrderStatusChange) error {
+	if f.updateStatusFn == nil {
+		return nil
+	}
+	return f.updateStatusFn(ctx, order, change)
+}
+
+// fakeProductCatalog keeps the reserved quantity of every product.
+type fakeProductCatalog struct {
+	products  map[string]domain.ProductSnapshot
+	reserved  map[string]int32
+	committed map[string]int32
+}
+
+func (f *fakeProductCatalog) GetProduct(_ context.Context, id string) (*domain.ProductSnapshot, error) {
+	product, ok := f.products[id]
+	if !ok {
+		return nil, domain.ErrProductUnavailable
+	}
+	return &product, nil
+}
+
+func (f *fakeProductCatalog) ReserveStock(_ context.Context, lines []domain.OrderLineInput) error {
+	if f.reserved == nil {
+		f.reserved = make(map[string]int32)
+	}
+	for _, line := range lines {
+		f.reserved[line.ProductID] += line.Quantity
+	}
+	return nil
+}
+
+func (f *fakeProductCatalog) CommitStock(_ context.Context, lines []domain.OrderLineInput) error {
+	if f.committed == nil {
+		f.committed = make(map[string]int32)
+	}
+	for _, line := range lines {
+		f.reserved[line.ProductID] -= line.Quantity
+		f.committed[line.ProductID] += line.Quantity
+	}
+	return nil
+}
+
+func (f *fakeProductCatalog) ReleaseStock(_ context.Context, lines []domain.OrderLineInput) error {
+	for _, line := range lines {
+		f.reserved[line.ProductID] -= line.Quantity
+	}
+	return nil
+}
+
+func (f *fakeProductCatalog) reservedTotal() int32 {
+	var total int32
+	for _, quantity := range f.reserved {
+		total += quantity
+	}
+	return total
+}
+
+type fakeDiscounts struct {
+	evaluateFn func(ctx context.Context, lines []domain.OrderLineInput, codes []string) (*domain.DiscountEvaluation, error)
+	redeemFn   func(ctx context.Context, userID, code, orderID string) error
+	released   []string
+}
+
+func (f *fakeDiscounts) EvaluateDiscounts(
+	ctx context.Context,
+	lines []domain.OrderLineInput,
+	codes []string,
+) (*domain.DiscountEvaluation, error) {
+	if f.evaluateFn == nil {
+		return &domain.DiscountEvaluation{}, nil
+	}
+	return f.evaluateFn(ctx, lines, codes)
+}
+
+func (f *fakeDiscounts) RedeemPromoCode(ctx context.Context, userID, code, orderID string) error {
+	if f.redeemFn == nil {
+		return nil
+	}
+	return f.redeemFn(ctx, userID, code, orderID)
+}
+
+func (f *fakeDiscounts) ReleaseRedemptions(_ context.Context, orderID string) error {
+	f.released = append(f.released, orderID)
+	return nil
+}
+
+func validOrderInput() domain.OrderInput {
+	return domain.OrderInput{
+		UserID:          "user-1",
+		DeliveryMethod:  domain.DeliveryMethodCourier,
+		DeliveryAddress: "Lenina 1",
+		CustomerName:    "Ivan",
+		CustomerPhone:   "+70000000000",
+		Lines: []domain.OrderLineInput{
+			{ProductID: "p1", Quantity: 1},
+			{ProductID: "p2", Quantity: 2},
+			{ProductID: "p1", Quantity: 1},
+		},
+	}
+}
+
+func TestOrderServiceCreateOrderSnapshotsPrices(t *testing.T) {
+	ctx := context.Background()
+	catalog := &fakeProductCatalog{products: map[string]domain.ProductSnapshot{
+		"p1": {ID: "p1", Name: "Amplifier", SKU: "AMP-1", PriceCents: 10000, Stock: 5, IsActive: true},
+		"p2": {ID: "p2", Name: "Cable", PriceCents: 500, Stock: 10, IsActive: true},
+	}}
+	var stored *domain.Order
+	repo := &fakeOrderRepo{
+		createFn: func(_ context.Context, order *domain.Order, change *domain.OrderStatusChange) error {
+			if change.ToStatus != domain.OrderStatusNew || change.ChangedBy != "user-1" {
+				t.Fatalf("unexpected initial history entry: %+v", change)
+			}
+			stored = order
+			return nil
+		},
+	}
+
+	svc := NewOrderService(repo, catalog, &fakeDiscounts{})
+	order, err := svc.CreateOrder(ctx, validOrderInput())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if stored == nil || stored.ID != order.ID {
+		t.Fatalf("expected order to be stored")
+	}
+	if order.Status != domain.OrderStatusNew || order.PaymentStatus != domain.PaymentStatusPending {
+		t.Fatalf("unexpected statuses: %s/%s", order.Status, order.PaymentStatus)
+	}
+	if len(order.Lines) != 2 {
+		t.Fatalf("expected repeated products to be merged, got %d lines", len(order.Lines))
+	}
+	if order.Lines[0].Quantity != 2 || order.Lines[0].PriceCents != 10000 || order.Lines[0].Name != "Amplifier" {
+		t.Fatalf("unexpected first line: %+v", order.Lines[0])
+	}
+	if order.ItemsCount != 4 || order.TotalCents != 21000 {
+		t.Fatalf("unexpected totals: items=%d total=%d", order.ItemsCount, order.TotalCents)
+	}
+}
+
+func TestOrderServiceCreateOrderRejectsInvalidInput(t *testing.T) {
+	ctx := context.Background()
+	catalog := &fakeProductCatalog{products: map[string]domain.ProductSnapshot{
+		"p1": {ID: "p1", PriceCents: 100, Stock: 1, IsActive: true},
+		"p2": {ID: "p2", PriceCents: 100, Stock: 10, IsActive: false},
+	}}
+
+	tests := []struct {
+		name   string
+		modify func(input *domain.OrderInput)
+		want   error
+	}{
+		{"missing address for courier", func(in *domain.OrderInput) { in.DeliveryAddress = "" }, domain.ErrInvalidArgument},
+		{"unknown delivery method", func(in *domain.OrderInput) { in.DeliveryMethod = "drone" }, domain.ErrInvalidArgument},
+		{"missing phone", func(in *domain.OrderInput) { in.CustomerPhone = " " }, domain.ErrInvalidArgument},
+		{"inactive product", func(in *domain.OrderInput) {
+			in.Lines = []domain.OrderLineInput{{ProductID: "p2", Quantity: 1}}
+		}, domain.ErrProductUnavailable},
+		{"unknown product", func(in *domain.OrderInput) {
+			in.Lines = []domain.OrderLineInput{{ProductID: "p3", Quantity: 1}}
+		}, domain.ErrProductUnavailable},
+		{"not enough stock", func(in *domain.OrderInput) {
+			in.Lines = []domain.OrderLineInput{{ProductID: "p1", Quantity: 2}}
+		}, domain.ErrInsufficientStock},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeOrderRepo{
+				createFn: func(_ context.Context, _ *domain.Order, _ *domain.OrderStatusChange) error {
+					t.Fatalf("order must not be stored")
+					return nil
+				},
+			}
+			input := validOrderInput()
+			tt.modify(&input)
+			_, err := NewOrderService(repo, catalog, &fakeDiscounts{}).CreateOrder(ctx, input)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("expected %v, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestOrderServiceCreateOrderAppliesDiscountsAndReservesStock(t *testing.T) {
+	ctx := context.Background()
+	catalog := &fakeProductCatalog{products: map[string]domain.ProductSnapshot{
+		"p1": {ID: "p1", Name: "Amplifier", PriceCents: 10000, Stock: 5, IsActive: true},
+		"p2": {ID: "p2", Name: "Cable", PriceCents: 500, Stock: 10, IsActive: true},
+	}}
+	var redeemed []string
+	discounts := &fakeDiscounts{
+		evaluateFn: func(_ context.Context, lines []domain.OrderLineInput, codes []string) (*domain.DiscountEvaluation, error) {
+			if len(lines) != 2 || len(codes) != 1 || codes[0] != "SPRING" {
+				t.Fatalf("unexpected evaluation input: %+v %v", lines, codes)
+			}
+			return &domain.DiscountEvaluation{
+				DiscountCents: 3000,
+				Applied: []domain.OrderDiscount{
+					{DiscountID: "d1", Name: "Spring sale", Code: "SPRING", AmountCents: 2000},
+					{DiscountID: "d2", Name: "Cables", AmountCents: 1000},
+				},
+			}, nil
+		},
+		redeemFn: func(_ context.Context, userID, code, _ string) error {
+			if userID != "user-1" {
+				t.Fatalf("expected redemption for the ordering user, got %q", userID)
+			}
+			redeemed = append(redeemed, code)
+			return nil
+		},
+	}
+	var stored *domain.Order
+	repo := &fakeOrderRepo{
+		createFn: func(_ context.Context, order *domain.Order, _ *domain.OrderStatusChange) error {
+			if catalog.reservedTotal() != 4 {
+				t.Fatalf("expected stock to be reserved before the order is stored")
+			}
+			if len(redeemed) != 0 {
+				t.Fatalf("promo codes must be redeemed only for a stored order")
+			}
+			stored = order
+			return nil
+		},
+	}
+
+	input := validOrderInput()
+	input.PromoCodes = []string{" spring ", "SPRING", ""}
+	order, err := NewOrderService(repo, catalog, discounts).CreateOrder(ctx, input)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if stored == nil || !stored.StockReserved {
+		t.Fatalf("expected the order to be stored with its reservation")
+	}
+	if order.SubtotalCents != 21000 || order.DiscountCents != 3000 || order.TotalCents != 18000 {
+		t.Fatalf("unexpected totals: %d - %d = %d", order.SubtotalCents, order.DiscountCents, order.TotalCents)
+	}
+	if len(order.Discounts) != 2 || order.Discounts[0].OrderID != order.ID {
+		t.Fatalf("unexpected discounts: %+v", order.Discounts)
+	}
+	if len(redeemed) != 1 || redeemed[0] != "SPRING" {
+		t.Fatalf("expected only the promo code to be redeemed, got %v", redeemed)
+	}
+}
+
+func TestOrderServiceCreateOrderReleasesStockWhenStoringFails(t *testing.T) {
+	catalog := &fakeProductCatalog{products: map[string]domain.ProductSnapshot{
+		"p1": {ID: "p1", PriceCents: 100, Stock: 5, IsActive: true},
+		"p2": {ID: "p2", PriceCents: 100, Stock: 5, IsActive: true},
+	}}
+	repo := &fakeOrderRepo{
+		createFn: func(_ context.Context, _ *domain.Order, _ *domain.OrderStatusChange) error {
+			return errors.New("database is down")
+		},
+	}
+	_, err := NewOrderService(repo, catalog, &fakeDiscounts{}).CreateOrder(context.Background(), validOrderInput())
+	if err == nil {
+		t.Fatalf("expected an error")
+	}
+	if catalog.reservedTotal() != 0 {
+		t.Fatalf("expected the reservation to be released, %d units still reserved", catalog.reservedTotal())
+	}
+}
+
+func TestOrderServiceCreateOrderCancelsOrderWhenPromoCodeIsUsedUp(t *testing.T) {
+	catalog := &fakeProductCatalog{products: map[string]domain.ProductSnapshot{
+		"p1": {ID: "p1", PriceCents: 100, Stock: 5, IsActive: true},
+		"p2": {ID: "p2", PriceCents: 100, Stock: 5, IsActive: true},
+	}}
+	discounts := &fakeDiscounts{
+		evaluateFn: func(_ context.Context, _ []domain.OrderLineInput, _ []string) (*domain.DiscountEvaluation, error) {
+			return &domain.DiscountEvaluation{
+				DiscountCents: 50,
+				Applied:       []domain.OrderDiscount{{DiscountID: "d1", Code: "LAST", AmountCents: 50}},
+			}, nil
+		},
+		redeemFn: func(_ context.Context, _, _, _ string) error {
+			return domain.ErrPromoCodeRejected
+		},
+	}
+	var cancelled *domain.OrderStatusChange
+	repo := &fakeOrderRepo{
+		updateStatusFn: func(_ context.Context, _ *domain.Order, change *domain.OrderStatusChange) error {
+			cancelled = change
+			return nil
+		},
+	}
+
+	input := validOrderInput()
+	input.PromoCodes = []string{"LAST"}
+	_, err := NewOrderService(repo, catalog, discounts).CreateOrder(context.Background(), input)
+	if !errors.Is(err, domain.ErrPromoCodeRejected) {
+		t.Fatalf("expected ErrPromoCodeRejected, got %v", err)
+	}
+	if cancelled == nil || cancelled.ToStatus != domain.OrderStatusCancelled || cancelled.ChangedBy != domain.SystemActor {
+		t.Fatalf("expected the stored order to be cancelled, got %+v", cancelled)
+	}
+	if catalog.reservedTotal() != 0 {
+		t.Fatalf("expected the reservation to be released, %d units still reserved", catalog.reservedTotal())
+	}
+}
+
+func TestOrderServiceCreateOrderReleasesCodesRedeemedBeforeAFailure(t *testing.T) {
+	catalog := &fakeProductCatalog{products: map[string]domain.ProductSnapshot{
+		"p1": {ID: "p1", PriceCents: 100, Stock: 5, IsActive: true},
+		"p2": {ID: "p2", PriceCents: 100, Stock: 5, IsActive: true},
+	}}
+	var redeemed []string
+	discounts := &fakeDiscounts{
+		evaluateFn: func(_ context.Context, _ []domain.OrderLineInput, _ []string) (*domain.DiscountEvaluation, error) {
+			return &domain.DiscountEvaluation{
+				DiscountCents: 80,
+				Applied: []domain.OrderDiscount{
+					{DiscountID: "d1", Code: "WELCOME", AmountCents: 30},
+					{DiscountID: "d2", Code: "LAST", AmountCents: 50},
+				},
+			}, nil
+		},
+		redeemFn: func(_ context.Context, _, code, _ string) error {
+			if code == "LAST" {
+				return domain.ErrPromoCodeRejected
+			}
+			redeemed = append(redeemed, code)
+			return nil
+		},
+	}
+
+	input := validOrderInput()
+	input.PromoCodes = []string{"WELCOME", "LAST"}
+	var orderID string
+	repo := &fakeOrderRepo{
+		createFn: func(_ context.Context, order *domain.Order, _ *domain.OrderStatusChange) error {
+			orderID = order.ID
+			return nil
+		},
+	}
+	_, err := NewOrderService(repo, catalog, discounts).CreateOrder(context.Background(), input)
+	if !errors.Is(err, domain.ErrPromoCodeRejected) {
+		t.Fatalf("expected ErrPromoCodeRejected, got %v", err)
+	}
+	if len(redeemed) != 1 || redeemed[0] != "WELCOME" {
+		t.Fatalf("expected the first code to be redeemed, got %v", redeemed)
+	}
+	if len(discounts.released) != 1 || discounts.released[0] != orderID {
+		t.Fatalf("expected the codes of order %q to be released, got %v", orderID, discounts.released)
+	}
+}
+
+func TestOrderServiceCancellingReleasesPromoCodes(t *testing.T) {
+	withCode := []domain.OrderDiscount{{DiscountID: "d1", Code: "SPRING", AmountCents: 100}}
+	automatic := []domain.OrderDiscount{{DiscountID: "d2", AmountCents: 100}}
+
+	tests := []struct {
+		name         string
+		from         string
+		payment      string
+		to           string
+		discounts    []domain.OrderDiscount
+		wantReleased bool
+	}{
+		{"cancelling an unpaid order", domain.OrderStatusNew, domain.PaymentStatusPending, domain.OrderStatusCancelled, withCode, true},
+		{"cancelling a paid order", domain.OrderStatusPaid, domain.PaymentStatusPaid, domain.OrderStatusCancelled, withCode, true},
+		{"order without promo codes", domain.OrderStatusNew, domain.PaymentStatusPending, domain.OrderStatusCancelled, automatic, false},
+		{"confirming keeps the codes", domain.OrderStatusNew, domain.PaymentStatusPending, domain.OrderStatusConfirmed, withCode, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeOrderRepo{
+				getByIDFn: func(_ context.Context, id string) (*domain.Order, error) {
+					return &domain.Order{ID: id, Status: tt.from, PaymentStatus: tt.payment}, nil
+				},
+				listDiscountsFn: func(_ context.Context, _ string) ([]domain.OrderDiscount, error) {
+					return tt.discounts, nil
+				},
+			}
+			discounts := &fakeDiscounts{}
+			_, err := NewOrderService(repo, &fakeProductCatalog{}, discounts).
+				TransitionOrder(context.Background(), "order-1", tt.to, "admin-1", "")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if released := len(discounts.released) == 1 && discounts.released[0] == "order-1"; released != tt.wantReleased {
+				t.Fatalf("expected released=%v, got %v", tt.wantReleased, discounts.released)
+			}
+		})
+	}
+
+	t.Run("expired reservation", func(t *testing.T) {
+		repo := &fakeOrderRepo{
+			listExpiredFn: func(_ context.Context, _ time.Time, _ int) ([]domain.Order, error) {
+				return []domain.Order{{ID: "order-2", Status: domain.OrderStatusNew}}, nil
+			},
+			listDiscountsFn: func(_ context.Context, _ string) ([]domain.OrderDiscount, error) {
+				return withCode, nil
+			},
+		}
+		discounts := &fakeDiscounts{}
+		expired, err := NewOrderService(repo, &fakeProductCatalog{}, discounts).ExpireReservations(context.Background(), time.Now())
+		if err != nil || expired != 1 {
+			t.Fatalf("expected one expired order, got %d, %v", expired, err)
+		}
+		if len(discounts.released) != 1 || discounts.released[0] != "order-2" {
+			t.Fatalf("expected the codes of the expired order to be released, got %v", discounts.released)
+		}
+	})
+}
+
+func TestOrderServiceTransitionOrder(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name        string
+		from        string
+		payment     string
+		to          string
+		wantErr     error
+		wantPayment string
+	}{
+		{"confirm new order", domain.OrderStatusNew, domain.PaymentStatusPending, domain.OrderStatusConfirmed, nil, domain.PaymentStatusPending},
+		{"pay confirmed order", domain.OrderStatusConfirmed, domain.PaymentStatusPending, domain.OrderStatusPaid, nil, domain.PaymentStatusPaid},
+		{"cancel paid order refunds", domain.OrderStatusPaid, domain.PaymentStatusPaid, domain.OrderStatusCancelled, nil, domain.PaymentStatusRefunded},
+		{"cancel unpaid order", domain.OrderStatusNew, domain.PaymentStatusPending, domain.OrderStatusCancelled, nil, domain.PaymentStatusPending},
+		{"return delivered order refunds", domain.OrderStatusDelivered, domain.PaymentStatusPaid, domain.OrderStatusReturned, nil, domain.PaymentStatusRefunded},
+		{"ship unpaid order", domain.OrderStatusConfirmed, domain.PaymentStatusPending, domain.OrderStatusShipped, domain.ErrInvalidTransition, ""},
+		{"cancel shipped order", domain.OrderStatusShipped, domain.PaymentStatusPaid, domain.OrderStatusCancelled, domain.ErrInvalidTransition, ""},
+		{"reopen cancelled order", domain.OrderStatusCancelled, domain.PaymentStatusPending, domain.OrderStatusNew, domain.ErrInvalidTransition, ""},
+		{"same status", domain.OrderStatusPaid, domain.PaymentStatusPaid, domain.OrderStatusPaid, domain.ErrInvalidTransition, ""},
+		{"unknown status", domain.OrderStatusNew, domain.PaymentStatusPending, "lost", domain.ErrInvalidArgument, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := false
+			repo := &fakeOrderRepo{
+				getByIDFn: func(_ context.Context, id string) (*domain.Order, error) {
+					return &domain.Order{ID: id, Status: tt.from, PaymentStatus: tt.payment}, nil
+				},
+				updateStatusFn: func(_ context.Context, order *domain.Order, change *domain.OrderStatusChange) error {
+					if change.FromStatus != tt.from || change.ToStatus != tt.to || change.ChangedBy != "admin-1" {
+						t.Fatalf("unexpected history entry: %+v", change)
+					}
+					updated = true
+					return nil
+				},
+			}
+			order, err := NewOrderService(repo, &fakeProductCatalog{}, &fakeDiscounts{}).
+				TransitionOrder(ctx, "order-1", tt.to, "admin-1", "")
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("expected %v, got %v", tt.wantErr, err)
+				}
+				if updated {
+					t.Fatalf("rejected transition must not be stored")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if order.Status != tt.to || order.PaymentStatus != tt.wantPayment {
+				t.Fatalf("unexpected order state: %s/%s", order.Status, order.PaymentStatus)
+			}
+		})
+	}
+}
+
+func TestOrderServiceTransitionOrderSettlesReservedStock(t *testing.T) {
+	tests := []struct {
+		name          string
+		from          string
+		payment       string
+		to            string
+		reserved      bool
+		wantReserved  int32
+		wantCommitted int32
+	}{
+		{"payment commits the reservation", domain.OrderStatusConfirmed, domain.PaymentStatusPending, domain.OrderStatusPaid, true, 0, 3},
+		{"cancelling an unpaid order releases it", domain.OrderStatusNew, domain.PaymentStatusPending, domain.OrderStatusCancelled, true, 0, 0},
+		{"confirming keeps it", domain.OrderStatusNew, domain.PaymentStatusPending, domain.OrderStatusConfirmed, true, 3, 0},
+		{"order without a reservation", domain.OrderStatusConfirmed, domain.PaymentStatusPending, domain.OrderStatusPaid, false, 3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			catalog := &fakeProductCatalog{reserved: map[string]int32{"p1": 3}}
+			repo := &fakeOrderRepo{
+				getByIDFn: func(_ context.Context, id string) (*domain.Order, error) {
+					return &domain.Order{ID: id, Status: tt.from, PaymentStatus: tt.payment, StockReserved: tt.reserved}, nil
+				},
+				listLinesFn: func(_ context.Context, _ []string) ([]domain.OrderLine, error) {
+					return []domain.OrderLine{{ProductID: "p1", Quantity: 3}}, nil
+				},
+			}
+			_, err := NewOrderService(repo, catalog, &fakeDiscounts{}).
+				TransitionOrder(context.Background(), "order-1", tt.to, "admin-1", "")
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if catalog.reserved["p1"] != tt.wantReserved || catalog.committed["p1"] != tt.wantCommitted {
+				t.Fatalf("unexpected stock: reserved=%d committed=%d", catalog.reserved["p1"], catalog.committed["p1"])
+			}
+		})
+	}
+}
+
+func TestOrderServiceExpireReservationsCancelsUnpaidOrders(t *testing.T) {
+	cutoff := time.Now().Add(-time.Hour)
+	catalog := &fakeProductCatalog{reserved: map[string]int32{"p1": 2}}
+	repo := &fakeOrderRepo{
+		listExpiredFn: func(_ context.Context, createdBefore time.Time, _ int) ([]domain.Order, error) {
+			if !createdBefore.Equal(cutoff) {
+				t.Fatalf("unexpected cutoff %v", createdBefore)
+			}
+			return []domain.Order{
+				{ID: "order-1", Status: domain.OrderStatusNew, StockReserved: true},
+				{ID: "order-2", Status: domain.OrderStatusConfirmed, StockReserved: true},
+			}, nil
+		},
+		listLinesFn: func(_ context.Context, _ []string) ([]domain.OrderLine, error) {
+			return []domain.OrderLine{{ProductID: "p1", Quantity: 1}}, nil
+		},
+		updateStatusFn: func(_ context.Context, order *domain.Order, change *domain.OrderStatusChange) error {
+			if order.ID == "order-2" {
+				// Paid in the meantime.
+				return domain.ErrOrderConflict
+			}
+			if change.ChangedBy != domain.SystemActor {
+				t.Fatalf("unexpected actor %q", change.ChangedBy)
+			}
+			return nil
+		},
+	}
+	expired, err := NewOrderService(repo, catalog, &fakeDiscounts{}).ExpireReservations(context.Background(), cutoff)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if expired != 1 || catalog.reserved["p1"] != 1 {
+		t.Fatalf("expected one order to expire, got %d with %d units reserved", expired, catalog.reserved["p1"])
+	}
+}
+
+func TestOrderServiceTransitionOrderConcurrentUpdate(t *testing.T) {
+	repo := &fakeOrderRepo{
+		getByIDFn: func(_ context.Context, id string) (*domain.Order, error) {
+			return &domain.Order{ID: id, Status: domain.OrderStatusNew, PaymentStatus: domain.PaymentStatusPending}, nil
+		},
+		updateStatusFn: func(_ context.Context, _ *domain.Order, _ *domain.OrderStatusChange) error {
+			return domain.ErrOrderConflict
+		},
+	}
+	_, err := NewOrderService(repo, &fakeProductCatalog{}, &fakeDiscounts{}).
+		TransitionOrder(context.Background(), "order-1", domain.OrderStatusConfirmed, "admin-1", "")
+	if !errors.Is(err, domain.ErrInvalidTransition) {
+		t.Fatalf("expected ErrInvalidTransition, got %v", err)
+	}
+}
+
+func TestOrderServiceGetUserOrderHidesForeignOrders(t *testing.T) {
+	repo := &fakeOrderRepo{
+		getByIDFn: func(_ context.Context, id string) (*domain.Order, error) {
+			return &domain.Order{ID: id, UserID: "user-2"}, nil
+		},
+	}
+	_, err := NewOrderService(repo, &fakeProductCatalog{}, &fakeDiscounts{}).GetUserOrder(context.Background(), "user-1", "order-1")
+	if !errors.Is(err, domain.ErrOrderNotFound) {
+		t.Fatalf("expected ErrOrderNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,255 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/viper"
+)
+
+type Config struct {
+	GRPCPort                string         `mapstructure:"grpc_port"`
+	HTTPPort                string         `mapstructure:"http_port"`
+	JWKSURL                 string         `mapstructure:"jwks_url"`
+	JWKSFile                string         `mapstructure:"jwks_file"`
+	JWKSCacheTTL            time.Duration  `mapstructure:"jwks_cache_ttl"`
+	JWTIssuer               string         `mapstructure:"jwt_issuer"`
+	JWTAudience             string         `mapstructure:"jwt_audience"`
+	JWTLeeway               time.Duration  `mapstructure:"jwt_leeway"`
+	AuthGRPCAddr            string         `mapstructure:"auth_grpc_addr"`
+	RevocationsRefreshEvery time.Duration  `mapstructure:"revocations_refresh_every"`
+	AllowedOrigins          []string       `mapstructure:"allowed_origins"`
+	CatalogGRPCAddr         string         `mapstructure:"catalog_grpc_addr"`
+	ClientID                string         `mapstructure:"client_id"`
+	ClientSecret            string         `mapstructure:"client_secret"`
+	ReservationTTL          time.Duration  `mapstructure:"reservation_ttl"`
+	ExpireReservationsEvery time.Duration  `mapstructure:"expire_reservations_every"`
+	HTTPReadTimeout         time.Duration  `mapstructure:"http_read_timeout"`
+	HTTPWriteTimeout        time.Duration  `mapstructure:"http_write_timeout"`
+	HTTPIdleTimeout         time.Duration  `mapstructure:"http_idle_timeout"`
+	ShutdownTimeout         time.Duration  `mapstructure:"shutdown_timeout"`
+	Database                DatabaseConfig `mapstructure:"database"`
+}
+
+type DatabaseConfig struct {
+	Host     string `mapstructure:"host"`
+	Port     int    `mapstructure:"port"`
+	User     string `mapstructure:"user"`
+	Password string `mapstructure:"password"`
+	DBName   string `mapstructure:"dbname"`
+	SSLMode  string `mapstructure:"sslmode"`
+}
+
+func LoadConfig() (*Config, error) {
+	if err := godotenv.Load(); err != nil {
+		slog.Info(".env file not loaded, relying on process environment", "error", err)
+	}
+
+	viper.AddConfigPath("./config")
+	viper.SetConfigName("order_service")
+	viper.SetConfigType("yaml")
+
+	if err := viper.ReadInConfig(); err != nil {
+		var notFoundError viper.ConfigFileNotFoundError
+		if !errors.As(err, &notFoundError) {
+			return nil, fmt.Errorf("failed to read config file: %w", err)
+		}
+		slog.Info("config file not found, using env vars", "error", err)
+	}
+
+	var cfg Config
+	if err := viper.Unmarshal(&cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
+	}
+
+	applyEnvOverrides(&cfg)
+
+	if err := validate(&cfg); err != nil {
+		return nil, err
+	}
+
+	slog.Info("order service configuration loaded")
+	return &cfg, nil
+}
+
+func applyEnvOverrides(cfg *Config) {
+	if v := os.Getenv("ORDERS_DATABASE_USER"); v != "" {
+		cfg.Database.User = v
+	}
+	if v := os.Getenv("ORDERS_DATABASE_PASSWORD"); v != "" {
+		cfg.Database.Password = v
+	}
+	if v := os.Getenv("ORDERS_DATABASE_DBNAME"); v != "" {
+		cfg.Database.DBName = v
+	}
+	if v := os.Getenv("ORDERS_DATABASE_HOST"); v != "" {
+		cfg.Database.Host = v
+	}
+	if v := os.Getenv("ORDERS_JWKS_URL"); v != "" {
+		cfg.JWKSURL = v
+	}
+	if v := os.Getenv("ORDERS_JWKS_FILE"); v != "" {
+		cfg.JWKSFile = v
+	}
+	if v := os.Getenv("ORDERS_JWT_ISSUER"); v != "" {
+		cfg.JWTIssuer = v
+	}
+	if v := os.Getenv("ORDERS_JWT_AUDIENCE"); v != "" {
+		cfg.JWTAudience = v
+	}
+	if v := os.Getenv("ORDERS_JWT_LEEWAY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.JWTLeeway = d
+		}
+	}
+	if v := os.Getenv("ORDERS_JWKS_CACHE_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.JWKSCacheTTL = d
+		}
+	}
+	if v := os.Getenv("ORDERS_AUTH_GRPC_ADDR"); v != "" {
+		cfg.AuthGRPCAddr = v
+	}
+	if v := os.Getenv("ORDERS_REVOCATIONS_REFRESH_EVERY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.RevocationsRefreshEvery = d
+		}
+	}
+	if v := os.Getenv("ORDERS_GRPC_PORT"); v != "" {
+		cfg.GRPCPort = v
+	}
+	if v := os.Getenv("ORDERS_HTTP_PORT"); v != "" {
+		cfg.HTTPPort = v
+	}
+	if v := os.Getenv("ORDERS_ALLOWED_ORIGINS"); v != "" {
+		cfg.AllowedOrigins = parseCommaSeparatedList(v)
+	}
+	if v := os.Getenv("ORDERS_CATALOG_GRPC_ADDR"); v != "" {
+		cfg.CatalogGRPCAddr = v
+	}
+	if v := os.Getenv("ORDERS_CLIENT_ID"); v != "" {
+		cfg.ClientID = v
+	}
+	if v := os.Getenv("ORDERS_CLIENT_SECRET"); v != "" {
+		cfg.ClientSecret = v
+	}
+	if v := os.Getenv("ORDERS_RESERVATION_TTL"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.ReservationTTL = d
+		}
+	}
+	if v := os.Getenv("ORDERS_EXPIRE_RESERVATIONS_EVERY"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.ExpireReservationsEvery = d
+		}
+	}
+	if v := os.Getenv("ORDERS_HTTP_READ_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.HTTPReadTimeout = d
+		}
+	}
+	if v := os.Getenv("ORDERS_HTTP_WRITE_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.HTTPWriteTimeout = d
+		}
+	}
+	if v := os.Getenv("ORDERS_HTTP_IDLE_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.HTTPIdleTimeout = d
+		}
+	}
+	if v := os.Getenv("ORDERS_SHUTDOWN_TIMEOUT"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			cfg.ShutdownTimeout = d
+		}
+	}
+}
+
+func validate(cfg *Config) error {
+	if cfg.JWKSURL == "" && cfg.JWKSFile == "" {
+		return errors.New("ORDERS_JWKS_URL or ORDERS_JWKS_FILE is required")
+	}
+	if cfg.Database.User == "" {
+		return errors.New("ORDERS_DATABASE_USER is required")
+	}
+	if cfg.Database.Password == "" {
+		return errors.New("ORDERS_DATABASE_PASSWORD is required")
+	}
+	if cfg.Database.DBName == "" {
+		return errors.New("ORDERS_DATABASE_DBNAME is required")
+	}
+	if cfg.Database.Host == "" {
+		return errors.New("ORDERS_DATABASE_HOST is required")
+	}
+	if cfg.GRPCPort == "" {
+		return errors.New("ORDERS_GRPC_PORT is required")
+	}
+	if cfg.HTTPPort == "" {
+		return errors.New("ORDERS_HTTP_PORT is required")
+	}
+	if cfg.CatalogGRPCAddr == "" {
+		return errors.New("ORDERS_CATALOG_GRPC_ADDR is required")
+	}
+	if cfg.ClientID == "" || cfg.ClientSecret == "" {
+		return errors.New("ORDERS_CLIENT_ID and ORDERS_CLIENT_SECRET are required")
+	}
+	if len(cfg.AllowedOrigins) == 0 {
+		return errors.New("ORDERS_ALLOWED_ORIGINS is required")
+	}
+	if cfg.HTTPReadTimeout <= 0 {
+		cfg.HTTPReadTimeout = 15 * time.Second
+	}
+	if cfg.HTTPWriteTimeout <= 0 {
+		cfg.HTTPWriteTimeout = 15 * time.Second
+	}
+	if cfg.HTTPIdleTimeout <= 0 {
+		cfg.HTTPIdleTimeout = 60 * time.Second
+	}
+	if cfg.ShutdownTimeout <= 0 {
+		cfg.ShutdownTimeout = 15 * time.Second
+	}
+	if cfg.JWTIssuer == "" {
+		cfg.JWTIssuer = "caraudio-auth"
+	}
+	if cfg.JWTAudience == "" {
+		cfg.JWTAudience = "caraudio-api"
+	}
+	if cfg.JWTLeeway < 0 {
+		cfg.JWTLeeway = 0
+	}
+	if cfg.AuthGRPCAddr == "" {
+		cfg.AuthGRPCAddr = "localhost:50051"
+	}
+	if cfg.RevocationsRefreshEvery <= 0 {
+		cfg.RevocationsRefreshEvery = 15 * time.Second
+	}
+	if cfg.ReservationTTL <= 0 {
+		cfg.ReservationTTL = 72 * time.Hour
+	}
+	if cfg.ExpireReservationsEvery <= 0 {
+		cfg.ExpireReservationsEvery = 10 * time.Minute
+	}
+	if cfg.Database.Port == 0 {
+		cfg.Database.Port = 5432
+	}
+	if cfg.Database.SSLMode == "" {
+		cfg.Database.SSLMode = "disable"
+	}
+	return nil
+}
+
+func parseCommaSeparatedList(value string) []string {
+	raw := strings.Split(value, ",")
+	parts := make([]string, 0, len(raw))
+	for _, part := range raw {
+		if trimmed := strings.TrimSpace(part); trimmed != "" {
+			parts = append(parts, trimmed)
+		}
+	}
+	return parts
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "errors"
+
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrInvalidPageToken   = errors.New("invalid page token")
+	ErrOrderNotFound      = errors.New("order not found")
+	ErrInvalidTransition  = errors.New("order status transition is not allowed")
+	ErrOrderConflict      = errors.New("order was modified concurrently")
+	ErrProductUnavailable = errors.New("product unavailable")
+	ErrInsufficientStock  = errors.New("insufficient stock")
+	ErrPromoCodeRejected  = errors.New("promo code cannot be applied")
+)
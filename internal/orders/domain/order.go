@@ -0,0 +1,202 @@
+package domain
+
+import "time"
+
+const (
+	OrderStatusNew       = "new"
+	OrderStatusConfirmed = "confirmed"
+	OrderStatusPaid      = "paid"
+	OrderStatusShipped   = "shipped"
+	OrderStatusDelivered = "delivered"
+	OrderStatusCancelled = "cancelled"
+	OrderStatusReturned  = "returned"
+)
+
+const (
+	PaymentStatusPending  = "pending"
+	PaymentStatusPaid     = "paid"
+	PaymentStatusRefunded = "refunded"
+)
+
+const (
+	DeliveryMethodPickup  = "pickup"
+	DeliveryMethodCourier = "courier"
+	DeliveryMethodPost    = "post"
+)
+
+// MaxLineQuantity matches the cart limit so a cart can always be ordered.
+const MaxLineQuantity = 999
+
+// MaxPromoCodes bounds the promo codes entered for one order.
+const MaxPromoCodes = 5
+
+// SystemActor is recorded in the history for changes the service makes on
+// its own, such as cancelling an order whose reservation expired.
+const SystemActor = "system"
+
+// orderTransitions lists the statuses each status may move to. Cancelled and
+// returned are final.
+var orderTransitions = map[string][]string{
+	OrderStatusNew:       {OrderStatusConfirmed, OrderStatusCancelled},
+	OrderStatusConfirmed: {OrderStatusPaid, OrderStatusCancelled},
+	OrderStatusPaid:      {OrderStatusShipped, OrderStatusCancelled},
+	OrderStatusShipped:   {OrderStatusDelivered, OrderStatusReturned},
+	OrderStatusDelivered: {OrderStatusReturned},
+}
+
+type Order struct {
+	ID              string `db:"id"`
+	UserID          string `db:"user_id"`
+	Status          string `db:"status"`
+	PaymentStatus   string `db:"payment_status"`
+	DeliveryMethod  string `db:"delivery_method"`
+	DeliveryAddress string `db:"delivery_address"`
+	CustomerName    string `db:"customer_name"`
+	CustomerPhone   string `db:"customer_phone"`
+	CustomerEmail   string `db:"customer_email"`
+	Comment         string `db:"comment"`
+	ItemsCount      int32  `db:"items_count"`
+	SubtotalCents   int64  `db:"subtotal_cents"`
+	DiscountCents   int64  `db:"discount_cents"`
+	TotalCents      int64  `db:"total_cents"`
+	// StockReserved is set when the catalog holds stock for the order;
+	// orders placed before reservations existed have it unset.
+	StockReserved bool                `db:"stock_reserved"`
+	CreatedAt     time.Time           `db:"created_at"`
+	UpdatedAt     time.Time           `db:"updated_at"`
+	Lines         []OrderLine         `db:"-"`
+	Discounts     []OrderDiscount     `db:"-"`
+	History       []OrderStatusChange `db:"-"`
+}
+
+// OrderLine keeps a snapshot of the product as it was sold, so later catalog
+// edits do not change past orders.
+type OrderLine struct {
+	OrderID        string `db:"order_id"`
+	ProductID      string `db:"product_id"`
+	SKU            string `db:"sku"`
+	Name           string `db:"name"`
+	PriceCents     int64  `db:"price_cents"`
+	Quantity       int32  `db:"quantity"`
+	LineTotalCents int64  `db:"line_total_cents"`
+}
+
+// OrderDiscount is a discount applied when the order was placed. Code is
+// empty for automatic discounts.
+type OrderDiscount struct {
+	OrderID     string `db:"order_id"`
+	DiscountID  string `db:"discount_id"`
+	Name        string `db:"name"`
+	Code        string `db:"code"`
+	AmountCents int64  `db:"amount_cents"`
+}
+
+type OrderStatusChange struct {
+	ID         int64     `db:"id"`
+	OrderID    string    `db:"order_id"`
+	FromStatus string    `db:"from_status"`
+	ToStatus   string    `db:"to_status"`
+	ChangedBy  string    `db:"changed_by"`
+	Comment    string    `db:"comment"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+type OrderLineInput struct {
+	ProductID string
+	Quantity  int32
+}
+
+type OrderInput struct {
+	UserID          string
+	Lines           []OrderLineInput
+	DeliveryMethod  string
+	DeliveryAddress string
+	CustomerName    string
+	CustomerPhone   string
+	CustomerEmail   string
+	Comment         string
+	PromoCodes      []string
+}
+
+type OrderFilter struct {
+	UserID         string
+	Status         string
+	PaymentStatus  string
+	DeliveryMethod string
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time
+	Page           int32
+	PageSize       int32
+	PageToken      string
+}
+
+type OrderListResult struct {
+	Orders        []Order
+	Total         int32
+	NextPageToken string
+}
+
+// ProductSnapshot is what the order service needs to know about a product at
+// the moment it is ordered.
+type ProductSnapshot struct {
+	ID         string
+	SKU        string
+	Name       string
+	PriceCents int64
+	Stock      int32
+	IsActive   bool
+}
+
+// DiscountEvaluation is what the discount rules take off the order lines.
+// Promo codes that do not qualify are left out of Applied.
+type DiscountEvaluation struct {
+	DiscountCents int64
+	Applied       []OrderDiscount
+}
+
+func IsValidOrderStatus(status string) bool {
+	_, ok := orderTransitions[status]
+	return ok || status == OrderStatusCancelled || status == OrderStatusReturned
+}
+
+func IsValidPaymentStatus(status string) bool {
+	switch status {
+	case PaymentStatusPending, PaymentStatusPaid, PaymentStatusRefunded:
+		return true
+	default:
+		return false
+	}
+}
+
+func IsValidDeliveryMethod(method string) bool {
+	switch method {
+	case DeliveryMethodPickup, DeliveryMethodCourier, DeliveryMethodPost:
+		return true
+	default:
+		return false
+	}
+}
+
+func CanTransition(from, to string) bool {
+	for _, next := range orderTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// PaymentStatusAfter returns the payment status an order has once it moves to
+// the given status: paying marks it paid, and cancelling or returning a paid
+// order refunds it.
+func PaymentStatusAfter(current, to string) string {
+	switch to {
+	case OrderStatusPaid:
+		return PaymentStatusPaid
+	case OrderStatusCancelled, OrderStatusReturned:
+		if current == PaymentStatusPaid {
+			return PaymentStatusRefunded
+		}
+	}
+	return current
+}
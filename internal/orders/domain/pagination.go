@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+)
+
+// PageCursor is the decoded form of an opaque page token: the created_at and
+// id of the last returned order.
+type PageCursor struct {
+	Keys []string `json:"k"`
+}
+
+func EncodePageCursor(cursor PageCursor) string {
+	raw, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodePageCursor(token string) (PageCursor, error) {
+	var cursor PageCursor
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return cursor, ErrInvalidPageToken
+	}
+	if err := json.Unmarshal(raw, &cursor); err != nil {
+		return cursor, ErrInvalidPageToken
+	}
+	if len(cursor.Keys) == 0 {
+		return cursor, ErrInvalidPageToken
+	}
+	return cursor, nil
+}
@@ -0,0 +1,92 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/orders/domain"
+	catalogv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/catalog/v1"
+)
+
+// GRPCProductCatalog reads products through the public catalog API, so only
+// products a customer can see can be ordered. Stock is reserved with the
+// order service's own credentials.
+type GRPCProductCatalog struct {
+	client      catalogv1.CatalogServiceClient
+	credentials credentials.PerRPCCredentials
+}
+
+func NewGRPCProductCatalog(
+	client catalogv1.CatalogServiceClient,
+	credentials credentials.PerRPCCredentials,
+) *GRPCProductCatalog {
+	return &GRPCProductCatalog{client: client, credentials: credentials}
+}
+
+func (c *GRPCProductCatalog) GetProduct(ctx context.Context, id string) (*domain.ProductSnapshot, error) {
+	resp, err := c.client.GetProduct(ctx, &catalogv1.GetProductRequest{Id: id})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound, codes.InvalidArgument:
+			return nil, domain.ErrProductUnavailable
+		default:
+			return nil, fmt.Errorf("failed to get product from catalog: %w", err)
+		}
+	}
+	product := resp.GetProduct()
+	return &domain.ProductSnapshot{
+		ID:         product.GetId(),
+		SKU:        product.GetSku(),
+		Name:       product.GetName(),
+		PriceCents: product.GetPriceCents(),
+		Stock:      product.GetStock(),
+		IsActive:   product.GetIsActive(),
+	}, nil
+}
+
+func (c *GRPCProductCatalog) ReserveStock(ctx context.Context, lines []domain.OrderLineInput) error {
+	_, err := c.client.ReserveStock(ctx, &catalogv1.ReserveStockRequest{Lines: toStockLines(lines)},
+		grpc.PerRPCCredentials(c.credentials))
+	if err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			return domain.ErrInsufficientStock
+		case codes.NotFound:
+			return domain.ErrProductUnavailable
+		default:
+			return fmt.Errorf("failed to reserve stock: %w", err)
+		}
+	}
+	return nil
+}
+
+func (c *GRPCProductCatalog) CommitStock(ctx context.Context, lines []domain.OrderLineInput) error {
+	_, err := c.client.CommitStock(ctx, &catalogv1.CommitStockRequest{Lines: toStockLines(lines)},
+		grpc.PerRPCCredentials(c.credentials))
+	if err != nil {
+		return fmt.Errorf("failed to commit stock: %w", err)
+	}
+	return nil
+}
+
+func (c *GRPCProductCatalog) ReleaseStock(ctx context.Context, lines []domain.OrderLineInput) error {
+	_, err := c.client.ReleaseStock(ctx, &catalogv1.ReleaseStockRequest{Lines: toStockLines(lines)},
+		grpc.PerRPCCredentials(c.credentials))
+	if err != nil {
+		return fmt.Errorf("failed to release stock: %w", err)
+	}
+	return nil
+}
+
+func toStockLines(lines []domain.OrderLineInput) []*catalogv1.StockLine {
+	out := make([]*catalogv1.StockLine, 0, len(lines))
+	for _, line := range lines {
+		out = append(out, &catalogv1.StockLine{ProductId: line.ProductID, Quantity: line.Quantity})
+	}
+	return out
+}
@@ -0,0 +1,280 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/orders/domain"
+)
+
+type OrderRepository interface {
+	Create(ctx context.Context, order *domain.Order, change *domain.OrderStatusChange) error
+	GetByID(ctx context.Context, id string) (*domain.Order, error)
+	List(ctx context.Context, filter domain.OrderFilter) (*domain.OrderListResult, error)
+	ListLines(ctx context.Context, orderIDs []string) ([]domain.OrderLine, error)
+	ListDiscounts(ctx context.Context, orderID string) ([]domain.OrderDiscount, error)
+	ListHistory(ctx context.Context, orderID string) ([]domain.OrderStatusChange, error)
+	// ListExpiredReservations returns open orders created before the given
+	// time that still hold reserved stock, oldest first.
+	ListExpiredReservations(ctx context.Context, createdBefore time.Time, limit int) ([]domain.Order, error)
+	UpdateStatus(ctx context.Context, order *domain.Order, change *domain.OrderStatusChange) error
+}
+
+type postgresOrderRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresOrderRepository(db *sqlx.DB) OrderRepository {
+	return &postgresOrderRepository{db: db}
+}
+
+// Create stores the order with its lines, discounts and the initial history
+// entry.
+func (r *postgresOrderRepository) Create(
+	ctx context.Context,
+	order *domain.Order,
+	change *domain.OrderStatusChange,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	_, err = tx.NamedExecContext(ctx,
+		`INSERT INTO orders (
+           id, user_id, status, payment_status, delivery_method, delivery_address,
+           customer_name, customer_phone, customer_email, comment, items_count,
+           subtotal_cents, discount_cents, total_cents, stock_reserved, created_at, updated_at
+         ) VALUES (
+           :id, :user_id, :status, :payment_status, :delivery_method, :delivery_address,
+           :customer_name, :customer_phone, :customer_email, :comment, :items_count,
+           :subtotal_cents, :discount_cents, :total_cents, :stock_reserved, :created_at, :updated_at
+         )`, order)
+	if err != nil {
+		return fmt.Errorf("failed to create order: %w", err)
+	}
+	for i := range order.Lines {
+		_, err = tx.NamedExecContext(ctx,
+			`INSERT INTO order_lines (order_id, product_id, sku, name, price_cents, quantity, line_total_cents)
+             VALUES (:order_id, :product_id, :sku, :name, :price_cents, :quantity, :line_total_cents)`,
+			order.Lines[i])
+		if err != nil {
+			return fmt.Errorf("failed to create order line: %w", err)
+		}
+	}
+	for i := range order.Discounts {
+		_, err = tx.NamedExecContext(ctx,
+			`INSERT INTO order_discounts (order_id, discount_id, name, code, amount_cents)
+             VALUES (:order_id, :discount_id, :name, :code, :amount_cents)`,
+			order.Discounts[i])
+		if err != nil {
+			return fmt.Errorf("failed to create order discount: %w", err)
+		}
+	}
+	if err = insertStatusChange(ctx, tx, change); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit order transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func (r *postgresOrderRepository) GetByID(ctx context.Context, id string) (*domain.Order, error) {
+	var order domain.Order
+	if err := r.db.GetContext(ctx, &order, orderSelectSQL+` WHERE id = $1`, id); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrOrderNotFound
+		}
+		return nil, fmt.Errorf("failed to get order: %w", err)
+	}
+	return &order, nil
+}
+
+func (r *postgresOrderRepository) List(ctx context.Context, filter domain.OrderFilter) (*domain.OrderListResult, error) {
+	where := ""
+	args := make([]interface{}, 0, 9)
+	if filter.UserID != "" {
+		args = append(args, filter.UserID)
+		where = appendWhere(where, fmt.Sprintf("user_id = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where = appendWhere(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filter.PaymentStatus != "" {
+		args = append(args, filter.PaymentStatus)
+		where = appendWhere(where, fmt.Sprintf("payment_status = $%d", len(args)))
+	}
+	if filter.DeliveryMethod != "" {
+		args = append(args, filter.DeliveryMethod)
+		where = appendWhere(where, fmt.Sprintf("delivery_method = $%d", len(args)))
+	}
+	if filter.CreatedFrom != nil {
+		args = append(args, *filter.CreatedFrom)
+		where = appendWhere(where, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if filter.CreatedTo != nil {
+		args = append(args, *filter.CreatedTo)
+		where = appendWhere(where, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+
+	cursorMode := filter.PageToken != ""
+	var total int32
+	if cursorMode {
+		createdAt, id, err := decodeTimeCursor(filter.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, createdAt, id)
+		where = appendWhere(where, fmt.Sprintf("(created_at, id) < ($%d, $%d)", len(args)-1, len(args)))
+	} else {
+		if err := r.db.GetContext(ctx, &total, `SELECT COUNT(*) FROM orders`+where, args...); err != nil {
+			return nil, fmt.Errorf("failed to count orders: %w", err)
+		}
+	}
+
+	args = append(args, filter.PageSize+1, pageOffset(filter.Page, filter.PageSize, cursorMode))
+	query := orderSelectSQL + where +
+		fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d", len(args)-1, len(args))
+
+	var list []domain.Order
+	if err := r.db.SelectContext(ctx, &list, query, args...); err != nil {
+		return nil, fmt.Errorf("failed to list orders: %w", err)
+	}
+
+	result := &domain.OrderListResult{Total: total}
+	if len(list) > int(filter.PageSize) {
+		list = list[:filter.PageSize]
+		last := list[len(list)-1]
+		result.NextPageToken = encodeTimeCursor(last.CreatedAt, last.ID)
+	}
+	result.Orders = list
+	return result, nil
+}
+
+func (r *postgresOrderRepository) ListLines(ctx context.Context, orderIDs []string) ([]domain.OrderLine, error) {
+	if len(orderIDs) == 0 {
+		return nil, nil
+	}
+	var lines []domain.OrderLine
+	err := r.db.SelectContext(ctx, &lines,
+		orderLineSelectSQL+` WHERE order_id = ANY($1) ORDER BY order_id, name, product_id`,
+		pq.Array(orderIDs))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list order lines: %w", err)
+	}
+	return lines, nil
+}
+
+func (r *postgresOrderRepository) ListDiscounts(ctx context.Context, orderID string) ([]domain.OrderDiscount, error) {
+	var discounts []domain.OrderDiscount
+	err := r.db.SelectContext(ctx, &discounts,
+		`SELECT order_id, discount_id, name, code, amount_cents
+         FROM order_discounts WHERE order_id = $1 ORDER BY amount_cents DESC, discount_id`, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list order discounts: %w", err)
+	}
+	return discounts, nil
+}
+
+func (r *postgresOrderRepository) ListExpiredReservations(
+	ctx context.Context,
+	createdBefore time.Time,
+	limit int,
+) ([]domain.Order, error) {
+	var orders []domain.Order
+	err := r.db.SelectContext(ctx, &orders,
+		orderSelectSQL+` WHERE stock_reserved AND status IN ($1, $2) AND created_at < $3
+         ORDER BY created_at LIMIT $4`,
+		domain.OrderStatusNew, domain.OrderStatusConfirmed, createdBefore, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list expired reservations: %w", err)
+	}
+	return orders, nil
+}
+
+func (r *postgresOrderRepository) ListHistory(ctx context.Context, orderID string) ([]domain.OrderStatusChange, error) {
+	var history []domain.OrderStatusChange
+	err := r.db.SelectContext(ctx, &history,
+		`SELECT id, order_id, from_status, to_status, changed_by, comment, created_at
+         FROM order_status_history WHERE order_id = $1 ORDER BY created_at ASC, id ASC`, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list order history: %w", err)
+	}
+	return history, nil
+}
+
+// UpdateStatus applies a transition only if the order is still in the status
+// the transition was checked against; otherwise ErrOrderConflict is returned
+// and nothing is written.
+func (r *postgresOrderRepository) UpdateStatus(
+	ctx context.Context,
+	order *domain.Order,
+	change *domain.OrderStatusChange,
+) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	defer func() {
+		if tx != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	result, err := tx.ExecContext(ctx,
+		`UPDATE orders SET status = $2, payment_status = $3, updated_at = $4
+         WHERE id = $1 AND status = $5`,
+		order.ID, order.Status, order.PaymentStatus, order.UpdatedAt, change.FromStatus)
+	if err != nil {
+		return fmt.Errorf("failed to update order status: %w", err)
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rows == 0 {
+		return domain.ErrOrderConflict
+	}
+	if err = insertStatusChange(ctx, tx, change); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit order transaction: %w", err)
+	}
+	tx = nil
+	return nil
+}
+
+func insertStatusChange(ctx context.Context, tx *sqlx.Tx, change *domain.OrderStatusChange) error {
+	err := tx.GetContext(ctx, &change.ID,
+		`INSERT INTO order_status_history (order_id, from_status, to_status, changed_by, comment, created_at)
+         VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`,
+		change.OrderID, change.FromStatus, change.ToStatus, change.ChangedBy, change.Comment, change.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to record order status change: %w", err)
+	}
+	return nil
+}
+
+const orderSelectSQL = `SELECT id, user_id, status, payment_status, delivery_method, delivery_address,
+  customer_name, customer_phone, customer_email, comment, items_count, subtotal_cents, discount_cents,
+  total_cents, stock_reserved, created_at, updated_at
+  FROM orders`
+
+const orderLineSelectSQL = `SELECT order_id, product_id, sku, name, price_cents, quantity, line_total_cents FROM order_lines`
@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/orders/domain"
+)
+
+func pageOffset(page, pageSize int32, cursorMode bool) int32 {
+	if cursorMode || page < 1 {
+		return 0
+	}
+	return (page - 1) * pageSize
+}
+
+func encodeTimeCursor(createdAt time.Time, id string) string {
+	return domain.EncodePageCursor(domain.PageCursor{
+		Keys: []string{createdAt.UTC().Format(time.RFC3339Nano), id},
+	})
+}
+
+func decodeTimeCursor(token string) (time.Time, string, error) {
+	cursor, err := domain.DecodePageCursor(token)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	if len(cursor.Keys) != 2 {
+		return time.Time{}, "", domain.ErrInvalidPageToken
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, cursor.Keys[0])
+	if err != nil {
+		return time.Time{}, "", domain.ErrInvalidPageToken
+	}
+	if err := uuid.Validate(cursor.Keys[1]); err != nil {
+		return time.Time{}, "", domain.ErrInvalidPageToken
+	}
+	return createdAt, cursor.Keys[1], nil
+}
+
+func appendWhere(where, condition string) string {
+	if where == "" {
+		return " WHERE " + condition
+	}
+	return where + " AND " + condition
+}
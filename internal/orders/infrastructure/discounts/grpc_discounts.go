@@ -0,0 +1,94 @@
+package discounts
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/KarpovYuri/caraudio-backend/internal/orders/domain"
+	discountsv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/discounts/v1"
+	"github.com/KarpovYuri/caraudio-backend/pkg/authz"
+)
+
+// GRPCDiscounts prices orders through the discount service. Evaluation runs
+// on behalf of the customer, whose token is passed on so that per-customer
+// limits apply; redemption and release use the order service's own
+// credentials.
+type GRPCDiscounts struct {
+	client      discountsv1.DiscountServiceClient
+	credentials credentials.PerRPCCredentials
+}
+
+func NewGRPCDiscounts(
+	client discountsv1.DiscountServiceClient,
+	credentials credentials.PerRPCCredentials,
+) *GRPCDiscounts {
+	return &GRPCDiscounts{client: client, credentials: credentials}
+}
+
+func (d *GRPCDiscounts) EvaluateDiscounts(
+	ctx context.Context,
+	lines []domain.OrderLineInput,
+	codes []string,
+) (*domain.DiscountEvaluation, error) {
+	req := &discountsv1.EvaluateDiscountsRequest{
+		Lines:      make([]*discountsv1.DiscountLineInput, 0, len(lines)),
+		PromoCodes: codes,
+	}
+	for _, line := range lines {
+		req.Lines = append(req.Lines, &discountsv1.DiscountLineInput{ProductId: line.ProductID, Quantity: line.Quantity})
+	}
+	if token := authz.BearerToken(ctx); token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+token)
+	}
+
+	resp, err := d.client.EvaluateDiscounts(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to evaluate discounts: %w", err)
+	}
+	evaluation := &domain.DiscountEvaluation{
+		DiscountCents: resp.DiscountCents,
+		Applied:       make([]domain.OrderDiscount, 0, len(resp.Applied)),
+	}
+	for _, applied := range resp.Applied {
+		evaluation.Applied = append(evaluation.Applied, domain.OrderDiscount{
+			DiscountID:  applied.DiscountId,
+			Name:        applied.Name,
+			Code:        applied.Code,
+			AmountCents: applied.AmountCents,
+		})
+	}
+	return evaluation, nil
+}
+
+func (d *GRPCDiscounts) RedeemPromoCode(ctx context.Context, userID, code, orderID string) error {
+	_, err := d.client.RedeemPromoCode(ctx, &discountsv1.RedeemPromoCodeRequest{
+		Code:    code,
+		OrderId: orderID,
+		UserId:  userID,
+	}, grpc.PerRPCCredentials(d.credentials))
+	if err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition, codes.NotFound, codes.AlreadyExists:
+			return fmt.Errorf("%w: %s", domain.ErrPromoCodeRejected, code)
+		default:
+			return fmt.Errorf("failed to redeem promo code: %w", err)
+		}
+	}
+	return nil
+}
+
+func (d *GRPCDiscounts) ReleaseRedemptions(ctx context.Context, orderID string) error {
+	_, err := d.client.ReleaseRedemptions(ctx, &discountsv1.ReleaseRedemptionsRequest{
+		OrderId: orderID,
+	}, grpc.PerRPCCredentials(d.credentials))
+	if err != nil {
+		return fmt.Errorf("failed to release promo codes: %w", err)
+	}
+	return nil
+}
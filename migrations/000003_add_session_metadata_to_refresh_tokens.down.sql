@@ -0,0 +1,8 @@
+DROP INDEX IF EXISTS idx_refresh_tokens_user_created;
+
+ALTER TABLE refresh_tokens ALTER COLUMN created_at DROP NOT NULL;
+
+ALTER TABLE refresh_tokens
+    DROP COLUMN IF EXISTS last_used_at,
+    DROP COLUMN IF EXISTS ip_address,
+    DROP COLUMN IF EXISTS user_agent;
@@ -0,0 +1,10 @@
+ALTER TABLE refresh_tokens
+    ADD COLUMN user_agent TEXT NOT NULL DEFAULT '',
+    ADD COLUMN ip_address TEXT NOT NULL DEFAULT '',
+    ADD COLUMN last_used_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+UPDATE refresh_tokens SET created_at = NOW() WHERE created_at IS NULL;
+
+ALTER TABLE refresh_tokens ALTER COLUMN created_at SET NOT NULL;
+
+CREATE INDEX idx_refresh_tokens_user_created ON refresh_tokens(user_id, created_at DESC);
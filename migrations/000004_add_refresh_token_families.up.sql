@@ -0,0 +1,12 @@
+ALTER TABLE refresh_tokens
+    ADD COLUMN family_id UUID,
+    ADD COLUMN rotated_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN replaced_by UUID;
+
+UPDATE refresh_tokens SET family_id = id WHERE family_id IS NULL;
+
+ALTER TABLE refresh_tokens ALTER COLUMN family_id SET NOT NULL;
+
+CREATE UNIQUE INDEX idx_refresh_tokens_family_active
+    ON refresh_tokens(family_id) WHERE rotated_at IS NULL;
+CREATE INDEX idx_refresh_tokens_family_id ON refresh_tokens(family_id);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_token_cutoffs;
+DROP TABLE IF EXISTS revoked_access_tokens;
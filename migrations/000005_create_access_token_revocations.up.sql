@@ -0,0 +1,16 @@
+CREATE TABLE revoked_access_tokens (
+    jti TEXT PRIMARY KEY,
+    user_id UUID NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    revoked_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_revoked_access_tokens_expires_at ON revoked_access_tokens(expires_at);
+
+-- Access tokens of the user issued before not_before are rejected.
+CREATE TABLE user_token_cutoffs (
+    user_id UUID PRIMARY KEY,
+    not_before TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE INDEX idx_user_token_cutoffs_not_before ON user_token_cutoffs(not_before);
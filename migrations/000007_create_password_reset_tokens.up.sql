@@ -0,0 +1,22 @@
+CREATE TABLE password_reset_tokens (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_password_reset_tokens_user_id ON password_reset_tokens(user_id);
+CREATE INDEX idx_password_reset_tokens_expires_at ON password_reset_tokens(expires_at);
+
+-- Password reset requests per email address (scope 'email') and per client
+-- IP (scope 'ip') within the current request window.
+CREATE TABLE password_reset_requests (
+    scope VARCHAR(16) NOT NULL,
+    key VARCHAR(320) NOT NULL,
+    requests INTEGER NOT NULL,
+    first_requested_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    PRIMARY KEY (scope, key)
+);
+
+CREATE INDEX idx_password_reset_requests_first_requested_at ON password_reset_requests(first_requested_at);
@@ -0,0 +1,13 @@
+-- Failed login attempts per login name (scope 'login') and per client IP
+-- (scope 'ip') within the current failure window.
+CREATE TABLE login_failures (
+    scope VARCHAR(16) NOT NULL,
+    key VARCHAR(255) NOT NULL,
+    failures INTEGER NOT NULL,
+    first_failed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_failed_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    PRIMARY KEY (scope, key)
+);
+
+CREATE INDEX idx_login_failures_last_failed_at ON login_failures(last_failed_at);
@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_users_last_login_at;
+DROP INDEX IF EXISTS idx_users_created_at;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS last_login_at,
+    DROP COLUMN IF EXISTS disabled_at;
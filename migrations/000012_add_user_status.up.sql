@@ -0,0 +1,7 @@
+-- A disabled user keeps their data but can no longer log in or refresh.
+ALTER TABLE users
+    ADD COLUMN disabled_at TIMESTAMP WITH TIME ZONE,
+    ADD COLUMN last_login_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_users_created_at ON users(created_at);
+CREATE INDEX idx_users_last_login_at ON users(last_login_at);
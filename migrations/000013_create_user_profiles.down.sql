@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_addresses;
+DROP TABLE IF EXISTS user_profiles;
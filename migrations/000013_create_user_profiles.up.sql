@@ -0,0 +1,32 @@
+-- Contact details used at checkout. email is the contact address and may
+-- differ from the account email used for login and password resets.
+CREATE TABLE user_profiles (
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    first_name VARCHAR(100) NOT NULL DEFAULT '',
+    last_name VARCHAR(100) NOT NULL DEFAULT '',
+    phone VARCHAR(16) NOT NULL DEFAULT '',
+    email VARCHAR(320) NOT NULL DEFAULT '',
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE user_addresses (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    label VARCHAR(50) NOT NULL DEFAULT '',
+    recipient_name VARCHAR(200) NOT NULL DEFAULT '',
+    recipient_phone VARCHAR(16) NOT NULL DEFAULT '',
+    country CHAR(2) NOT NULL,
+    region VARCHAR(100) NOT NULL DEFAULT '',
+    city VARCHAR(100) NOT NULL,
+    street VARCHAR(200) NOT NULL,
+    house VARCHAR(20) NOT NULL,
+    apartment VARCHAR(20) NOT NULL DEFAULT '',
+    postal_code VARCHAR(10) NOT NULL DEFAULT '',
+    comment VARCHAR(500) NOT NULL DEFAULT '',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_user_addresses_user_id ON user_addresses(user_id);
+CREATE UNIQUE INDEX idx_user_addresses_default ON user_addresses(user_id) WHERE is_default;
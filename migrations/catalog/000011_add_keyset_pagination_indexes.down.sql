@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_supplier_product_mappings_created_at_id;
+DROP INDEX IF EXISTS idx_supplier_category_mappings_created_at_id;
+DROP INDEX IF EXISTS idx_suppliers_name_id;
+DROP INDEX IF EXISTS idx_products_created_at_id;
@@ -0,0 +1,6 @@
+CREATE INDEX IF NOT EXISTS idx_products_created_at_id ON products (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_suppliers_name_id ON suppliers (name, id);
+CREATE INDEX IF NOT EXISTS idx_supplier_category_mappings_created_at_id
+    ON supplier_category_mappings (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_supplier_product_mappings_created_at_id
+    ON supplier_product_mappings (created_at DESC, id DESC);
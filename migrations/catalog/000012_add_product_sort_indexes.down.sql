@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_products_in_stock;
+DROP INDEX IF EXISTS idx_products_popularity;
+DROP INDEX IF EXISTS idx_products_name_id;
+DROP INDEX IF EXISTS idx_products_price_cents_id;
+ALTER TABLE products DROP COLUMN IF EXISTS popularity;
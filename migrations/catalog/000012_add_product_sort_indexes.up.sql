@@ -0,0 +1,9 @@
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS popularity BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS idx_products_price_cents_id ON products (price_cents, id);
+CREATE INDEX IF NOT EXISTS idx_products_name_id ON products (name, id);
+CREATE INDEX IF NOT EXISTS idx_products_popularity
+    ON products (popularity DESC, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_products_in_stock
+    ON products ((stock > 0) DESC, created_at DESC, id DESC);
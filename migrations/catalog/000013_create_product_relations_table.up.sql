@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS product_relations (
+    id UUID PRIMARY KEY,
+    product_id UUID NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    related_product_id UUID NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    relation_type VARCHAR(32) NOT NULL
+        CHECK (relation_type IN ('accessory', 'analogue', 'bundle_part', 'upsell')),
+    sort_order INT NOT NULL DEFAULT 0 CHECK (sort_order >= 0),
+    -- Shared by the two rows of a bidirectional relation, NULL otherwise.
+    pair_id UUID,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (product_id, related_product_id, relation_type),
+    CHECK (product_id <> related_product_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_relations_product_id
+    ON product_relations (product_id, relation_type, sort_order);
+CREATE INDEX IF NOT EXISTS idx_product_relations_related_product_id
+    ON product_relations (related_product_id);
+CREATE INDEX IF NOT EXISTS idx_product_relations_pair_id
+    ON product_relations (pair_id) WHERE pair_id IS NOT NULL;
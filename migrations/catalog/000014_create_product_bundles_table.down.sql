@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS bundle_items;
+DROP TABLE IF EXISTS product_bundles;
+ALTER TABLE products DROP COLUMN IF EXISTS reserved_stock;
+ALTER TABLE products DROP COLUMN IF EXISTS kind;
@@ -0,0 +1,25 @@
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS kind VARCHAR(16) NOT NULL DEFAULT 'simple'
+        CHECK (kind IN ('simple', 'bundle'));
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS reserved_stock INT NOT NULL DEFAULT 0 CHECK (reserved_stock >= 0);
+
+CREATE TABLE IF NOT EXISTS product_bundles (
+    product_id UUID PRIMARY KEY REFERENCES products (id) ON DELETE CASCADE,
+    pricing_mode VARCHAR(16) NOT NULL CHECK (pricing_mode IN ('fixed', 'discount')),
+    discount_percent INT NOT NULL DEFAULT 0 CHECK (discount_percent BETWEEN 0 AND 100),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS bundle_items (
+    bundle_id UUID NOT NULL REFERENCES product_bundles (product_id) ON DELETE CASCADE,
+    component_product_id UUID NOT NULL REFERENCES products (id) ON DELETE RESTRICT,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    sort_order INT NOT NULL DEFAULT 0 CHECK (sort_order >= 0),
+    PRIMARY KEY (bundle_id, component_product_id),
+    CHECK (bundle_id <> component_product_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_bundle_items_component_product_id
+    ON bundle_items (component_product_id);
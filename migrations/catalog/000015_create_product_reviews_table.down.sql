@@ -0,0 +1,3 @@
+ALTER TABLE products DROP COLUMN IF EXISTS rating_count;
+ALTER TABLE products DROP COLUMN IF EXISTS rating_avg;
+DROP TABLE IF EXISTS product_reviews;
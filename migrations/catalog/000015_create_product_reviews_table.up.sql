@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS product_reviews (
+    id UUID PRIMARY KEY,
+    product_id UUID NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    user_id VARCHAR(64) NOT NULL,
+    rating SMALLINT NOT NULL CHECK (rating BETWEEN 1 AND 5),
+    pros TEXT NOT NULL DEFAULT '',
+    cons TEXT NOT NULL DEFAULT '',
+    body TEXT NOT NULL DEFAULT '',
+    status VARCHAR(16) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    moderation_note TEXT NOT NULL DEFAULT '',
+    moderated_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (product_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_reviews_product_status
+    ON product_reviews (product_id, status, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_product_reviews_status
+    ON product_reviews (status, created_at DESC, id DESC);
+
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS rating_avg NUMERIC(3, 2) NOT NULL DEFAULT 0;
+ALTER TABLE products
+    ADD COLUMN IF NOT EXISTS rating_count INT NOT NULL DEFAULT 0;
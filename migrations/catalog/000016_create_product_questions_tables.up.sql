@@ -0,0 +1,33 @@
+CREATE TABLE IF NOT EXISTS product_questions (
+    id UUID PRIMARY KEY,
+    product_id UUID NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    user_id VARCHAR(64) NOT NULL,
+    body TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    moderation_note TEXT NOT NULL DEFAULT '',
+    moderated_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_questions_product_status
+    ON product_questions (product_id, status, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_product_questions_status
+    ON product_questions (status, created_at DESC, id DESC);
+
+CREATE TABLE IF NOT EXISTS product_answers (
+    id UUID PRIMARY KEY,
+    question_id UUID NOT NULL REFERENCES product_questions (id) ON DELETE CASCADE,
+    user_id VARCHAR(64) NOT NULL,
+    body TEXT NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending'
+        CHECK (status IN ('pending', 'approved', 'rejected')),
+    moderation_note TEXT NOT NULL DEFAULT '',
+    moderated_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_answers_question_id
+    ON product_answers (question_id, status, created_at);
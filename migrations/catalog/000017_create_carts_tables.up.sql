@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS carts (
+    id UUID PRIMARY KEY,
+    user_id VARCHAR(64) UNIQUE,
+    guest_token VARCHAR(64) UNIQUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (user_id IS NOT NULL OR guest_token IS NOT NULL)
+);
+
+CREATE TABLE IF NOT EXISTS cart_items (
+    cart_id UUID NOT NULL REFERENCES carts (id) ON DELETE CASCADE,
+    product_id UUID NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    price_cents BIGINT NOT NULL CHECK (price_cents >= 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (cart_id, product_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_carts_updated_at ON carts (updated_at);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS discount_redemptions;
+DROP TABLE IF EXISTS discounts;
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS discounts (
+    id UUID PRIMARY KEY,
+    name VARCHAR(255) NOT NULL,
+    code VARCHAR(64) UNIQUE,
+    kind VARCHAR(16) NOT NULL CHECK (kind IN ('percent', 'fixed')),
+    value BIGINT NOT NULL CHECK (value > 0),
+    scope_type VARCHAR(16) NOT NULL DEFAULT 'all' CHECK (scope_type IN ('all', 'brand', 'category', 'product')),
+    scope_id UUID,
+    min_subtotal_cents BIGINT NOT NULL DEFAULT 0 CHECK (min_subtotal_cents >= 0),
+    stackable BOOLEAN NOT NULL DEFAULT FALSE,
+    priority INT NOT NULL DEFAULT 0,
+    max_uses INT CHECK (max_uses > 0),
+    max_uses_per_user INT CHECK (max_uses_per_user > 0),
+    used_count INT NOT NULL DEFAULT 0,
+    starts_at TIMESTAMP WITH TIME ZONE,
+    ends_at TIMESTAMP WITH TIME ZONE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CHECK (kind <> 'percent' OR value <= 100),
+    CHECK ((scope_type = 'all') = (scope_id IS NULL)),
+    CHECK (ends_at IS NULL OR starts_at IS NULL OR ends_at > starts_at)
+);
+
+CREATE TABLE IF NOT EXISTS discount_redemptions (
+    id BIGSERIAL PRIMARY KEY,
+    discount_id UUID NOT NULL REFERENCES discounts (id) ON DELETE CASCADE,
+    user_id VARCHAR(64) NOT NULL,
+    order_id VARCHAR(64) NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (discount_id, order_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_discounts_automatic ON discounts (is_active) WHERE code IS NULL;
+CREATE INDEX IF NOT EXISTS idx_discount_redemptions_user ON discount_redemptions (discount_id, user_id);
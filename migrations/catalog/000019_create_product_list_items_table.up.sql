@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS product_list_items (
+    user_id VARCHAR(64) NOT NULL,
+    list_type VARCHAR(16) NOT NULL CHECK (list_type IN ('wishlist', 'compare')),
+    product_id UUID NOT NULL REFERENCES products (id) ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, list_type, product_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_product_list_items_product_id ON product_list_items (product_id);
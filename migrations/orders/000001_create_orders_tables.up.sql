@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS orders (
+    id UUID PRIMARY KEY,
+    user_id VARCHAR(64) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'new'
+        CHECK (status IN ('new', 'confirmed', 'paid', 'shipped', 'delivered', 'cancelled', 'returned')),
+    payment_status VARCHAR(16) NOT NULL DEFAULT 'pending'
+        CHECK (payment_status IN ('pending', 'paid', 'refunded')),
+    delivery_method VARCHAR(16) NOT NULL CHECK (delivery_method IN ('pickup', 'courier', 'post')),
+    delivery_address TEXT NOT NULL DEFAULT '',
+    customer_name VARCHAR(255) NOT NULL,
+    customer_phone VARCHAR(32) NOT NULL,
+    customer_email VARCHAR(255) NOT NULL DEFAULT '',
+    comment TEXT NOT NULL DEFAULT '',
+    items_count INT NOT NULL CHECK (items_count > 0),
+    total_cents BIGINT NOT NULL CHECK (total_cents >= 0),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS order_lines (
+    order_id UUID NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    product_id UUID NOT NULL,
+    sku VARCHAR(100) NOT NULL DEFAULT '',
+    name VARCHAR(255) NOT NULL,
+    price_cents BIGINT NOT NULL CHECK (price_cents >= 0),
+    quantity INT NOT NULL CHECK (quantity > 0),
+    line_total_cents BIGINT NOT NULL CHECK (line_total_cents >= 0),
+    PRIMARY KEY (order_id, product_id)
+);
+
+CREATE TABLE IF NOT EXISTS order_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    order_id UUID NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    from_status VARCHAR(16) NOT NULL DEFAULT '',
+    to_status VARCHAR(16) NOT NULL,
+    changed_by VARCHAR(64) NOT NULL,
+    comment TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_orders_created_at_id ON orders (created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_orders_user_id_created_at ON orders (user_id, created_at DESC, id DESC);
+CREATE INDEX IF NOT EXISTS idx_orders_status ON orders (status);
+CREATE INDEX IF NOT EXISTS idx_order_status_history_order_id ON order_status_history (order_id, created_at);
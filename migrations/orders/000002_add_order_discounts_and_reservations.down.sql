@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_orders_open_reservations;
+DROP TABLE IF EXISTS order_discounts;
+
+ALTER TABLE orders
+    DROP COLUMN IF EXISTS stock_reserved,
+    DROP COLUMN IF EXISTS discount_cents,
+    DROP COLUMN IF EXISTS subtotal_cents;
@@ -0,0 +1,18 @@
+ALTER TABLE orders
+    ADD COLUMN IF NOT EXISTS subtotal_cents BIGINT NOT NULL DEFAULT 0 CHECK (subtotal_cents >= 0),
+    ADD COLUMN IF NOT EXISTS discount_cents BIGINT NOT NULL DEFAULT 0 CHECK (discount_cents >= 0),
+    ADD COLUMN IF NOT EXISTS stock_reserved BOOLEAN NOT NULL DEFAULT FALSE;
+
+UPDATE orders SET subtotal_cents = total_cents;
+
+CREATE TABLE IF NOT EXISTS order_discounts (
+    order_id UUID NOT NULL REFERENCES orders (id) ON DELETE CASCADE,
+    discount_id UUID NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    code VARCHAR(64) NOT NULL DEFAULT '',
+    amount_cents BIGINT NOT NULL CHECK (amount_cents >= 0),
+    PRIMARY KEY (order_id, discount_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_orders_open_reservations ON orders (created_at)
+    WHERE stock_reserved AND status IN ('new', 'confirmed');
@@ -0,0 +1,751 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.31.1
+// source: auth/v1/api_client_service.proto
+
+package authv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ApiClient struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes        []string               `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	ExpiresAt     string                 `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	LastUsedAt    string                 `protobuf:"bytes,5,opt,name=last_used_at,json=lastUsedAt,proto3" json:"last_used_at,omitempty"`
+	RevokedAt     string                 `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string                 `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApiClient) Reset() {
+	*x = ApiClient{}
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApiClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiClient) ProtoMessage() {}
+
+func (x *ApiClient) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiClient.ProtoReflect.Descriptor instead.
+func (*ApiClient) Descriptor() ([]byte, []int) {
+	return file_auth_v1_api_client_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApiClient) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiClient) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ApiClient) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *ApiClient) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *ApiClient) GetLastUsedAt() string {
+	if x != nil {
+		return x.LastUsedAt
+	}
+	return ""
+}
+
+func (x *ApiClient) GetRevokedAt() string {
+	if x != nil {
+		return x.RevokedAt
+	}
+	return ""
+}
+
+func (x *ApiClient) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ApiClient) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListApiClientsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiClientsRequest) Reset() {
+	*x = ListApiClientsRequest{}
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiClientsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiClientsRequest) ProtoMessage() {}
+
+func (x *ListApiClientsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiClientsRequest.ProtoReflect.Descriptor instead.
+func (*ListApiClientsRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_api_client_service_proto_rawDescGZIP(), []int{1}
+}
+
+type ListApiClientsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Clients       []*ApiClient           `protobuf:"bytes,1,rep,name=clients,proto3" json:"clients,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListApiClientsResponse) Reset() {
+	*x = ListApiClientsResponse{}
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListApiClientsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListApiClientsResponse) ProtoMessage() {}
+
+func (x *ListApiClientsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListApiClientsResponse.ProtoReflect.Descriptor instead.
+func (*ListApiClientsResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_api_client_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListApiClientsResponse) GetClients() []*ApiClient {
+	if x != nil {
+		return x.Clients
+	}
+	return nil
+}
+
+type CreateApiClientRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Permissions granted to the client's tokens.
+	Scopes []string `protobuf:"bytes,2,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// Lifetime of the client in seconds; 0 means it does not expire.
+	TtlSeconds    int64 `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiClientRequest) Reset() {
+	*x = CreateApiClientRequest{}
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiClientRequest) ProtoMessage() {}
+
+func (x *CreateApiClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiClientRequest.ProtoReflect.Descriptor instead.
+func (*CreateApiClientRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_api_client_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateApiClientRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateApiClientRequest) GetScopes() []string {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
+}
+
+func (x *CreateApiClientRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+type CreateApiClientResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Client *ApiClient             `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	// Shown only once; store it in the integration's secret storage.
+	ClientSecret  string `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateApiClientResponse) Reset() {
+	*x = CreateApiClientResponse{}
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateApiClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateApiClientResponse) ProtoMessage() {}
+
+func (x *CreateApiClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateApiClientResponse.ProtoReflect.Descriptor instead.
+func (*CreateApiClientResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_api_client_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateApiClientResponse) GetClient() *ApiClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *CreateApiClientResponse) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+type RotateApiClientSecretRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateApiClientSecretRequest) Reset() {
+	*x = RotateApiClientSecretRequest{}
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateApiClientSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateApiClientSecretRequest) ProtoMessage() {}
+
+func (x *RotateApiClientSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateApiClientSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateApiClientSecretRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_api_client_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RotateApiClientSecretRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RotateApiClientSecretResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Client        *ApiClient             `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	ClientSecret  string                 `protobuf:"bytes,2,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateApiClientSecretResponse) Reset() {
+	*x = RotateApiClientSecretResponse{}
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateApiClientSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateApiClientSecretResponse) ProtoMessage() {}
+
+func (x *RotateApiClientSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateApiClientSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotateApiClientSecretResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_api_client_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RotateApiClientSecretResponse) GetClient() *ApiClient {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+func (x *RotateApiClientSecretResponse) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+type RevokeApiClientRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiClientRequest) Reset() {
+	*x = RevokeApiClientRequest{}
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiClientRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiClientRequest) ProtoMessage() {}
+
+func (x *RevokeApiClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiClientRequest.ProtoReflect.Descriptor instead.
+func (*RevokeApiClientRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_api_client_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RevokeApiClientRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeApiClientResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeApiClientResponse) Reset() {
+	*x = RevokeApiClientResponse{}
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeApiClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeApiClientResponse) ProtoMessage() {}
+
+func (x *RevokeApiClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeApiClientResponse.ProtoReflect.Descriptor instead.
+func (*RevokeApiClientResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_api_client_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RevokeApiClientResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type IssueClientTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only "client_credentials" is supported.
+	GrantType     string `protobuf:"bytes,1,opt,name=grant_type,json=grantType,proto3" json:"grant_type,omitempty"`
+	ClientId      string `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	ClientSecret  string `protobuf:"bytes,3,opt,name=client_secret,json=clientSecret,proto3" json:"client_secret,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueClientTokenRequest) Reset() {
+	*x = IssueClientTokenRequest{}
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueClientTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueClientTokenRequest) ProtoMessage() {}
+
+func (x *IssueClientTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueClientTokenRequest.ProtoReflect.Descriptor instead.
+func (*IssueClientTokenRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_api_client_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IssueClientTokenRequest) GetGrantType() string {
+	if x != nil {
+		return x.GrantType
+	}
+	return ""
+}
+
+func (x *IssueClientTokenRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *IssueClientTokenRequest) GetClientSecret() string {
+	if x != nil {
+		return x.ClientSecret
+	}
+	return ""
+}
+
+type IssueClientTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken   string                 `protobuf:"bytes,1,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	TokenType     string                 `protobuf:"bytes,2,opt,name=token_type,json=tokenType,proto3" json:"token_type,omitempty"`
+	ExpiresIn     int64                  `protobuf:"varint,3,opt,name=expires_in,json=expiresIn,proto3" json:"expires_in,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IssueClientTokenResponse) Reset() {
+	*x = IssueClientTokenResponse{}
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueClientTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueClientTokenResponse) ProtoMessage() {}
+
+func (x *IssueClientTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_api_client_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueClientTokenResponse.ProtoReflect.Descriptor instead.
+func (*IssueClientTokenResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_api_client_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IssueClientTokenResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *IssueClientTokenResponse) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+func (x *IssueClientTokenResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
+var File_auth_v1_api_client_service_proto protoreflect.FileDescriptor
+
+const file_auth_v1_api_client_service_proto_rawDesc = "" +
+	"\n" +
+	" auth/v1/api_client_service.proto\x12\aauth.v1\x1a\x1cgoogle/api/annotations.proto\"\xe5\x01\n" +
+	"\tApiClient\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x03 \x03(\tR\x06scopes\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\x12 \n" +
+	"\flast_used_at\x18\x05 \x01(\tR\n" +
+	"lastUsedAt\x12\x1d\n" +
+	"\n" +
+	"revoked_at\x18\x06 \x01(\tR\trevokedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"\x17\n" +
+	"\x15ListApiClientsRequest\"F\n" +
+	"\x16ListApiClientsResponse\x12,\n" +
+	"\aclients\x18\x01 \x03(\v2\x12.auth.v1.ApiClientR\aclients\"e\n" +
+	"\x16CreateApiClientRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06scopes\x18\x02 \x03(\tR\x06scopes\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x03R\n" +
+	"ttlSeconds\"j\n" +
+	"\x17CreateApiClientResponse\x12*\n" +
+	"\x06client\x18\x01 \x01(\v2\x12.auth.v1.ApiClientR\x06client\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\".\n" +
+	"\x1cRotateApiClientSecretRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"p\n" +
+	"\x1dRotateApiClientSecretResponse\x12*\n" +
+	"\x06client\x18\x01 \x01(\v2\x12.auth.v1.ApiClientR\x06client\x12#\n" +
+	"\rclient_secret\x18\x02 \x01(\tR\fclientSecret\"(\n" +
+	"\x16RevokeApiClientRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"3\n" +
+	"\x17RevokeApiClientResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"z\n" +
+	"\x17IssueClientTokenRequest\x12\x1d\n" +
+	"\n" +
+	"grant_type\x18\x01 \x01(\tR\tgrantType\x12\x1b\n" +
+	"\tclient_id\x18\x02 \x01(\tR\bclientId\x12#\n" +
+	"\rclient_secret\x18\x03 \x01(\tR\fclientSecret\"{\n" +
+	"\x18IssueClientTokenResponse\x12!\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x1d\n" +
+	"\n" +
+	"token_type\x18\x02 \x01(\tR\ttokenType\x12\x1d\n" +
+	"\n" +
+	"expires_in\x18\x03 \x01(\x03R\texpiresIn2\xfa\x04\n" +
+	"\x10ApiClientService\x12j\n" +
+	"\x0eListApiClients\x12\x1e.auth.v1.ListApiClientsRequest\x1a\x1f.auth.v1.ListApiClientsResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/api-clients\x12p\n" +
+	"\x0fCreateApiClient\x12\x1f.auth.v1.CreateApiClientRequest\x1a .auth.v1.CreateApiClientResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/api-clients\x12\x95\x01\n" +
+	"\x15RotateApiClientSecret\x12%.auth.v1.RotateApiClientSecretRequest\x1a&.auth.v1.RotateApiClientSecretResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/api-clients/{id}/rotate-secret\x12|\n" +
+	"\x0fRevokeApiClient\x12\x1f.auth.v1.RevokeApiClientRequest\x1a .auth.v1.RevokeApiClientResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/api-clients/{id}/revoke\x12r\n" +
+	"\x10IssueClientToken\x12 .auth.v1.IssueClientTokenRequest\x1a!.auth.v1.IssueClientTokenResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/tokenBEZCgithub.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1;authv1b\x06proto3"
+
+var (
+	file_auth_v1_api_client_service_proto_rawDescOnce sync.Once
+	file_auth_v1_api_client_service_proto_rawDescData []byte
+)
+
+func file_auth_v1_api_client_service_proto_rawDescGZIP() []byte {
+	file_auth_v1_api_client_service_proto_rawDescOnce.Do(func() {
+		file_auth_v1_api_client_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auth_v1_api_client_service_proto_rawDesc), len(file_auth_v1_api_client_service_proto_rawDesc)))
+	})
+	return file_auth_v1_api_client_service_proto_rawDescData
+}
+
+var file_auth_v1_api_client_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_auth_v1_api_client_service_proto_goTypes = []any{
+	(*ApiClient)(nil),                     // 0: auth.v1.ApiClient
+	(*ListApiClientsRequest)(nil),         // 1: auth.v1.ListApiClientsRequest
+	(*ListApiClientsResponse)(nil),        // 2: auth.v1.ListApiClientsResponse
+	(*CreateApiClientRequest)(nil),        // 3: auth.v1.CreateApiClientRequest
+	(*CreateApiClientResponse)(nil),       // 4: auth.v1.CreateApiClientResponse
+	(*RotateApiClientSecretRequest)(nil),  // 5: auth.v1.RotateApiClientSecretRequest
+	(*RotateApiClientSecretResponse)(nil), // 6: auth.v1.RotateApiClientSecretResponse
+	(*RevokeApiClientRequest)(nil),        // 7: auth.v1.RevokeApiClientRequest
+	(*RevokeApiClientResponse)(nil),       // 8: auth.v1.RevokeApiClientResponse
+	(*IssueClientTokenRequest)(nil),       // 9: auth.v1.IssueClientTokenRequest
+	(*IssueClientTokenResponse)(nil),      // 10: auth.v1.IssueClientTokenResponse
+}
+var file_auth_v1_api_client_service_proto_depIdxs = []int32{
+	0,  // 0: auth.v1.ListApiClientsResponse.clients:type_name -> auth.v1.ApiClient
+	0,  // 1: auth.v1.CreateApiClientResponse.client:type_name -> auth.v1.ApiClient
+	0,  // 2: auth.v1.RotateApiClientSecretResponse.client:type_name -> auth.v1.ApiClient
+	1,  // 3: auth.v1.ApiClientService.ListApiClients:input_type -> auth.v1.ListApiClientsRequest
+	3,  // 4: auth.v1.ApiClientService.CreateApiClient:input_type -> auth.v1.CreateApiClientRequest
+	5,  // 5: auth.v1.ApiClientService.RotateApiClientSecret:input_type -> auth.v1.RotateApiClientSecretRequest
+	7,  // 6: auth.v1.ApiClientService.RevokeApiClient:input_type -> auth.v1.RevokeApiClientRequest
+	9,  // 7: auth.v1.ApiClientService.IssueClientToken:input_type -> auth.v1.IssueClientTokenRequest
+	2,  // 8: auth.v1.ApiClientService.ListApiClients:output_type -> auth.v1.ListApiClientsResponse
+	4,  // 9: auth.v1.ApiClientService.CreateApiClient:output_type -> auth.v1.CreateApiClientResponse
+	6,  // 10: auth.v1.ApiClientService.RotateApiClientSecret:output_type -> auth.v1.RotateApiClientSecretResponse
+	8,  // 11: auth.v1.ApiClientService.RevokeApiClient:output_type -> auth.v1.RevokeApiClientResponse
+	10, // 12: auth.v1.ApiClientService.IssueClientToken:output_type -> auth.v1.IssueClientTokenResponse
+	8,  // [8:13] is the sub-list for method output_type
+	3,  // [3:8] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_api_client_service_proto_init() }
+func file_auth_v1_api_client_service_proto_init() {
+	if File_auth_v1_api_client_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_api_client_service_proto_rawDesc), len(file_auth_v1_api_client_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   11,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_api_client_service_proto_goTypes,
+		DependencyIndexes: file_auth_v1_api_client_service_proto_depIdxs,
+		MessageInfos:      file_auth_v1_api_client_service_proto_msgTypes,
+	}.Build()
+	File_auth_v1_api_client_service_proto = out.File
+	file_auth_v1_api_client_service_proto_goTypes = nil
+	file_auth_v1_api_client_service_proto_depIdxs = nil
+}
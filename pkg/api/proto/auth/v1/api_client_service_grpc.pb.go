@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             v6.31.1
+// source: auth/v1/api_client_service.proto
+
+package authv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ApiClientService_ListApiClients_FullMethodName        = "/auth.v1.ApiClientService/ListApiClients"
+	ApiClientService_CreateApiClient_FullMethodName       = "/auth.v1.ApiClientService/CreateApiClient"
+	ApiClientService_RotateApiClientSecret_FullMethodName = "/auth.v1.ApiClientService/RotateApiClientSecret"
+	ApiClientService_RevokeApiClient_FullMethodName       = "/auth.v1.ApiClientService/RevokeApiClient"
+	ApiClientService_IssueClientToken_FullMethodName      = "/auth.v1.ApiClientService/IssueClientToken"
+)
+
+// ApiClientServiceClient is the client API for ApiClientService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ApiClientServiceClient interface {
+	ListApiClients(ctx context.Context, in *ListApiClientsRequest, opts ...grpc.CallOption) (*ListApiClientsResponse, error)
+	CreateApiClient(ctx context.Context, in *CreateApiClientRequest, opts ...grpc.CallOption) (*CreateApiClientResponse, error)
+	RotateApiClientSecret(ctx context.Context, in *RotateApiClientSecretRequest, opts ...grpc.CallOption) (*RotateApiClientSecretResponse, error)
+	// Disables the client and revokes the access tokens it holds.
+	RevokeApiClient(ctx context.Context, in *RevokeApiClientRequest, opts ...grpc.CallOption) (*RevokeApiClientResponse, error)
+	// Client credentials grant: exchanges a client id and secret for a
+	// short-lived access token accepted by every service.
+	IssueClientToken(ctx context.Context, in *IssueClientTokenRequest, opts ...grpc.CallOption) (*IssueClientTokenResponse, error)
+}
+
+type apiClientServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewApiClientServiceClient(cc grpc.ClientConnInterface) ApiClientServiceClient {
+	return &apiClientServiceClient{cc}
+}
+
+func (c *apiClientServiceClient) ListApiClients(ctx context.Context, in *ListApiClientsRequest, opts ...grpc.CallOption) (*ListApiClientsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListApiClientsResponse)
+	err := c.cc.Invoke(ctx, ApiClientService_ListApiClients_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClientServiceClient) CreateApiClient(ctx context.Context, in *CreateApiClientRequest, opts ...grpc.CallOption) (*CreateApiClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateApiClientResponse)
+	err := c.cc.Invoke(ctx, ApiClientService_CreateApiClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClientServiceClient) RotateApiClientSecret(ctx context.Context, in *RotateApiClientSecretRequest, opts ...grpc.CallOption) (*RotateApiClientSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateApiClientSecretResponse)
+	err := c.cc.Invoke(ctx, ApiClientService_RotateApiClientSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClientServiceClient) RevokeApiClient(ctx context.Context, in *RevokeApiClientRequest, opts ...grpc.CallOption) (*RevokeApiClientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeApiClientResponse)
+	err := c.cc.Invoke(ctx, ApiClientService_RevokeApiClient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClientServiceClient) IssueClientToken(ctx context.Context, in *IssueClientTokenRequest, opts ...grpc.CallOption) (*IssueClientTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IssueClientTokenResponse)
+	err := c.cc.Invoke(ctx, ApiClientService_IssueClientToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApiClientServiceServer is the server API for ApiClientService service.
+// All implementations must embed UnimplementedApiClientServiceServer
+// for forward compatibility.
+type ApiClientServiceServer interface {
+	ListApiClients(context.Context, *ListApiClientsRequest) (*ListApiClientsResponse, error)
+	CreateApiClient(context.Context, *CreateApiClientRequest) (*CreateApiClientResponse, error)
+	RotateApiClientSecret(context.Context, *RotateApiClientSecretRequest) (*RotateApiClientSecretResponse, error)
+	// Disables the client and revokes the access tokens it holds.
+	RevokeApiClient(context.Context, *RevokeApiClientRequest) (*RevokeApiClientResponse, error)
+	// Client credentials grant: exchanges a client id and secret for a
+	// short-lived access token accepted by every service.
+	IssueClientToken(context.Context, *IssueClientTokenRequest) (*IssueClientTokenResponse, error)
+	mustEmbedUnimplementedApiClientServiceServer()
+}
+
+// UnimplementedApiClientServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedApiClientServiceServer struct{}
+
+func (UnimplementedApiClientServiceServer) ListApiClients(context.Context, *ListApiClientsRequest) (*ListApiClientsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListApiClients not implemented")
+}
+func (UnimplementedApiClientServiceServer) CreateApiClient(context.Context, *CreateApiClientRequest) (*CreateApiClientResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateApiClient not implemented")
+}
+func (UnimplementedApiClientServiceServer) RotateApiClientSecret(context.Context, *RotateApiClientSecretRequest) (*RotateApiClientSecretResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RotateApiClientSecret not implemented")
+}
+func (UnimplementedApiClientServiceServer) RevokeApiClient(context.Context, *RevokeApiClientRequest) (*RevokeApiClientResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeApiClient not implemented")
+}
+func (UnimplementedApiClientServiceServer) IssueClientToken(context.Context, *IssueClientTokenRequest) (*IssueClientTokenResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method IssueClientToken not implemented")
+}
+func (UnimplementedApiClientServiceServer) mustEmbedUnimplementedApiClientServiceServer() {}
+func (UnimplementedApiClientServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeApiClientServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ApiClientServiceServer will
+// result in compilation errors.
+type UnsafeApiClientServiceServer interface {
+	mustEmbedUnimplementedApiClientServiceServer()
+}
+
+func RegisterApiClientServiceServer(s grpc.ServiceRegistrar, srv ApiClientServiceServer) {
+	// If the following call panics, it indicates UnimplementedApiClientServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ApiClientService_ServiceDesc, srv)
+}
+
+func _ApiClientService_ListApiClients_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiClientsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiClientServiceServer).ListApiClients(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiClientService_ListApiClients_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiClientServiceServer).ListApiClients(ctx, req.(*ListApiClientsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiClientService_CreateApiClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiClientServiceServer).CreateApiClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiClientService_CreateApiClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiClientServiceServer).CreateApiClient(ctx, req.(*CreateApiClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiClientService_RotateApiClientSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateApiClientSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiClientServiceServer).RotateApiClientSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiClientService_RotateApiClientSecret_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiClientServiceServer).RotateApiClientSecret(ctx, req.(*RotateApiClientSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiClientService_RevokeApiClient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiClientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiClientServiceServer).RevokeApiClient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiClientService_RevokeApiClient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiClientServiceServer).RevokeApiClient(ctx, req.(*RevokeApiClientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiClientService_IssueClientToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueClientTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiClientServiceServer).IssueClientToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiClientService_IssueClientToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiClientServiceServer).IssueClientToken(ctx, req.(*IssueClientTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ApiClientService_ServiceDesc is the grpc.ServiceDesc for ApiClientService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ApiClientService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "auth.v1.ApiClientService",
+	HandlerType: (*ApiClientServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListApiClients",
+			Handler:    _ApiClientService_ListApiClients_Handler,
+		},
+		{
+			MethodName: "CreateApiClient",
+			Handler:    _ApiClientService_CreateApiClient_Handler,
+		},
+		{
+			MethodName: "RotateApiClientSecret",
+			Handler:    _ApiClientService_RotateApiClientSecret_Handler,
+		},
+		{
+			MethodName: "RevokeApiClient",
+			Handler:    _ApiClientService_RevokeApiClient_Handler,
+		},
+		{
+			MethodName: "IssueClientToken",
+			Handler:    _ApiClientService_IssueClientToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth/v1/api_client_service.proto",
+}
@@ -0,0 +1,1167 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.31.1
+// source: auth/v1/profile_service.proto
+
+package authv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Profile struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	FirstName string                 `protobuf:"bytes,2,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string                 `protobuf:"bytes,3,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	// International format, e.g. +79001234567.
+	Phone string `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	// Contact address for orders; defaults to the account email.
+	Email         string `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Profile) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Profile) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *Profile) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *Profile) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Profile) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Profile) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type Address struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Free-form name such as "Home" or "Office".
+	Label          string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	RecipientName  string `protobuf:"bytes,3,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"`
+	RecipientPhone string `protobuf:"bytes,4,opt,name=recipient_phone,json=recipientPhone,proto3" json:"recipient_phone,omitempty"`
+	// ISO 3166-1 alpha-2 code; defaults to RU.
+	Country       string `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	Region        string `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	City          string `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`
+	Street        string `protobuf:"bytes,8,opt,name=street,proto3" json:"street,omitempty"`
+	House         string `protobuf:"bytes,9,opt,name=house,proto3" json:"house,omitempty"`
+	Apartment     string `protobuf:"bytes,10,opt,name=apartment,proto3" json:"apartment,omitempty"`
+	PostalCode    string `protobuf:"bytes,11,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	Comment       string `protobuf:"bytes,12,opt,name=comment,proto3" json:"comment,omitempty"`
+	IsDefault     bool   `protobuf:"varint,13,opt,name=is_default,json=isDefault,proto3" json:"is_default,omitempty"`
+	CreatedAt     string `protobuf:"bytes,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     string `protobuf:"bytes,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Address) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Address) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Address) GetRecipientName() string {
+	if x != nil {
+		return x.RecipientName
+	}
+	return ""
+}
+
+func (x *Address) GetRecipientPhone() string {
+	if x != nil {
+		return x.RecipientPhone
+	}
+	return ""
+}
+
+func (x *Address) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *Address) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *Address) GetHouse() string {
+	if x != nil {
+		return x.House
+	}
+	return ""
+}
+
+func (x *Address) GetApartment() string {
+	if x != nil {
+		return x.Apartment
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *Address) GetIsDefault() bool {
+	if x != nil {
+		return x.IsDefault
+	}
+	return false
+}
+
+func (x *Address) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Address) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type GetMyProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyProfileRequest) Reset() {
+	*x = GetMyProfileRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyProfileRequest) ProtoMessage() {}
+
+func (x *GetMyProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetMyProfileRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{2}
+}
+
+type GetMyProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyProfileResponse) Reset() {
+	*x = GetMyProfileResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyProfileResponse) ProtoMessage() {}
+
+func (x *GetMyProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetMyProfileResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetMyProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateMyProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FirstName     string                 `protobuf:"bytes,1,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName      string                 `protobuf:"bytes,2,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMyProfileRequest) Reset() {
+	*x = UpdateMyProfileRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMyProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMyProfileRequest) ProtoMessage() {}
+
+func (x *UpdateMyProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMyProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMyProfileRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UpdateMyProfileRequest) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *UpdateMyProfileRequest) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *UpdateMyProfileRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateMyProfileRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type UpdateMyProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMyProfileResponse) Reset() {
+	*x = UpdateMyProfileResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMyProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMyProfileResponse) ProtoMessage() {}
+
+func (x *UpdateMyProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMyProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMyProfileResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UpdateMyProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type ListMyAddressesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyAddressesRequest) Reset() {
+	*x = ListMyAddressesRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyAddressesRequest) ProtoMessage() {}
+
+func (x *ListMyAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyAddressesRequest.ProtoReflect.Descriptor instead.
+func (*ListMyAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{6}
+}
+
+type ListMyAddressesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Addresses     []*Address             `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMyAddressesResponse) Reset() {
+	*x = ListMyAddressesResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMyAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMyAddressesResponse) ProtoMessage() {}
+
+func (x *ListMyAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMyAddressesResponse.ProtoReflect.Descriptor instead.
+func (*ListMyAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListMyAddressesResponse) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type CreateMyAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMyAddressRequest) Reset() {
+	*x = CreateMyAddressRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMyAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMyAddressRequest) ProtoMessage() {}
+
+func (x *CreateMyAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMyAddressRequest.ProtoReflect.Descriptor instead.
+func (*CreateMyAddressRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateMyAddressRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type CreateMyAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateMyAddressResponse) Reset() {
+	*x = CreateMyAddressResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateMyAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateMyAddressResponse) ProtoMessage() {}
+
+func (x *CreateMyAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateMyAddressResponse.ProtoReflect.Descriptor instead.
+func (*CreateMyAddressResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateMyAddressResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type UpdateMyAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address       *Address               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMyAddressRequest) Reset() {
+	*x = UpdateMyAddressRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMyAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMyAddressRequest) ProtoMessage() {}
+
+func (x *UpdateMyAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMyAddressRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMyAddressRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateMyAddressRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateMyAddressRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type UpdateMyAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMyAddressResponse) Reset() {
+	*x = UpdateMyAddressResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMyAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMyAddressResponse) ProtoMessage() {}
+
+func (x *UpdateMyAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMyAddressResponse.ProtoReflect.Descriptor instead.
+func (*UpdateMyAddressResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *UpdateMyAddressResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type DeleteMyAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyAddressRequest) Reset() {
+	*x = DeleteMyAddressRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyAddressRequest) ProtoMessage() {}
+
+func (x *DeleteMyAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyAddressRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMyAddressRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteMyAddressRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteMyAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteMyAddressResponse) Reset() {
+	*x = DeleteMyAddressResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteMyAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteMyAddressResponse) ProtoMessage() {}
+
+func (x *DeleteMyAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteMyAddressResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMyAddressResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteMyAddressResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SetDefaultAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultAddressRequest) Reset() {
+	*x = SetDefaultAddressRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultAddressRequest) ProtoMessage() {}
+
+func (x *SetDefaultAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultAddressRequest.ProtoReflect.Descriptor instead.
+func (*SetDefaultAddressRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetDefaultAddressRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SetDefaultAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Address       *Address               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetDefaultAddressResponse) Reset() {
+	*x = SetDefaultAddressResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetDefaultAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetDefaultAddressResponse) ProtoMessage() {}
+
+func (x *SetDefaultAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetDefaultAddressResponse.ProtoReflect.Descriptor instead.
+func (*SetDefaultAddressResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *SetDefaultAddressResponse) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type GetUserProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileRequest) Reset() {
+	*x = GetUserProfileRequest{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileRequest) ProtoMessage() {}
+
+func (x *GetUserProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetUserProfileRequest) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUserProfileRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *Profile               `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Addresses     []*Address             `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserProfileResponse) Reset() {
+	*x = GetUserProfileResponse{}
+	mi := &file_auth_v1_profile_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileResponse) ProtoMessage() {}
+
+func (x *GetUserProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_v1_profile_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetUserProfileResponse) Descriptor() ([]byte, []int) {
+	return file_auth_v1_profile_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetUserProfileResponse) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *GetUserProfileResponse) GetAddresses() []*Address {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+var File_auth_v1_profile_service_proto protoreflect.FileDescriptor
+
+const file_auth_v1_profile_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dauth/v1/profile_service.proto\x12\aauth.v1\x1a\x1cgoogle/api/annotations.proto\"\xa9\x01\n" +
+	"\aProfile\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\x12\x14\n" +
+	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\tR\tupdatedAt\"\xa9\x03\n" +
+	"\aAddress\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
+	"\x05label\x18\x02 \x01(\tR\x05label\x12%\n" +
+	"\x0erecipient_name\x18\x03 \x01(\tR\rrecipientName\x12'\n" +
+	"\x0frecipient_phone\x18\x04 \x01(\tR\x0erecipientPhone\x12\x18\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\x12\x16\n" +
+	"\x06region\x18\x06 \x01(\tR\x06region\x12\x12\n" +
+	"\x04city\x18\a \x01(\tR\x04city\x12\x16\n" +
+	"\x06street\x18\b \x01(\tR\x06street\x12\x14\n" +
+	"\x05house\x18\t \x01(\tR\x05house\x12\x1c\n" +
+	"\tapartment\x18\n" +
+	" \x01(\tR\tapartment\x12\x1f\n" +
+	"\vpostal_code\x18\v \x01(\tR\n" +
+	"postalCode\x12\x18\n" +
+	"\acomment\x18\f \x01(\tR\acomment\x12\x1d\n" +
+	"\n" +
+	"is_default\x18\r \x01(\bR\tisDefault\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x0e \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x0f \x01(\tR\tupdatedAt\"\x15\n" +
+	"\x13GetMyProfileRequest\"B\n" +
+	"\x14GetMyProfileResponse\x12*\n" +
+	"\aprofile\x18\x01 \x01(\v2\x10.auth.v1.ProfileR\aprofile\"\x80\x01\n" +
+	"\x16UpdateMyProfileRequest\x12\x1d\n" +
+	"\n" +
+	"first_name\x18\x01 \x01(\tR\tfirstName\x12\x1b\n" +
+	"\tlast_name\x18\x02 \x01(\tR\blastName\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\"E\n" +
+	"\x17UpdateMyProfileResponse\x12*\n" +
+	"\aprofile\x18\x01 \x01(\v2\x10.auth.v1.ProfileR\aprofile\"\x18\n" +
+	"\x16ListMyAddressesRequest\"I\n" +
+	"\x17ListMyAddressesResponse\x12.\n" +
+	"\taddresses\x18\x01 \x03(\v2\x10.auth.v1.AddressR\taddresses\"D\n" +
+	"\x16CreateMyAddressRequest\x12*\n" +
+	"\aaddress\x18\x01 \x01(\v2\x10.auth.v1.AddressR\aaddress\"E\n" +
+	"\x17CreateMyAddressResponse\x12*\n" +
+	"\aaddress\x18\x01 \x01(\v2\x10.auth.v1.AddressR\aaddress\"T\n" +
+	"\x16UpdateMyAddressRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12*\n" +
+	"\aaddress\x18\x02 \x01(\v2\x10.auth.v1.AddressR\aaddress\"E\n" +
+	"\x17UpdateMyAddressResponse\x12*\n" +
+	"\aaddress\x18\x01 \x01(\v2\x10.auth.v1.AddressR\aaddress\"(\n" +
+	"\x16DeleteMyAddressRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"3\n" +
+	"\x17DeleteMyAddressResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"*\n" +
+	"\x18SetDefaultAddressRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"G\n" +
+	"\x19SetDefaultAddressResponse\x12*\n" +
+	"\aaddress\x18\x01 \x01(\v2\x10.auth.v1.AddressR\aaddress\"0\n" +
+	"\x15GetUserProfileRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"t\n" +
+	"\x16GetUserProfileResponse\x12*\n" +
+	"\aprofile\x18\x01 \x01(\v2\x10.auth.v1.ProfileR\aprofile\x12.\n" +
+	"\taddresses\x18\x02 \x03(\v2\x10.auth.v1.AddressR\taddresses2\xd5\a\n" +
+	"\x0eProfileService\x12`\n" +
+	"\fGetMyProfile\x12\x1c.auth.v1.GetMyProfileRequest\x1a\x1d.auth.v1.GetMyProfileResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/profile\x12l\n" +
+	"\x0fUpdateMyProfile\x12\x1f.auth.v1.UpdateMyProfileRequest\x1a .auth.v1.UpdateMyProfileResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\x1a\v/v1/profile\x12s\n" +
+	"\x0fListMyAddresses\x12\x1f.auth.v1.ListMyAddressesRequest\x1a .auth.v1.ListMyAddressesResponse\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/profile/addresses\x12|\n" +
+	"\x0fCreateMyAddress\x12\x1f.auth.v1.CreateMyAddressRequest\x1a .auth.v1.CreateMyAddressResponse\"&\x82\xd3\xe4\x93\x02 :\aaddress\"\x15/v1/profile/addresses\x12\x81\x01\n" +
+	"\x0fUpdateMyAddress\x12\x1f.auth.v1.UpdateMyAddressRequest\x1a .auth.v1.UpdateMyAddressResponse\"+\x82\xd3\xe4\x93\x02%:\aaddress\x1a\x1a/v1/profile/addresses/{id}\x12x\n" +
+	"\x0fDeleteMyAddress\x12\x1f.auth.v1.DeleteMyAddressRequest\x1a .auth.v1.DeleteMyAddressResponse\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/v1/profile/addresses/{id}\x12\x89\x01\n" +
+	"\x11SetDefaultAddress\x12!.auth.v1.SetDefaultAddressRequest\x1a\".auth.v1.SetDefaultAddressResponse\"-\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/profile/addresses/{id}/default\x12v\n" +
+	"\x0eGetUserProfile\x12\x1e.auth.v1.GetUserProfileRequest\x1a\x1f.auth.v1.GetUserProfileResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/users/{user_id}/profileBEZCgithub.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1;authv1b\x06proto3"
+
+var (
+	file_auth_v1_profile_service_proto_rawDescOnce sync.Once
+	file_auth_v1_profile_service_proto_rawDescData []byte
+)
+
+func file_auth_v1_profile_service_proto_rawDescGZIP() []byte {
+	file_auth_v1_profile_service_proto_rawDescOnce.Do(func() {
+		file_auth_v1_profile_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_auth_v1_profile_service_proto_rawDesc), len(file_auth_v1_profile_service_proto_rawDesc)))
+	})
+	return file_auth_v1_profile_service_proto_rawDescData
+}
+
+var file_auth_v1_profile_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_auth_v1_profile_service_proto_goTypes = []any{
+	(*Profile)(nil),                   // 0: auth.v1.Profile
+	(*Address)(nil),                   // 1: auth.v1.Address
+	(*GetMyProfileRequest)(nil),       // 2: auth.v1.GetMyProfileRequest
+	(*GetMyProfileResponse)(nil),      // 3: auth.v1.GetMyProfileResponse
+	(*UpdateMyProfileRequest)(nil),    // 4: auth.v1.UpdateMyProfileRequest
+	(*UpdateMyProfileResponse)(nil),   // 5: auth.v1.UpdateMyProfileResponse
+	(*ListMyAddressesRequest)(nil),    // 6: auth.v1.ListMyAddressesRequest
+	(*ListMyAddressesResponse)(nil),   // 7: auth.v1.ListMyAddressesResponse
+	(*CreateMyAddressRequest)(nil),    // 8: auth.v1.CreateMyAddressRequest
+	(*CreateMyAddressResponse)(nil),   // 9: auth.v1.CreateMyAddressResponse
+	(*UpdateMyAddressRequest)(nil),    // 10: auth.v1.UpdateMyAddressRequest
+	(*UpdateMyAddressResponse)(nil),   // 11: auth.v1.UpdateMyAddressResponse
+	(*DeleteMyAddressRequest)(nil),    // 12: auth.v1.DeleteMyAddressRequest
+	(*DeleteMyAddressResponse)(nil),   // 13: auth.v1.DeleteMyAddressResponse
+	(*SetDefaultAddressRequest)(nil),  // 14: auth.v1.SetDefaultAddressRequest
+	(*SetDefaultAddressResponse)(nil), // 15: auth.v1.SetDefaultAddressResponse
+	(*GetUserProfileRequest)(nil),     // 16: auth.v1.GetUserProfileRequest
+	(*GetUserProfileResponse)(nil),    // 17: auth.v1.GetUserProfileResponse
+}
+var file_auth_v1_profile_service_proto_depIdxs = []int32{
+	0,  // 0: auth.v1.GetMyProfileResponse.profile:type_name -> auth.v1.Profile
+	0,  // 1: auth.v1.UpdateMyProfileResponse.profile:type_name -> auth.v1.Profile
+	1,  // 2: auth.v1.ListMyAddressesResponse.addresses:type_name -> auth.v1.Address
+	1,  // 3: auth.v1.CreateMyAddressRequest.address:type_name -> auth.v1.Address
+	1,  // 4: auth.v1.CreateMyAddressResponse.address:type_name -> auth.v1.Address
+	1,  // 5: auth.v1.UpdateMyAddressRequest.address:type_name -> auth.v1.Address
+	1,  // 6: auth.v1.UpdateMyAddressResponse.address:type_name -> auth.v1.Address
+	1,  // 7: auth.v1.SetDefaultAddressResponse.address:type_name -> auth.v1.Address
+	0,  // 8: auth.v1.GetUserProfileResponse.profile:type_name -> auth.v1.Profile
+	1,  // 9: auth.v1.GetUserProfileResponse.addresses:type_name -> auth.v1.Address
+	2,  // 10: auth.v1.ProfileService.GetMyProfile:input_type -> auth.v1.GetMyProfileRequest
+	4,  // 11: auth.v1.ProfileService.UpdateMyProfile:input_type -> auth.v1.UpdateMyProfileRequest
+	6,  // 12: auth.v1.ProfileService.ListMyAddresses:input_type -> auth.v1.ListMyAddressesRequest
+	8,  // 13: auth.v1.ProfileService.CreateMyAddress:input_type -> auth.v1.CreateMyAddressRequest
+	10, // 14: auth.v1.ProfileService.UpdateMyAddress:input_type -> auth.v1.UpdateMyAddressRequest
+	12, // 15: auth.v1.ProfileService.DeleteMyAddress:input_type -> auth.v1.DeleteMyAddressRequest
+	14, // 16: auth.v1.ProfileService.SetDefaultAddress:input_type -> auth.v1.SetDefaultAddressRequest
+	16, // 17: auth.v1.ProfileService.GetUserProfile:input_type -> auth.v1.GetUserProfileRequest
+	3,  // 18: auth.v1.ProfileService.GetMyProfile:output_type -> auth.v1.GetMyProfileResponse
+	5,  // 19: auth.v1.ProfileService.UpdateMyProfile:output_type -> auth.v1.UpdateMyProfileResponse
+	7,  // 20: auth.v1.ProfileService.ListMyAddresses:output_type -> auth.v1.ListMyAddressesResponse
+	9,  // 21: auth.v1.ProfileService.CreateMyAddress:output_type -> auth.v1.CreateMyAddressResponse
+	11, // 22: auth.v1.ProfileService.UpdateMyAddress:output_type -> auth.v1.UpdateMyAddressResponse
+	13, // 23: auth.v1.ProfileService.DeleteMyAddress:output_type -> auth.v1.DeleteMyAddressResponse
+	15, // 24: auth.v1.ProfileService.SetDefaultAddress:output_type -> auth.v1.SetDefaultAddressResponse
+	17, // 25: auth.v1.ProfileService.GetUserProfile:output_type -> auth.v1.GetUserProfileResponse
+	18, // [18:26] is the sub-list for method output_type
+	10, // [10:18] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_auth_v1_profile_service_proto_init() }
+func file_auth_v1_profile_service_proto_init() {
+	if File_auth_v1_profile_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_v1_profile_service_proto_rawDesc), len(file_auth_v1_profile_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_auth_v1_profile_service_proto_goTypes,
+		DependencyIndexes: file_auth_v1_profile_service_proto_depIdxs,
+		MessageInfos:      file_auth_v1_profile_service_proto_msgTypes,
+	}.Build()
+	File_auth_v1_profile_service_proto = out.File
+	file_auth_v1_profile_service_proto_goTypes = nil
+	file_auth_v1_profile_service_proto_depIdxs = nil
+}
@@ -771,21 +771,27 @@ func (x *ProductAttribute) GetUpdatedAt() string {
 }
 
 type Product struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	SupplierId    int64                  `protobuf:"varint,3,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	PriceCents    int64                  `protobuf:"varint,6,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
-	Sku           string                 `protobuf:"bytes,7,opt,name=sku,proto3" json:"sku,omitempty"`
-	Stock         int32                  `protobuf:"varint,8,opt,name=stock,proto3" json:"stock,omitempty"`
-	IsActive      bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Images        []*ProductImage        `protobuf:"bytes,12,rep,name=images,proto3" json:"images,omitempty"`
-	BrandId       string                 `protobuf:"bytes,13,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
-	Attributes    []*ProductAttribute    `protobuf:"bytes,14,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId  string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	SupplierId  int64                  `protobuf:"varint,3,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	Name        string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	PriceCents  int64                  `protobuf:"varint,6,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	Sku         string                 `protobuf:"bytes,7,opt,name=sku,proto3" json:"sku,omitempty"`
+	Stock       int32                  `protobuf:"varint,8,opt,name=stock,proto3" json:"stock,omitempty"`
+	IsActive    bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt   string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt   string                 `protobuf:"bytes,11,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Images      []*ProductImage        `protobuf:"bytes,12,rep,name=images,proto3" json:"images,omitempty"`
+	BrandId     string                 `protobuf:"bytes,13,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	Attributes  []*ProductAttribute    `protobuf:"bytes,14,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	Relations   []*RelatedProduct      `protobuf:"bytes,15,rep,name=relations,proto3" json:"relations,omitempty"`
+	// simple or bundle. Bundle stock is derived from its components.
+	Kind string `protobuf:"bytes,16,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Aggregated over approved reviews only.
+	RatingAvg     float64 `protobuf:"fixed64,17,opt,name=rating_avg,json=ratingAvg,proto3" json:"rating_avg,omitempty"`
+	RatingCount   int32   `protobuf:"varint,18,opt,name=rating_count,json=ratingCount,proto3" json:"rating_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -918,32 +924,67 @@ func (x *Product) GetAttributes() []*ProductAttribute {
 	return nil
 }
 
-type ListProductsRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId      string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	Page            int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize        int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	IncludeInactive bool                   `protobuf:"varint,4,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
-	SupplierId      int64                  `protobuf:"varint,5,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
-	BrandId         string                 `protobuf:"bytes,6,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *Product) GetRelations() []*RelatedProduct {
+	if x != nil {
+		return x.Relations
+	}
+	return nil
 }
 
-func (x *ListProductsRequest) Reset() {
-	*x = ListProductsRequest{}
+func (x *Product) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Product) GetRatingAvg() float64 {
+	if x != nil {
+		return x.RatingAvg
+	}
+	return 0
+}
+
+func (x *Product) GetRatingCount() int32 {
+	if x != nil {
+		return x.RatingCount
+	}
+	return 0
+}
+
+type Review struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Rating    int32                  `protobuf:"varint,4,opt,name=rating,proto3" json:"rating,omitempty"`
+	Pros      string                 `protobuf:"bytes,5,opt,name=pros,proto3" json:"pros,omitempty"`
+	Cons      string                 `protobuf:"bytes,6,opt,name=cons,proto3" json:"cons,omitempty"`
+	Text      string                 `protobuf:"bytes,7,opt,name=text,proto3" json:"text,omitempty"`
+	// One of: pending, approved, rejected.
+	Status         string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	ModerationNote string `protobuf:"bytes,9,opt,name=moderation_note,json=moderationNote,proto3" json:"moderation_note,omitempty"`
+	ModeratedAt    string `protobuf:"bytes,10,opt,name=moderated_at,json=moderatedAt,proto3" json:"moderated_at,omitempty"`
+	CreatedAt      string `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
 	mi := &file_catalog_v1_catalog_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductsRequest) String() string {
+func (x *Review) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductsRequest) ProtoMessage() {}
+func (*Review) ProtoMessage() {}
 
-func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+func (x *Review) ProtoReflect() protoreflect.Message {
 	mi := &file_catalog_v1_catalog_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -955,143 +996,125 @@ func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
-func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
 	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ListProductsRequest) GetCategoryId() string {
+func (x *Review) GetId() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *ListProductsRequest) GetPage() int32 {
+func (x *Review) GetProductId() string {
 	if x != nil {
-		return x.Page
+		return x.ProductId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListProductsRequest) GetPageSize() int32 {
+func (x *Review) GetUserId() string {
 	if x != nil {
-		return x.PageSize
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListProductsRequest) GetIncludeInactive() bool {
+func (x *Review) GetRating() int32 {
 	if x != nil {
-		return x.IncludeInactive
+		return x.Rating
 	}
-	return false
+	return 0
 }
 
-func (x *ListProductsRequest) GetSupplierId() int64 {
+func (x *Review) GetPros() string {
 	if x != nil {
-		return x.SupplierId
+		return x.Pros
 	}
-	return 0
+	return ""
 }
 
-func (x *ListProductsRequest) GetBrandId() string {
+func (x *Review) GetCons() string {
 	if x != nil {
-		return x.BrandId
+		return x.Cons
 	}
 	return ""
 }
 
-type ListProductsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListProductsResponse) Reset() {
-	*x = ListProductsResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[15]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListProductsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*ListProductsResponse) ProtoMessage() {}
-
-func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[15]
+func (x *Review) GetText() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Text
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
-func (*ListProductsResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{15}
+func (x *Review) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-func (x *ListProductsResponse) GetProducts() []*Product {
+func (x *Review) GetModerationNote() string {
 	if x != nil {
-		return x.Products
+		return x.ModerationNote
 	}
-	return nil
+	return ""
 }
 
-func (x *ListProductsResponse) GetTotal() int32 {
+func (x *Review) GetModeratedAt() string {
 	if x != nil {
-		return x.Total
+		return x.ModeratedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *ListProductsResponse) GetPage() int32 {
+func (x *Review) GetCreatedAt() string {
 	if x != nil {
-		return x.Page
+		return x.CreatedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *ListProductsResponse) GetPageSize() int32 {
+func (x *Review) GetUpdatedAt() string {
 	if x != nil {
-		return x.PageSize
+		return x.UpdatedAt
 	}
-	return 0
+	return ""
 }
 
-type GetProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type Answer struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	QuestionId     string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Text           string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	Status         string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ModerationNote string                 `protobuf:"bytes,6,opt,name=moderation_note,json=moderationNote,proto3" json:"moderation_note,omitempty"`
+	ModeratedAt    string                 `protobuf:"bytes,7,opt,name=moderated_at,json=moderatedAt,proto3" json:"moderated_at,omitempty"`
+	CreatedAt      string                 `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string                 `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetProductRequest) Reset() {
-	*x = GetProductRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[16]
+func (x *Answer) Reset() {
+	*x = Answer{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductRequest) String() string {
+func (x *Answer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductRequest) ProtoMessage() {}
+func (*Answer) ProtoMessage() {}
 
-func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[16]
+func (x *Answer) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1102,92 +1125,106 @@ func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
-func (*GetProductRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use Answer.ProtoReflect.Descriptor instead.
+func (*Answer) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetProductRequest) GetId() string {
+func (x *Answer) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type GetProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Answer) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
 }
 
-func (x *GetProductResponse) Reset() {
-	*x = GetProductResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[17]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Answer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (x *GetProductResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Answer) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
 }
 
-func (*GetProductResponse) ProtoMessage() {}
+func (x *Answer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
 
-func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[17]
+func (x *Answer) GetModerationNote() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ModerationNote
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
-func (*GetProductResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{17}
+func (x *Answer) GetModeratedAt() string {
+	if x != nil {
+		return x.ModeratedAt
+	}
+	return ""
 }
 
-func (x *GetProductResponse) GetProduct() *Product {
+func (x *Answer) GetCreatedAt() string {
 	if x != nil {
-		return x.Product
+		return x.CreatedAt
 	}
-	return nil
+	return ""
 }
 
-type CreateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	SupplierId    int64                  `protobuf:"varint,2,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	PriceCents    int64                  `protobuf:"varint,5,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
-	Sku           string                 `protobuf:"bytes,6,opt,name=sku,proto3" json:"sku,omitempty"`
-	Stock         int32                  `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`
-	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	BrandId       string                 `protobuf:"bytes,9,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Answer) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
 }
 
-func (x *CreateProductRequest) Reset() {
-	*x = CreateProductRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[18]
+type Question struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId    string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Text      string                 `protobuf:"bytes,4,opt,name=text,proto3" json:"text,omitempty"`
+	// One of: pending, approved, rejected.
+	Status         string    `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	ModerationNote string    `protobuf:"bytes,6,opt,name=moderation_note,json=moderationNote,proto3" json:"moderation_note,omitempty"`
+	ModeratedAt    string    `protobuf:"bytes,7,opt,name=moderated_at,json=moderatedAt,proto3" json:"moderated_at,omitempty"`
+	CreatedAt      string    `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string    `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Answers        []*Answer `protobuf:"bytes,10,rep,name=answers,proto3" json:"answers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Question) Reset() {
+	*x = Question{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductRequest) String() string {
+func (x *Question) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductRequest) ProtoMessage() {}
+func (*Question) ProtoMessage() {}
 
-func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[18]
+func (x *Question) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1198,96 +1235,4019 @@ func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
-func (*CreateProductRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use Question.ProtoReflect.Descriptor instead.
+func (*Question) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateProductRequest) GetCategoryId() string {
+func (x *Question) GetId() string {
 	if x != nil {
-		return x.CategoryId
+		return x.Id
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetSupplierId() int64 {
+func (x *Question) GetProductId() string {
 	if x != nil {
-		return x.SupplierId
+		return x.ProductId
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateProductRequest) GetName() string {
+func (x *Question) GetUserId() string {
 	if x != nil {
-		return x.Name
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetDescription() string {
+func (x *Question) GetText() string {
 	if x != nil {
-		return x.Description
+		return x.Text
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetPriceCents() int64 {
+func (x *Question) GetStatus() string {
 	if x != nil {
-		return x.PriceCents
+		return x.Status
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateProductRequest) GetSku() string {
+func (x *Question) GetModerationNote() string {
 	if x != nil {
-		return x.Sku
+		return x.ModerationNote
 	}
 	return ""
 }
 
-func (x *CreateProductRequest) GetStock() int32 {
+func (x *Question) GetModeratedAt() string {
 	if x != nil {
-		return x.Stock
+		return x.ModeratedAt
 	}
-	return 0
+	return ""
 }
 
-func (x *CreateProductRequest) GetIsActive() bool {
+func (x *Question) GetCreatedAt() string {
 	if x != nil {
-		return x.IsActive
+		return x.CreatedAt
 	}
-	return false
+	return ""
 }
 
-func (x *CreateProductRequest) GetBrandId() string {
+func (x *Question) GetUpdatedAt() string {
 	if x != nil {
-		return x.BrandId
+		return x.UpdatedAt
 	}
 	return ""
 }
 
-type CreateProductResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
+func (x *Question) GetAnswers() []*Answer {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type ListProductQuestionsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Page      int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize  int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Admin only; public callers get approved questions with approved answers.
+	Status        string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductQuestionsRequest) Reset() {
+	*x = ListProductQuestionsRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductQuestionsRequest) ProtoMessage() {}
+
+func (x *ListProductQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListProductQuestionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListProductQuestionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductQuestionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductQuestionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProductQuestionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListProductQuestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Questions     []*Question            `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductQuestionsResponse) Reset() {
+	*x = ListProductQuestionsResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductQuestionsResponse) ProtoMessage() {}
+
+func (x *ListProductQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListProductQuestionsResponse) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *ListProductQuestionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListProductQuestionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductQuestionsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductQuestionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ListQuestionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuestionsRequest) Reset() {
+	*x = ListQuestionsRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuestionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuestionsRequest) ProtoMessage() {}
+
+func (x *ListQuestionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuestionsRequest.ProtoReflect.Descriptor instead.
+func (*ListQuestionsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListQuestionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListQuestionsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListQuestionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListQuestionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListQuestionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListQuestionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Questions     []*Question            `protobuf:"bytes,1,rep,name=questions,proto3" json:"questions,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQuestionsResponse) Reset() {
+	*x = ListQuestionsResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQuestionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQuestionsResponse) ProtoMessage() {}
+
+func (x *ListQuestionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQuestionsResponse.ProtoReflect.Descriptor instead.
+func (*ListQuestionsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListQuestionsResponse) GetQuestions() []*Question {
+	if x != nil {
+		return x.Questions
+	}
+	return nil
+}
+
+func (x *ListQuestionsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListQuestionsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListQuestionsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListQuestionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type AskProductQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Text          string                 `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AskProductQuestionRequest) Reset() {
+	*x = AskProductQuestionRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AskProductQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskProductQuestionRequest) ProtoMessage() {}
+
+func (x *AskProductQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskProductQuestionRequest.ProtoReflect.Descriptor instead.
+func (*AskProductQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AskProductQuestionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AskProductQuestionRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type AskProductQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      *Question              `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AskProductQuestionResponse) Reset() {
+	*x = AskProductQuestionResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AskProductQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AskProductQuestionResponse) ProtoMessage() {}
+
+func (x *AskProductQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AskProductQuestionResponse.ProtoReflect.Descriptor instead.
+func (*AskProductQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AskProductQuestionResponse) GetQuestion() *Question {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+type AnswerProductQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Text          string                 `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerProductQuestionRequest) Reset() {
+	*x = AnswerProductQuestionRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerProductQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerProductQuestionRequest) ProtoMessage() {}
+
+func (x *AnswerProductQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerProductQuestionRequest.ProtoReflect.Descriptor instead.
+func (*AnswerProductQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AnswerProductQuestionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AnswerProductQuestionRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *AnswerProductQuestionRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type AnswerProductQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answer        *Answer                `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnswerProductQuestionResponse) Reset() {
+	*x = AnswerProductQuestionResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnswerProductQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnswerProductQuestionResponse) ProtoMessage() {}
+
+func (x *AnswerProductQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnswerProductQuestionResponse.ProtoReflect.Descriptor instead.
+func (*AnswerProductQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AnswerProductQuestionResponse) GetAnswer() *Answer {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+type ModerateProductQuestionRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id             string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Status         string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	ModerationNote string                 `protobuf:"bytes,4,opt,name=moderation_note,json=moderationNote,proto3" json:"moderation_note,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ModerateProductQuestionRequest) Reset() {
+	*x = ModerateProductQuestionRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateProductQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateProductQuestionRequest) ProtoMessage() {}
+
+func (x *ModerateProductQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateProductQuestionRequest.ProtoReflect.Descriptor instead.
+func (*ModerateProductQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ModerateProductQuestionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ModerateProductQuestionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateProductQuestionRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModerateProductQuestionRequest) GetModerationNote() string {
+	if x != nil {
+		return x.ModerationNote
+	}
+	return ""
+}
+
+type ModerateProductQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Question      *Question              `protobuf:"bytes,1,opt,name=question,proto3" json:"question,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateProductQuestionResponse) Reset() {
+	*x = ModerateProductQuestionResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateProductQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateProductQuestionResponse) ProtoMessage() {}
+
+func (x *ModerateProductQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateProductQuestionResponse.ProtoReflect.Descriptor instead.
+func (*ModerateProductQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ModerateProductQuestionResponse) GetQuestion() *Question {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+type DeleteProductQuestionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductQuestionRequest) Reset() {
+	*x = DeleteProductQuestionRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductQuestionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductQuestionRequest) ProtoMessage() {}
+
+func (x *DeleteProductQuestionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductQuestionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductQuestionRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteProductQuestionRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeleteProductQuestionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductQuestionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductQuestionResponse) Reset() {
+	*x = DeleteProductQuestionResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductQuestionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductQuestionResponse) ProtoMessage() {}
+
+func (x *DeleteProductQuestionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductQuestionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductQuestionResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteProductQuestionResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ModerateProductAnswerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProductId      string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	QuestionId     string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Id             string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ModerationNote string                 `protobuf:"bytes,5,opt,name=moderation_note,json=moderationNote,proto3" json:"moderation_note,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ModerateProductAnswerRequest) Reset() {
+	*x = ModerateProductAnswerRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateProductAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateProductAnswerRequest) ProtoMessage() {}
+
+func (x *ModerateProductAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateProductAnswerRequest.ProtoReflect.Descriptor instead.
+func (*ModerateProductAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ModerateProductAnswerRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ModerateProductAnswerRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *ModerateProductAnswerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateProductAnswerRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModerateProductAnswerRequest) GetModerationNote() string {
+	if x != nil {
+		return x.ModerationNote
+	}
+	return ""
+}
+
+type ModerateProductAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Answer        *Answer                `protobuf:"bytes,1,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateProductAnswerResponse) Reset() {
+	*x = ModerateProductAnswerResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateProductAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateProductAnswerResponse) ProtoMessage() {}
+
+func (x *ModerateProductAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateProductAnswerResponse.ProtoReflect.Descriptor instead.
+func (*ModerateProductAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ModerateProductAnswerResponse) GetAnswer() *Answer {
+	if x != nil {
+		return x.Answer
+	}
+	return nil
+}
+
+type DeleteProductAnswerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	QuestionId    string                 `protobuf:"bytes,2,opt,name=question_id,json=questionId,proto3" json:"question_id,omitempty"`
+	Id            string                 `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductAnswerRequest) Reset() {
+	*x = DeleteProductAnswerRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductAnswerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductAnswerRequest) ProtoMessage() {}
+
+func (x *DeleteProductAnswerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductAnswerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductAnswerRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DeleteProductAnswerRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeleteProductAnswerRequest) GetQuestionId() string {
+	if x != nil {
+		return x.QuestionId
+	}
+	return ""
+}
+
+func (x *DeleteProductAnswerRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductAnswerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductAnswerResponse) Reset() {
+	*x = DeleteProductAnswerResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductAnswerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductAnswerResponse) ProtoMessage() {}
+
+func (x *DeleteProductAnswerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductAnswerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductAnswerResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *DeleteProductAnswerResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListProductReviewsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Page      int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize  int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Admin only; public callers always get approved reviews.
+	Status        string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductReviewsRequest) Reset() {
+	*x = ListProductReviewsRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductReviewsRequest) ProtoMessage() {}
+
+func (x *ListProductReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListProductReviewsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListProductReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductReviewsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProductReviewsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ListProductReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductReviewsResponse) Reset() {
+	*x = ListProductReviewsResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductReviewsResponse) ProtoMessage() {}
+
+func (x *ListProductReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListProductReviewsResponse) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListProductReviewsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListProductReviewsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductReviewsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductReviewsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type CreateProductReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Rating        int32                  `protobuf:"varint,2,opt,name=rating,proto3" json:"rating,omitempty"`
+	Pros          string                 `protobuf:"bytes,3,opt,name=pros,proto3" json:"pros,omitempty"`
+	Cons          string                 `protobuf:"bytes,4,opt,name=cons,proto3" json:"cons,omitempty"`
+	Text          string                 `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductReviewRequest) Reset() {
+	*x = CreateProductReviewRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductReviewRequest) ProtoMessage() {}
+
+func (x *CreateProductReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductReviewRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductReviewRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateProductReviewRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateProductReviewRequest) GetRating() int32 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CreateProductReviewRequest) GetPros() string {
+	if x != nil {
+		return x.Pros
+	}
+	return ""
+}
+
+func (x *CreateProductReviewRequest) GetCons() string {
+	if x != nil {
+		return x.Cons
+	}
+	return ""
+}
+
+func (x *CreateProductReviewRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type CreateProductReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductReviewResponse) Reset() {
+	*x = CreateProductReviewResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductReviewResponse) ProtoMessage() {}
+
+func (x *CreateProductReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductReviewResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductReviewResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateProductReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type ListReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,4,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewsRequest) Reset() {
+	*x = ListReviewsRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsRequest) ProtoMessage() {}
+
+func (x *ListReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ListReviewsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListReviewsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListReviewsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReviewsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*Review              `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewsResponse) Reset() {
+	*x = ListReviewsResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewsResponse) ProtoMessage() {}
+
+func (x *ListReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListReviewsResponse) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListReviewsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListReviewsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReviewsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListReviewsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type ModerateReviewRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status         string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	ModerationNote string                 `protobuf:"bytes,3,opt,name=moderation_note,json=moderationNote,proto3" json:"moderation_note,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ModerateReviewRequest) Reset() {
+	*x = ModerateReviewRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewRequest) ProtoMessage() {}
+
+func (x *ModerateReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewRequest.ProtoReflect.Descriptor instead.
+func (*ModerateReviewRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ModerateReviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ModerateReviewRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ModerateReviewRequest) GetModerationNote() string {
+	if x != nil {
+		return x.ModerationNote
+	}
+	return ""
+}
+
+type ModerateReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *Review                `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerateReviewResponse) Reset() {
+	*x = ModerateReviewResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerateReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerateReviewResponse) ProtoMessage() {}
+
+func (x *ModerateReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerateReviewResponse.ProtoReflect.Descriptor instead.
+func (*ModerateReviewResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ModerateReviewResponse) GetReview() *Review {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type DeleteReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewRequest) Reset() {
+	*x = DeleteReviewRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewRequest) ProtoMessage() {}
+
+func (x *DeleteReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReviewRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DeleteReviewRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReviewResponse) Reset() {
+	*x = DeleteReviewResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReviewResponse) ProtoMessage() {}
+
+func (x *DeleteReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReviewResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReviewResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteReviewResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ProductRelation struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId        string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RelatedProductId string                 `protobuf:"bytes,3,opt,name=related_product_id,json=relatedProductId,proto3" json:"related_product_id,omitempty"`
+	// One of: accessory, analogue, bundle_part, upsell.
+	Type      string `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	SortOrder int32  `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	CreatedAt string `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Set on both rows of a relation created with bidirectional = true.
+	Bidirectional bool `protobuf:"varint,8,opt,name=bidirectional,proto3" json:"bidirectional,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductRelation) Reset() {
+	*x = ProductRelation{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductRelation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductRelation) ProtoMessage() {}
+
+func (x *ProductRelation) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductRelation.ProtoReflect.Descriptor instead.
+func (*ProductRelation) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ProductRelation) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProductRelation) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductRelation) GetRelatedProductId() string {
+	if x != nil {
+		return x.RelatedProductId
+	}
+	return ""
+}
+
+func (x *ProductRelation) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProductRelation) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *ProductRelation) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ProductRelation) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *ProductRelation) GetBidirectional() bool {
+	if x != nil {
+		return x.Bidirectional
+	}
+	return false
+}
+
+type RelatedProduct struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Relation *ProductRelation       `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"`
+	// Only the primary image of the related product is populated.
+	Product       *Product `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RelatedProduct) Reset() {
+	*x = RelatedProduct{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelatedProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelatedProduct) ProtoMessage() {}
+
+func (x *RelatedProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelatedProduct.ProtoReflect.Descriptor instead.
+func (*RelatedProduct) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RelatedProduct) GetRelation() *ProductRelation {
+	if x != nil {
+		return x.Relation
+	}
+	return nil
+}
+
+func (x *RelatedProduct) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ListProductsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId      string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Page            int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	IncludeInactive bool                   `protobuf:"varint,4,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	SupplierId      int64                  `protobuf:"varint,5,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	BrandId         string                 `protobuf:"bytes,6,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	// Opaque cursor from a previous next_page_token. When set, page is ignored
+	// and total is not computed.
+	PageToken string `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// One of: newest (default), price_asc, price_desc, name, popularity, in_stock.
+	// Popularity is the number of units sold in paid orders.
+	Sort          string `protobuf:"bytes,8,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ListProductsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListProductsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type GetProductRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional expansions, e.g. include=relations.
+	Include       []string `protobuf:"bytes,2,rep,name=include,proto3" json:"include,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetProductRequest) GetInclude() []string {
+	if x != nil {
+		return x.Include
+	}
+	return nil
+}
+
+type GetProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type CreateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	SupplierId    int64                  `protobuf:"varint,2,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	PriceCents    int64                  `protobuf:"varint,5,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	Sku           string                 `protobuf:"bytes,6,opt,name=sku,proto3" json:"sku,omitempty"`
+	Stock         int32                  `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`
+	IsActive      bool                   `protobuf:"varint,8,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	BrandId       string                 `protobuf:"bytes,9,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateProductRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateProductRequest) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+type CreateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type UpdateProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	SupplierId    int64                  `protobuf:"varint,3,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
+	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	PriceCents    int64                  `protobuf:"varint,6,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	Sku           string                 `protobuf:"bytes,7,opt,name=sku,proto3" json:"sku,omitempty"`
+	Stock         int32                  `protobuf:"varint,8,opt,name=stock,proto3" json:"stock,omitempty"`
+	IsActive      bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	BrandId       string                 `protobuf:"bytes,10,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *UpdateProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetSupplierId() int64 {
+	if x != nil {
+		return x.SupplierId
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetStock() int32 {
+	if x != nil {
+		return x.Stock
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateProductRequest) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *UpdateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type UpdateProductPriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PriceCents    int64                  `protobuf:"varint,2,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductPriceRequest) Reset() {
+	*x = UpdateProductPriceRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductPriceRequest) ProtoMessage() {}
+
+func (x *UpdateProductPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductPriceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductPriceRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UpdateProductPriceRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductPriceRequest) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+type UpdateProductPriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductPriceResponse) Reset() {
+	*x = UpdateProductPriceResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductPriceResponse) ProtoMessage() {}
+
+func (x *UpdateProductPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductPriceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductPriceResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UpdateProductPriceResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DeleteProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DeleteProductResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListProductImagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductImagesRequest) Reset() {
+	*x = ListProductImagesRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductImagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductImagesRequest) ProtoMessage() {}
+
+func (x *ListProductImagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductImagesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductImagesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListProductImagesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ListProductImagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Images        []*ProductImage        `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductImagesResponse) Reset() {
+	*x = ListProductImagesResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductImagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductImagesResponse) ProtoMessage() {}
+
+func (x *ListProductImagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductImagesResponse.ProtoReflect.Descriptor instead.
+func (*ListProductImagesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListProductImagesResponse) GetImages() []*ProductImage {
+	if x != nil {
+		return x.Images
+	}
+	return nil
+}
+
+type GetProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductImageRequest) Reset() {
+	*x = GetProductImageRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductImageRequest) ProtoMessage() {}
+
+func (x *GetProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductImageRequest.ProtoReflect.Descriptor instead.
+func (*GetProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetProductImageRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductImageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductImageResponse) Reset() {
+	*x = GetProductImageResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductImageResponse) ProtoMessage() {}
+
+func (x *GetProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductImageResponse.ProtoReflect.Descriptor instead.
+func (*GetProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetProductImageResponse) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type CreateProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	AltText       string                 `protobuf:"bytes,3,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,5,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductImageRequest) Reset() {
+	*x = CreateProductImageRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductImageRequest) ProtoMessage() {}
+
+func (x *CreateProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductImageRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CreateProductImageRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateProductImageRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateProductImageRequest) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *CreateProductImageRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *CreateProductImageRequest) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type CreateProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductImageResponse) Reset() {
+	*x = CreateProductImageResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductImageResponse) ProtoMessage() {}
+
+func (x *CreateProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductImageResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CreateProductImageResponse) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type UpdateProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	AltText       string                 `protobuf:"bytes,4,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	IsPrimary     bool                   `protobuf:"varint,6,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductImageRequest) Reset() {
+	*x = UpdateProductImageRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductImageRequest) ProtoMessage() {}
+
+func (x *UpdateProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductImageRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *UpdateProductImageRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateProductImageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductImageRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateProductImageRequest) GetAltText() string {
+	if x != nil {
+		return x.AltText
+	}
+	return ""
+}
+
+func (x *UpdateProductImageRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+func (x *UpdateProductImageRequest) GetIsPrimary() bool {
+	if x != nil {
+		return x.IsPrimary
+	}
+	return false
+}
+
+type UpdateProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Image         *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductImageResponse) Reset() {
+	*x = UpdateProductImageResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductImageResponse) ProtoMessage() {}
+
+func (x *UpdateProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductImageResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateProductImageResponse) GetImage() *ProductImage {
+	if x != nil {
+		return x.Image
+	}
+	return nil
+}
+
+type DeleteProductImageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductImageRequest) Reset() {
+	*x = DeleteProductImageRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductImageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductImageRequest) ProtoMessage() {}
+
+func (x *DeleteProductImageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductImageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductImageRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DeleteProductImageRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeleteProductImageRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductImageResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductImageResponse) Reset() {
+	*x = DeleteProductImageResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductImageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductImageResponse) ProtoMessage() {}
+
+func (x *DeleteProductImageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductImageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductImageResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteProductImageResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ListProductAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductAttributesRequest) Reset() {
+	*x = ListProductAttributesRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductAttributesRequest) ProtoMessage() {}
+
+func (x *ListProductAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductAttributesRequest.ProtoReflect.Descriptor instead.
+func (*ListProductAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListProductAttributesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type ListProductAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attributes    []*ProductAttribute    `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductAttributesResponse) Reset() {
+	*x = ListProductAttributesResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductAttributesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductAttributesResponse) ProtoMessage() {}
+
+func (x *ListProductAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductAttributesResponse.ProtoReflect.Descriptor instead.
+func (*ListProductAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListProductAttributesResponse) GetAttributes() []*ProductAttribute {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+type GetProductAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductAttributeRequest) Reset() {
+	*x = GetProductAttributeRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductAttributeRequest) ProtoMessage() {}
+
+func (x *GetProductAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductAttributeRequest.ProtoReflect.Descriptor instead.
+func (*GetProductAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetProductAttributeRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetProductAttributeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetProductAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attribute     *ProductAttribute      `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductAttributeResponse) Reset() {
+	*x = GetProductAttributeResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductAttributeResponse) ProtoMessage() {}
+
+func (x *GetProductAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductAttributeResponse.ProtoReflect.Descriptor instead.
+func (*GetProductAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetProductAttributeResponse) GetAttribute() *ProductAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+type CreateProductAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductAttributeRequest) Reset() {
+	*x = CreateProductAttributeRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductAttributeRequest) ProtoMessage() {}
+
+func (x *CreateProductAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductAttributeRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateProductAttributeRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateProductAttributeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductAttributeRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *CreateProductAttributeRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type CreateProductAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attribute     *ProductAttribute      `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductAttributeResponse) Reset() {
+	*x = CreateProductAttributeResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductAttributeResponse) ProtoMessage() {}
+
+func (x *CreateProductAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductAttributeResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateProductAttributeResponse) GetAttribute() *ProductAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+type UpdateProductAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductAttributeRequest) Reset() {
+	*x = UpdateProductAttributeRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductAttributeRequest) ProtoMessage() {}
+
+func (x *UpdateProductAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductAttributeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateProductAttributeRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *UpdateProductAttributeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductAttributeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductAttributeRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *UpdateProductAttributeRequest) GetSortOrder() int32 {
+	if x != nil {
+		return x.SortOrder
+	}
+	return 0
+}
+
+type UpdateProductAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attribute     *ProductAttribute      `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductAttributeResponse) Reset() {
+	*x = UpdateProductAttributeResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductAttributeResponse) ProtoMessage() {}
+
+func (x *UpdateProductAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductAttributeResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UpdateProductAttributeResponse) GetAttribute() *ProductAttribute {
+	if x != nil {
+		return x.Attribute
+	}
+	return nil
+}
+
+type DeleteProductAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductAttributeRequest) Reset() {
+	*x = DeleteProductAttributeRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductAttributeRequest) ProtoMessage() {}
+
+func (x *DeleteProductAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductAttributeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteProductAttributeRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DeleteProductAttributeRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteProductAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductAttributeResponse) Reset() {
+	*x = DeleteProductAttributeResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductAttributeResponse) ProtoMessage() {}
+
+func (x *DeleteProductAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductAttributeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *DeleteProductAttributeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type BundleItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Product       *Product               `protobuf:"bytes,3,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleItem) Reset() {
+	*x = BundleItem{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleItem) ProtoMessage() {}
+
+func (x *BundleItem) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleItem.ProtoReflect.Descriptor instead.
+func (*BundleItem) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *BundleItem) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BundleItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BundleItem) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type Bundle struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Product *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	// fixed: product.price_cents is set by the admin;
+	// discount: components total minus discount_percent.
+	PricingMode     string        `protobuf:"bytes,2,opt,name=pricing_mode,json=pricingMode,proto3" json:"pricing_mode,omitempty"`
+	DiscountPercent int32         `protobuf:"varint,3,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	Items           []*BundleItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Bundle) Reset() {
+	*x = Bundle{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Bundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Bundle) ProtoMessage() {}
+
+func (x *Bundle) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Bundle.ProtoReflect.Descriptor instead.
+func (*Bundle) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *Bundle) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *Bundle) GetPricingMode() string {
+	if x != nil {
+		return x.PricingMode
+	}
+	return ""
+}
+
+func (x *Bundle) GetDiscountPercent() int32 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *Bundle) GetItems() []*BundleItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type BundleItemInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleItemInput) Reset() {
+	*x = BundleItemInput{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleItemInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleItemInput) ProtoMessage() {}
+
+func (x *BundleItemInput) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleItemInput.ProtoReflect.Descriptor instead.
+func (*BundleItemInput) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *BundleItemInput) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BundleItemInput) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type GetBundleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBundleRequest) Reset() {
+	*x = GetBundleRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBundleRequest) ProtoMessage() {}
+
+func (x *GetBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBundleRequest.ProtoReflect.Descriptor instead.
+func (*GetBundleRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetBundleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundle        *Bundle                `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetBundleResponse) Reset() {
+	*x = GetBundleResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBundleResponse) ProtoMessage() {}
+
+func (x *GetBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBundleResponse.ProtoReflect.Descriptor instead.
+func (*GetBundleResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetBundleResponse) GetBundle() *Bundle {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+type CreateBundleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId      string                 `protobuf:"bytes,1,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	BrandId         string                 `protobuf:"bytes,2,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Sku             string                 `protobuf:"bytes,5,opt,name=sku,proto3" json:"sku,omitempty"`
+	IsActive        bool                   `protobuf:"varint,6,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	PricingMode     string                 `protobuf:"bytes,7,opt,name=pricing_mode,json=pricingMode,proto3" json:"pricing_mode,omitempty"`
+	PriceCents      int64                  `protobuf:"varint,8,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	DiscountPercent int32                  `protobuf:"varint,9,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	Items           []*BundleItemInput     `protobuf:"bytes,10,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateBundleRequest) Reset() {
+	*x = CreateBundleRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBundleRequest) ProtoMessage() {}
+
+func (x *CreateBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateBundleRequest.ProtoReflect.Descriptor instead.
+func (*CreateBundleRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *CreateBundleRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *CreateBundleRequest) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+func (x *CreateBundleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateBundleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateBundleRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateBundleRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CreateBundleRequest) GetPricingMode() string {
+	if x != nil {
+		return x.PricingMode
+	}
+	return ""
+}
+
+func (x *CreateBundleRequest) GetPriceCents() int64 {
+	if x != nil {
+		return x.PriceCents
+	}
+	return 0
+}
+
+func (x *CreateBundleRequest) GetDiscountPercent() int32 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *CreateBundleRequest) GetItems() []*BundleItemInput {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
 
-func (x *CreateProductResponse) Reset() {
-	*x = CreateProductResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[19]
+type CreateBundleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Bundle        *Bundle                `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateBundleResponse) Reset() {
+	*x = CreateBundleResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductResponse) String() string {
+func (x *CreateBundleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductResponse) ProtoMessage() {}
+func (*CreateBundleResponse) ProtoMessage() {}
 
-func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[19]
+func (x *CreateBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1298,49 +5258,50 @@ func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
-func (*CreateProductResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use CreateBundleResponse.ProtoReflect.Descriptor instead.
+func (*CreateBundleResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *CreateProductResponse) GetProduct() *Product {
+func (x *CreateBundleResponse) GetBundle() *Bundle {
 	if x != nil {
-		return x.Product
+		return x.Bundle
 	}
 	return nil
 }
 
-type UpdateProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	SupplierId    int64                  `protobuf:"varint,3,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	PriceCents    int64                  `protobuf:"varint,6,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
-	Sku           string                 `protobuf:"bytes,7,opt,name=sku,proto3" json:"sku,omitempty"`
-	Stock         int32                  `protobuf:"varint,8,opt,name=stock,proto3" json:"stock,omitempty"`
-	IsActive      bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
-	BrandId       string                 `protobuf:"bytes,10,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type UpdateBundleRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CategoryId      string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	BrandId         string                 `protobuf:"bytes,3,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	Name            string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Sku             string                 `protobuf:"bytes,6,opt,name=sku,proto3" json:"sku,omitempty"`
+	IsActive        bool                   `protobuf:"varint,7,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	PricingMode     string                 `protobuf:"bytes,8,opt,name=pricing_mode,json=pricingMode,proto3" json:"pricing_mode,omitempty"`
+	PriceCents      int64                  `protobuf:"varint,9,opt,name=price_cents,json=priceCents,proto3" json:"price_cents,omitempty"`
+	DiscountPercent int32                  `protobuf:"varint,10,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`
+	Items           []*BundleItemInput     `protobuf:"bytes,11,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[20]
+func (x *UpdateBundleRequest) Reset() {
+	*x = UpdateBundleRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *UpdateBundleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*UpdateBundleRequest) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[20]
+func (x *UpdateBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1351,103 +5312,110 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use UpdateBundleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBundleRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *UpdateProductRequest) GetId() string {
+func (x *UpdateBundleRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetCategoryId() string {
+func (x *UpdateBundleRequest) GetCategoryId() string {
 	if x != nil {
 		return x.CategoryId
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetSupplierId() int64 {
+func (x *UpdateBundleRequest) GetBrandId() string {
 	if x != nil {
-		return x.SupplierId
+		return x.BrandId
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateProductRequest) GetName() string {
+func (x *UpdateBundleRequest) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetDescription() string {
+func (x *UpdateBundleRequest) GetDescription() string {
 	if x != nil {
 		return x.Description
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetPriceCents() int64 {
+func (x *UpdateBundleRequest) GetSku() string {
 	if x != nil {
-		return x.PriceCents
+		return x.Sku
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateProductRequest) GetSku() string {
+func (x *UpdateBundleRequest) GetIsActive() bool {
 	if x != nil {
-		return x.Sku
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UpdateBundleRequest) GetPricingMode() string {
+	if x != nil {
+		return x.PricingMode
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetStock() int32 {
+func (x *UpdateBundleRequest) GetPriceCents() int64 {
 	if x != nil {
-		return x.Stock
+		return x.PriceCents
 	}
 	return 0
 }
 
-func (x *UpdateProductRequest) GetIsActive() bool {
+func (x *UpdateBundleRequest) GetDiscountPercent() int32 {
 	if x != nil {
-		return x.IsActive
+		return x.DiscountPercent
 	}
-	return false
+	return 0
 }
 
-func (x *UpdateProductRequest) GetBrandId() string {
+func (x *UpdateBundleRequest) GetItems() []*BundleItemInput {
 	if x != nil {
-		return x.BrandId
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-type UpdateProductResponse struct {
+type UpdateBundleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	Bundle        *Bundle                `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductResponse) Reset() {
-	*x = UpdateProductResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[21]
+func (x *UpdateBundleResponse) Reset() {
+	*x = UpdateBundleResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductResponse) String() string {
+func (x *UpdateBundleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductResponse) ProtoMessage() {}
+func (*UpdateBundleResponse) ProtoMessage() {}
 
-func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[21]
+func (x *UpdateBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,40 +5426,41 @@ func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use UpdateBundleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBundleResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *UpdateProductResponse) GetProduct() *Product {
+func (x *UpdateBundleResponse) GetBundle() *Bundle {
 	if x != nil {
-		return x.Product
+		return x.Bundle
 	}
 	return nil
 }
 
-type DeleteProductRequest struct {
+type StockLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductRequest) Reset() {
-	*x = DeleteProductRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[22]
+func (x *StockLine) Reset() {
+	*x = StockLine{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductRequest) String() string {
+func (x *StockLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductRequest) ProtoMessage() {}
+func (*StockLine) ProtoMessage() {}
 
-func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[22]
+func (x *StockLine) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1502,40 +5471,91 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use StockLine.ProtoReflect.Descriptor instead.
+func (*StockLine) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *DeleteProductRequest) GetId() string {
+func (x *StockLine) GetProductId() string {
 	if x != nil {
-		return x.Id
+		return x.ProductId
 	}
 	return ""
 }
 
-type DeleteProductResponse struct {
+func (x *StockLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type ReserveStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*StockLine           `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ReserveStockRequest) GetLines() []*StockLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type ReserveStockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductResponse) Reset() {
-	*x = DeleteProductResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[23]
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductResponse) String() string {
+func (x *ReserveStockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductResponse) ProtoMessage() {}
+func (*ReserveStockResponse) ProtoMessage() {}
 
-func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[23]
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1546,40 +5566,40 @@ func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *DeleteProductResponse) GetSuccess() bool {
+func (x *ReserveStockResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type ListProductImagesRequest struct {
+type CommitStockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Lines         []*StockLine           `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductImagesRequest) Reset() {
-	*x = ListProductImagesRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[24]
+func (x *CommitStockRequest) Reset() {
+	*x = CommitStockRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductImagesRequest) String() string {
+func (x *CommitStockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductImagesRequest) ProtoMessage() {}
+func (*CommitStockRequest) ProtoMessage() {}
 
-func (x *ListProductImagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[24]
+func (x *CommitStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1590,40 +5610,128 @@ func (x *ListProductImagesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductImagesRequest.ProtoReflect.Descriptor instead.
-func (*ListProductImagesRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use CommitStockRequest.ProtoReflect.Descriptor instead.
+func (*CommitStockRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *ListProductImagesRequest) GetProductId() string {
+func (x *CommitStockRequest) GetLines() []*StockLine {
 	if x != nil {
-		return x.ProductId
+		return x.Lines
 	}
-	return ""
+	return nil
 }
 
-type ListProductImagesResponse struct {
+type CommitStockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Images        []*ProductImage        `protobuf:"bytes,1,rep,name=images,proto3" json:"images,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductImagesResponse) Reset() {
-	*x = ListProductImagesResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[25]
+func (x *CommitStockResponse) Reset() {
+	*x = CommitStockResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductImagesResponse) String() string {
+func (x *CommitStockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductImagesResponse) ProtoMessage() {}
+func (*CommitStockResponse) ProtoMessage() {}
 
-func (x *ListProductImagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[25]
+func (x *CommitStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitStockResponse.ProtoReflect.Descriptor instead.
+func (*CommitStockResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *CommitStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ReleaseStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*StockLine           `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStockRequest) Reset() {
+	*x = ReleaseStockRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStockRequest) ProtoMessage() {}
+
+func (x *ReleaseStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStockRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseStockRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ReleaseStockRequest) GetLines() []*StockLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type ReleaseStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStockResponse) Reset() {
+	*x = ReleaseStockResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStockResponse) ProtoMessage() {}
+
+func (x *ReleaseStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1634,41 +5742,40 @@ func (x *ListProductImagesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductImagesResponse.ProtoReflect.Descriptor instead.
-func (*ListProductImagesResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ReleaseStockResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseStockResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *ListProductImagesResponse) GetImages() []*ProductImage {
+func (x *ReleaseStockResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Images
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type GetProductImageRequest struct {
+type ListProductRelationsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductImageRequest) Reset() {
-	*x = GetProductImageRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[26]
+func (x *ListProductRelationsRequest) Reset() {
+	*x = ListProductRelationsRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductImageRequest) String() string {
+func (x *ListProductRelationsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductImageRequest) ProtoMessage() {}
+func (*ListProductRelationsRequest) ProtoMessage() {}
 
-func (x *GetProductImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[26]
+func (x *ListProductRelationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1679,47 +5786,40 @@ func (x *GetProductImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductImageRequest.ProtoReflect.Descriptor instead.
-func (*GetProductImageRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ListProductRelationsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductRelationsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *GetProductImageRequest) GetProductId() string {
+func (x *ListProductRelationsRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *GetProductImageRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-type GetProductImageResponse struct {
+type ListProductRelationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Image         *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Relations     []*ProductRelation     `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductImageResponse) Reset() {
-	*x = GetProductImageResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[27]
+func (x *ListProductRelationsResponse) Reset() {
+	*x = ListProductRelationsResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductImageResponse) String() string {
+func (x *ListProductRelationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductImageResponse) ProtoMessage() {}
+func (*ListProductRelationsResponse) ProtoMessage() {}
 
-func (x *GetProductImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[27]
+func (x *ListProductRelationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1730,44 +5830,45 @@ func (x *GetProductImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductImageResponse.ProtoReflect.Descriptor instead.
-func (*GetProductImageResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ListProductRelationsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductRelationsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *GetProductImageResponse) GetImage() *ProductImage {
+func (x *ListProductRelationsResponse) GetRelations() []*ProductRelation {
 	if x != nil {
-		return x.Image
+		return x.Relations
 	}
 	return nil
 }
 
-type CreateProductImageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Url           string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	AltText       string                 `protobuf:"bytes,3,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
-	SortOrder     int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
-	IsPrimary     bool                   `protobuf:"varint,5,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+type CreateProductRelationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	RelatedProductId string                 `protobuf:"bytes,2,opt,name=related_product_id,json=relatedProductId,proto3" json:"related_product_id,omitempty"`
+	Type             string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	SortOrder        int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// Also link related_product_id back to product_id. Analogues only.
+	Bidirectional bool `protobuf:"varint,5,opt,name=bidirectional,proto3" json:"bidirectional,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductImageRequest) Reset() {
-	*x = CreateProductImageRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[28]
+func (x *CreateProductRelationRequest) Reset() {
+	*x = CreateProductRelationRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductImageRequest) String() string {
+func (x *CreateProductRelationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductImageRequest) ProtoMessage() {}
+func (*CreateProductRelationRequest) ProtoMessage() {}
 
-func (x *CreateProductImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[28]
+func (x *CreateProductRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1778,68 +5879,68 @@ func (x *CreateProductImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductImageRequest.ProtoReflect.Descriptor instead.
-func (*CreateProductImageRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use CreateProductRelationRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRelationRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *CreateProductImageRequest) GetProductId() string {
+func (x *CreateProductRelationRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *CreateProductImageRequest) GetUrl() string {
+func (x *CreateProductRelationRequest) GetRelatedProductId() string {
 	if x != nil {
-		return x.Url
+		return x.RelatedProductId
 	}
 	return ""
 }
 
-func (x *CreateProductImageRequest) GetAltText() string {
+func (x *CreateProductRelationRequest) GetType() string {
 	if x != nil {
-		return x.AltText
+		return x.Type
 	}
 	return ""
 }
 
-func (x *CreateProductImageRequest) GetSortOrder() int32 {
+func (x *CreateProductRelationRequest) GetSortOrder() int32 {
 	if x != nil {
 		return x.SortOrder
 	}
 	return 0
 }
 
-func (x *CreateProductImageRequest) GetIsPrimary() bool {
+func (x *CreateProductRelationRequest) GetBidirectional() bool {
 	if x != nil {
-		return x.IsPrimary
+		return x.Bidirectional
 	}
 	return false
 }
 
-type CreateProductImageResponse struct {
+type CreateProductRelationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Image         *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Relation      *ProductRelation       `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductImageResponse) Reset() {
-	*x = CreateProductImageResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[29]
+func (x *CreateProductRelationResponse) Reset() {
+	*x = CreateProductRelationResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductImageResponse) String() string {
+func (x *CreateProductRelationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductImageResponse) ProtoMessage() {}
+func (*CreateProductRelationResponse) ProtoMessage() {}
 
-func (x *CreateProductImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[29]
+func (x *CreateProductRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1850,45 +5951,43 @@ func (x *CreateProductImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductImageResponse.ProtoReflect.Descriptor instead.
-func (*CreateProductImageResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use CreateProductRelationResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductRelationResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *CreateProductImageResponse) GetImage() *ProductImage {
+func (x *CreateProductRelationResponse) GetRelation() *ProductRelation {
 	if x != nil {
-		return x.Image
+		return x.Relation
 	}
 	return nil
 }
 
-type UpdateProductImageRequest struct {
+type UpdateProductRelationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	AltText       string                 `protobuf:"bytes,4,opt,name=alt_text,json=altText,proto3" json:"alt_text,omitempty"`
-	SortOrder     int32                  `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
-	IsPrimary     bool                   `protobuf:"varint,6,opt,name=is_primary,json=isPrimary,proto3" json:"is_primary,omitempty"`
+	Type          string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	SortOrder     int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductImageRequest) Reset() {
-	*x = UpdateProductImageRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[30]
+func (x *UpdateProductRelationRequest) Reset() {
+	*x = UpdateProductRelationRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductImageRequest) String() string {
+func (x *UpdateProductRelationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductImageRequest) ProtoMessage() {}
+func (*UpdateProductRelationRequest) ProtoMessage() {}
 
-func (x *UpdateProductImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[30]
+func (x *UpdateProductRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1899,75 +5998,61 @@ func (x *UpdateProductImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductImageRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductImageRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use UpdateProductRelationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRelationRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *UpdateProductImageRequest) GetProductId() string {
+func (x *UpdateProductRelationRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *UpdateProductImageRequest) GetId() string {
+func (x *UpdateProductRelationRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateProductImageRequest) GetUrl() string {
-	if x != nil {
-		return x.Url
-	}
-	return ""
-}
-
-func (x *UpdateProductImageRequest) GetAltText() string {
+func (x *UpdateProductRelationRequest) GetType() string {
 	if x != nil {
-		return x.AltText
+		return x.Type
 	}
 	return ""
 }
 
-func (x *UpdateProductImageRequest) GetSortOrder() int32 {
+func (x *UpdateProductRelationRequest) GetSortOrder() int32 {
 	if x != nil {
 		return x.SortOrder
 	}
 	return 0
 }
 
-func (x *UpdateProductImageRequest) GetIsPrimary() bool {
-	if x != nil {
-		return x.IsPrimary
-	}
-	return false
-}
-
-type UpdateProductImageResponse struct {
+type UpdateProductRelationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Image         *ProductImage          `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
+	Relation      *ProductRelation       `protobuf:"bytes,1,opt,name=relation,proto3" json:"relation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductImageResponse) Reset() {
-	*x = UpdateProductImageResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[31]
+func (x *UpdateProductRelationResponse) Reset() {
+	*x = UpdateProductRelationResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductImageResponse) String() string {
+func (x *UpdateProductRelationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductImageResponse) ProtoMessage() {}
+func (*UpdateProductRelationResponse) ProtoMessage() {}
 
-func (x *UpdateProductImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[31]
+func (x *UpdateProductRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,41 +6063,44 @@ func (x *UpdateProductImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductImageResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProductImageResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use UpdateProductRelationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductRelationResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *UpdateProductImageResponse) GetImage() *ProductImage {
+func (x *UpdateProductRelationResponse) GetRelation() *ProductRelation {
 	if x != nil {
-		return x.Image
+		return x.Relation
 	}
 	return nil
 }
 
-type DeleteProductImageRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+type DeleteProductRelationRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Id        string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Also delete the other row of a bidirectional relation. Without it that
+	// row is kept as a one-way relation.
+	Bidirectional bool `protobuf:"varint,3,opt,name=bidirectional,proto3" json:"bidirectional,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductImageRequest) Reset() {
-	*x = DeleteProductImageRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[32]
+func (x *DeleteProductRelationRequest) Reset() {
+	*x = DeleteProductRelationRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductImageRequest) String() string {
+func (x *DeleteProductRelationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductImageRequest) ProtoMessage() {}
+func (*DeleteProductRelationRequest) ProtoMessage() {}
 
-func (x *DeleteProductImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[32]
+func (x *DeleteProductRelationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2023,47 +6111,54 @@ func (x *DeleteProductImageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductImageRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductImageRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use DeleteProductRelationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRelationRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *DeleteProductImageRequest) GetProductId() string {
+func (x *DeleteProductRelationRequest) GetProductId() string {
 	if x != nil {
 		return x.ProductId
 	}
 	return ""
 }
 
-func (x *DeleteProductImageRequest) GetId() string {
+func (x *DeleteProductRelationRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type DeleteProductImageResponse struct {
+func (x *DeleteProductRelationRequest) GetBidirectional() bool {
+	if x != nil {
+		return x.Bidirectional
+	}
+	return false
+}
+
+type DeleteProductRelationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductImageResponse) Reset() {
-	*x = DeleteProductImageResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[33]
+func (x *DeleteProductRelationResponse) Reset() {
+	*x = DeleteProductRelationResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductImageResponse) String() string {
+func (x *DeleteProductRelationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductImageResponse) ProtoMessage() {}
+func (*DeleteProductRelationResponse) ProtoMessage() {}
 
-func (x *DeleteProductImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[33]
+func (x *DeleteProductRelationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2074,40 +6169,42 @@ func (x *DeleteProductImageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductImageResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProductImageResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use DeleteProductRelationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductRelationResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *DeleteProductImageResponse) GetSuccess() bool {
+func (x *DeleteProductRelationResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-type ListProductAttributesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+type ProductListItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only the primary image of the product is populated.
+	Product       *Product `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	AddedAt       string   `protobuf:"bytes,2,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductAttributesRequest) Reset() {
-	*x = ListProductAttributesRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[34]
+func (x *ProductListItem) Reset() {
+	*x = ProductListItem{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductAttributesRequest) String() string {
+func (x *ProductListItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductAttributesRequest) ProtoMessage() {}
+func (*ProductListItem) ProtoMessage() {}
 
-func (x *ListProductAttributesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[34]
+func (x *ProductListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2118,40 +6215,51 @@ func (x *ListProductAttributesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductAttributesRequest.ProtoReflect.Descriptor instead.
-func (*ListProductAttributesRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ProductListItem.ProtoReflect.Descriptor instead.
+func (*ProductListItem) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *ListProductAttributesRequest) GetProductId() string {
+func (x *ProductListItem) GetProduct() *Product {
 	if x != nil {
-		return x.ProductId
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ProductListItem) GetAddedAt() string {
+	if x != nil {
+		return x.AddedAt
 	}
 	return ""
 }
 
-type ListProductAttributesResponse struct {
+// One attribute across the compared products. values is aligned with
+// CompareProductsResponse.products and empty where a product lacks it.
+type ComparisonRow struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Attributes    []*ProductAttribute    `protobuf:"bytes,1,rep,name=attributes,proto3" json:"attributes,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values        []string               `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	Differs       bool                   `protobuf:"varint,3,opt,name=differs,proto3" json:"differs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProductAttributesResponse) Reset() {
-	*x = ListProductAttributesResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[35]
+func (x *ComparisonRow) Reset() {
+	*x = ComparisonRow{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProductAttributesResponse) String() string {
+func (x *ComparisonRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProductAttributesResponse) ProtoMessage() {}
+func (*ComparisonRow) ProtoMessage() {}
 
-func (x *ListProductAttributesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[35]
+func (x *ComparisonRow) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2162,41 +6270,55 @@ func (x *ListProductAttributesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProductAttributesResponse.ProtoReflect.Descriptor instead.
-func (*ListProductAttributesResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ComparisonRow.ProtoReflect.Descriptor instead.
+func (*ComparisonRow) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *ListProductAttributesResponse) GetAttributes() []*ProductAttribute {
+func (x *ComparisonRow) GetName() string {
 	if x != nil {
-		return x.Attributes
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ComparisonRow) GetValues() []string {
+	if x != nil {
+		return x.Values
 	}
 	return nil
 }
 
-type GetProductAttributeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+func (x *ComparisonRow) GetDiffers() bool {
+	if x != nil {
+		return x.Differs
+	}
+	return false
+}
+
+type ListProductListItemsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// wishlist or compare.
+	List          string `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductAttributeRequest) Reset() {
-	*x = GetProductAttributeRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[36]
+func (x *ListProductListItemsRequest) Reset() {
+	*x = ListProductListItemsRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductAttributeRequest) String() string {
+func (x *ListProductListItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductAttributeRequest) ProtoMessage() {}
+func (*ListProductListItemsRequest) ProtoMessage() {}
 
-func (x *GetProductAttributeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[36]
+func (x *ListProductListItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2207,47 +6329,40 @@ func (x *GetProductAttributeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductAttributeRequest.ProtoReflect.Descriptor instead.
-func (*GetProductAttributeRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *GetProductAttributeRequest) GetProductId() string {
-	if x != nil {
-		return x.ProductId
-	}
-	return ""
+// Deprecated: Use ListProductListItemsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductListItemsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *GetProductAttributeRequest) GetId() string {
+func (x *ListProductListItemsRequest) GetList() string {
 	if x != nil {
-		return x.Id
+		return x.List
 	}
 	return ""
 }
 
-type GetProductAttributeResponse struct {
+type ListProductListItemsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Attribute     *ProductAttribute      `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Items         []*ProductListItem     `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductAttributeResponse) Reset() {
-	*x = GetProductAttributeResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[37]
+func (x *ListProductListItemsResponse) Reset() {
+	*x = ListProductListItemsResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductAttributeResponse) String() string {
+func (x *ListProductListItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductAttributeResponse) ProtoMessage() {}
+func (*ListProductListItemsResponse) ProtoMessage() {}
 
-func (x *GetProductAttributeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[37]
+func (x *ListProductListItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2258,43 +6373,41 @@ func (x *GetProductAttributeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductAttributeResponse.ProtoReflect.Descriptor instead.
-func (*GetProductAttributeResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ListProductListItemsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductListItemsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *GetProductAttributeResponse) GetAttribute() *ProductAttribute {
+func (x *ListProductListItemsResponse) GetItems() []*ProductListItem {
 	if x != nil {
-		return x.Attribute
+		return x.Items
 	}
 	return nil
-}
-
-type CreateProductAttributeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Value         string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	SortOrder     int32                  `protobuf:"varint,4,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+}
+
+type AddProductListItemRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          string                 `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductAttributeRequest) Reset() {
-	*x = CreateProductAttributeRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[38]
+func (x *AddProductListItemRequest) Reset() {
+	*x = AddProductListItemRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductAttributeRequest) String() string {
+func (x *AddProductListItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductAttributeRequest) ProtoMessage() {}
+func (*AddProductListItemRequest) ProtoMessage() {}
 
-func (x *CreateProductAttributeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[38]
+func (x *AddProductListItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2305,61 +6418,47 @@ func (x *CreateProductAttributeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductAttributeRequest.ProtoReflect.Descriptor instead.
-func (*CreateProductAttributeRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *CreateProductAttributeRequest) GetProductId() string {
-	if x != nil {
-		return x.ProductId
-	}
-	return ""
+// Deprecated: Use AddProductListItemRequest.ProtoReflect.Descriptor instead.
+func (*AddProductListItemRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *CreateProductAttributeRequest) GetName() string {
+func (x *AddProductListItemRequest) GetList() string {
 	if x != nil {
-		return x.Name
+		return x.List
 	}
 	return ""
 }
 
-func (x *CreateProductAttributeRequest) GetValue() string {
+func (x *AddProductListItemRequest) GetProductId() string {
 	if x != nil {
-		return x.Value
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *CreateProductAttributeRequest) GetSortOrder() int32 {
-	if x != nil {
-		return x.SortOrder
-	}
-	return 0
-}
-
-type CreateProductAttributeResponse struct {
+type AddProductListItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Attribute     *ProductAttribute      `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateProductAttributeResponse) Reset() {
-	*x = CreateProductAttributeResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[39]
+func (x *AddProductListItemResponse) Reset() {
+	*x = AddProductListItemResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateProductAttributeResponse) String() string {
+func (x *AddProductListItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateProductAttributeResponse) ProtoMessage() {}
+func (*AddProductListItemResponse) ProtoMessage() {}
 
-func (x *CreateProductAttributeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[39]
+func (x *AddProductListItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2370,44 +6469,41 @@ func (x *CreateProductAttributeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateProductAttributeResponse.ProtoReflect.Descriptor instead.
-func (*CreateProductAttributeResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use AddProductListItemResponse.ProtoReflect.Descriptor instead.
+func (*AddProductListItemResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *CreateProductAttributeResponse) GetAttribute() *ProductAttribute {
+func (x *AddProductListItemResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Attribute
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type UpdateProductAttributeRequest struct {
+type RemoveProductListItemRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Value         string                 `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
-	SortOrder     int32                  `protobuf:"varint,5,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	List          string                 `protobuf:"bytes,1,opt,name=list,proto3" json:"list,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductAttributeRequest) Reset() {
-	*x = UpdateProductAttributeRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[40]
+func (x *RemoveProductListItemRequest) Reset() {
+	*x = RemoveProductListItemRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductAttributeRequest) String() string {
+func (x *RemoveProductListItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductAttributeRequest) ProtoMessage() {}
+func (*RemoveProductListItemRequest) ProtoMessage() {}
 
-func (x *UpdateProductAttributeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[40]
+func (x *RemoveProductListItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,68 +6514,47 @@ func (x *UpdateProductAttributeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductAttributeRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductAttributeRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *UpdateProductAttributeRequest) GetProductId() string {
-	if x != nil {
-		return x.ProductId
-	}
-	return ""
-}
-
-func (x *UpdateProductAttributeRequest) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+// Deprecated: Use RemoveProductListItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveProductListItemRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *UpdateProductAttributeRequest) GetName() string {
+func (x *RemoveProductListItemRequest) GetList() string {
 	if x != nil {
-		return x.Name
+		return x.List
 	}
 	return ""
 }
 
-func (x *UpdateProductAttributeRequest) GetValue() string {
+func (x *RemoveProductListItemRequest) GetProductId() string {
 	if x != nil {
-		return x.Value
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *UpdateProductAttributeRequest) GetSortOrder() int32 {
-	if x != nil {
-		return x.SortOrder
-	}
-	return 0
-}
-
-type UpdateProductAttributeResponse struct {
+type RemoveProductListItemResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Attribute     *ProductAttribute      `protobuf:"bytes,1,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductAttributeResponse) Reset() {
-	*x = UpdateProductAttributeResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[41]
+func (x *RemoveProductListItemResponse) Reset() {
+	*x = RemoveProductListItemResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductAttributeResponse) String() string {
+func (x *RemoveProductListItemResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductAttributeResponse) ProtoMessage() {}
+func (*RemoveProductListItemResponse) ProtoMessage() {}
 
-func (x *UpdateProductAttributeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[41]
+func (x *RemoveProductListItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2490,41 +6565,42 @@ func (x *UpdateProductAttributeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductAttributeResponse.ProtoReflect.Descriptor instead.
-func (*UpdateProductAttributeResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use RemoveProductListItemResponse.ProtoReflect.Descriptor instead.
+func (*RemoveProductListItemResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *UpdateProductAttributeResponse) GetAttribute() *ProductAttribute {
+func (x *RemoveProductListItemResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Attribute
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type DeleteProductAttributeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type CompareProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// When empty, the caller's comparison list is used.
+	ProductIds      []string `protobuf:"bytes,1,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	DifferencesOnly bool     `protobuf:"varint,2,opt,name=differences_only,json=differencesOnly,proto3" json:"differences_only,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *DeleteProductAttributeRequest) Reset() {
-	*x = DeleteProductAttributeRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[42]
+func (x *CompareProductsRequest) Reset() {
+	*x = CompareProductsRequest{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductAttributeRequest) String() string {
+func (x *CompareProductsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductAttributeRequest) ProtoMessage() {}
+func (*CompareProductsRequest) ProtoMessage() {}
 
-func (x *DeleteProductAttributeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[42]
+func (x *CompareProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2535,47 +6611,48 @@ func (x *DeleteProductAttributeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductAttributeRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductAttributeRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use CompareProductsRequest.ProtoReflect.Descriptor instead.
+func (*CompareProductsRequest) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *DeleteProductAttributeRequest) GetProductId() string {
+func (x *CompareProductsRequest) GetProductIds() []string {
 	if x != nil {
-		return x.ProductId
+		return x.ProductIds
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteProductAttributeRequest) GetId() string {
+func (x *CompareProductsRequest) GetDifferencesOnly() bool {
 	if x != nil {
-		return x.Id
+		return x.DifferencesOnly
 	}
-	return ""
+	return false
 }
 
-type DeleteProductAttributeResponse struct {
+type CompareProductsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Rows          []*ComparisonRow       `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductAttributeResponse) Reset() {
-	*x = DeleteProductAttributeResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[43]
+func (x *CompareProductsResponse) Reset() {
+	*x = CompareProductsResponse{}
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductAttributeResponse) String() string {
+func (x *CompareProductsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductAttributeResponse) ProtoMessage() {}
+func (*CompareProductsResponse) ProtoMessage() {}
 
-func (x *DeleteProductAttributeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[43]
+func (x *CompareProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2586,16 +6663,23 @@ func (x *DeleteProductAttributeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductAttributeResponse.ProtoReflect.Descriptor instead.
-func (*DeleteProductAttributeResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use CompareProductsResponse.ProtoReflect.Descriptor instead.
+func (*CompareProductsResponse) Descriptor() ([]byte, []int) {
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *DeleteProductAttributeResponse) GetSuccess() bool {
+func (x *CompareProductsResponse) GetProducts() []*Product {
 	if x != nil {
-		return x.Success
+		return x.Products
 	}
-	return false
+	return nil
+}
+
+func (x *CompareProductsResponse) GetRows() []*ComparisonRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
 }
 
 type Brand struct {
@@ -2613,7 +6697,7 @@ type Brand struct {
 
 func (x *Brand) Reset() {
 	*x = Brand{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[44]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2625,7 +6709,7 @@ func (x *Brand) String() string {
 func (*Brand) ProtoMessage() {}
 
 func (x *Brand) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[44]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2638,7 +6722,7 @@ func (x *Brand) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Brand.ProtoReflect.Descriptor instead.
 func (*Brand) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{44}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{111}
 }
 
 func (x *Brand) GetId() string {
@@ -2699,7 +6783,7 @@ type ListBrandsRequest struct {
 
 func (x *ListBrandsRequest) Reset() {
 	*x = ListBrandsRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[45]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[112]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2711,7 +6795,7 @@ func (x *ListBrandsRequest) String() string {
 func (*ListBrandsRequest) ProtoMessage() {}
 
 func (x *ListBrandsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[45]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[112]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2724,7 +6808,7 @@ func (x *ListBrandsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBrandsRequest.ProtoReflect.Descriptor instead.
 func (*ListBrandsRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{45}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{112}
 }
 
 func (x *ListBrandsRequest) GetIncludeInactive() bool {
@@ -2743,7 +6827,7 @@ type ListBrandsResponse struct {
 
 func (x *ListBrandsResponse) Reset() {
 	*x = ListBrandsResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[46]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2755,7 +6839,7 @@ func (x *ListBrandsResponse) String() string {
 func (*ListBrandsResponse) ProtoMessage() {}
 
 func (x *ListBrandsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[46]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2768,7 +6852,7 @@ func (x *ListBrandsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBrandsResponse.ProtoReflect.Descriptor instead.
 func (*ListBrandsResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{46}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{113}
 }
 
 func (x *ListBrandsResponse) GetBrands() []*Brand {
@@ -2787,7 +6871,7 @@ type GetBrandRequest struct {
 
 func (x *GetBrandRequest) Reset() {
 	*x = GetBrandRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[47]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2799,7 +6883,7 @@ func (x *GetBrandRequest) String() string {
 func (*GetBrandRequest) ProtoMessage() {}
 
 func (x *GetBrandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[47]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2812,7 +6896,7 @@ func (x *GetBrandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBrandRequest.ProtoReflect.Descriptor instead.
 func (*GetBrandRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{47}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{114}
 }
 
 func (x *GetBrandRequest) GetId() string {
@@ -2831,7 +6915,7 @@ type GetBrandResponse struct {
 
 func (x *GetBrandResponse) Reset() {
 	*x = GetBrandResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[48]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2843,7 +6927,7 @@ func (x *GetBrandResponse) String() string {
 func (*GetBrandResponse) ProtoMessage() {}
 
 func (x *GetBrandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[48]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2856,7 +6940,7 @@ func (x *GetBrandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBrandResponse.ProtoReflect.Descriptor instead.
 func (*GetBrandResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{48}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{115}
 }
 
 func (x *GetBrandResponse) GetBrand() *Brand {
@@ -2878,7 +6962,7 @@ type CreateBrandRequest struct {
 
 func (x *CreateBrandRequest) Reset() {
 	*x = CreateBrandRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[49]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2890,7 +6974,7 @@ func (x *CreateBrandRequest) String() string {
 func (*CreateBrandRequest) ProtoMessage() {}
 
 func (x *CreateBrandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[49]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2903,7 +6987,7 @@ func (x *CreateBrandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBrandRequest.ProtoReflect.Descriptor instead.
 func (*CreateBrandRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{49}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{116}
 }
 
 func (x *CreateBrandRequest) GetName() string {
@@ -2943,7 +7027,7 @@ type CreateBrandResponse struct {
 
 func (x *CreateBrandResponse) Reset() {
 	*x = CreateBrandResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[50]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2955,7 +7039,7 @@ func (x *CreateBrandResponse) String() string {
 func (*CreateBrandResponse) ProtoMessage() {}
 
 func (x *CreateBrandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[50]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2968,7 +7052,7 @@ func (x *CreateBrandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBrandResponse.ProtoReflect.Descriptor instead.
 func (*CreateBrandResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{50}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{117}
 }
 
 func (x *CreateBrandResponse) GetBrand() *Brand {
@@ -2991,7 +7075,7 @@ type UpdateBrandRequest struct {
 
 func (x *UpdateBrandRequest) Reset() {
 	*x = UpdateBrandRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[51]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3003,7 +7087,7 @@ func (x *UpdateBrandRequest) String() string {
 func (*UpdateBrandRequest) ProtoMessage() {}
 
 func (x *UpdateBrandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[51]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3016,7 +7100,7 @@ func (x *UpdateBrandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBrandRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBrandRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{51}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{118}
 }
 
 func (x *UpdateBrandRequest) GetId() string {
@@ -3063,7 +7147,7 @@ type UpdateBrandResponse struct {
 
 func (x *UpdateBrandResponse) Reset() {
 	*x = UpdateBrandResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[52]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3075,7 +7159,7 @@ func (x *UpdateBrandResponse) String() string {
 func (*UpdateBrandResponse) ProtoMessage() {}
 
 func (x *UpdateBrandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[52]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3088,7 +7172,7 @@ func (x *UpdateBrandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBrandResponse.ProtoReflect.Descriptor instead.
 func (*UpdateBrandResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{52}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{119}
 }
 
 func (x *UpdateBrandResponse) GetBrand() *Brand {
@@ -3107,7 +7191,7 @@ type DeleteBrandRequest struct {
 
 func (x *DeleteBrandRequest) Reset() {
 	*x = DeleteBrandRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[53]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3119,7 +7203,7 @@ func (x *DeleteBrandRequest) String() string {
 func (*DeleteBrandRequest) ProtoMessage() {}
 
 func (x *DeleteBrandRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[53]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3132,7 +7216,7 @@ func (x *DeleteBrandRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBrandRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBrandRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{53}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{120}
 }
 
 func (x *DeleteBrandRequest) GetId() string {
@@ -3151,7 +7235,7 @@ type DeleteBrandResponse struct {
 
 func (x *DeleteBrandResponse) Reset() {
 	*x = DeleteBrandResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[54]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3163,7 +7247,7 @@ func (x *DeleteBrandResponse) String() string {
 func (*DeleteBrandResponse) ProtoMessage() {}
 
 func (x *DeleteBrandResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[54]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3176,7 +7260,7 @@ func (x *DeleteBrandResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBrandResponse.ProtoReflect.Descriptor instead.
 func (*DeleteBrandResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{54}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{121}
 }
 
 func (x *DeleteBrandResponse) GetSuccess() bool {
@@ -3202,7 +7286,7 @@ type Supplier struct {
 
 func (x *Supplier) Reset() {
 	*x = Supplier{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[55]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3214,7 +7298,7 @@ func (x *Supplier) String() string {
 func (*Supplier) ProtoMessage() {}
 
 func (x *Supplier) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[55]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3227,7 +7311,7 @@ func (x *Supplier) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Supplier.ProtoReflect.Descriptor instead.
 func (*Supplier) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{55}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *Supplier) GetId() int64 {
@@ -3288,13 +7372,16 @@ func (x *Supplier) GetUpdatedAt() string {
 
 type ListSuppliersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListSuppliersRequest) Reset() {
 	*x = ListSuppliersRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[56]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3306,7 +7393,7 @@ func (x *ListSuppliersRequest) String() string {
 func (*ListSuppliersRequest) ProtoMessage() {}
 
 func (x *ListSuppliersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[56]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3319,19 +7406,44 @@ func (x *ListSuppliersRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSuppliersRequest.ProtoReflect.Descriptor instead.
 func (*ListSuppliersRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{56}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *ListSuppliersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSuppliersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSuppliersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
 }
 
 type ListSuppliersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Suppliers     []*Supplier            `protobuf:"bytes,1,rep,name=suppliers,proto3" json:"suppliers,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListSuppliersResponse) Reset() {
 	*x = ListSuppliersResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[57]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3343,7 +7455,7 @@ func (x *ListSuppliersResponse) String() string {
 func (*ListSuppliersResponse) ProtoMessage() {}
 
 func (x *ListSuppliersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[57]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3356,7 +7468,7 @@ func (x *ListSuppliersResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSuppliersResponse.ProtoReflect.Descriptor instead.
 func (*ListSuppliersResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{57}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{124}
 }
 
 func (x *ListSuppliersResponse) GetSuppliers() []*Supplier {
@@ -3366,6 +7478,34 @@ func (x *ListSuppliersResponse) GetSuppliers() []*Supplier {
 	return nil
 }
 
+func (x *ListSuppliersResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListSuppliersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSuppliersResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSuppliersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetSupplierRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3375,7 +7515,7 @@ type GetSupplierRequest struct {
 
 func (x *GetSupplierRequest) Reset() {
 	*x = GetSupplierRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[58]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3387,7 +7527,7 @@ func (x *GetSupplierRequest) String() string {
 func (*GetSupplierRequest) ProtoMessage() {}
 
 func (x *GetSupplierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[58]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3400,7 +7540,7 @@ func (x *GetSupplierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSupplierRequest.ProtoReflect.Descriptor instead.
 func (*GetSupplierRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{58}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{125}
 }
 
 func (x *GetSupplierRequest) GetId() int64 {
@@ -3419,7 +7559,7 @@ type GetSupplierResponse struct {
 
 func (x *GetSupplierResponse) Reset() {
 	*x = GetSupplierResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[59]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3431,7 +7571,7 @@ func (x *GetSupplierResponse) String() string {
 func (*GetSupplierResponse) ProtoMessage() {}
 
 func (x *GetSupplierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[59]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3444,7 +7584,7 @@ func (x *GetSupplierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSupplierResponse.ProtoReflect.Descriptor instead.
 func (*GetSupplierResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{59}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{126}
 }
 
 func (x *GetSupplierResponse) GetSupplier() *Supplier {
@@ -3467,7 +7607,7 @@ type CreateSupplierRequest struct {
 
 func (x *CreateSupplierRequest) Reset() {
 	*x = CreateSupplierRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[60]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3479,7 +7619,7 @@ func (x *CreateSupplierRequest) String() string {
 func (*CreateSupplierRequest) ProtoMessage() {}
 
 func (x *CreateSupplierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[60]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3492,7 +7632,7 @@ func (x *CreateSupplierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSupplierRequest.ProtoReflect.Descriptor instead.
 func (*CreateSupplierRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{60}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{127}
 }
 
 func (x *CreateSupplierRequest) GetName() string {
@@ -3539,7 +7679,7 @@ type CreateSupplierResponse struct {
 
 func (x *CreateSupplierResponse) Reset() {
 	*x = CreateSupplierResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[61]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3551,7 +7691,7 @@ func (x *CreateSupplierResponse) String() string {
 func (*CreateSupplierResponse) ProtoMessage() {}
 
 func (x *CreateSupplierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[61]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3564,7 +7704,7 @@ func (x *CreateSupplierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSupplierResponse.ProtoReflect.Descriptor instead.
 func (*CreateSupplierResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{61}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{128}
 }
 
 func (x *CreateSupplierResponse) GetSupplier() *Supplier {
@@ -3588,7 +7728,7 @@ type UpdateSupplierRequest struct {
 
 func (x *UpdateSupplierRequest) Reset() {
 	*x = UpdateSupplierRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[62]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3600,7 +7740,7 @@ func (x *UpdateSupplierRequest) String() string {
 func (*UpdateSupplierRequest) ProtoMessage() {}
 
 func (x *UpdateSupplierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[62]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3613,7 +7753,7 @@ func (x *UpdateSupplierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSupplierRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSupplierRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{62}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{129}
 }
 
 func (x *UpdateSupplierRequest) GetId() int64 {
@@ -3667,7 +7807,7 @@ type UpdateSupplierResponse struct {
 
 func (x *UpdateSupplierResponse) Reset() {
 	*x = UpdateSupplierResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[63]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3679,7 +7819,7 @@ func (x *UpdateSupplierResponse) String() string {
 func (*UpdateSupplierResponse) ProtoMessage() {}
 
 func (x *UpdateSupplierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[63]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3692,7 +7832,7 @@ func (x *UpdateSupplierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSupplierResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSupplierResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{63}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{130}
 }
 
 func (x *UpdateSupplierResponse) GetSupplier() *Supplier {
@@ -3711,7 +7851,7 @@ type DeleteSupplierRequest struct {
 
 func (x *DeleteSupplierRequest) Reset() {
 	*x = DeleteSupplierRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[64]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3723,7 +7863,7 @@ func (x *DeleteSupplierRequest) String() string {
 func (*DeleteSupplierRequest) ProtoMessage() {}
 
 func (x *DeleteSupplierRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[64]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3736,7 +7876,7 @@ func (x *DeleteSupplierRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSupplierRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSupplierRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{64}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{131}
 }
 
 func (x *DeleteSupplierRequest) GetId() int64 {
@@ -3755,7 +7895,7 @@ type DeleteSupplierResponse struct {
 
 func (x *DeleteSupplierResponse) Reset() {
 	*x = DeleteSupplierResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[65]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3767,7 +7907,7 @@ func (x *DeleteSupplierResponse) String() string {
 func (*DeleteSupplierResponse) ProtoMessage() {}
 
 func (x *DeleteSupplierResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[65]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3780,7 +7920,7 @@ func (x *DeleteSupplierResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSupplierResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSupplierResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{65}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{132}
 }
 
 func (x *DeleteSupplierResponse) GetSuccess() bool {
@@ -3806,7 +7946,7 @@ type SupplierCategoryMapping struct {
 
 func (x *SupplierCategoryMapping) Reset() {
 	*x = SupplierCategoryMapping{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[66]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3818,7 +7958,7 @@ func (x *SupplierCategoryMapping) String() string {
 func (*SupplierCategoryMapping) ProtoMessage() {}
 
 func (x *SupplierCategoryMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[66]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3831,7 +7971,7 @@ func (x *SupplierCategoryMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SupplierCategoryMapping.ProtoReflect.Descriptor instead.
 func (*SupplierCategoryMapping) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{66}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{133}
 }
 
 func (x *SupplierCategoryMapping) GetId() string {
@@ -3894,13 +8034,16 @@ type ListSupplierCategoryMappingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SupplierId    int64                  `protobuf:"varint,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
 	CategoryId    string                 `protobuf:"bytes,2,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListSupplierCategoryMappingsRequest) Reset() {
 	*x = ListSupplierCategoryMappingsRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[67]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3912,7 +8055,7 @@ func (x *ListSupplierCategoryMappingsRequest) String() string {
 func (*ListSupplierCategoryMappingsRequest) ProtoMessage() {}
 
 func (x *ListSupplierCategoryMappingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[67]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3925,7 +8068,7 @@ func (x *ListSupplierCategoryMappingsRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ListSupplierCategoryMappingsRequest.ProtoReflect.Descriptor instead.
 func (*ListSupplierCategoryMappingsRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{67}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{134}
 }
 
 func (x *ListSupplierCategoryMappingsRequest) GetSupplierId() int64 {
@@ -3942,16 +8085,41 @@ func (x *ListSupplierCategoryMappingsRequest) GetCategoryId() string {
 	return ""
 }
 
+func (x *ListSupplierCategoryMappingsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSupplierCategoryMappingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSupplierCategoryMappingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListSupplierCategoryMappingsResponse struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
 	Mappings      []*SupplierCategoryMapping `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	Total         int32                      `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                      `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                      `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken string                     `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListSupplierCategoryMappingsResponse) Reset() {
 	*x = ListSupplierCategoryMappingsResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[68]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3963,7 +8131,7 @@ func (x *ListSupplierCategoryMappingsResponse) String() string {
 func (*ListSupplierCategoryMappingsResponse) ProtoMessage() {}
 
 func (x *ListSupplierCategoryMappingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[68]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3976,7 +8144,7 @@ func (x *ListSupplierCategoryMappingsResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use ListSupplierCategoryMappingsResponse.ProtoReflect.Descriptor instead.
 func (*ListSupplierCategoryMappingsResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{68}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{135}
 }
 
 func (x *ListSupplierCategoryMappingsResponse) GetMappings() []*SupplierCategoryMapping {
@@ -3986,6 +8154,34 @@ func (x *ListSupplierCategoryMappingsResponse) GetMappings() []*SupplierCategory
 	return nil
 }
 
+func (x *ListSupplierCategoryMappingsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListSupplierCategoryMappingsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSupplierCategoryMappingsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSupplierCategoryMappingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetSupplierCategoryMappingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -3995,7 +8191,7 @@ type GetSupplierCategoryMappingRequest struct {
 
 func (x *GetSupplierCategoryMappingRequest) Reset() {
 	*x = GetSupplierCategoryMappingRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[69]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4007,7 +8203,7 @@ func (x *GetSupplierCategoryMappingRequest) String() string {
 func (*GetSupplierCategoryMappingRequest) ProtoMessage() {}
 
 func (x *GetSupplierCategoryMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[69]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4020,7 +8216,7 @@ func (x *GetSupplierCategoryMappingRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetSupplierCategoryMappingRequest.ProtoReflect.Descriptor instead.
 func (*GetSupplierCategoryMappingRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{69}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{136}
 }
 
 func (x *GetSupplierCategoryMappingRequest) GetId() string {
@@ -4039,7 +8235,7 @@ type GetSupplierCategoryMappingResponse struct {
 
 func (x *GetSupplierCategoryMappingResponse) Reset() {
 	*x = GetSupplierCategoryMappingResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[70]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4051,7 +8247,7 @@ func (x *GetSupplierCategoryMappingResponse) String() string {
 func (*GetSupplierCategoryMappingResponse) ProtoMessage() {}
 
 func (x *GetSupplierCategoryMappingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[70]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4064,7 +8260,7 @@ func (x *GetSupplierCategoryMappingResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetSupplierCategoryMappingResponse.ProtoReflect.Descriptor instead.
 func (*GetSupplierCategoryMappingResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{70}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{137}
 }
 
 func (x *GetSupplierCategoryMappingResponse) GetMapping() *SupplierCategoryMapping {
@@ -4087,7 +8283,7 @@ type CreateSupplierCategoryMappingRequest struct {
 
 func (x *CreateSupplierCategoryMappingRequest) Reset() {
 	*x = CreateSupplierCategoryMappingRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[71]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[138]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4099,7 +8295,7 @@ func (x *CreateSupplierCategoryMappingRequest) String() string {
 func (*CreateSupplierCategoryMappingRequest) ProtoMessage() {}
 
 func (x *CreateSupplierCategoryMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[71]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[138]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4112,7 +8308,7 @@ func (x *CreateSupplierCategoryMappingRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use CreateSupplierCategoryMappingRequest.ProtoReflect.Descriptor instead.
 func (*CreateSupplierCategoryMappingRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{71}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{138}
 }
 
 func (x *CreateSupplierCategoryMappingRequest) GetCategoryId() string {
@@ -4159,7 +8355,7 @@ type CreateSupplierCategoryMappingResponse struct {
 
 func (x *CreateSupplierCategoryMappingResponse) Reset() {
 	*x = CreateSupplierCategoryMappingResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[72]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[139]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4171,7 +8367,7 @@ func (x *CreateSupplierCategoryMappingResponse) String() string {
 func (*CreateSupplierCategoryMappingResponse) ProtoMessage() {}
 
 func (x *CreateSupplierCategoryMappingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[72]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[139]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4184,7 +8380,7 @@ func (x *CreateSupplierCategoryMappingResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use CreateSupplierCategoryMappingResponse.ProtoReflect.Descriptor instead.
 func (*CreateSupplierCategoryMappingResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{72}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{139}
 }
 
 func (x *CreateSupplierCategoryMappingResponse) GetMapping() *SupplierCategoryMapping {
@@ -4208,7 +8404,7 @@ type UpdateSupplierCategoryMappingRequest struct {
 
 func (x *UpdateSupplierCategoryMappingRequest) Reset() {
 	*x = UpdateSupplierCategoryMappingRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[73]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4220,7 +8416,7 @@ func (x *UpdateSupplierCategoryMappingRequest) String() string {
 func (*UpdateSupplierCategoryMappingRequest) ProtoMessage() {}
 
 func (x *UpdateSupplierCategoryMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[73]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4233,7 +8429,7 @@ func (x *UpdateSupplierCategoryMappingRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use UpdateSupplierCategoryMappingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSupplierCategoryMappingRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{73}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{140}
 }
 
 func (x *UpdateSupplierCategoryMappingRequest) GetId() string {
@@ -4287,7 +8483,7 @@ type UpdateSupplierCategoryMappingResponse struct {
 
 func (x *UpdateSupplierCategoryMappingResponse) Reset() {
 	*x = UpdateSupplierCategoryMappingResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[74]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4299,7 +8495,7 @@ func (x *UpdateSupplierCategoryMappingResponse) String() string {
 func (*UpdateSupplierCategoryMappingResponse) ProtoMessage() {}
 
 func (x *UpdateSupplierCategoryMappingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[74]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4312,7 +8508,7 @@ func (x *UpdateSupplierCategoryMappingResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use UpdateSupplierCategoryMappingResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSupplierCategoryMappingResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{74}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{141}
 }
 
 func (x *UpdateSupplierCategoryMappingResponse) GetMapping() *SupplierCategoryMapping {
@@ -4331,7 +8527,7 @@ type DeleteSupplierCategoryMappingRequest struct {
 
 func (x *DeleteSupplierCategoryMappingRequest) Reset() {
 	*x = DeleteSupplierCategoryMappingRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[75]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[142]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4343,7 +8539,7 @@ func (x *DeleteSupplierCategoryMappingRequest) String() string {
 func (*DeleteSupplierCategoryMappingRequest) ProtoMessage() {}
 
 func (x *DeleteSupplierCategoryMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[75]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[142]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4356,7 +8552,7 @@ func (x *DeleteSupplierCategoryMappingRequest) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use DeleteSupplierCategoryMappingRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSupplierCategoryMappingRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{75}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{142}
 }
 
 func (x *DeleteSupplierCategoryMappingRequest) GetId() string {
@@ -4375,7 +8571,7 @@ type DeleteSupplierCategoryMappingResponse struct {
 
 func (x *DeleteSupplierCategoryMappingResponse) Reset() {
 	*x = DeleteSupplierCategoryMappingResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[76]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4387,7 +8583,7 @@ func (x *DeleteSupplierCategoryMappingResponse) String() string {
 func (*DeleteSupplierCategoryMappingResponse) ProtoMessage() {}
 
 func (x *DeleteSupplierCategoryMappingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[76]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4400,7 +8596,7 @@ func (x *DeleteSupplierCategoryMappingResponse) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use DeleteSupplierCategoryMappingResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSupplierCategoryMappingResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{76}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{143}
 }
 
 func (x *DeleteSupplierCategoryMappingResponse) GetSuccess() bool {
@@ -4427,7 +8623,7 @@ type SupplierProductMapping struct {
 
 func (x *SupplierProductMapping) Reset() {
 	*x = SupplierProductMapping{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[77]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[144]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4439,7 +8635,7 @@ func (x *SupplierProductMapping) String() string {
 func (*SupplierProductMapping) ProtoMessage() {}
 
 func (x *SupplierProductMapping) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[77]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[144]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4452,7 +8648,7 @@ func (x *SupplierProductMapping) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SupplierProductMapping.ProtoReflect.Descriptor instead.
 func (*SupplierProductMapping) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{77}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{144}
 }
 
 func (x *SupplierProductMapping) GetId() string {
@@ -4522,13 +8718,16 @@ type ListSupplierProductMappingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SupplierId    int64                  `protobuf:"varint,1,opt,name=supplier_id,json=supplierId,proto3" json:"supplier_id,omitempty"`
 	ProductId     string                 `protobuf:"bytes,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	PageToken     string                 `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListSupplierProductMappingsRequest) Reset() {
 	*x = ListSupplierProductMappingsRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[78]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[145]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4540,7 +8739,7 @@ func (x *ListSupplierProductMappingsRequest) String() string {
 func (*ListSupplierProductMappingsRequest) ProtoMessage() {}
 
 func (x *ListSupplierProductMappingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[78]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[145]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4553,7 +8752,7 @@ func (x *ListSupplierProductMappingsRequest) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use ListSupplierProductMappingsRequest.ProtoReflect.Descriptor instead.
 func (*ListSupplierProductMappingsRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{78}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{145}
 }
 
 func (x *ListSupplierProductMappingsRequest) GetSupplierId() int64 {
@@ -4570,16 +8769,41 @@ func (x *ListSupplierProductMappingsRequest) GetProductId() string {
 	return ""
 }
 
+func (x *ListSupplierProductMappingsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSupplierProductMappingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSupplierProductMappingsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
 type ListSupplierProductMappingsResponse struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	Mappings      []*SupplierProductMapping `protobuf:"bytes,1,rep,name=mappings,proto3" json:"mappings,omitempty"`
+	Total         int32                     `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                     `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                     `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	NextPageToken string                    `protobuf:"bytes,5,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListSupplierProductMappingsResponse) Reset() {
 	*x = ListSupplierProductMappingsResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[79]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[146]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4591,7 +8815,7 @@ func (x *ListSupplierProductMappingsResponse) String() string {
 func (*ListSupplierProductMappingsResponse) ProtoMessage() {}
 
 func (x *ListSupplierProductMappingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[79]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[146]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4604,7 +8828,7 @@ func (x *ListSupplierProductMappingsResponse) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use ListSupplierProductMappingsResponse.ProtoReflect.Descriptor instead.
 func (*ListSupplierProductMappingsResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{79}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{146}
 }
 
 func (x *ListSupplierProductMappingsResponse) GetMappings() []*SupplierProductMapping {
@@ -4614,6 +8838,34 @@ func (x *ListSupplierProductMappingsResponse) GetMappings() []*SupplierProductMa
 	return nil
 }
 
+func (x *ListSupplierProductMappingsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListSupplierProductMappingsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListSupplierProductMappingsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSupplierProductMappingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
 type GetSupplierProductMappingRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -4623,7 +8875,7 @@ type GetSupplierProductMappingRequest struct {
 
 func (x *GetSupplierProductMappingRequest) Reset() {
 	*x = GetSupplierProductMappingRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[80]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[147]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4635,7 +8887,7 @@ func (x *GetSupplierProductMappingRequest) String() string {
 func (*GetSupplierProductMappingRequest) ProtoMessage() {}
 
 func (x *GetSupplierProductMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[80]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[147]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4648,7 +8900,7 @@ func (x *GetSupplierProductMappingRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSupplierProductMappingRequest.ProtoReflect.Descriptor instead.
 func (*GetSupplierProductMappingRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{80}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{147}
 }
 
 func (x *GetSupplierProductMappingRequest) GetId() string {
@@ -4667,7 +8919,7 @@ type GetSupplierProductMappingResponse struct {
 
 func (x *GetSupplierProductMappingResponse) Reset() {
 	*x = GetSupplierProductMappingResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[81]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[148]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4679,7 +8931,7 @@ func (x *GetSupplierProductMappingResponse) String() string {
 func (*GetSupplierProductMappingResponse) ProtoMessage() {}
 
 func (x *GetSupplierProductMappingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[81]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[148]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4692,7 +8944,7 @@ func (x *GetSupplierProductMappingResponse) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use GetSupplierProductMappingResponse.ProtoReflect.Descriptor instead.
 func (*GetSupplierProductMappingResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{81}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{148}
 }
 
 func (x *GetSupplierProductMappingResponse) GetMapping() *SupplierProductMapping {
@@ -4716,7 +8968,7 @@ type CreateSupplierProductMappingRequest struct {
 
 func (x *CreateSupplierProductMappingRequest) Reset() {
 	*x = CreateSupplierProductMappingRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[82]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[149]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4728,7 +8980,7 @@ func (x *CreateSupplierProductMappingRequest) String() string {
 func (*CreateSupplierProductMappingRequest) ProtoMessage() {}
 
 func (x *CreateSupplierProductMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[82]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[149]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4741,7 +8993,7 @@ func (x *CreateSupplierProductMappingRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use CreateSupplierProductMappingRequest.ProtoReflect.Descriptor instead.
 func (*CreateSupplierProductMappingRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{82}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{149}
 }
 
 func (x *CreateSupplierProductMappingRequest) GetProductId() string {
@@ -4795,7 +9047,7 @@ type CreateSupplierProductMappingResponse struct {
 
 func (x *CreateSupplierProductMappingResponse) Reset() {
 	*x = CreateSupplierProductMappingResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[83]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[150]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4807,7 +9059,7 @@ func (x *CreateSupplierProductMappingResponse) String() string {
 func (*CreateSupplierProductMappingResponse) ProtoMessage() {}
 
 func (x *CreateSupplierProductMappingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[83]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[150]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4820,7 +9072,7 @@ func (x *CreateSupplierProductMappingResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use CreateSupplierProductMappingResponse.ProtoReflect.Descriptor instead.
 func (*CreateSupplierProductMappingResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{83}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{150}
 }
 
 func (x *CreateSupplierProductMappingResponse) GetMapping() *SupplierProductMapping {
@@ -4845,7 +9097,7 @@ type UpdateSupplierProductMappingRequest struct {
 
 func (x *UpdateSupplierProductMappingRequest) Reset() {
 	*x = UpdateSupplierProductMappingRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[84]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[151]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4857,7 +9109,7 @@ func (x *UpdateSupplierProductMappingRequest) String() string {
 func (*UpdateSupplierProductMappingRequest) ProtoMessage() {}
 
 func (x *UpdateSupplierProductMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[84]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[151]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4870,7 +9122,7 @@ func (x *UpdateSupplierProductMappingRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UpdateSupplierProductMappingRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSupplierProductMappingRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{84}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{151}
 }
 
 func (x *UpdateSupplierProductMappingRequest) GetId() string {
@@ -4931,7 +9183,7 @@ type UpdateSupplierProductMappingResponse struct {
 
 func (x *UpdateSupplierProductMappingResponse) Reset() {
 	*x = UpdateSupplierProductMappingResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[85]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[152]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4943,7 +9195,7 @@ func (x *UpdateSupplierProductMappingResponse) String() string {
 func (*UpdateSupplierProductMappingResponse) ProtoMessage() {}
 
 func (x *UpdateSupplierProductMappingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[85]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[152]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4956,7 +9208,7 @@ func (x *UpdateSupplierProductMappingResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use UpdateSupplierProductMappingResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSupplierProductMappingResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{85}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{152}
 }
 
 func (x *UpdateSupplierProductMappingResponse) GetMapping() *SupplierProductMapping {
@@ -4975,7 +9227,7 @@ type DeleteSupplierProductMappingRequest struct {
 
 func (x *DeleteSupplierProductMappingRequest) Reset() {
 	*x = DeleteSupplierProductMappingRequest{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[86]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[153]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4987,7 +9239,7 @@ func (x *DeleteSupplierProductMappingRequest) String() string {
 func (*DeleteSupplierProductMappingRequest) ProtoMessage() {}
 
 func (x *DeleteSupplierProductMappingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[86]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[153]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5000,7 +9252,7 @@ func (x *DeleteSupplierProductMappingRequest) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use DeleteSupplierProductMappingRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSupplierProductMappingRequest) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{86}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{153}
 }
 
 func (x *DeleteSupplierProductMappingRequest) GetId() string {
@@ -5019,7 +9271,7 @@ type DeleteSupplierProductMappingResponse struct {
 
 func (x *DeleteSupplierProductMappingResponse) Reset() {
 	*x = DeleteSupplierProductMappingResponse{}
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[87]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[154]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5031,7 +9283,7 @@ func (x *DeleteSupplierProductMappingResponse) String() string {
 func (*DeleteSupplierProductMappingResponse) ProtoMessage() {}
 
 func (x *DeleteSupplierProductMappingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_catalog_v1_catalog_service_proto_msgTypes[87]
+	mi := &file_catalog_v1_catalog_service_proto_msgTypes[154]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5044,7 +9296,7 @@ func (x *DeleteSupplierProductMappingResponse) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use DeleteSupplierProductMappingResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSupplierProductMappingResponse) Descriptor() ([]byte, []int) {
-	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{87}
+	return file_catalog_v1_catalog_service_proto_rawDescGZIP(), []int{154}
 }
 
 func (x *DeleteSupplierProductMappingResponse) GetSuccess() bool {
@@ -5120,7 +9372,7 @@ const file_catalog_v1_catalog_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\tR\tupdatedAt\"\xc0\x03\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\"\xd0\x04\n" +
 	"\aProduct\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\tR\n" +
@@ -5143,7 +9395,195 @@ const file_catalog_v1_catalog_service_proto_rawDesc = "" +
 	"\bbrand_id\x18\r \x01(\tR\abrandId\x12<\n" +
 	"\n" +
 	"attributes\x18\x0e \x03(\v2\x1c.catalog.v1.ProductAttributeR\n" +
-	"attributes\"\xce\x01\n" +
+	"attributes\x128\n" +
+	"\trelations\x18\x0f \x03(\v2\x1a.catalog.v1.RelatedProductR\trelations\x12\x12\n" +
+	"\x04kind\x18\x10 \x01(\tR\x04kind\x12\x1d\n" +
+	"\n" +
+	"rating_avg\x18\x11 \x01(\x01R\tratingAvg\x12!\n" +
+	"\frating_count\x18\x12 \x01(\x05R\vratingCount\"\xc6\x02\n" +
+	"\x06Review\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06rating\x18\x04 \x01(\x05R\x06rating\x12\x12\n" +
+	"\x04pros\x18\x05 \x01(\tR\x04pros\x12\x12\n" +
+	"\x04cons\x18\x06 \x01(\tR\x04cons\x12\x12\n" +
+	"\x04text\x18\a \x01(\tR\x04text\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12'\n" +
+	"\x0fmoderation_note\x18\t \x01(\tR\x0emoderationNote\x12!\n" +
+	"\fmoderated_at\x18\n" +
+	" \x01(\tR\vmoderatedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\tR\tupdatedAt\"\x88\x02\n" +
+	"\x06Answer\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12'\n" +
+	"\x0fmoderation_note\x18\x06 \x01(\tR\x0emoderationNote\x12!\n" +
+	"\fmoderated_at\x18\a \x01(\tR\vmoderatedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"\xb6\x02\n" +
+	"\bQuestion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04text\x18\x04 \x01(\tR\x04text\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12'\n" +
+	"\x0fmoderation_note\x18\x06 \x01(\tR\x0emoderationNote\x12!\n" +
+	"\fmoderated_at\x18\a \x01(\tR\vmoderatedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\x12,\n" +
+	"\aanswers\x18\n" +
+	" \x03(\v2\x12.catalog.v1.AnswerR\aanswers\"\xa4\x01\n" +
+	"\x1bListProductQuestionsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"\xc1\x01\n" +
+	"\x1cListProductQuestionsResponse\x122\n" +
+	"\tquestions\x18\x01 \x03(\v2\x14.catalog.v1.QuestionR\tquestions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"\x9d\x01\n" +
+	"\x14ListQuestionsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\xba\x01\n" +
+	"\x15ListQuestionsResponse\x122\n" +
+	"\tquestions\x18\x01 \x03(\v2\x14.catalog.v1.QuestionR\tquestions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"N\n" +
+	"\x19AskProductQuestionRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"N\n" +
+	"\x1aAskProductQuestionResponse\x120\n" +
+	"\bquestion\x18\x01 \x01(\v2\x14.catalog.v1.QuestionR\bquestion\"r\n" +
+	"\x1cAnswerProductQuestionRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12\x12\n" +
+	"\x04text\x18\x03 \x01(\tR\x04text\"K\n" +
+	"\x1dAnswerProductQuestionResponse\x12*\n" +
+	"\x06answer\x18\x01 \x01(\v2\x12.catalog.v1.AnswerR\x06answer\"\x90\x01\n" +
+	"\x1eModerateProductQuestionRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12'\n" +
+	"\x0fmoderation_note\x18\x04 \x01(\tR\x0emoderationNote\"S\n" +
+	"\x1fModerateProductQuestionResponse\x120\n" +
+	"\bquestion\x18\x01 \x01(\v2\x14.catalog.v1.QuestionR\bquestion\"M\n" +
+	"\x1cDeleteProductQuestionRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"9\n" +
+	"\x1dDeleteProductQuestionResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xaf\x01\n" +
+	"\x1cModerateProductAnswerRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12'\n" +
+	"\x0fmoderation_note\x18\x05 \x01(\tR\x0emoderationNote\"K\n" +
+	"\x1dModerateProductAnswerResponse\x12*\n" +
+	"\x06answer\x18\x01 \x01(\v2\x12.catalog.v1.AnswerR\x06answer\"l\n" +
+	"\x1aDeleteProductAnswerRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1f\n" +
+	"\vquestion_id\x18\x02 \x01(\tR\n" +
+	"questionId\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\"7\n" +
+	"\x1bDeleteProductAnswerResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xa2\x01\n" +
+	"\x19ListProductReviewsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\"\xb9\x01\n" +
+	"\x1aListProductReviewsResponse\x12,\n" +
+	"\areviews\x18\x01 \x03(\v2\x12.catalog.v1.ReviewR\areviews\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"\x8f\x01\n" +
+	"\x1aCreateProductReviewRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x16\n" +
+	"\x06rating\x18\x02 \x01(\x05R\x06rating\x12\x12\n" +
+	"\x04pros\x18\x03 \x01(\tR\x04pros\x12\x12\n" +
+	"\x04cons\x18\x04 \x01(\tR\x04cons\x12\x12\n" +
+	"\x04text\x18\x05 \x01(\tR\x04text\"I\n" +
+	"\x1bCreateProductReviewResponse\x12*\n" +
+	"\x06review\x18\x01 \x01(\v2\x12.catalog.v1.ReviewR\x06review\"\xb4\x01\n" +
+	"\x12ListReviewsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04page\x18\x04 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x05 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\xb2\x01\n" +
+	"\x13ListReviewsResponse\x12,\n" +
+	"\areviews\x18\x01 \x03(\v2\x12.catalog.v1.ReviewR\areviews\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"h\n" +
+	"\x15ModerateReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12'\n" +
+	"\x0fmoderation_note\x18\x03 \x01(\tR\x0emoderationNote\"D\n" +
+	"\x16ModerateReviewResponse\x12*\n" +
+	"\x06review\x18\x01 \x01(\v2\x12.catalog.v1.ReviewR\x06review\"%\n" +
+	"\x13DeleteReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"0\n" +
+	"\x14DeleteReviewResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x85\x02\n" +
+	"\x0fProductRelation\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12,\n" +
+	"\x12related_product_id\x18\x03 \x01(\tR\x10relatedProductId\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x05 \x01(\x05R\tsortOrder\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\tR\tupdatedAt\x12$\n" +
+	"\rbidirectional\x18\b \x01(\bR\rbidirectional\"x\n" +
+	"\x0eRelatedProduct\x127\n" +
+	"\brelation\x18\x01 \x01(\v2\x1b.catalog.v1.ProductRelationR\brelation\x12-\n" +
+	"\aproduct\x18\x02 \x01(\v2\x13.catalog.v1.ProductR\aproduct\"\x81\x02\n" +
 	"\x13ListProductsRequest\x12\x1f\n" +
 	"\vcategory_id\x18\x01 \x01(\tR\n" +
 	"categoryId\x12\x12\n" +
@@ -5152,14 +9592,19 @@ const file_catalog_v1_catalog_service_proto_rawDesc = "" +
 	"\x10include_inactive\x18\x04 \x01(\bR\x0fincludeInactive\x12\x1f\n" +
 	"\vsupplier_id\x18\x05 \x01(\x03R\n" +
 	"supplierId\x12\x19\n" +
-	"\bbrand_id\x18\x06 \x01(\tR\abrandId\"\x8e\x01\n" +
+	"\bbrand_id\x18\x06 \x01(\tR\abrandId\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12\x12\n" +
+	"\x04sort\x18\b \x01(\tR\x04sort\"\xb6\x01\n" +
 	"\x14ListProductsResponse\x12/\n" +
 	"\bproducts\x18\x01 \x03(\v2\x13.catalog.v1.ProductR\bproducts\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"#\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"=\n" +
 	"\x11GetProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"C\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\ainclude\x18\x02 \x03(\tR\ainclude\"C\n" +
 	"\x12GetProductResponse\x12-\n" +
 	"\aproduct\x18\x01 \x01(\v2\x13.catalog.v1.ProductR\aproduct\"\x8f\x02\n" +
 	"\x14CreateProductRequest\x12\x1f\n" +
@@ -5193,6 +9638,12 @@ const file_catalog_v1_catalog_service_proto_rawDesc = "" +
 	"\bbrand_id\x18\n" +
 	" \x01(\tR\abrandId\"F\n" +
 	"\x15UpdateProductResponse\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.catalog.v1.ProductR\aproduct\"L\n" +
+	"\x19UpdateProductPriceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vprice_cents\x18\x02 \x01(\x03R\n" +
+	"priceCents\"K\n" +
+	"\x1aUpdateProductPriceResponse\x12-\n" +
 	"\aproduct\x18\x01 \x01(\v2\x13.catalog.v1.ProductR\aproduct\"&\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"1\n" +
@@ -5275,7 +9726,136 @@ const file_catalog_v1_catalog_service_proto_rawDesc = "" +
 	"product_id\x18\x01 \x01(\tR\tproductId\x12\x0e\n" +
 	"\x02id\x18\x02 \x01(\tR\x02id\":\n" +
 	"\x1eDeleteProductAttributeResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xbc\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"v\n" +
+	"\n" +
+	"BundleItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12-\n" +
+	"\aproduct\x18\x03 \x01(\v2\x13.catalog.v1.ProductR\aproduct\"\xb3\x01\n" +
+	"\x06Bundle\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.catalog.v1.ProductR\aproduct\x12!\n" +
+	"\fpricing_mode\x18\x02 \x01(\tR\vpricingMode\x12)\n" +
+	"\x10discount_percent\x18\x03 \x01(\x05R\x0fdiscountPercent\x12,\n" +
+	"\x05items\x18\x04 \x03(\v2\x16.catalog.v1.BundleItemR\x05items\"L\n" +
+	"\x0fBundleItemInput\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\"\n" +
+	"\x10GetBundleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"?\n" +
+	"\x11GetBundleResponse\x12*\n" +
+	"\x06bundle\x18\x01 \x01(\v2\x12.catalog.v1.BundleR\x06bundle\"\xd8\x02\n" +
+	"\x13CreateBundleRequest\x12\x1f\n" +
+	"\vcategory_id\x18\x01 \x01(\tR\n" +
+	"categoryId\x12\x19\n" +
+	"\bbrand_id\x18\x02 \x01(\tR\abrandId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x10\n" +
+	"\x03sku\x18\x05 \x01(\tR\x03sku\x12\x1b\n" +
+	"\tis_active\x18\x06 \x01(\bR\bisActive\x12!\n" +
+	"\fpricing_mode\x18\a \x01(\tR\vpricingMode\x12\x1f\n" +
+	"\vprice_cents\x18\b \x01(\x03R\n" +
+	"priceCents\x12)\n" +
+	"\x10discount_percent\x18\t \x01(\x05R\x0fdiscountPercent\x121\n" +
+	"\x05items\x18\n" +
+	" \x03(\v2\x1b.catalog.v1.BundleItemInputR\x05items\"B\n" +
+	"\x14CreateBundleResponse\x12*\n" +
+	"\x06bundle\x18\x01 \x01(\v2\x12.catalog.v1.BundleR\x06bundle\"\xe8\x02\n" +
+	"\x13UpdateBundleRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vcategory_id\x18\x02 \x01(\tR\n" +
+	"categoryId\x12\x19\n" +
+	"\bbrand_id\x18\x03 \x01(\tR\abrandId\x12\x12\n" +
+	"\x04name\x18\x04 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x10\n" +
+	"\x03sku\x18\x06 \x01(\tR\x03sku\x12\x1b\n" +
+	"\tis_active\x18\a \x01(\bR\bisActive\x12!\n" +
+	"\fpricing_mode\x18\b \x01(\tR\vpricingMode\x12\x1f\n" +
+	"\vprice_cents\x18\t \x01(\x03R\n" +
+	"priceCents\x12)\n" +
+	"\x10discount_percent\x18\n" +
+	" \x01(\x05R\x0fdiscountPercent\x121\n" +
+	"\x05items\x18\v \x03(\v2\x1b.catalog.v1.BundleItemInputR\x05items\"B\n" +
+	"\x14UpdateBundleResponse\x12*\n" +
+	"\x06bundle\x18\x01 \x01(\v2\x12.catalog.v1.BundleR\x06bundle\"F\n" +
+	"\tStockLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"B\n" +
+	"\x13ReserveStockRequest\x12+\n" +
+	"\x05lines\x18\x01 \x03(\v2\x15.catalog.v1.StockLineR\x05lines\"0\n" +
+	"\x14ReserveStockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"A\n" +
+	"\x12CommitStockRequest\x12+\n" +
+	"\x05lines\x18\x01 \x03(\v2\x15.catalog.v1.StockLineR\x05lines\"/\n" +
+	"\x13CommitStockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"B\n" +
+	"\x13ReleaseStockRequest\x12+\n" +
+	"\x05lines\x18\x01 \x03(\v2\x15.catalog.v1.StockLineR\x05lines\"0\n" +
+	"\x14ReleaseStockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
+	"\x1bListProductRelationsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\"Y\n" +
+	"\x1cListProductRelationsResponse\x129\n" +
+	"\trelations\x18\x01 \x03(\v2\x1b.catalog.v1.ProductRelationR\trelations\"\xc4\x01\n" +
+	"\x1cCreateProductRelationRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12,\n" +
+	"\x12related_product_id\x18\x02 \x01(\tR\x10relatedProductId\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x05R\tsortOrder\x12$\n" +
+	"\rbidirectional\x18\x05 \x01(\bR\rbidirectional\"X\n" +
+	"\x1dCreateProductRelationResponse\x127\n" +
+	"\brelation\x18\x01 \x01(\v2\x1b.catalog.v1.ProductRelationR\brelation\"\x80\x01\n" +
+	"\x1cUpdateProductRelationRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\x04 \x01(\x05R\tsortOrder\"X\n" +
+	"\x1dUpdateProductRelationResponse\x127\n" +
+	"\brelation\x18\x01 \x01(\v2\x1b.catalog.v1.ProductRelationR\brelation\"s\n" +
+	"\x1cDeleteProductRelationRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12$\n" +
+	"\rbidirectional\x18\x03 \x01(\bR\rbidirectional\"9\n" +
+	"\x1dDeleteProductRelationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"[\n" +
+	"\x0fProductListItem\x12-\n" +
+	"\aproduct\x18\x01 \x01(\v2\x13.catalog.v1.ProductR\aproduct\x12\x19\n" +
+	"\badded_at\x18\x02 \x01(\tR\aaddedAt\"U\n" +
+	"\rComparisonRow\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06values\x18\x02 \x03(\tR\x06values\x12\x18\n" +
+	"\adiffers\x18\x03 \x01(\bR\adiffers\"1\n" +
+	"\x1bListProductListItemsRequest\x12\x12\n" +
+	"\x04list\x18\x01 \x01(\tR\x04list\"Q\n" +
+	"\x1cListProductListItemsResponse\x121\n" +
+	"\x05items\x18\x01 \x03(\v2\x1b.catalog.v1.ProductListItemR\x05items\"N\n" +
+	"\x19AddProductListItemRequest\x12\x12\n" +
+	"\x04list\x18\x01 \x01(\tR\x04list\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"6\n" +
+	"\x1aAddProductListItemResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"Q\n" +
+	"\x1cRemoveProductListItemRequest\x12\x12\n" +
+	"\x04list\x18\x01 \x01(\tR\x04list\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\"9\n" +
+	"\x1dRemoveProductListItemResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"d\n" +
+	"\x16CompareProductsRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\tR\n" +
+	"productIds\x12)\n" +
+	"\x10differences_only\x18\x02 \x01(\bR\x0fdifferencesOnly\"y\n" +
+	"\x17CompareProductsResponse\x12/\n" +
+	"\bproducts\x18\x01 \x03(\v2\x13.catalog.v1.ProductR\bproducts\x12-\n" +
+	"\x04rows\x18\x02 \x03(\v2\x19.catalog.v1.ComparisonRowR\x04rows\"\xbc\x01\n" +
 	"\x05Brand\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
@@ -5323,10 +9903,18 @@ const file_catalog_v1_catalog_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\tR\tupdatedAt\"\x16\n" +
-	"\x14ListSuppliersRequest\"K\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"f\n" +
+	"\x14ListSuppliersRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xba\x01\n" +
 	"\x15ListSuppliersResponse\x122\n" +
-	"\tsuppliers\x18\x01 \x03(\v2\x14.catalog.v1.SupplierR\tsuppliers\"$\n" +
+	"\tsuppliers\x18\x01 \x03(\v2\x14.catalog.v1.SupplierR\tsuppliers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"$\n" +
 	"\x12GetSupplierRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"G\n" +
 	"\x13GetSupplierResponse\x120\n" +
@@ -5365,14 +9953,22 @@ const file_catalog_v1_catalog_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\tR\tupdatedAt\"g\n" +
+	"updated_at\x18\b \x01(\tR\tupdatedAt\"\xb7\x01\n" +
 	"#ListSupplierCategoryMappingsRequest\x12\x1f\n" +
 	"\vsupplier_id\x18\x01 \x01(\x03R\n" +
 	"supplierId\x12\x1f\n" +
 	"\vcategory_id\x18\x02 \x01(\tR\n" +
-	"categoryId\"g\n" +
+	"categoryId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\xd6\x01\n" +
 	"$ListSupplierCategoryMappingsResponse\x12?\n" +
-	"\bmappings\x18\x01 \x03(\v2#.catalog.v1.SupplierCategoryMappingR\bmappings\"3\n" +
+	"\bmappings\x18\x01 \x03(\v2#.catalog.v1.SupplierCategoryMappingR\bmappings\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"3\n" +
 	"!GetSupplierCategoryMappingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"c\n" +
 	"\"GetSupplierCategoryMappingResponse\x12=\n" +
@@ -5418,14 +10014,22 @@ const file_catalog_v1_catalog_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\b \x01(\tR\tcreatedAt\x12\x1d\n" +
 	"\n" +
-	"updated_at\x18\t \x01(\tR\tupdatedAt\"d\n" +
+	"updated_at\x18\t \x01(\tR\tupdatedAt\"\xb4\x01\n" +
 	"\"ListSupplierProductMappingsRequest\x12\x1f\n" +
 	"\vsupplier_id\x18\x01 \x01(\x03R\n" +
 	"supplierId\x12\x1d\n" +
 	"\n" +
-	"product_id\x18\x02 \x01(\tR\tproductId\"e\n" +
+	"product_id\x18\x02 \x01(\tR\tproductId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\xd4\x01\n" +
 	"#ListSupplierProductMappingsResponse\x12>\n" +
-	"\bmappings\x18\x01 \x03(\v2\".catalog.v1.SupplierProductMappingR\bmappings\"2\n" +
+	"\bmappings\x18\x01 \x03(\v2\".catalog.v1.SupplierProductMappingR\bmappings\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\x12&\n" +
+	"\x0fnext_page_token\x18\x05 \x01(\tR\rnextPageToken\"2\n" +
 	" GetSupplierProductMappingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"a\n" +
 	"!GetSupplierProductMappingResponse\x12<\n" +
@@ -5458,7 +10062,7 @@ const file_catalog_v1_catalog_service_proto_rawDesc = "" +
 	"#DeleteSupplierProductMappingRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"@\n" +
 	"$DeleteSupplierProductMappingResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess2\x82+\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess2\xb3I\n" +
 	"\x0eCatalogService\x12k\n" +
 	"\rListSuppliers\x12 .catalog.v1.ListSuppliersRequest\x1a!.catalog.v1.ListSuppliersResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/suppliers\x12j\n" +
 	"\vGetSupplier\x12\x1e.catalog.v1.GetSupplierRequest\x1a\x1f.catalog.v1.GetSupplierResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/suppliers/{id}\x12q\n" +
@@ -5474,7 +10078,8 @@ const file_catalog_v1_catalog_service_proto_rawDesc = "" +
 	"\n" +
 	"GetProduct\x12\x1d.catalog.v1.GetProductRequest\x1a\x1e.catalog.v1.GetProductResponse\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/products/{id}\x12m\n" +
 	"\rCreateProduct\x12 .catalog.v1.CreateProductRequest\x1a!.catalog.v1.CreateProductResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/products\x12r\n" +
-	"\rUpdateProduct\x12 .catalog.v1.UpdateProductRequest\x1a!.catalog.v1.UpdateProductResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/products/{id}\x12o\n" +
+	"\rUpdateProduct\x12 .catalog.v1.UpdateProductRequest\x1a!.catalog.v1.UpdateProductResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*2\x11/v1/products/{id}\x12\x87\x01\n" +
+	"\x12UpdateProductPrice\x12%.catalog.v1.UpdateProductPriceRequest\x1a&.catalog.v1.UpdateProductPriceResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*2\x17/v1/products/{id}/price\x12o\n" +
 	"\rDeleteProduct\x12 .catalog.v1.DeleteProductRequest\x1a!.catalog.v1.DeleteProductResponse\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/v1/products/{id}\x12\x8a\x01\n" +
 	"\x11ListProductImages\x12$.catalog.v1.ListProductImagesRequest\x1a%.catalog.v1.ListProductImagesResponse\"(\x82\xd3\xe4\x93\x02\"\x12 /v1/products/{product_id}/images\x12\x89\x01\n" +
 	"\x0fGetProductImage\x12\".catalog.v1.GetProductImageRequest\x1a#.catalog.v1.GetProductImageResponse\"-\x82\xd3\xe4\x93\x02'\x12%/v1/products/{product_id}/images/{id}\x12\x90\x01\n" +
@@ -5485,7 +10090,34 @@ const file_catalog_v1_catalog_service_proto_rawDesc = "" +
 	"\x13GetProductAttribute\x12&.catalog.v1.GetProductAttributeRequest\x1a'.catalog.v1.GetProductAttributeResponse\"1\x82\xd3\xe4\x93\x02+\x12)/v1/products/{product_id}/attributes/{id}\x12\xa0\x01\n" +
 	"\x16CreateProductAttribute\x12).catalog.v1.CreateProductAttributeRequest\x1a*.catalog.v1.CreateProductAttributeResponse\"/\x82\xd3\xe4\x93\x02):\x01*\"$/v1/products/{product_id}/attributes\x12\xa5\x01\n" +
 	"\x16UpdateProductAttribute\x12).catalog.v1.UpdateProductAttributeRequest\x1a*.catalog.v1.UpdateProductAttributeResponse\"4\x82\xd3\xe4\x93\x02.:\x01*2)/v1/products/{product_id}/attributes/{id}\x12\xa2\x01\n" +
-	"\x16DeleteProductAttribute\x12).catalog.v1.DeleteProductAttributeRequest\x1a*.catalog.v1.DeleteProductAttributeResponse\"1\x82\xd3\xe4\x93\x02+*)/v1/products/{product_id}/attributes/{id}\x12_\n" +
+	"\x16DeleteProductAttribute\x12).catalog.v1.DeleteProductAttributeRequest\x1a*.catalog.v1.DeleteProductAttributeResponse\"1\x82\xd3\xe4\x93\x02+*)/v1/products/{product_id}/attributes/{id}\x12\x96\x01\n" +
+	"\x14ListProductRelations\x12'.catalog.v1.ListProductRelationsRequest\x1a(.catalog.v1.ListProductRelationsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/products/{product_id}/relations\x12\x9c\x01\n" +
+	"\x15CreateProductRelation\x12(.catalog.v1.CreateProductRelationRequest\x1a).catalog.v1.CreateProductRelationResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/products/{product_id}/relations\x12\xa1\x01\n" +
+	"\x15UpdateProductRelation\x12(.catalog.v1.UpdateProductRelationRequest\x1a).catalog.v1.UpdateProductRelationResponse\"3\x82\xd3\xe4\x93\x02-:\x01*2(/v1/products/{product_id}/relations/{id}\x12\x9e\x01\n" +
+	"\x15DeleteProductRelation\x12(.catalog.v1.DeleteProductRelationRequest\x1a).catalog.v1.DeleteProductRelationResponse\"0\x82\xd3\xe4\x93\x02**(/v1/products/{product_id}/relations/{id}\x12b\n" +
+	"\tGetBundle\x12\x1c.catalog.v1.GetBundleRequest\x1a\x1d.catalog.v1.GetBundleResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/bundles/{id}\x12i\n" +
+	"\fCreateBundle\x12\x1f.catalog.v1.CreateBundleRequest\x1a .catalog.v1.CreateBundleResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/bundles\x12n\n" +
+	"\fUpdateBundle\x12\x1f.catalog.v1.UpdateBundleRequest\x1a .catalog.v1.UpdateBundleResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/v1/bundles/{id}\x12o\n" +
+	"\fReserveStock\x12\x1f.catalog.v1.ReserveStockRequest\x1a .catalog.v1.ReserveStockResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/stock/reserve\x12k\n" +
+	"\vCommitStock\x12\x1e.catalog.v1.CommitStockRequest\x1a\x1f.catalog.v1.CommitStockResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/v1/stock/commit\x12o\n" +
+	"\fReleaseStock\x12\x1f.catalog.v1.ReleaseStockRequest\x1a .catalog.v1.ReleaseStockResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/v1/stock/release\x12\x8e\x01\n" +
+	"\x12ListProductReviews\x12%.catalog.v1.ListProductReviewsRequest\x1a&.catalog.v1.ListProductReviewsResponse\")\x82\xd3\xe4\x93\x02#\x12!/v1/products/{product_id}/reviews\x12\x94\x01\n" +
+	"\x13CreateProductReview\x12&.catalog.v1.CreateProductReviewRequest\x1a'.catalog.v1.CreateProductReviewResponse\",\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/products/{product_id}/reviews\x12c\n" +
+	"\vListReviews\x12\x1e.catalog.v1.ListReviewsRequest\x1a\x1f.catalog.v1.ListReviewsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/reviews\x12t\n" +
+	"\x0eModerateReview\x12!.catalog.v1.ModerateReviewRequest\x1a\".catalog.v1.ModerateReviewResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*2\x10/v1/reviews/{id}\x12k\n" +
+	"\fDeleteReview\x12\x1f.catalog.v1.DeleteReviewRequest\x1a .catalog.v1.DeleteReviewResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/reviews/{id}\x12\x96\x01\n" +
+	"\x14ListProductQuestions\x12'.catalog.v1.ListProductQuestionsRequest\x1a(.catalog.v1.ListProductQuestionsResponse\"+\x82\xd3\xe4\x93\x02%\x12#/v1/products/{product_id}/questions\x12\x93\x01\n" +
+	"\x12AskProductQuestion\x12%.catalog.v1.AskProductQuestionRequest\x1a&.catalog.v1.AskProductQuestionResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/products/{product_id}/questions\x12\xa7\x01\n" +
+	"\x17ModerateProductQuestion\x12*.catalog.v1.ModerateProductQuestionRequest\x1a+.catalog.v1.ModerateProductQuestionResponse\"3\x82\xd3\xe4\x93\x02-:\x01*2(/v1/products/{product_id}/questions/{id}\x12\x9e\x01\n" +
+	"\x15DeleteProductQuestion\x12(.catalog.v1.DeleteProductQuestionRequest\x1a).catalog.v1.DeleteProductQuestionResponse\"0\x82\xd3\xe4\x93\x02**(/v1/products/{product_id}/questions/{id}\x12\xb2\x01\n" +
+	"\x15AnswerProductQuestion\x12(.catalog.v1.AnswerProductQuestionRequest\x1a).catalog.v1.AnswerProductQuestionResponse\"D\x82\xd3\xe4\x93\x02>:\x01*\"9/v1/products/{product_id}/questions/{question_id}/answers\x12\xb7\x01\n" +
+	"\x15ModerateProductAnswer\x12(.catalog.v1.ModerateProductAnswerRequest\x1a).catalog.v1.ModerateProductAnswerResponse\"I\x82\xd3\xe4\x93\x02C:\x01*2>/v1/products/{product_id}/questions/{question_id}/answers/{id}\x12\xae\x01\n" +
+	"\x13DeleteProductAnswer\x12&.catalog.v1.DeleteProductAnswerRequest\x1a'.catalog.v1.DeleteProductAnswerResponse\"F\x82\xd3\xe4\x93\x02@*>/v1/products/{product_id}/questions/{question_id}/answers/{id}\x12k\n" +
+	"\rListQuestions\x12 .catalog.v1.ListQuestionsRequest\x1a!.catalog.v1.ListQuestionsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/questions\x12\x86\x01\n" +
+	"\x14ListProductListItems\x12'.catalog.v1.ListProductListItemsRequest\x1a(.catalog.v1.ListProductListItemsResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/me/lists/{list}\x12\x89\x01\n" +
+	"\x12AddProductListItem\x12%.catalog.v1.AddProductListItemRequest\x1a&.catalog.v1.AddProductListItemResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/me/lists/{list}/items\x12\x9c\x01\n" +
+	"\x15RemoveProductListItem\x12(.catalog.v1.RemoveProductListItemRequest\x1a).catalog.v1.RemoveProductListItemResponse\".\x82\xd3\xe4\x93\x02(*&/v1/me/lists/{list}/items/{product_id}\x12o\n" +
+	"\x0fCompareProducts\x12\".catalog.v1.CompareProductsRequest\x1a#.catalog.v1.CompareProductsResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/compare\x12_\n" +
 	"\n" +
 	"ListBrands\x12\x1d.catalog.v1.ListBrandsRequest\x1a\x1e.catalog.v1.ListBrandsResponse\"\x12\x82\xd3\xe4\x93\x02\f\x12\n" +
 	"/v1/brands\x12^\n" +
@@ -5517,7 +10149,7 @@ func file_catalog_v1_catalog_service_proto_rawDescGZIP() []byte {
 	return file_catalog_v1_catalog_service_proto_rawDescData
 }
 
-var file_catalog_v1_catalog_service_proto_msgTypes = make([]protoimpl.MessageInfo, 88)
+var file_catalog_v1_catalog_service_proto_msgTypes = make([]protoimpl.MessageInfo, 155)
 var file_catalog_v1_catalog_service_proto_goTypes = []any{
 	(*Category)(nil),                              // 0: catalog.v1.Category
 	(*ListCategoriesRequest)(nil),                 // 1: catalog.v1.ListCategoriesRequest
@@ -5533,201 +10165,357 @@ var file_catalog_v1_catalog_service_proto_goTypes = []any{
 	(*ProductImage)(nil),                          // 11: catalog.v1.ProductImage
 	(*ProductAttribute)(nil),                      // 12: catalog.v1.ProductAttribute
 	(*Product)(nil),                               // 13: catalog.v1.Product
-	(*ListProductsRequest)(nil),                   // 14: catalog.v1.ListProductsRequest
-	(*ListProductsResponse)(nil),                  // 15: catalog.v1.ListProductsResponse
-	(*GetProductRequest)(nil),                     // 16: catalog.v1.GetProductRequest
-	(*GetProductResponse)(nil),                    // 17: catalog.v1.GetProductResponse
-	(*CreateProductRequest)(nil),                  // 18: catalog.v1.CreateProductRequest
-	(*CreateProductResponse)(nil),                 // 19: catalog.v1.CreateProductResponse
-	(*UpdateProductRequest)(nil),                  // 20: catalog.v1.UpdateProductRequest
-	(*UpdateProductResponse)(nil),                 // 21: catalog.v1.UpdateProductResponse
-	(*DeleteProductRequest)(nil),                  // 22: catalog.v1.DeleteProductRequest
-	(*DeleteProductResponse)(nil),                 // 23: catalog.v1.DeleteProductResponse
-	(*ListProductImagesRequest)(nil),              // 24: catalog.v1.ListProductImagesRequest
-	(*ListProductImagesResponse)(nil),             // 25: catalog.v1.ListProductImagesResponse
-	(*GetProductImageRequest)(nil),                // 26: catalog.v1.GetProductImageRequest
-	(*GetProductImageResponse)(nil),               // 27: catalog.v1.GetProductImageResponse
-	(*CreateProductImageRequest)(nil),             // 28: catalog.v1.CreateProductImageRequest
-	(*CreateProductImageResponse)(nil),            // 29: catalog.v1.CreateProductImageResponse
-	(*UpdateProductImageRequest)(nil),             // 30: catalog.v1.UpdateProductImageRequest
-	(*UpdateProductImageResponse)(nil),            // 31: catalog.v1.UpdateProductImageResponse
-	(*DeleteProductImageRequest)(nil),             // 32: catalog.v1.DeleteProductImageRequest
-	(*DeleteProductImageResponse)(nil),            // 33: catalog.v1.DeleteProductImageResponse
-	(*ListProductAttributesRequest)(nil),          // 34: catalog.v1.ListProductAttributesRequest
-	(*ListProductAttributesResponse)(nil),         // 35: catalog.v1.ListProductAttributesResponse
-	(*GetProductAttributeRequest)(nil),            // 36: catalog.v1.GetProductAttributeRequest
-	(*GetProductAttributeResponse)(nil),           // 37: catalog.v1.GetProductAttributeResponse
-	(*CreateProductAttributeRequest)(nil),         // 38: catalog.v1.CreateProductAttributeRequest
-	(*CreateProductAttributeResponse)(nil),        // 39: catalog.v1.CreateProductAttributeResponse
-	(*UpdateProductAttributeRequest)(nil),         // 40: catalog.v1.UpdateProductAttributeRequest
-	(*UpdateProductAttributeResponse)(nil),        // 41: catalog.v1.UpdateProductAttributeResponse
-	(*DeleteProductAttributeRequest)(nil),         // 42: catalog.v1.DeleteProductAttributeRequest
-	(*DeleteProductAttributeResponse)(nil),        // 43: catalog.v1.DeleteProductAttributeResponse
-	(*Brand)(nil),                                 // 44: catalog.v1.Brand
-	(*ListBrandsRequest)(nil),                     // 45: catalog.v1.ListBrandsRequest
-	(*ListBrandsResponse)(nil),                    // 46: catalog.v1.ListBrandsResponse
-	(*GetBrandRequest)(nil),                       // 47: catalog.v1.GetBrandRequest
-	(*GetBrandResponse)(nil),                      // 48: catalog.v1.GetBrandResponse
-	(*CreateBrandRequest)(nil),                    // 49: catalog.v1.CreateBrandRequest
-	(*CreateBrandResponse)(nil),                   // 50: catalog.v1.CreateBrandResponse
-	(*UpdateBrandRequest)(nil),                    // 51: catalog.v1.UpdateBrandRequest
-	(*UpdateBrandResponse)(nil),                   // 52: catalog.v1.UpdateBrandResponse
-	(*DeleteBrandRequest)(nil),                    // 53: catalog.v1.DeleteBrandRequest
-	(*DeleteBrandResponse)(nil),                   // 54: catalog.v1.DeleteBrandResponse
-	(*Supplier)(nil),                              // 55: catalog.v1.Supplier
-	(*ListSuppliersRequest)(nil),                  // 56: catalog.v1.ListSuppliersRequest
-	(*ListSuppliersResponse)(nil),                 // 57: catalog.v1.ListSuppliersResponse
-	(*GetSupplierRequest)(nil),                    // 58: catalog.v1.GetSupplierRequest
-	(*GetSupplierResponse)(nil),                   // 59: catalog.v1.GetSupplierResponse
-	(*CreateSupplierRequest)(nil),                 // 60: catalog.v1.CreateSupplierRequest
-	(*CreateSupplierResponse)(nil),                // 61: catalog.v1.CreateSupplierResponse
-	(*UpdateSupplierRequest)(nil),                 // 62: catalog.v1.UpdateSupplierRequest
-	(*UpdateSupplierResponse)(nil),                // 63: catalog.v1.UpdateSupplierResponse
-	(*DeleteSupplierRequest)(nil),                 // 64: catalog.v1.DeleteSupplierRequest
-	(*DeleteSupplierResponse)(nil),                // 65: catalog.v1.DeleteSupplierResponse
-	(*SupplierCategoryMapping)(nil),               // 66: catalog.v1.SupplierCategoryMapping
-	(*ListSupplierCategoryMappingsRequest)(nil),   // 67: catalog.v1.ListSupplierCategoryMappingsRequest
-	(*ListSupplierCategoryMappingsResponse)(nil),  // 68: catalog.v1.ListSupplierCategoryMappingsResponse
-	(*GetSupplierCategoryMappingRequest)(nil),     // 69: catalog.v1.GetSupplierCategoryMappingRequest
-	(*GetSupplierCategoryMappingResponse)(nil),    // 70: catalog.v1.GetSupplierCategoryMappingResponse
-	(*CreateSupplierCategoryMappingRequest)(nil),  // 71: catalog.v1.CreateSupplierCategoryMappingRequest
-	(*CreateSupplierCategoryMappingResponse)(nil), // 72: catalog.v1.CreateSupplierCategoryMappingResponse
-	(*UpdateSupplierCategoryMappingRequest)(nil),  // 73: catalog.v1.UpdateSupplierCategoryMappingRequest
-	(*UpdateSupplierCategoryMappingResponse)(nil), // 74: catalog.v1.UpdateSupplierCategoryMappingResponse
-	(*DeleteSupplierCategoryMappingRequest)(nil),  // 75: catalog.v1.DeleteSupplierCategoryMappingRequest
-	(*DeleteSupplierCategoryMappingResponse)(nil), // 76: catalog.v1.DeleteSupplierCategoryMappingResponse
-	(*SupplierProductMapping)(nil),                // 77: catalog.v1.SupplierProductMapping
-	(*ListSupplierProductMappingsRequest)(nil),    // 78: catalog.v1.ListSupplierProductMappingsRequest
-	(*ListSupplierProductMappingsResponse)(nil),   // 79: catalog.v1.ListSupplierProductMappingsResponse
-	(*GetSupplierProductMappingRequest)(nil),      // 80: catalog.v1.GetSupplierProductMappingRequest
-	(*GetSupplierProductMappingResponse)(nil),     // 81: catalog.v1.GetSupplierProductMappingResponse
-	(*CreateSupplierProductMappingRequest)(nil),   // 82: catalog.v1.CreateSupplierProductMappingRequest
-	(*CreateSupplierProductMappingResponse)(nil),  // 83: catalog.v1.CreateSupplierProductMappingResponse
-	(*UpdateSupplierProductMappingRequest)(nil),   // 84: catalog.v1.UpdateSupplierProductMappingRequest
-	(*UpdateSupplierProductMappingResponse)(nil),  // 85: catalog.v1.UpdateSupplierProductMappingResponse
-	(*DeleteSupplierProductMappingRequest)(nil),   // 86: catalog.v1.DeleteSupplierProductMappingRequest
-	(*DeleteSupplierProductMappingResponse)(nil),  // 87: catalog.v1.DeleteSupplierProductMappingResponse
+	(*Review)(nil),                                // 14: catalog.v1.Review
+	(*Answer)(nil),                                // 15: catalog.v1.Answer
+	(*Question)(nil),                              // 16: catalog.v1.Question
+	(*ListProductQuestionsRequest)(nil),           // 17: catalog.v1.ListProductQuestionsRequest
+	(*ListProductQuestionsResponse)(nil),          // 18: catalog.v1.ListProductQuestionsResponse
+	(*ListQuestionsRequest)(nil),                  // 19: catalog.v1.ListQuestionsRequest
+	(*ListQuestionsResponse)(nil),                 // 20: catalog.v1.ListQuestionsResponse
+	(*AskProductQuestionRequest)(nil),             // 21: catalog.v1.AskProductQuestionRequest
+	(*AskProductQuestionResponse)(nil),            // 22: catalog.v1.AskProductQuestionResponse
+	(*AnswerProductQuestionRequest)(nil),          // 23: catalog.v1.AnswerProductQuestionRequest
+	(*AnswerProductQuestionResponse)(nil),         // 24: catalog.v1.AnswerProductQuestionResponse
+	(*ModerateProductQuestionRequest)(nil),        // 25: catalog.v1.ModerateProductQuestionRequest
+	(*ModerateProductQuestionResponse)(nil),       // 26: catalog.v1.ModerateProductQuestionResponse
+	(*DeleteProductQuestionRequest)(nil),          // 27: catalog.v1.DeleteProductQuestionRequest
+	(*DeleteProductQuestionResponse)(nil),         // 28: catalog.v1.DeleteProductQuestionResponse
+	(*ModerateProductAnswerRequest)(nil),          // 29: catalog.v1.ModerateProductAnswerRequest
+	(*ModerateProductAnswerResponse)(nil),         // 30: catalog.v1.ModerateProductAnswerResponse
+	(*DeleteProductAnswerRequest)(nil),            // 31: catalog.v1.DeleteProductAnswerRequest
+	(*DeleteProductAnswerResponse)(nil),           // 32: catalog.v1.DeleteProductAnswerResponse
+	(*ListProductReviewsRequest)(nil),             // 33: catalog.v1.ListProductReviewsRequest
+	(*ListProductReviewsResponse)(nil),            // 34: catalog.v1.ListProductReviewsResponse
+	(*CreateProductReviewRequest)(nil),            // 35: catalog.v1.CreateProductReviewRequest
+	(*CreateProductReviewResponse)(nil),           // 36: catalog.v1.CreateProductReviewResponse
+	(*ListReviewsRequest)(nil),                    // 37: catalog.v1.ListReviewsRequest
+	(*ListReviewsResponse)(nil),                   // 38: catalog.v1.ListReviewsResponse
+	(*ModerateReviewRequest)(nil),                 // 39: catalog.v1.ModerateReviewRequest
+	(*ModerateReviewResponse)(nil),                // 40: catalog.v1.ModerateReviewResponse
+	(*DeleteReviewRequest)(nil),                   // 41: catalog.v1.DeleteReviewRequest
+	(*DeleteReviewResponse)(nil),                  // 42: catalog.v1.DeleteReviewResponse
+	(*ProductRelation)(nil),                       // 43: catalog.v1.ProductRelation
+	(*RelatedProduct)(nil),                        // 44: catalog.v1.RelatedProduct
+	(*ListProductsRequest)(nil),                   // 45: catalog.v1.ListProductsRequest
+	(*ListProductsResponse)(nil),                  // 46: catalog.v1.ListProductsResponse
+	(*GetProductRequest)(nil),                     // 47: catalog.v1.GetProductRequest
+	(*GetProductResponse)(nil),                    // 48: catalog.v1.GetProductResponse
+	(*CreateProductRequest)(nil),                  // 49: catalog.v1.CreateProductRequest
+	(*CreateProductResponse)(nil),                 // 50: catalog.v1.CreateProductResponse
+	(*UpdateProductRequest)(nil),                  // 51: catalog.v1.UpdateProductRequest
+	(*UpdateProductResponse)(nil),                 // 52: catalog.v1.UpdateProductResponse
+	(*UpdateProductPriceRequest)(nil),             // 53: catalog.v1.UpdateProductPriceRequest
+	(*UpdateProductPriceResponse)(nil),            // 54: catalog.v1.UpdateProductPriceResponse
+	(*DeleteProductRequest)(nil),                  // 55: catalog.v1.DeleteProductRequest
+	(*DeleteProductResponse)(nil),                 // 56: catalog.v1.DeleteProductResponse
+	(*ListProductImagesRequest)(nil),              // 57: catalog.v1.ListProductImagesRequest
+	(*ListProductImagesResponse)(nil),             // 58: catalog.v1.ListProductImagesResponse
+	(*GetProductImageRequest)(nil),                // 59: catalog.v1.GetProductImageRequest
+	(*GetProductImageResponse)(nil),               // 60: catalog.v1.GetProductImageResponse
+	(*CreateProductImageRequest)(nil),             // 61: catalog.v1.CreateProductImageRequest
+	(*CreateProductImageResponse)(nil),            // 62: catalog.v1.CreateProductImageResponse
+	(*UpdateProductImageRequest)(nil),             // 63: catalog.v1.UpdateProductImageRequest
+	(*UpdateProductImageResponse)(nil),            // 64: catalog.v1.UpdateProductImageResponse
+	(*DeleteProductImageRequest)(nil),             // 65: catalog.v1.DeleteProductImageRequest
+	(*DeleteProductImageResponse)(nil),            // 66: catalog.v1.DeleteProductImageResponse
+	(*ListProductAttributesRequest)(nil),          // 67: catalog.v1.ListProductAttributesRequest
+	(*ListProductAttributesResponse)(nil),         // 68: catalog.v1.ListProductAttributesResponse
+	(*GetProductAttributeRequest)(nil),            // 69: catalog.v1.GetProductAttributeRequest
+	(*GetProductAttributeResponse)(nil),           // 70: catalog.v1.GetProductAttributeResponse
+	(*CreateProductAttributeRequest)(nil),         // 71: catalog.v1.CreateProductAttributeRequest
+	(*CreateProductAttributeResponse)(nil),        // 72: catalog.v1.CreateProductAttributeResponse
+	(*UpdateProductAttributeRequest)(nil),         // 73: catalog.v1.UpdateProductAttributeRequest
+	(*UpdateProductAttributeResponse)(nil),        // 74: catalog.v1.UpdateProductAttributeResponse
+	(*DeleteProductAttributeRequest)(nil),         // 75: catalog.v1.DeleteProductAttributeRequest
+	(*DeleteProductAttributeResponse)(nil),        // 76: catalog.v1.DeleteProductAttributeResponse
+	(*BundleItem)(nil),                            // 77: catalog.v1.BundleItem
+	(*Bundle)(nil),                                // 78: catalog.v1.Bundle
+	(*BundleItemInput)(nil),                       // 79: catalog.v1.BundleItemInput
+	(*GetBundleRequest)(nil),                      // 80: catalog.v1.GetBundleRequest
+	(*GetBundleResponse)(nil),                     // 81: catalog.v1.GetBundleResponse
+	(*CreateBundleRequest)(nil),                   // 82: catalog.v1.CreateBundleRequest
+	(*CreateBundleResponse)(nil),                  // 83: catalog.v1.CreateBundleResponse
+	(*UpdateBundleRequest)(nil),                   // 84: catalog.v1.UpdateBundleRequest
+	(*UpdateBundleResponse)(nil),                  // 85: catalog.v1.UpdateBundleResponse
+	(*StockLine)(nil),                             // 86: catalog.v1.StockLine
+	(*ReserveStockRequest)(nil),                   // 87: catalog.v1.ReserveStockRequest
+	(*ReserveStockResponse)(nil),                  // 88: catalog.v1.ReserveStockResponse
+	(*CommitStockRequest)(nil),                    // 89: catalog.v1.CommitStockRequest
+	(*CommitStockResponse)(nil),                   // 90: catalog.v1.CommitStockResponse
+	(*ReleaseStockRequest)(nil),                   // 91: catalog.v1.ReleaseStockRequest
+	(*ReleaseStockResponse)(nil),                  // 92: catalog.v1.ReleaseStockResponse
+	(*ListProductRelationsRequest)(nil),           // 93: catalog.v1.ListProductRelationsRequest
+	(*ListProductRelationsResponse)(nil),          // 94: catalog.v1.ListProductRelationsResponse
+	(*CreateProductRelationRequest)(nil),          // 95: catalog.v1.CreateProductRelationRequest
+	(*CreateProductRelationResponse)(nil),         // 96: catalog.v1.CreateProductRelationResponse
+	(*UpdateProductRelationRequest)(nil),          // 97: catalog.v1.UpdateProductRelationRequest
+	(*UpdateProductRelationResponse)(nil),         // 98: catalog.v1.UpdateProductRelationResponse
+	(*DeleteProductRelationRequest)(nil),          // 99: catalog.v1.DeleteProductRelationRequest
+	(*DeleteProductRelationResponse)(nil),         // 100: catalog.v1.DeleteProductRelationResponse
+	(*ProductListItem)(nil),                       // 101: catalog.v1.ProductListItem
+	(*ComparisonRow)(nil),                         // 102: catalog.v1.ComparisonRow
+	(*ListProductListItemsRequest)(nil),           // 103: catalog.v1.ListProductListItemsRequest
+	(*ListProductListItemsResponse)(nil),          // 104: catalog.v1.ListProductListItemsResponse
+	(*AddProductListItemRequest)(nil),             // 105: catalog.v1.AddProductListItemRequest
+	(*AddProductListItemResponse)(nil),            // 106: catalog.v1.AddProductListItemResponse
+	(*RemoveProductListItemRequest)(nil),          // 107: catalog.v1.RemoveProductListItemRequest
+	(*RemoveProductListItemResponse)(nil),         // 108: catalog.v1.RemoveProductListItemResponse
+	(*CompareProductsRequest)(nil),                // 109: catalog.v1.CompareProductsRequest
+	(*CompareProductsResponse)(nil),               // 110: catalog.v1.CompareProductsResponse
+	(*Brand)(nil),                                 // 111: catalog.v1.Brand
+	(*ListBrandsRequest)(nil),                     // 112: catalog.v1.ListBrandsRequest
+	(*ListBrandsResponse)(nil),                    // 113: catalog.v1.ListBrandsResponse
+	(*GetBrandRequest)(nil),                       // 114: catalog.v1.GetBrandRequest
+	(*GetBrandResponse)(nil),                      // 115: catalog.v1.GetBrandResponse
+	(*CreateBrandRequest)(nil),                    // 116: catalog.v1.CreateBrandRequest
+	(*CreateBrandResponse)(nil),                   // 117: catalog.v1.CreateBrandResponse
+	(*UpdateBrandRequest)(nil),                    // 118: catalog.v1.UpdateBrandRequest
+	(*UpdateBrandResponse)(nil),                   // 119: catalog.v1.UpdateBrandResponse
+	(*DeleteBrandRequest)(nil),                    // 120: catalog.v1.DeleteBrandRequest
+	(*DeleteBrandResponse)(nil),                   // 121: catalog.v1.DeleteBrandResponse
+	(*Supplier)(nil),                              // 122: catalog.v1.Supplier
+	(*ListSuppliersRequest)(nil),                  // 123: catalog.v1.ListSuppliersRequest
+	(*ListSuppliersResponse)(nil),                 // 124: catalog.v1.ListSuppliersResponse
+	(*GetSupplierRequest)(nil),                    // 125: catalog.v1.GetSupplierRequest
+	(*GetSupplierResponse)(nil),                   // 126: catalog.v1.GetSupplierResponse
+	(*CreateSupplierRequest)(nil),                 // 127: catalog.v1.CreateSupplierRequest
+	(*CreateSupplierResponse)(nil),                // 128: catalog.v1.CreateSupplierResponse
+	(*UpdateSupplierRequest)(nil),                 // 129: catalog.v1.UpdateSupplierRequest
+	(*UpdateSupplierResponse)(nil),                // 130: catalog.v1.UpdateSupplierResponse
+	(*DeleteSupplierRequest)(nil),                 // 131: catalog.v1.DeleteSupplierRequest
+	(*DeleteSupplierResponse)(nil),                // 132: catalog.v1.DeleteSupplierResponse
+	(*SupplierCategoryMapping)(nil),               // 133: catalog.v1.SupplierCategoryMapping
+	(*ListSupplierCategoryMappingsRequest)(nil),   // 134: catalog.v1.ListSupplierCategoryMappingsRequest
+	(*ListSupplierCategoryMappingsResponse)(nil),  // 135: catalog.v1.ListSupplierCategoryMappingsResponse
+	(*GetSupplierCategoryMappingRequest)(nil),     // 136: catalog.v1.GetSupplierCategoryMappingRequest
+	(*GetSupplierCategoryMappingResponse)(nil),    // 137: catalog.v1.GetSupplierCategoryMappingResponse
+	(*CreateSupplierCategoryMappingRequest)(nil),  // 138: catalog.v1.CreateSupplierCategoryMappingRequest
+	(*CreateSupplierCategoryMappingResponse)(nil), // 139: catalog.v1.CreateSupplierCategoryMappingResponse
+	(*UpdateSupplierCategoryMappingRequest)(nil),  // 140: catalog.v1.UpdateSupplierCategoryMappingRequest
+	(*UpdateSupplierCategoryMappingResponse)(nil), // 141: catalog.v1.UpdateSupplierCategoryMappingResponse
+	(*DeleteSupplierCategoryMappingRequest)(nil),  // 142: catalog.v1.DeleteSupplierCategoryMappingRequest
+	(*DeleteSupplierCategoryMappingResponse)(nil), // 143: catalog.v1.DeleteSupplierCategoryMappingResponse
+	(*SupplierProductMapping)(nil),                // 144: catalog.v1.SupplierProductMapping
+	(*ListSupplierProductMappingsRequest)(nil),    // 145: catalog.v1.ListSupplierProductMappingsRequest
+	(*ListSupplierProductMappingsResponse)(nil),   // 146: catalog.v1.ListSupplierProductMappingsResponse
+	(*GetSupplierProductMappingRequest)(nil),      // 147: catalog.v1.GetSupplierProductMappingRequest
+	(*GetSupplierProductMappingResponse)(nil),     // 148: catalog.v1.GetSupplierProductMappingResponse
+	(*CreateSupplierProductMappingRequest)(nil),   // 149: catalog.v1.CreateSupplierProductMappingRequest
+	(*CreateSupplierProductMappingResponse)(nil),  // 150: catalog.v1.CreateSupplierProductMappingResponse
+	(*UpdateSupplierProductMappingRequest)(nil),   // 151: catalog.v1.UpdateSupplierProductMappingRequest
+	(*UpdateSupplierProductMappingResponse)(nil),  // 152: catalog.v1.UpdateSupplierProductMappingResponse
+	(*DeleteSupplierProductMappingRequest)(nil),   // 153: catalog.v1.DeleteSupplierProductMappingRequest
+	(*DeleteSupplierProductMappingResponse)(nil),  // 154: catalog.v1.DeleteSupplierProductMappingResponse
 }
 var file_catalog_v1_catalog_service_proto_depIdxs = []int32{
-	0,  // 0: catalog.v1.ListCategoriesResponse.categories:type_name -> catalog.v1.Category
-	0,  // 1: catalog.v1.GetCategoryResponse.category:type_name -> catalog.v1.Category
-	0,  // 2: catalog.v1.CreateCategoryResponse.category:type_name -> catalog.v1.Category
-	0,  // 3: catalog.v1.UpdateCategoryResponse.category:type_name -> catalog.v1.Category
-	11, // 4: catalog.v1.Product.images:type_name -> catalog.v1.ProductImage
-	12, // 5: catalog.v1.Product.attributes:type_name -> catalog.v1.ProductAttribute
-	13, // 6: catalog.v1.ListProductsResponse.products:type_name -> catalog.v1.Product
-	13, // 7: catalog.v1.GetProductResponse.product:type_name -> catalog.v1.Product
-	13, // 8: catalog.v1.CreateProductResponse.product:type_name -> catalog.v1.Product
-	13, // 9: catalog.v1.UpdateProductResponse.product:type_name -> catalog.v1.Product
-	11, // 10: catalog.v1.ListProductImagesResponse.images:type_name -> catalog.v1.ProductImage
-	11, // 11: catalog.v1.GetProductImageResponse.image:type_name -> catalog.v1.ProductImage
-	11, // 12: catalog.v1.CreateProductImageResponse.image:type_name -> catalog.v1.ProductImage
-	11, // 13: catalog.v1.UpdateProductImageResponse.image:type_name -> catalog.v1.ProductImage
-	12, // 14: catalog.v1.ListProductAttributesResponse.attributes:type_name -> catalog.v1.ProductAttribute
-	12, // 15: catalog.v1.GetProductAttributeResponse.attribute:type_name -> catalog.v1.ProductAttribute
-	12, // 16: catalog.v1.CreateProductAttributeResponse.attribute:type_name -> catalog.v1.ProductAttribute
-	12, // 17: catalog.v1.UpdateProductAttributeResponse.attribute:type_name -> catalog.v1.ProductAttribute
-	44, // 18: catalog.v1.ListBrandsResponse.brands:type_name -> catalog.v1.Brand
-	44, // 19: catalog.v1.GetBrandResponse.brand:type_name -> catalog.v1.Brand
-	44, // 20: catalog.v1.CreateBrandResponse.brand:type_name -> catalog.v1.Brand
-	44, // 21: catalog.v1.UpdateBrandResponse.brand:type_name -> catalog.v1.Brand
-	55, // 22: catalog.v1.ListSuppliersResponse.suppliers:type_name -> catalog.v1.Supplier
-	55, // 23: catalog.v1.GetSupplierResponse.supplier:type_name -> catalog.v1.Supplier
-	55, // 24: catalog.v1.CreateSupplierResponse.supplier:type_name -> catalog.v1.Supplier
-	55, // 25: catalog.v1.UpdateSupplierResponse.supplier:type_name -> catalog.v1.Supplier
-	66, // 26: catalog.v1.ListSupplierCategoryMappingsResponse.mappings:type_name -> catalog.v1.SupplierCategoryMapping
-	66, // 27: catalog.v1.GetSupplierCategoryMappingResponse.mapping:type_name -> catalog.v1.SupplierCategoryMapping
-	66, // 28: catalog.v1.CreateSupplierCategoryMappingResponse.mapping:type_name -> catalog.v1.SupplierCategoryMapping
-	66, // 29: catalog.v1.UpdateSupplierCategoryMappingResponse.mapping:type_name -> catalog.v1.SupplierCategoryMapping
-	77, // 30: catalog.v1.ListSupplierProductMappingsResponse.mappings:type_name -> catalog.v1.SupplierProductMapping
-	77, // 31: catalog.v1.GetSupplierProductMappingResponse.mapping:type_name -> catalog.v1.SupplierProductMapping
-	77, // 32: catalog.v1.CreateSupplierProductMappingResponse.mapping:type_name -> catalog.v1.SupplierProductMapping
-	77, // 33: catalog.v1.UpdateSupplierProductMappingResponse.mapping:type_name -> catalog.v1.SupplierProductMapping
-	56, // 34: catalog.v1.CatalogService.ListSuppliers:input_type -> catalog.v1.ListSuppliersRequest
-	58, // 35: catalog.v1.CatalogService.GetSupplier:input_type -> catalog.v1.GetSupplierRequest
-	60, // 36: catalog.v1.CatalogService.CreateSupplier:input_type -> catalog.v1.CreateSupplierRequest
-	62, // 37: catalog.v1.CatalogService.UpdateSupplier:input_type -> catalog.v1.UpdateSupplierRequest
-	64, // 38: catalog.v1.CatalogService.DeleteSupplier:input_type -> catalog.v1.DeleteSupplierRequest
-	1,  // 39: catalog.v1.CatalogService.ListCategories:input_type -> catalog.v1.ListCategoriesRequest
-	3,  // 40: catalog.v1.CatalogService.GetCategory:input_type -> catalog.v1.GetCategoryRequest
-	5,  // 41: catalog.v1.CatalogService.CreateCategory:input_type -> catalog.v1.CreateCategoryRequest
-	7,  // 42: catalog.v1.CatalogService.UpdateCategory:input_type -> catalog.v1.UpdateCategoryRequest
-	9,  // 43: catalog.v1.CatalogService.DeleteCategory:input_type -> catalog.v1.DeleteCategoryRequest
-	14, // 44: catalog.v1.CatalogService.ListProducts:input_type -> catalog.v1.ListProductsRequest
-	16, // 45: catalog.v1.CatalogService.GetProduct:input_type -> catalog.v1.GetProductRequest
-	18, // 46: catalog.v1.CatalogService.CreateProduct:input_type -> catalog.v1.CreateProductRequest
-	20, // 47: catalog.v1.CatalogService.UpdateProduct:input_type -> catalog.v1.UpdateProductRequest
-	22, // 48: catalog.v1.CatalogService.DeleteProduct:input_type -> catalog.v1.DeleteProductRequest
-	24, // 49: catalog.v1.CatalogService.ListProductImages:input_type -> catalog.v1.ListProductImagesRequest
-	26, // 50: catalog.v1.CatalogService.GetProductImage:input_type -> catalog.v1.GetProductImageRequest
-	28, // 51: catalog.v1.CatalogService.CreateProductImage:input_type -> catalog.v1.CreateProductImageRequest
-	30, // 52: catalog.v1.CatalogService.UpdateProductImage:input_type -> catalog.v1.UpdateProductImageRequest
-	32, // 53: catalog.v1.CatalogService.DeleteProductImage:input_type -> catalog.v1.DeleteProductImageRequest
-	34, // 54: catalog.v1.CatalogService.ListProductAttributes:input_type -> catalog.v1.ListProductAttributesRequest
-	36, // 55: catalog.v1.CatalogService.GetProductAttribute:input_type -> catalog.v1.GetProductAttributeRequest
-	38, // 56: catalog.v1.CatalogService.CreateProductAttribute:input_type -> catalog.v1.CreateProductAttributeRequest
-	40, // 57: catalog.v1.CatalogService.UpdateProductAttribute:input_type -> catalog.v1.UpdateProductAttributeRequest
-	42, // 58: catalog.v1.CatalogService.DeleteProductAttribute:input_type -> catalog.v1.DeleteProductAttributeRequest
-	45, // 59: catalog.v1.CatalogService.ListBrands:input_type -> catalog.v1.ListBrandsRequest
-	47, // 60: catalog.v1.CatalogService.GetBrand:input_type -> catalog.v1.GetBrandRequest
-	49, // 61: catalog.v1.CatalogService.CreateBrand:input_type -> catalog.v1.CreateBrandRequest
-	51, // 62: catalog.v1.CatalogService.UpdateBrand:input_type -> catalog.v1.UpdateBrandRequest
-	53, // 63: catalog.v1.CatalogService.DeleteBrand:input_type -> catalog.v1.DeleteBrandRequest
-	67, // 64: catalog.v1.CatalogService.ListSupplierCategoryMappings:input_type -> catalog.v1.ListSupplierCategoryMappingsRequest
-	69, // 65: catalog.v1.CatalogService.GetSupplierCategoryMapping:input_type -> catalog.v1.GetSupplierCategoryMappingRequest
-	71, // 66: catalog.v1.CatalogService.CreateSupplierCategoryMapping:input_type -> catalog.v1.CreateSupplierCategoryMappingRequest
-	73, // 67: catalog.v1.CatalogService.UpdateSupplierCategoryMapping:input_type -> catalog.v1.UpdateSupplierCategoryMappingRequest
-	75, // 68: catalog.v1.CatalogService.DeleteSupplierCategoryMapping:input_type -> catalog.v1.DeleteSupplierCategoryMappingRequest
-	78, // 69: catalog.v1.CatalogService.ListSupplierProductMappings:input_type -> catalog.v1.ListSupplierProductMappingsRequest
-	80, // 70: catalog.v1.CatalogService.GetSupplierProductMapping:input_type -> catalog.v1.GetSupplierProductMappingRequest
-	82, // 71: catalog.v1.CatalogService.CreateSupplierProductMapping:input_type -> catalog.v1.CreateSupplierProductMappingRequest
-	84, // 72: catalog.v1.CatalogService.UpdateSupplierProductMapping:input_type -> catalog.v1.UpdateSupplierProductMappingRequest
-	86, // 73: catalog.v1.CatalogService.DeleteSupplierProductMapping:input_type -> catalog.v1.DeleteSupplierProductMappingRequest
-	57, // 74: catalog.v1.CatalogService.ListSuppliers:output_type -> catalog.v1.ListSuppliersResponse
-	59, // 75: catalog.v1.CatalogService.GetSupplier:output_type -> catalog.v1.GetSupplierResponse
-	61, // 76: catalog.v1.CatalogService.CreateSupplier:output_type -> catalog.v1.CreateSupplierResponse
-	63, // 77: catalog.v1.CatalogService.UpdateSupplier:output_type -> catalog.v1.UpdateSupplierResponse
-	65, // 78: catalog.v1.CatalogService.DeleteSupplier:output_type -> catalog.v1.DeleteSupplierResponse
-	2,  // 79: catalog.v1.CatalogService.ListCategories:output_type -> catalog.v1.ListCategoriesResponse
-	4,  // 80: catalog.v1.CatalogService.GetCategory:output_type -> catalog.v1.GetCategoryResponse
-	6,  // 81: catalog.v1.CatalogService.CreateCategory:output_type -> catalog.v1.CreateCategoryResponse
-	8,  // 82: catalog.v1.CatalogService.UpdateCategory:output_type -> catalog.v1.UpdateCategoryResponse
-	10, // 83: catalog.v1.CatalogService.DeleteCategory:output_type -> catalog.v1.DeleteCategoryResponse
-	15, // 84: catalog.v1.CatalogService.ListProducts:output_type -> catalog.v1.ListProductsResponse
-	17, // 85: catalog.v1.CatalogService.GetProduct:output_type -> catalog.v1.GetProductResponse
-	19, // 86: catalog.v1.CatalogService.CreateProduct:output_type -> catalog.v1.CreateProductResponse
-	21, // 87: catalog.v1.CatalogService.UpdateProduct:output_type -> catalog.v1.UpdateProductResponse
-	23, // 88: catalog.v1.CatalogService.DeleteProduct:output_type -> catalog.v1.DeleteProductResponse
-	25, // 89: catalog.v1.CatalogService.ListProductImages:output_type -> catalog.v1.ListProductImagesResponse
-	27, // 90: catalog.v1.CatalogService.GetProductImage:output_type -> catalog.v1.GetProductImageResponse
-	29, // 91: catalog.v1.CatalogService.CreateProductImage:output_type -> catalog.v1.CreateProductImageResponse
-	31, // 92: catalog.v1.CatalogService.UpdateProductImage:output_type -> catalog.v1.UpdateProductImageResponse
-	33, // 93: catalog.v1.CatalogService.DeleteProductImage:output_type -> catalog.v1.DeleteProductImageResponse
-	35, // 94: catalog.v1.CatalogService.ListProductAttributes:output_type -> catalog.v1.ListProductAttributesResponse
-	37, // 95: catalog.v1.CatalogService.GetProductAttribute:output_type -> catalog.v1.GetProductAttributeResponse
-	39, // 96: catalog.v1.CatalogService.CreateProductAttribute:output_type -> catalog.v1.CreateProductAttributeResponse
-	41, // 97: catalog.v1.CatalogService.UpdateProductAttribute:output_type -> catalog.v1.UpdateProductAttributeResponse
-	43, // 98: catalog.v1.CatalogService.DeleteProductAttribute:output_type -> catalog.v1.DeleteProductAttributeResponse
-	46, // 99: catalog.v1.CatalogService.ListBrands:output_type -> catalog.v1.ListBrandsResponse
-	48, // 100: catalog.v1.CatalogService.GetBrand:output_type -> catalog.v1.GetBrandResponse
-	50, // 101: catalog.v1.CatalogService.CreateBrand:output_type -> catalog.v1.CreateBrandResponse
-	52, // 102: catalog.v1.CatalogService.UpdateBrand:output_type -> catalog.v1.UpdateBrandResponse
-	54, // 103: catalog.v1.CatalogService.DeleteBrand:output_type -> catalog.v1.DeleteBrandResponse
-	68, // 104: catalog.v1.CatalogService.ListSupplierCategoryMappings:output_type -> catalog.v1.ListSupplierCategoryMappingsResponse
-	70, // 105: catalog.v1.CatalogService.GetSupplierCategoryMapping:output_type -> catalog.v1.GetSupplierCategoryMappingResponse
-	72, // 106: catalog.v1.CatalogService.CreateSupplierCategoryMapping:output_type -> catalog.v1.CreateSupplierCategoryMappingResponse
-	74, // 107: catalog.v1.CatalogService.UpdateSupplierCategoryMapping:output_type -> catalog.v1.UpdateSupplierCategoryMappingResponse
-	76, // 108: catalog.v1.CatalogService.DeleteSupplierCategoryMapping:output_type -> catalog.v1.DeleteSupplierCategoryMappingResponse
-	79, // 109: catalog.v1.CatalogService.ListSupplierProductMappings:output_type -> catalog.v1.ListSupplierProductMappingsResponse
-	81, // 110: catalog.v1.CatalogService.GetSupplierProductMapping:output_type -> catalog.v1.GetSupplierProductMappingResponse
-	83, // 111: catalog.v1.CatalogService.CreateSupplierProductMapping:output_type -> catalog.v1.CreateSupplierProductMappingResponse
-	85, // 112: catalog.v1.CatalogService.UpdateSupplierProductMapping:output_type -> catalog.v1.UpdateSupplierProductMappingResponse
-	87, // 113: catalog.v1.CatalogService.DeleteSupplierProductMapping:output_type -> catalog.v1.DeleteSupplierProductMappingResponse
-	74, // [74:114] is the sub-list for method output_type
-	34, // [34:74] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	0,   // 0: catalog.v1.ListCategoriesResponse.categories:type_name -> catalog.v1.Category
+	0,   // 1: catalog.v1.GetCategoryResponse.category:type_name -> catalog.v1.Category
+	0,   // 2: catalog.v1.CreateCategoryResponse.category:type_name -> catalog.v1.Category
+	0,   // 3: catalog.v1.UpdateCategoryResponse.category:type_name -> catalog.v1.Category
+	11,  // 4: catalog.v1.Product.images:type_name -> catalog.v1.ProductImage
+	12,  // 5: catalog.v1.Product.attributes:type_name -> catalog.v1.ProductAttribute
+	44,  // 6: catalog.v1.Product.relations:type_name -> catalog.v1.RelatedProduct
+	15,  // 7: catalog.v1.Question.answers:type_name -> catalog.v1.Answer
+	16,  // 8: catalog.v1.ListProductQuestionsResponse.questions:type_name -> catalog.v1.Question
+	16,  // 9: catalog.v1.ListQuestionsResponse.questions:type_name -> catalog.v1.Question
+	16,  // 10: catalog.v1.AskProductQuestionResponse.question:type_name -> catalog.v1.Question
+	15,  // 11: catalog.v1.AnswerProductQuestionResponse.answer:type_name -> catalog.v1.Answer
+	16,  // 12: catalog.v1.ModerateProductQuestionResponse.question:type_name -> catalog.v1.Question
+	15,  // 13: catalog.v1.ModerateProductAnswerResponse.answer:type_name -> catalog.v1.Answer
+	14,  // 14: catalog.v1.ListProductReviewsResponse.reviews:type_name -> catalog.v1.Review
+	14,  // 15: catalog.v1.CreateProductReviewResponse.review:type_name -> catalog.v1.Review
+	14,  // 16: catalog.v1.ListReviewsResponse.reviews:type_name -> catalog.v1.Review
+	14,  // 17: catalog.v1.ModerateReviewResponse.review:type_name -> catalog.v1.Review
+	43,  // 18: catalog.v1.RelatedProduct.relation:type_name -> catalog.v1.ProductRelation
+	13,  // 19: catalog.v1.RelatedProduct.product:type_name -> catalog.v1.Product
+	13,  // 20: catalog.v1.ListProductsResponse.products:type_name -> catalog.v1.Product
+	13,  // 21: catalog.v1.GetProductResponse.product:type_name -> catalog.v1.Product
+	13,  // 22: catalog.v1.CreateProductResponse.product:type_name -> catalog.v1.Product
+	13,  // 23: catalog.v1.UpdateProductResponse.product:type_name -> catalog.v1.Product
+	13,  // 24: catalog.v1.UpdateProductPriceResponse.product:type_name -> catalog.v1.Product
+	11,  // 25: catalog.v1.ListProductImagesResponse.images:type_name -> catalog.v1.ProductImage
+	11,  // 26: catalog.v1.GetProductImageResponse.image:type_name -> catalog.v1.ProductImage
+	11,  // 27: catalog.v1.CreateProductImageResponse.image:type_name -> catalog.v1.ProductImage
+	11,  // 28: catalog.v1.UpdateProductImageResponse.image:type_name -> catalog.v1.ProductImage
+	12,  // 29: catalog.v1.ListProductAttributesResponse.attributes:type_name -> catalog.v1.ProductAttribute
+	12,  // 30: catalog.v1.GetProductAttributeResponse.attribute:type_name -> catalog.v1.ProductAttribute
+	12,  // 31: catalog.v1.CreateProductAttributeResponse.attribute:type_name -> catalog.v1.ProductAttribute
+	12,  // 32: catalog.v1.UpdateProductAttributeResponse.attribute:type_name -> catalog.v1.ProductAttribute
+	13,  // 33: catalog.v1.BundleItem.product:type_name -> catalog.v1.Product
+	13,  // 34: catalog.v1.Bundle.product:type_name -> catalog.v1.Product
+	77,  // 35: catalog.v1.Bundle.items:type_name -> catalog.v1.BundleItem
+	78,  // 36: catalog.v1.GetBundleResponse.bundle:type_name -> catalog.v1.Bundle
+	79,  // 37: catalog.v1.CreateBundleRequest.items:type_name -> catalog.v1.BundleItemInput
+	78,  // 38: catalog.v1.CreateBundleResponse.bundle:type_name -> catalog.v1.Bundle
+	79,  // 39: catalog.v1.UpdateBundleRequest.items:type_name -> catalog.v1.BundleItemInput
+	78,  // 40: catalog.v1.UpdateBundleResponse.bundle:type_name -> catalog.v1.Bundle
+	86,  // 41: catalog.v1.ReserveStockRequest.lines:type_name -> catalog.v1.StockLine
+	86,  // 42: catalog.v1.CommitStockRequest.lines:type_name -> catalog.v1.StockLine
+	86,  // 43: catalog.v1.ReleaseStockRequest.lines:type_name -> catalog.v1.StockLine
+	43,  // 44: catalog.v1.ListProductRelationsResponse.relations:type_name -> catalog.v1.ProductRelation
+	43,  // 45: catalog.v1.CreateProductRelationResponse.relation:type_name -> catalog.v1.ProductRelation
+	43,  // 46: catalog.v1.UpdateProductRelationResponse.relation:type_name -> catalog.v1.ProductRelation
+	13,  // 47: catalog.v1.ProductListItem.product:type_name -> catalog.v1.Product
+	101, // 48: catalog.v1.ListProductListItemsResponse.items:type_name -> catalog.v1.ProductListItem
+	13,  // 49: catalog.v1.CompareProductsResponse.products:type_name -> catalog.v1.Product
+	102, // 50: catalog.v1.CompareProductsResponse.rows:type_name -> catalog.v1.ComparisonRow
+	111, // 51: catalog.v1.ListBrandsResponse.brands:type_name -> catalog.v1.Brand
+	111, // 52: catalog.v1.GetBrandResponse.brand:type_name -> catalog.v1.Brand
+	111, // 53: catalog.v1.CreateBrandResponse.brand:type_name -> catalog.v1.Brand
+	111, // 54: catalog.v1.UpdateBrandResponse.brand:type_name -> catalog.v1.Brand
+	122, // 55: catalog.v1.ListSuppliersResponse.suppliers:type_name -> catalog.v1.Supplier
+	122, // 56: catalog.v1.GetSupplierResponse.supplier:type_name -> catalog.v1.Supplier
+	122, // 57: catalog.v1.CreateSupplierResponse.supplier:type_name -> catalog.v1.Supplier
+	122, // 58: catalog.v1.UpdateSupplierResponse.supplier:type_name -> catalog.v1.Supplier
+	133, // 59: catalog.v1.ListSupplierCategoryMappingsResponse.mappings:type_name -> catalog.v1.SupplierCategoryMapping
+	133, // 60: catalog.v1.GetSupplierCategoryMappingResponse.mapping:type_name -> catalog.v1.SupplierCategoryMapping
+	133, // 61: catalog.v1.CreateSupplierCategoryMappingResponse.mapping:type_name -> catalog.v1.SupplierCategoryMapping
+	133, // 62: catalog.v1.UpdateSupplierCategoryMappingResponse.mapping:type_name -> catalog.v1.SupplierCategoryMapping
+	144, // 63: catalog.v1.ListSupplierProductMappingsResponse.mappings:type_name -> catalog.v1.SupplierProductMapping
+	144, // 64: catalog.v1.GetSupplierProductMappingResponse.mapping:type_name -> catalog.v1.SupplierProductMapping
+	144, // 65: catalog.v1.CreateSupplierProductMappingResponse.mapping:type_name -> catalog.v1.SupplierProductMapping
+	144, // 66: catalog.v1.UpdateSupplierProductMappingResponse.mapping:type_name -> catalog.v1.SupplierProductMapping
+	123, // 67: catalog.v1.CatalogService.ListSuppliers:input_type -> catalog.v1.ListSuppliersRequest
+	125, // 68: catalog.v1.CatalogService.GetSupplier:input_type -> catalog.v1.GetSupplierRequest
+	127, // 69: catalog.v1.CatalogService.CreateSupplier:input_type -> catalog.v1.CreateSupplierRequest
+	129, // 70: catalog.v1.CatalogService.UpdateSupplier:input_type -> catalog.v1.UpdateSupplierRequest
+	131, // 71: catalog.v1.CatalogService.DeleteSupplier:input_type -> catalog.v1.DeleteSupplierRequest
+	1,   // 72: catalog.v1.CatalogService.ListCategories:input_type -> catalog.v1.ListCategoriesRequest
+	3,   // 73: catalog.v1.CatalogService.GetCategory:input_type -> catalog.v1.GetCategoryRequest
+	5,   // 74: catalog.v1.CatalogService.CreateCategory:input_type -> catalog.v1.CreateCategoryRequest
+	7,   // 75: catalog.v1.CatalogService.UpdateCategory:input_type -> catalog.v1.UpdateCategoryRequest
+	9,   // 76: catalog.v1.CatalogService.DeleteCategory:input_type -> catalog.v1.DeleteCategoryRequest
+	45,  // 77: catalog.v1.CatalogService.ListProducts:input_type -> catalog.v1.ListProductsRequest
+	47,  // 78: catalog.v1.CatalogService.GetProduct:input_type -> catalog.v1.GetProductRequest
+	49,  // 79: catalog.v1.CatalogService.CreateProduct:input_type -> catalog.v1.CreateProductRequest
+	51,  // 80: catalog.v1.CatalogService.UpdateProduct:input_type -> catalog.v1.UpdateProductRequest
+	53,  // 81: catalog.v1.CatalogService.UpdateProductPrice:input_type -> catalog.v1.UpdateProductPriceRequest
+	55,  // 82: catalog.v1.CatalogService.DeleteProduct:input_type -> catalog.v1.DeleteProductRequest
+	57,  // 83: catalog.v1.CatalogService.ListProductImages:input_type -> catalog.v1.ListProductImagesRequest
+	59,  // 84: catalog.v1.CatalogService.GetProductImage:input_type -> catalog.v1.GetProductImageRequest
+	61,  // 85: catalog.v1.CatalogService.CreateProductImage:input_type -> catalog.v1.CreateProductImageRequest
+	63,  // 86: catalog.v1.CatalogService.UpdateProductImage:input_type -> catalog.v1.UpdateProductImageRequest
+	65,  // 87: catalog.v1.CatalogService.DeleteProductImage:input_type -> catalog.v1.DeleteProductImageRequest
+	67,  // 88: catalog.v1.CatalogService.ListProductAttributes:input_type -> catalog.v1.ListProductAttributesRequest
+	69,  // 89: catalog.v1.CatalogService.GetProductAttribute:input_type -> catalog.v1.GetProductAttributeRequest
+	71,  // 90: catalog.v1.CatalogService.CreateProductAttribute:input_type -> catalog.v1.CreateProductAttributeRequest
+	73,  // 91: catalog.v1.CatalogService.UpdateProductAttribute:input_type -> catalog.v1.UpdateProductAttributeRequest
+	75,  // 92: catalog.v1.CatalogService.DeleteProductAttribute:input_type -> catalog.v1.DeleteProductAttributeRequest
+	93,  // 93: catalog.v1.CatalogService.ListProductRelations:input_type -> catalog.v1.ListProductRelationsRequest
+	95,  // 94: catalog.v1.CatalogService.CreateProductRelation:input_type -> catalog.v1.CreateProductRelationRequest
+	97,  // 95: catalog.v1.CatalogService.UpdateProductRelation:input_type -> catalog.v1.UpdateProductRelationRequest
+	99,  // 96: catalog.v1.CatalogService.DeleteProductRelation:input_type -> catalog.v1.DeleteProductRelationRequest
+	80,  // 97: catalog.v1.CatalogService.GetBundle:input_type -> catalog.v1.GetBundleRequest
+	82,  // 98: catalog.v1.CatalogService.CreateBundle:input_type -> catalog.v1.CreateBundleRequest
+	84,  // 99: catalog.v1.CatalogService.UpdateBundle:input_type -> catalog.v1.UpdateBundleRequest
+	87,  // 100: catalog.v1.CatalogService.ReserveStock:input_type -> catalog.v1.ReserveStockRequest
+	89,  // 101: catalog.v1.CatalogService.CommitStock:input_type -> catalog.v1.CommitStockRequest
+	91,  // 102: catalog.v1.CatalogService.ReleaseStock:input_type -> catalog.v1.ReleaseStockRequest
+	33,  // 103: catalog.v1.CatalogService.ListProductReviews:input_type -> catalog.v1.ListProductReviewsRequest
+	35,  // 104: catalog.v1.CatalogService.CreateProductReview:input_type -> catalog.v1.CreateProductReviewRequest
+	37,  // 105: catalog.v1.CatalogService.ListReviews:input_type -> catalog.v1.ListReviewsRequest
+	39,  // 106: catalog.v1.CatalogService.ModerateReview:input_type -> catalog.v1.ModerateReviewRequest
+	41,  // 107: catalog.v1.CatalogService.DeleteReview:input_type -> catalog.v1.DeleteReviewRequest
+	17,  // 108: catalog.v1.CatalogService.ListProductQuestions:input_type -> catalog.v1.ListProductQuestionsRequest
+	21,  // 109: catalog.v1.CatalogService.AskProductQuestion:input_type -> catalog.v1.AskProductQuestionRequest
+	25,  // 110: catalog.v1.CatalogService.ModerateProductQuestion:input_type -> catalog.v1.ModerateProductQuestionRequest
+	27,  // 111: catalog.v1.CatalogService.DeleteProductQuestion:input_type -> catalog.v1.DeleteProductQuestionRequest
+	23,  // 112: catalog.v1.CatalogService.AnswerProductQuestion:input_type -> catalog.v1.AnswerProductQuestionRequest
+	29,  // 113: catalog.v1.CatalogService.ModerateProductAnswer:input_type -> catalog.v1.ModerateProductAnswerRequest
+	31,  // 114: catalog.v1.CatalogService.DeleteProductAnswer:input_type -> catalog.v1.DeleteProductAnswerRequest
+	19,  // 115: catalog.v1.CatalogService.ListQuestions:input_type -> catalog.v1.ListQuestionsRequest
+	103, // 116: catalog.v1.CatalogService.ListProductListItems:input_type -> catalog.v1.ListProductListItemsRequest
+	105, // 117: catalog.v1.CatalogService.AddProductListItem:input_type -> catalog.v1.AddProductListItemRequest
+	107, // 118: catalog.v1.CatalogService.RemoveProductListItem:input_type -> catalog.v1.RemoveProductListItemRequest
+	109, // 119: catalog.v1.CatalogService.CompareProducts:input_type -> catalog.v1.CompareProductsRequest
+	112, // 120: catalog.v1.CatalogService.ListBrands:input_type -> catalog.v1.ListBrandsRequest
+	114, // 121: catalog.v1.CatalogService.GetBrand:input_type -> catalog.v1.GetBrandRequest
+	116, // 122: catalog.v1.CatalogService.CreateBrand:input_type -> catalog.v1.CreateBrandRequest
+	118, // 123: catalog.v1.CatalogService.UpdateBrand:input_type -> catalog.v1.UpdateBrandRequest
+	120, // 124: catalog.v1.CatalogService.DeleteBrand:input_type -> catalog.v1.DeleteBrandRequest
+	134, // 125: catalog.v1.CatalogService.ListSupplierCategoryMappings:input_type -> catalog.v1.ListSupplierCategoryMappingsRequest
+	136, // 126: catalog.v1.CatalogService.GetSupplierCategoryMapping:input_type -> catalog.v1.GetSupplierCategoryMappingRequest
+	138, // 127: catalog.v1.CatalogService.CreateSupplierCategoryMapping:input_type -> catalog.v1.CreateSupplierCategoryMappingRequest
+	140, // 128: catalog.v1.CatalogService.UpdateSupplierCategoryMapping:input_type -> catalog.v1.UpdateSupplierCategoryMappingRequest
+	142, // 129: catalog.v1.CatalogService.DeleteSupplierCategoryMapping:input_type -> catalog.v1.DeleteSupplierCategoryMappingRequest
+	145, // 130: catalog.v1.CatalogService.ListSupplierProductMappings:input_type -> catalog.v1.ListSupplierProductMappingsRequest
+	147, // 131: catalog.v1.CatalogService.GetSupplierProductMapping:input_type -> catalog.v1.GetSupplierProductMappingRequest
+	149, // 132: catalog.v1.CatalogService.CreateSupplierProductMapping:input_type -> catalog.v1.CreateSupplierProductMappingRequest
+	151, // 133: catalog.v1.CatalogService.UpdateSupplierProductMapping:input_type -> catalog.v1.UpdateSupplierProductMappingRequest
+	153, // 134: catalog.v1.CatalogService.DeleteSupplierProductMapping:input_type -> catalog.v1.DeleteSupplierProductMappingRequest
+	124, // 135: catalog.v1.CatalogService.ListSuppliers:output_type -> catalog.v1.ListSuppliersResponse
+	126, // 136: catalog.v1.CatalogService.GetSupplier:output_type -> catalog.v1.GetSupplierResponse
+	128, // 137: catalog.v1.CatalogService.CreateSupplier:output_type -> catalog.v1.CreateSupplierResponse
+	130, // 138: catalog.v1.CatalogService.UpdateSupplier:output_type -> catalog.v1.UpdateSupplierResponse
+	132, // 139: catalog.v1.CatalogService.DeleteSupplier:output_type -> catalog.v1.DeleteSupplierResponse
+	2,   // 140: catalog.v1.CatalogService.ListCategories:output_type -> catalog.v1.ListCategoriesResponse
+	4,   // 141: catalog.v1.CatalogService.GetCategory:output_type -> catalog.v1.GetCategoryResponse
+	6,   // 142: catalog.v1.CatalogService.CreateCategory:output_type -> catalog.v1.CreateCategoryResponse
+	8,   // 143: catalog.v1.CatalogService.UpdateCategory:output_type -> catalog.v1.UpdateCategoryResponse
+	10,  // 144: catalog.v1.CatalogService.DeleteCategory:output_type -> catalog.v1.DeleteCategoryResponse
+	46,  // 145: catalog.v1.CatalogService.ListProducts:output_type -> catalog.v1.ListProductsResponse
+	48,  // 146: catalog.v1.CatalogService.GetProduct:output_type -> catalog.v1.GetProductResponse
+	50,  // 147: catalog.v1.CatalogService.CreateProduct:output_type -> catalog.v1.CreateProductResponse
+	52,  // 148: catalog.v1.CatalogService.UpdateProduct:output_type -> catalog.v1.UpdateProductResponse
+	54,  // 149: catalog.v1.CatalogService.UpdateProductPrice:output_type -> catalog.v1.UpdateProductPriceResponse
+	56,  // 150: catalog.v1.CatalogService.DeleteProduct:output_type -> catalog.v1.DeleteProductResponse
+	58,  // 151: catalog.v1.CatalogService.ListProductImages:output_type -> catalog.v1.ListProductImagesResponse
+	60,  // 152: catalog.v1.CatalogService.GetProductImage:output_type -> catalog.v1.GetProductImageResponse
+	62,  // 153: catalog.v1.CatalogService.CreateProductImage:output_type -> catalog.v1.CreateProductImageResponse
+	64,  // 154: catalog.v1.CatalogService.UpdateProductImage:output_type -> catalog.v1.UpdateProductImageResponse
+	66,  // 155: catalog.v1.CatalogService.DeleteProductImage:output_type -> catalog.v1.DeleteProductImageResponse
+	68,  // 156: catalog.v1.CatalogService.ListProductAttributes:output_type -> catalog.v1.ListProductAttributesResponse
+	70,  // 157: catalog.v1.CatalogService.GetProductAttribute:output_type -> catalog.v1.GetProductAttributeResponse
+	72,  // 158: catalog.v1.CatalogService.CreateProductAttribute:output_type -> catalog.v1.CreateProductAttributeResponse
+	74,  // 159: catalog.v1.CatalogService.UpdateProductAttribute:output_type -> catalog.v1.UpdateProductAttributeResponse
+	76,  // 160: catalog.v1.CatalogService.DeleteProductAttribute:output_type -> catalog.v1.DeleteProductAttributeResponse
+	94,  // 161: catalog.v1.CatalogService.ListProductRelations:output_type -> catalog.v1.ListProductRelationsResponse
+	96,  // 162: catalog.v1.CatalogService.CreateProductRelation:output_type -> catalog.v1.CreateProductRelationResponse
+	98,  // 163: catalog.v1.CatalogService.UpdateProductRelation:output_type -> catalog.v1.UpdateProductRelationResponse
+	100, // 164: catalog.v1.CatalogService.DeleteProductRelation:output_type -> catalog.v1.DeleteProductRelationResponse
+	81,  // 165: catalog.v1.CatalogService.GetBundle:output_type -> catalog.v1.GetBundleResponse
+	83,  // 166: catalog.v1.CatalogService.CreateBundle:output_type -> catalog.v1.CreateBundleResponse
+	85,  // 167: catalog.v1.CatalogService.UpdateBundle:output_type -> catalog.v1.UpdateBundleResponse
+	88,  // 168: catalog.v1.CatalogService.ReserveStock:output_type -> catalog.v1.ReserveStockResponse
+	90,  // 169: catalog.v1.CatalogService.CommitStock:output_type -> catalog.v1.CommitStockResponse
+	92,  // 170: catalog.v1.CatalogService.ReleaseStock:output_type -> catalog.v1.ReleaseStockResponse
+	34,  // 171: catalog.v1.CatalogService.ListProductReviews:output_type -> catalog.v1.ListProductReviewsResponse
+	36,  // 172: catalog.v1.CatalogService.CreateProductReview:output_type -> catalog.v1.CreateProductReviewResponse
+	38,  // 173: catalog.v1.CatalogService.ListReviews:output_type -> catalog.v1.ListReviewsResponse
+	40,  // 174: catalog.v1.CatalogService.ModerateReview:output_type -> catalog.v1.ModerateReviewResponse
+	42,  // 175: catalog.v1.CatalogService.DeleteReview:output_type -> catalog.v1.DeleteReviewResponse
+	18,  // 176: catalog.v1.CatalogService.ListProductQuestions:output_type -> catalog.v1.ListProductQuestionsResponse
+	22,  // 177: catalog.v1.CatalogService.AskProductQuestion:output_type -> catalog.v1.AskProductQuestionResponse
+	26,  // 178: catalog.v1.CatalogService.ModerateProductQuestion:output_type -> catalog.v1.ModerateProductQuestionResponse
+	28,  // 179: catalog.v1.CatalogService.DeleteProductQuestion:output_type -> catalog.v1.DeleteProductQuestionResponse
+	24,  // 180: catalog.v1.CatalogService.AnswerProductQuestion:output_type -> catalog.v1.AnswerProductQuestionResponse
+	30,  // 181: catalog.v1.CatalogService.ModerateProductAnswer:output_type -> catalog.v1.ModerateProductAnswerResponse
+	32,  // 182: catalog.v1.CatalogService.DeleteProductAnswer:output_type -> catalog.v1.DeleteProductAnswerResponse
+	20,  // 183: catalog.v1.CatalogService.ListQuestions:output_type -> catalog.v1.ListQuestionsResponse
+	104, // 184: catalog.v1.CatalogService.ListProductListItems:output_type -> catalog.v1.ListProductListItemsResponse
+	106, // 185: catalog.v1.CatalogService.AddProductListItem:output_type -> catalog.v1.AddProductListItemResponse
+	108, // 186: catalog.v1.CatalogService.RemoveProductListItem:output_type -> catalog.v1.RemoveProductListItemResponse
+	110, // 187: catalog.v1.CatalogService.CompareProducts:output_type -> catalog.v1.CompareProductsResponse
+	113, // 188: catalog.v1.CatalogService.ListBrands:output_type -> catalog.v1.ListBrandsResponse
+	115, // 189: catalog.v1.CatalogService.GetBrand:output_type -> catalog.v1.GetBrandResponse
+	117, // 190: catalog.v1.CatalogService.CreateBrand:output_type -> catalog.v1.CreateBrandResponse
+	119, // 191: catalog.v1.CatalogService.UpdateBrand:output_type -> catalog.v1.UpdateBrandResponse
+	121, // 192: catalog.v1.CatalogService.DeleteBrand:output_type -> catalog.v1.DeleteBrandResponse
+	135, // 193: catalog.v1.CatalogService.ListSupplierCategoryMappings:output_type -> catalog.v1.ListSupplierCategoryMappingsResponse
+	137, // 194: catalog.v1.CatalogService.GetSupplierCategoryMapping:output_type -> catalog.v1.GetSupplierCategoryMappingResponse
+	139, // 195: catalog.v1.CatalogService.CreateSupplierCategoryMapping:output_type -> catalog.v1.CreateSupplierCategoryMappingResponse
+	141, // 196: catalog.v1.CatalogService.UpdateSupplierCategoryMapping:output_type -> catalog.v1.UpdateSupplierCategoryMappingResponse
+	143, // 197: catalog.v1.CatalogService.DeleteSupplierCategoryMapping:output_type -> catalog.v1.DeleteSupplierCategoryMappingResponse
+	146, // 198: catalog.v1.CatalogService.ListSupplierProductMappings:output_type -> catalog.v1.ListSupplierProductMappingsResponse
+	148, // 199: catalog.v1.CatalogService.GetSupplierProductMapping:output_type -> catalog.v1.GetSupplierProductMappingResponse
+	150, // 200: catalog.v1.CatalogService.CreateSupplierProductMapping:output_type -> catalog.v1.CreateSupplierProductMappingResponse
+	152, // 201: catalog.v1.CatalogService.UpdateSupplierProductMapping:output_type -> catalog.v1.UpdateSupplierProductMappingResponse
+	154, // 202: catalog.v1.CatalogService.DeleteSupplierProductMapping:output_type -> catalog.v1.DeleteSupplierProductMappingResponse
+	135, // [135:203] is the sub-list for method output_type
+	67,  // [67:135] is the sub-list for method input_type
+	67,  // [67:67] is the sub-list for extension type_name
+	67,  // [67:67] is the sub-list for extension extendee
+	0,   // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_catalog_v1_catalog_service_proto_init() }
@@ -5741,7 +10529,7 @@ func file_catalog_v1_catalog_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_catalog_v1_catalog_service_proto_rawDesc), len(file_catalog_v1_catalog_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   88,
+			NumMessages:   155,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
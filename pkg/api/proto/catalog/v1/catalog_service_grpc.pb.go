@@ -33,6 +33,7 @@ const (
 	CatalogService_GetProduct_FullMethodName                    = "/catalog.v1.CatalogService/GetProduct"
 	CatalogService_CreateProduct_FullMethodName                 = "/catalog.v1.CatalogService/CreateProduct"
 	CatalogService_UpdateProduct_FullMethodName                 = "/catalog.v1.CatalogService/UpdateProduct"
+	CatalogService_UpdateProductPrice_FullMethodName            = "/catalog.v1.CatalogService/UpdateProductPrice"
 	CatalogService_DeleteProduct_FullMethodName                 = "/catalog.v1.CatalogService/DeleteProduct"
 	CatalogService_ListProductImages_FullMethodName             = "/catalog.v1.CatalogService/ListProductImages"
 	CatalogService_GetProductImage_FullMethodName               = "/catalog.v1.CatalogService/GetProductImage"
@@ -44,6 +45,33 @@ const (
 	CatalogService_CreateProductAttribute_FullMethodName        = "/catalog.v1.CatalogService/CreateProductAttribute"
 	CatalogService_UpdateProductAttribute_FullMethodName        = "/catalog.v1.CatalogService/UpdateProductAttribute"
 	CatalogService_DeleteProductAttribute_FullMethodName        = "/catalog.v1.CatalogService/DeleteProductAttribute"
+	CatalogService_ListProductRelations_FullMethodName          = "/catalog.v1.CatalogService/ListProductRelations"
+	CatalogService_CreateProductRelation_FullMethodName         = "/catalog.v1.CatalogService/CreateProductRelation"
+	CatalogService_UpdateProductRelation_FullMethodName         = "/catalog.v1.CatalogService/UpdateProductRelation"
+	CatalogService_DeleteProductRelation_FullMethodName         = "/catalog.v1.CatalogService/DeleteProductRelation"
+	CatalogService_GetBundle_FullMethodName                     = "/catalog.v1.CatalogService/GetBundle"
+	CatalogService_CreateBundle_FullMethodName                  = "/catalog.v1.CatalogService/CreateBundle"
+	CatalogService_UpdateBundle_FullMethodName                  = "/catalog.v1.CatalogService/UpdateBundle"
+	CatalogService_ReserveStock_FullMethodName                  = "/catalog.v1.CatalogService/ReserveStock"
+	CatalogService_CommitStock_FullMethodName                   = "/catalog.v1.CatalogService/CommitStock"
+	CatalogService_ReleaseStock_FullMethodName                  = "/catalog.v1.CatalogService/ReleaseStock"
+	CatalogService_ListProductReviews_FullMethodName            = "/catalog.v1.CatalogService/ListProductReviews"
+	CatalogService_CreateProductReview_FullMethodName           = "/catalog.v1.CatalogService/CreateProductReview"
+	CatalogService_ListReviews_FullMethodName                   = "/catalog.v1.CatalogService/ListReviews"
+	CatalogService_ModerateReview_FullMethodName                = "/catalog.v1.CatalogService/ModerateReview"
+	CatalogService_DeleteReview_FullMethodName                  = "/catalog.v1.CatalogService/DeleteReview"
+	CatalogService_ListProductQuestions_FullMethodName          = "/catalog.v1.CatalogService/ListProductQuestions"
+	CatalogService_AskProductQuestion_FullMethodName            = "/catalog.v1.CatalogService/AskProductQuestion"
+	CatalogService_ModerateProductQuestion_FullMethodName       = "/catalog.v1.CatalogService/ModerateProductQuestion"
+	CatalogService_DeleteProductQuestion_FullMethodName         = "/catalog.v1.CatalogService/DeleteProductQuestion"
+	CatalogService_AnswerProductQuestion_FullMethodName         = "/catalog.v1.CatalogService/AnswerProductQuestion"
+	CatalogService_ModerateProductAnswer_FullMethodName         = "/catalog.v1.CatalogService/ModerateProductAnswer"
+	CatalogService_DeleteProductAnswer_FullMethodName           = "/catalog.v1.CatalogService/DeleteProductAnswer"
+	CatalogService_ListQuestions_FullMethodName                 = "/catalog.v1.CatalogService/ListQuestions"
+	CatalogService_ListProductListItems_FullMethodName          = "/catalog.v1.CatalogService/ListProductListItems"
+	CatalogService_AddProductListItem_FullMethodName            = "/catalog.v1.CatalogService/AddProductListItem"
+	CatalogService_RemoveProductListItem_FullMethodName         = "/catalog.v1.CatalogService/RemoveProductListItem"
+	CatalogService_CompareProducts_FullMethodName               = "/catalog.v1.CatalogService/CompareProducts"
 	CatalogService_ListBrands_FullMethodName                    = "/catalog.v1.CatalogService/ListBrands"
 	CatalogService_GetBrand_FullMethodName                      = "/catalog.v1.CatalogService/GetBrand"
 	CatalogService_CreateBrand_FullMethodName                   = "/catalog.v1.CatalogService/CreateBrand"
@@ -79,6 +107,7 @@ type CatalogServiceClient interface {
 	GetProduct(ctx context.Context, in *GetProductRequest, opts ...grpc.CallOption) (*GetProductResponse, error)
 	CreateProduct(ctx context.Context, in *CreateProductRequest, opts ...grpc.CallOption) (*CreateProductResponse, error)
 	UpdateProduct(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*UpdateProductResponse, error)
+	UpdateProductPrice(ctx context.Context, in *UpdateProductPriceRequest, opts ...grpc.CallOption) (*UpdateProductPriceResponse, error)
 	DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error)
 	ListProductImages(ctx context.Context, in *ListProductImagesRequest, opts ...grpc.CallOption) (*ListProductImagesResponse, error)
 	GetProductImage(ctx context.Context, in *GetProductImageRequest, opts ...grpc.CallOption) (*GetProductImageResponse, error)
@@ -90,6 +119,33 @@ type CatalogServiceClient interface {
 	CreateProductAttribute(ctx context.Context, in *CreateProductAttributeRequest, opts ...grpc.CallOption) (*CreateProductAttributeResponse, error)
 	UpdateProductAttribute(ctx context.Context, in *UpdateProductAttributeRequest, opts ...grpc.CallOption) (*UpdateProductAttributeResponse, error)
 	DeleteProductAttribute(ctx context.Context, in *DeleteProductAttributeRequest, opts ...grpc.CallOption) (*DeleteProductAttributeResponse, error)
+	ListProductRelations(ctx context.Context, in *ListProductRelationsRequest, opts ...grpc.CallOption) (*ListProductRelationsResponse, error)
+	CreateProductRelation(ctx context.Context, in *CreateProductRelationRequest, opts ...grpc.CallOption) (*CreateProductRelationResponse, error)
+	UpdateProductRelation(ctx context.Context, in *UpdateProductRelationRequest, opts ...grpc.CallOption) (*UpdateProductRelationResponse, error)
+	DeleteProductRelation(ctx context.Context, in *DeleteProductRelationRequest, opts ...grpc.CallOption) (*DeleteProductRelationResponse, error)
+	GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*GetBundleResponse, error)
+	CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*CreateBundleResponse, error)
+	UpdateBundle(ctx context.Context, in *UpdateBundleRequest, opts ...grpc.CallOption) (*UpdateBundleResponse, error)
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error)
+	CommitStock(ctx context.Context, in *CommitStockRequest, opts ...grpc.CallOption) (*CommitStockResponse, error)
+	ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*ReleaseStockResponse, error)
+	ListProductReviews(ctx context.Context, in *ListProductReviewsRequest, opts ...grpc.CallOption) (*ListProductReviewsResponse, error)
+	CreateProductReview(ctx context.Context, in *CreateProductReviewRequest, opts ...grpc.CallOption) (*CreateProductReviewResponse, error)
+	ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error)
+	ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*ModerateReviewResponse, error)
+	DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error)
+	ListProductQuestions(ctx context.Context, in *ListProductQuestionsRequest, opts ...grpc.CallOption) (*ListProductQuestionsResponse, error)
+	AskProductQuestion(ctx context.Context, in *AskProductQuestionRequest, opts ...grpc.CallOption) (*AskProductQuestionResponse, error)
+	ModerateProductQuestion(ctx context.Context, in *ModerateProductQuestionRequest, opts ...grpc.CallOption) (*ModerateProductQuestionResponse, error)
+	DeleteProductQuestion(ctx context.Context, in *DeleteProductQuestionRequest, opts ...grpc.CallOption) (*DeleteProductQuestionResponse, error)
+	AnswerProductQuestion(ctx context.Context, in *AnswerProductQuestionRequest, opts ...grpc.CallOption) (*AnswerProductQuestionResponse, error)
+	ModerateProductAnswer(ctx context.Context, in *ModerateProductAnswerRequest, opts ...grpc.CallOption) (*ModerateProductAnswerResponse, error)
+	DeleteProductAnswer(ctx context.Context, in *DeleteProductAnswerRequest, opts ...grpc.CallOption) (*DeleteProductAnswerResponse, error)
+	ListQuestions(ctx context.Context, in *ListQuestionsRequest, opts ...grpc.CallOption) (*ListQuestionsResponse, error)
+	ListProductListItems(ctx context.Context, in *ListProductListItemsRequest, opts ...grpc.CallOption) (*ListProductListItemsResponse, error)
+	AddProductListItem(ctx context.Context, in *AddProductListItemRequest, opts ...grpc.CallOption) (*AddProductListItemResponse, error)
+	RemoveProductListItem(ctx context.Context, in *RemoveProductListItemRequest, opts ...grpc.CallOption) (*RemoveProductListItemResponse, error)
+	CompareProducts(ctx context.Context, in *CompareProductsRequest, opts ...grpc.CallOption) (*CompareProductsResponse, error)
 	ListBrands(ctx context.Context, in *ListBrandsRequest, opts ...grpc.CallOption) (*ListBrandsResponse, error)
 	GetBrand(ctx context.Context, in *GetBrandRequest, opts ...grpc.CallOption) (*GetBrandResponse, error)
 	CreateBrand(ctx context.Context, in *CreateBrandRequest, opts ...grpc.CallOption) (*CreateBrandResponse, error)
@@ -255,6 +311,16 @@ func (c *catalogServiceClient) UpdateProduct(ctx context.Context, in *UpdateProd
 	return out, nil
 }
 
+func (c *catalogServiceClient) UpdateProductPrice(ctx context.Context, in *UpdateProductPriceRequest, opts ...grpc.CallOption) (*UpdateProductPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProductPriceResponse)
+	err := c.cc.Invoke(ctx, CatalogService_UpdateProductPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) DeleteProduct(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*DeleteProductResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteProductResponse)
@@ -365,6 +431,276 @@ func (c *catalogServiceClient) DeleteProductAttribute(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *catalogServiceClient) ListProductRelations(ctx context.Context, in *ListProductRelationsRequest, opts ...grpc.CallOption) (*ListProductRelationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductRelationsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListProductRelations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreateProductRelation(ctx context.Context, in *CreateProductRelationRequest, opts ...grpc.CallOption) (*CreateProductRelationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProductRelationResponse)
+	err := c.cc.Invoke(ctx, CatalogService_CreateProductRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdateProductRelation(ctx context.Context, in *UpdateProductRelationRequest, opts ...grpc.CallOption) (*UpdateProductRelationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateProductRelationResponse)
+	err := c.cc.Invoke(ctx, CatalogService_UpdateProductRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteProductRelation(ctx context.Context, in *DeleteProductRelationRequest, opts ...grpc.CallOption) (*DeleteProductRelationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductRelationResponse)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteProductRelation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*GetBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetBundleResponse)
+	err := c.cc.Invoke(ctx, CatalogService_GetBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreateBundle(ctx context.Context, in *CreateBundleRequest, opts ...grpc.CallOption) (*CreateBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBundleResponse)
+	err := c.cc.Invoke(ctx, CatalogService_CreateBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) UpdateBundle(ctx context.Context, in *UpdateBundleRequest, opts ...grpc.CallOption) (*UpdateBundleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateBundleResponse)
+	err := c.cc.Invoke(ctx, CatalogService_UpdateBundle_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveStockResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ReserveStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CommitStock(ctx context.Context, in *CommitStockRequest, opts ...grpc.CallOption) (*CommitStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitStockResponse)
+	err := c.cc.Invoke(ctx, CatalogService_CommitStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*ReleaseStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseStockResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ReleaseStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListProductReviews(ctx context.Context, in *ListProductReviewsRequest, opts ...grpc.CallOption) (*ListProductReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductReviewsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListProductReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CreateProductReview(ctx context.Context, in *CreateProductReviewRequest, opts ...grpc.CallOption) (*CreateProductReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateProductReviewResponse)
+	err := c.cc.Invoke(ctx, CatalogService_CreateProductReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListReviews(ctx context.Context, in *ListReviewsRequest, opts ...grpc.CallOption) (*ListReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ModerateReview(ctx context.Context, in *ModerateReviewRequest, opts ...grpc.CallOption) (*ModerateReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateReviewResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ModerateReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteReview(ctx context.Context, in *DeleteReviewRequest, opts ...grpc.CallOption) (*DeleteReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReviewResponse)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListProductQuestions(ctx context.Context, in *ListProductQuestionsRequest, opts ...grpc.CallOption) (*ListProductQuestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductQuestionsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListProductQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) AskProductQuestion(ctx context.Context, in *AskProductQuestionRequest, opts ...grpc.CallOption) (*AskProductQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AskProductQuestionResponse)
+	err := c.cc.Invoke(ctx, CatalogService_AskProductQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ModerateProductQuestion(ctx context.Context, in *ModerateProductQuestionRequest, opts ...grpc.CallOption) (*ModerateProductQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateProductQuestionResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ModerateProductQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteProductQuestion(ctx context.Context, in *DeleteProductQuestionRequest, opts ...grpc.CallOption) (*DeleteProductQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductQuestionResponse)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteProductQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) AnswerProductQuestion(ctx context.Context, in *AnswerProductQuestionRequest, opts ...grpc.CallOption) (*AnswerProductQuestionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnswerProductQuestionResponse)
+	err := c.cc.Invoke(ctx, CatalogService_AnswerProductQuestion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ModerateProductAnswer(ctx context.Context, in *ModerateProductAnswerRequest, opts ...grpc.CallOption) (*ModerateProductAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerateProductAnswerResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ModerateProductAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) DeleteProductAnswer(ctx context.Context, in *DeleteProductAnswerRequest, opts ...grpc.CallOption) (*DeleteProductAnswerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteProductAnswerResponse)
+	err := c.cc.Invoke(ctx, CatalogService_DeleteProductAnswer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListQuestions(ctx context.Context, in *ListQuestionsRequest, opts ...grpc.CallOption) (*ListQuestionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuestionsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListQuestions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) ListProductListItems(ctx context.Context, in *ListProductListItemsRequest, opts ...grpc.CallOption) (*ListProductListItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProductListItemsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_ListProductListItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) AddProductListItem(ctx context.Context, in *AddProductListItemRequest, opts ...grpc.CallOption) (*AddProductListItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddProductListItemResponse)
+	err := c.cc.Invoke(ctx, CatalogService_AddProductListItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) RemoveProductListItem(ctx context.Context, in *RemoveProductListItemRequest, opts ...grpc.CallOption) (*RemoveProductListItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveProductListItemResponse)
+	err := c.cc.Invoke(ctx, CatalogService_RemoveProductListItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *catalogServiceClient) CompareProducts(ctx context.Context, in *CompareProductsRequest, opts ...grpc.CallOption) (*CompareProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompareProductsResponse)
+	err := c.cc.Invoke(ctx, CatalogService_CompareProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *catalogServiceClient) ListBrands(ctx context.Context, in *ListBrandsRequest, opts ...grpc.CallOption) (*ListBrandsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListBrandsResponse)
@@ -533,6 +869,7 @@ type CatalogServiceServer interface {
 	GetProduct(context.Context, *GetProductRequest) (*GetProductResponse, error)
 	CreateProduct(context.Context, *CreateProductRequest) (*CreateProductResponse, error)
 	UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error)
+	UpdateProductPrice(context.Context, *UpdateProductPriceRequest) (*UpdateProductPriceResponse, error)
 	DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error)
 	ListProductImages(context.Context, *ListProductImagesRequest) (*ListProductImagesResponse, error)
 	GetProductImage(context.Context, *GetProductImageRequest) (*GetProductImageResponse, error)
@@ -544,6 +881,33 @@ type CatalogServiceServer interface {
 	CreateProductAttribute(context.Context, *CreateProductAttributeRequest) (*CreateProductAttributeResponse, error)
 	UpdateProductAttribute(context.Context, *UpdateProductAttributeRequest) (*UpdateProductAttributeResponse, error)
 	DeleteProductAttribute(context.Context, *DeleteProductAttributeRequest) (*DeleteProductAttributeResponse, error)
+	ListProductRelations(context.Context, *ListProductRelationsRequest) (*ListProductRelationsResponse, error)
+	CreateProductRelation(context.Context, *CreateProductRelationRequest) (*CreateProductRelationResponse, error)
+	UpdateProductRelation(context.Context, *UpdateProductRelationRequest) (*UpdateProductRelationResponse, error)
+	DeleteProductRelation(context.Context, *DeleteProductRelationRequest) (*DeleteProductRelationResponse, error)
+	GetBundle(context.Context, *GetBundleRequest) (*GetBundleResponse, error)
+	CreateBundle(context.Context, *CreateBundleRequest) (*CreateBundleResponse, error)
+	UpdateBundle(context.Context, *UpdateBundleRequest) (*UpdateBundleResponse, error)
+	ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error)
+	CommitStock(context.Context, *CommitStockRequest) (*CommitStockResponse, error)
+	ReleaseStock(context.Context, *ReleaseStockRequest) (*ReleaseStockResponse, error)
+	ListProductReviews(context.Context, *ListProductReviewsRequest) (*ListProductReviewsResponse, error)
+	CreateProductReview(context.Context, *CreateProductReviewRequest) (*CreateProductReviewResponse, error)
+	ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error)
+	ModerateReview(context.Context, *ModerateReviewRequest) (*ModerateReviewResponse, error)
+	DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error)
+	ListProductQuestions(context.Context, *ListProductQuestionsRequest) (*ListProductQuestionsResponse, error)
+	AskProductQuestion(context.Context, *AskProductQuestionRequest) (*AskProductQuestionResponse, error)
+	ModerateProductQuestion(context.Context, *ModerateProductQuestionRequest) (*ModerateProductQuestionResponse, error)
+	DeleteProductQuestion(context.Context, *DeleteProductQuestionRequest) (*DeleteProductQuestionResponse, error)
+	AnswerProductQuestion(context.Context, *AnswerProductQuestionRequest) (*AnswerProductQuestionResponse, error)
+	ModerateProductAnswer(context.Context, *ModerateProductAnswerRequest) (*ModerateProductAnswerResponse, error)
+	DeleteProductAnswer(context.Context, *DeleteProductAnswerRequest) (*DeleteProductAnswerResponse, error)
+	ListQuestions(context.Context, *ListQuestionsRequest) (*ListQuestionsResponse, error)
+	ListProductListItems(context.Context, *ListProductListItemsRequest) (*ListProductListItemsResponse, error)
+	AddProductListItem(context.Context, *AddProductListItemRequest) (*AddProductListItemResponse, error)
+	RemoveProductListItem(context.Context, *RemoveProductListItemRequest) (*RemoveProductListItemResponse, error)
+	CompareProducts(context.Context, *CompareProductsRequest) (*CompareProductsResponse, error)
 	ListBrands(context.Context, *ListBrandsRequest) (*ListBrandsResponse, error)
 	GetBrand(context.Context, *GetBrandRequest) (*GetBrandResponse, error)
 	CreateBrand(context.Context, *CreateBrandRequest) (*CreateBrandResponse, error)
@@ -611,6 +975,9 @@ func (UnimplementedCatalogServiceServer) CreateProduct(context.Context, *CreateP
 func (UnimplementedCatalogServiceServer) UpdateProduct(context.Context, *UpdateProductRequest) (*UpdateProductResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateProduct not implemented")
 }
+func (UnimplementedCatalogServiceServer) UpdateProductPrice(context.Context, *UpdateProductPriceRequest) (*UpdateProductPriceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProductPrice not implemented")
+}
 func (UnimplementedCatalogServiceServer) DeleteProduct(context.Context, *DeleteProductRequest) (*DeleteProductResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteProduct not implemented")
 }
@@ -644,6 +1011,87 @@ func (UnimplementedCatalogServiceServer) UpdateProductAttribute(context.Context,
 func (UnimplementedCatalogServiceServer) DeleteProductAttribute(context.Context, *DeleteProductAttributeRequest) (*DeleteProductAttributeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteProductAttribute not implemented")
 }
+func (UnimplementedCatalogServiceServer) ListProductRelations(context.Context, *ListProductRelationsRequest) (*ListProductRelationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProductRelations not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreateProductRelation(context.Context, *CreateProductRelationRequest) (*CreateProductRelationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProductRelation not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdateProductRelation(context.Context, *UpdateProductRelationRequest) (*UpdateProductRelationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateProductRelation not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteProductRelation(context.Context, *DeleteProductRelationRequest) (*DeleteProductRelationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProductRelation not implemented")
+}
+func (UnimplementedCatalogServiceServer) GetBundle(context.Context, *GetBundleRequest) (*GetBundleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetBundle not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreateBundle(context.Context, *CreateBundleRequest) (*CreateBundleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateBundle not implemented")
+}
+func (UnimplementedCatalogServiceServer) UpdateBundle(context.Context, *UpdateBundleRequest) (*UpdateBundleResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateBundle not implemented")
+}
+func (UnimplementedCatalogServiceServer) ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (UnimplementedCatalogServiceServer) CommitStock(context.Context, *CommitStockRequest) (*CommitStockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CommitStock not implemented")
+}
+func (UnimplementedCatalogServiceServer) ReleaseStock(context.Context, *ReleaseStockRequest) (*ReleaseStockResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReleaseStock not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListProductReviews(context.Context, *ListProductReviewsRequest) (*ListProductReviewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProductReviews not implemented")
+}
+func (UnimplementedCatalogServiceServer) CreateProductReview(context.Context, *CreateProductReviewRequest) (*CreateProductReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateProductReview not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListReviews(context.Context, *ListReviewsRequest) (*ListReviewsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReviews not implemented")
+}
+func (UnimplementedCatalogServiceServer) ModerateReview(context.Context, *ModerateReviewRequest) (*ModerateReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ModerateReview not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteReview(context.Context, *DeleteReviewRequest) (*DeleteReviewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteReview not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListProductQuestions(context.Context, *ListProductQuestionsRequest) (*ListProductQuestionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProductQuestions not implemented")
+}
+func (UnimplementedCatalogServiceServer) AskProductQuestion(context.Context, *AskProductQuestionRequest) (*AskProductQuestionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AskProductQuestion not implemented")
+}
+func (UnimplementedCatalogServiceServer) ModerateProductQuestion(context.Context, *ModerateProductQuestionRequest) (*ModerateProductQuestionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ModerateProductQuestion not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteProductQuestion(context.Context, *DeleteProductQuestionRequest) (*DeleteProductQuestionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProductQuestion not implemented")
+}
+func (UnimplementedCatalogServiceServer) AnswerProductQuestion(context.Context, *AnswerProductQuestionRequest) (*AnswerProductQuestionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnswerProductQuestion not implemented")
+}
+func (UnimplementedCatalogServiceServer) ModerateProductAnswer(context.Context, *ModerateProductAnswerRequest) (*ModerateProductAnswerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ModerateProductAnswer not implemented")
+}
+func (UnimplementedCatalogServiceServer) DeleteProductAnswer(context.Context, *DeleteProductAnswerRequest) (*DeleteProductAnswerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteProductAnswer not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListQuestions(context.Context, *ListQuestionsRequest) (*ListQuestionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQuestions not implemented")
+}
+func (UnimplementedCatalogServiceServer) ListProductListItems(context.Context, *ListProductListItemsRequest) (*ListProductListItemsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListProductListItems not implemented")
+}
+func (UnimplementedCatalogServiceServer) AddProductListItem(context.Context, *AddProductListItemRequest) (*AddProductListItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AddProductListItem not implemented")
+}
+func (UnimplementedCatalogServiceServer) RemoveProductListItem(context.Context, *RemoveProductListItemRequest) (*RemoveProductListItemResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveProductListItem not implemented")
+}
+func (UnimplementedCatalogServiceServer) CompareProducts(context.Context, *CompareProductsRequest) (*CompareProductsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompareProducts not implemented")
+}
 func (UnimplementedCatalogServiceServer) ListBrands(context.Context, *ListBrandsRequest) (*ListBrandsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListBrands not implemented")
 }
@@ -962,6 +1410,24 @@ func _CatalogService_UpdateProduct_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CatalogService_UpdateProductPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdateProductPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpdateProductPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpdateProductPrice(ctx, req.(*UpdateProductPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CatalogService_DeleteProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteProductRequest)
 	if err := dec(in); err != nil {
@@ -1160,71 +1626,557 @@ func _CatalogService_DeleteProductAttribute_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CatalogService_ListBrands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListBrandsRequest)
+func _CatalogService_ListProductRelations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductRelationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CatalogServiceServer).ListBrands(ctx, in)
+		return srv.(CatalogServiceServer).ListProductRelations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CatalogService_ListBrands_FullMethodName,
+		FullMethod: CatalogService_ListProductRelations_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CatalogServiceServer).ListBrands(ctx, req.(*ListBrandsRequest))
+		return srv.(CatalogServiceServer).ListProductRelations(ctx, req.(*ListProductRelationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CatalogService_GetBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBrandRequest)
+func _CatalogService_CreateProductRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductRelationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CatalogServiceServer).GetBrand(ctx, in)
+		return srv.(CatalogServiceServer).CreateProductRelation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CatalogService_GetBrand_FullMethodName,
+		FullMethod: CatalogService_CreateProductRelation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CatalogServiceServer).GetBrand(ctx, req.(*GetBrandRequest))
+		return srv.(CatalogServiceServer).CreateProductRelation(ctx, req.(*CreateProductRelationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CatalogService_CreateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBrandRequest)
+func _CatalogService_UpdateProductRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProductRelationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CatalogServiceServer).CreateBrand(ctx, in)
+		return srv.(CatalogServiceServer).UpdateProductRelation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CatalogService_CreateBrand_FullMethodName,
+		FullMethod: CatalogService_UpdateProductRelation_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CatalogServiceServer).CreateBrand(ctx, req.(*CreateBrandRequest))
+		return srv.(CatalogServiceServer).UpdateProductRelation(ctx, req.(*UpdateProductRelationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CatalogService_UpdateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateBrandRequest)
+func _CatalogService_DeleteProductRelation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductRelationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CatalogServiceServer).UpdateBrand(ctx, in)
+		return srv.(CatalogServiceServer).DeleteProductRelation(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: CatalogService_UpdateBrand_FullMethodName,
+		FullMethod: CatalogService_DeleteProductRelation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteProductRelation(ctx, req.(*DeleteProductRelationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetBundle(ctx, req.(*GetBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateBundle(ctx, req.(*CreateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpdateBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdateBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpdateBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).UpdateBundle(ctx, req.(*UpdateBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CommitStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CommitStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CommitStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CommitStock(ctx, req.(*CommitStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ReleaseStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ReleaseStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ReleaseStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ReleaseStock(ctx, req.(*ReleaseStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListProductReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListProductReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListProductReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListProductReviews(ctx, req.(*ListProductReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreateProductReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProductReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateProductReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateProductReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateProductReview(ctx, req.(*CreateProductReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListReviews(ctx, req.(*ListReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ModerateReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ModerateReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ModerateReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ModerateReview(ctx, req.(*ModerateReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteReview(ctx, req.(*DeleteReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListProductQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListProductQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListProductQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListProductQuestions(ctx, req.(*ListProductQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_AskProductQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AskProductQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).AskProductQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_AskProductQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).AskProductQuestion(ctx, req.(*AskProductQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ModerateProductQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateProductQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ModerateProductQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ModerateProductQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ModerateProductQuestion(ctx, req.(*ModerateProductQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteProductQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteProductQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteProductQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteProductQuestion(ctx, req.(*DeleteProductQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_AnswerProductQuestion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnswerProductQuestionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).AnswerProductQuestion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_AnswerProductQuestion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).AnswerProductQuestion(ctx, req.(*AnswerProductQuestionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ModerateProductAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModerateProductAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ModerateProductAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ModerateProductAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ModerateProductAnswer(ctx, req.(*ModerateProductAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_DeleteProductAnswer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProductAnswerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).DeleteProductAnswer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_DeleteProductAnswer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).DeleteProductAnswer(ctx, req.(*DeleteProductAnswerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListQuestions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuestionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListQuestions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListQuestions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListQuestions(ctx, req.(*ListQuestionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListProductListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProductListItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListProductListItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListProductListItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListProductListItems(ctx, req.(*ListProductListItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_AddProductListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddProductListItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).AddProductListItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_AddProductListItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).AddProductListItem(ctx, req.(*AddProductListItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_RemoveProductListItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveProductListItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).RemoveProductListItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_RemoveProductListItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).RemoveProductListItem(ctx, req.(*RemoveProductListItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CompareProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CompareProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CompareProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CompareProducts(ctx, req.(*CompareProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_ListBrands_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBrandsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).ListBrands(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_ListBrands_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).ListBrands(ctx, req.(*ListBrandsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_GetBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).GetBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_GetBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).GetBrand(ctx, req.(*GetBrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_CreateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).CreateBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_CreateBrand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CatalogServiceServer).CreateBrand(ctx, req.(*CreateBrandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CatalogService_UpdateBrand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBrandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CatalogServiceServer).UpdateBrand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CatalogService_UpdateBrand_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(CatalogServiceServer).UpdateBrand(ctx, req.(*UpdateBrandRequest))
@@ -1493,6 +2445,10 @@ var CatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProduct",
 			Handler:    _CatalogService_UpdateProduct_Handler,
 		},
+		{
+			MethodName: "UpdateProductPrice",
+			Handler:    _CatalogService_UpdateProductPrice_Handler,
+		},
 		{
 			MethodName: "DeleteProduct",
 			Handler:    _CatalogService_DeleteProduct_Handler,
@@ -1537,6 +2493,114 @@ var CatalogService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteProductAttribute",
 			Handler:    _CatalogService_DeleteProductAttribute_Handler,
 		},
+		{
+			MethodName: "ListProductRelations",
+			Handler:    _CatalogService_ListProductRelations_Handler,
+		},
+		{
+			MethodName: "CreateProductRelation",
+			Handler:    _CatalogService_CreateProductRelation_Handler,
+		},
+		{
+			MethodName: "UpdateProductRelation",
+			Handler:    _CatalogService_UpdateProductRelation_Handler,
+		},
+		{
+			MethodName: "DeleteProductRelation",
+			Handler:    _CatalogService_DeleteProductRelation_Handler,
+		},
+		{
+			MethodName: "GetBundle",
+			Handler:    _CatalogService_GetBundle_Handler,
+		},
+		{
+			MethodName: "CreateBundle",
+			Handler:    _CatalogService_CreateBundle_Handler,
+		},
+		{
+			MethodName: "UpdateBundle",
+			Handler:    _CatalogService_UpdateBundle_Handler,
+		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _CatalogService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "CommitStock",
+			Handler:    _CatalogService_CommitStock_Handler,
+		},
+		{
+			MethodName: "ReleaseStock",
+			Handler:    _CatalogService_ReleaseStock_Handler,
+		},
+		{
+			MethodName: "ListProductReviews",
+			Handler:    _CatalogService_ListProductReviews_Handler,
+		},
+		{
+			MethodName: "CreateProductReview",
+			Handler:    _CatalogService_CreateProductReview_Handler,
+		},
+		{
+			MethodName: "ListReviews",
+			Handler:    _CatalogService_ListReviews_Handler,
+		},
+		{
+			MethodName: "ModerateReview",
+			Handler:    _CatalogService_ModerateReview_Handler,
+		},
+		{
+			MethodName: "DeleteReview",
+			Handler:    _CatalogService_DeleteReview_Handler,
+		},
+		{
+			MethodName: "ListProductQuestions",
+			Handler:    _CatalogService_ListProductQuestions_Handler,
+		},
+		{
+			MethodName: "AskProductQuestion",
+			Handler:    _CatalogService_AskProductQuestion_Handler,
+		},
+		{
+			MethodName: "ModerateProductQuestion",
+			Handler:    _CatalogService_ModerateProductQuestion_Handler,
+		},
+		{
+			MethodName: "DeleteProductQuestion",
+			Handler:    _CatalogService_DeleteProductQuestion_Handler,
+		},
+		{
+			MethodName: "AnswerProductQuestion",
+			Handler:    _CatalogService_AnswerProductQuestion_Handler,
+		},
+		{
+			MethodName: "ModerateProductAnswer",
+			Handler:    _CatalogService_ModerateProductAnswer_Handler,
+		},
+		{
+			MethodName: "DeleteProductAnswer",
+			Handler:    _CatalogService_DeleteProductAnswer_Handler,
+		},
+		{
+			MethodName: "ListQuestions",
+			Handler:    _CatalogService_ListQuestions_Handler,
+		},
+		{
+			MethodName: "ListProductListItems",
+			Handler:    _CatalogService_ListProductListItems_Handler,
+		},
+		{
+			MethodName: "AddProductListItem",
+			Handler:    _CatalogService_AddProductListItem_Handler,
+		},
+		{
+			MethodName: "RemoveProductListItem",
+			Handler:    _CatalogService_RemoveProductListItem_Handler,
+		},
+		{
+			MethodName: "CompareProducts",
+			Handler:    _CatalogService_CompareProducts_Handler,
+		},
 		{
 			MethodName: "ListBrands",
 			Handler:    _CatalogService_ListBrands_Handler,
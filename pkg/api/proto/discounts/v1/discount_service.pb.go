@@ -0,0 +1,1624 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v6.31.1
+// source: discounts/v1/discount_service.proto
+
+package discountsv1
+
+import (
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A discount without a code is applied automatically; one with a code only
+// when the customer enters it.
+type Discount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name  string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code  string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	// percent or fixed.
+	Kind string `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Percentage (1-100) for percent discounts, cents for fixed ones.
+	Value int64 `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	// all, brand, category (including subcategories) or product.
+	ScopeType        string `protobuf:"bytes,6,opt,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"`
+	ScopeId          string `protobuf:"bytes,7,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	MinSubtotalCents int64  `protobuf:"varint,8,opt,name=min_subtotal_cents,json=minSubtotalCents,proto3" json:"min_subtotal_cents,omitempty"`
+	// Stackable discounts combine with each other; others apply alone.
+	Stackable bool  `protobuf:"varint,9,opt,name=stackable,proto3" json:"stackable,omitempty"`
+	Priority  int32 `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Zero means unlimited.
+	MaxUses        int32  `protobuf:"varint,11,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	MaxUsesPerUser int32  `protobuf:"varint,12,opt,name=max_uses_per_user,json=maxUsesPerUser,proto3" json:"max_uses_per_user,omitempty"`
+	UsedCount      int32  `protobuf:"varint,13,opt,name=used_count,json=usedCount,proto3" json:"used_count,omitempty"`
+	StartsAt       string `protobuf:"bytes,14,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt         string `protobuf:"bytes,15,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	IsActive       bool   `protobuf:"varint,16,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt      string `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt      string `protobuf:"bytes,18,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Discount) Reset() {
+	*x = Discount{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Discount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Discount) ProtoMessage() {}
+
+func (x *Discount) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Discount.ProtoReflect.Descriptor instead.
+func (*Discount) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Discount) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Discount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Discount) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Discount) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Discount) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *Discount) GetScopeType() string {
+	if x != nil {
+		return x.ScopeType
+	}
+	return ""
+}
+
+func (x *Discount) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *Discount) GetMinSubtotalCents() int64 {
+	if x != nil {
+		return x.MinSubtotalCents
+	}
+	return 0
+}
+
+func (x *Discount) GetStackable() bool {
+	if x != nil {
+		return x.Stackable
+	}
+	return false
+}
+
+func (x *Discount) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Discount) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *Discount) GetMaxUsesPerUser() int32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *Discount) GetUsedCount() int32 {
+	if x != nil {
+		return x.UsedCount
+	}
+	return 0
+}
+
+func (x *Discount) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *Discount) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *Discount) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *Discount) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *Discount) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+type ListDiscountsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// automatic, promo_code or empty for both.
+	Type          string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ActiveOnly    bool   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiscountsRequest) Reset() {
+	*x = ListDiscountsRequest{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiscountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiscountsRequest) ProtoMessage() {}
+
+func (x *ListDiscountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiscountsRequest.ProtoReflect.Descriptor instead.
+func (*ListDiscountsRequest) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListDiscountsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListDiscountsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListDiscountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discounts     []*Discount            `protobuf:"bytes,1,rep,name=discounts,proto3" json:"discounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDiscountsResponse) Reset() {
+	*x = ListDiscountsResponse{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDiscountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDiscountsResponse) ProtoMessage() {}
+
+func (x *ListDiscountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDiscountsResponse.ProtoReflect.Descriptor instead.
+func (*ListDiscountsResponse) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListDiscountsResponse) GetDiscounts() []*Discount {
+	if x != nil {
+		return x.Discounts
+	}
+	return nil
+}
+
+type GetDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiscountRequest) Reset() {
+	*x = GetDiscountRequest{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscountRequest) ProtoMessage() {}
+
+func (x *GetDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscountRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetDiscountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetDiscountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discount      *Discount              `protobuf:"bytes,1,opt,name=discount,proto3" json:"discount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDiscountResponse) Reset() {
+	*x = GetDiscountResponse{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscountResponse) ProtoMessage() {}
+
+func (x *GetDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscountResponse.ProtoReflect.Descriptor instead.
+func (*GetDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDiscountResponse) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+type CreateDiscountRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Code             string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	Kind             string                 `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value            int64                  `protobuf:"varint,4,opt,name=value,proto3" json:"value,omitempty"`
+	ScopeType        string                 `protobuf:"bytes,5,opt,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"`
+	ScopeId          string                 `protobuf:"bytes,6,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	MinSubtotalCents int64                  `protobuf:"varint,7,opt,name=min_subtotal_cents,json=minSubtotalCents,proto3" json:"min_subtotal_cents,omitempty"`
+	Stackable        bool                   `protobuf:"varint,8,opt,name=stackable,proto3" json:"stackable,omitempty"`
+	Priority         int32                  `protobuf:"varint,9,opt,name=priority,proto3" json:"priority,omitempty"`
+	MaxUses          int32                  `protobuf:"varint,10,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	MaxUsesPerUser   int32                  `protobuf:"varint,11,opt,name=max_uses_per_user,json=maxUsesPerUser,proto3" json:"max_uses_per_user,omitempty"`
+	// RFC 3339; empty means no bound.
+	StartsAt      string `protobuf:"bytes,12,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        string `protobuf:"bytes,13,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	IsActive      bool   `protobuf:"varint,14,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDiscountRequest) Reset() {
+	*x = CreateDiscountRequest{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDiscountRequest) ProtoMessage() {}
+
+func (x *CreateDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDiscountRequest.ProtoReflect.Descriptor instead.
+func (*CreateDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateDiscountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDiscountRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateDiscountRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateDiscountRequest) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *CreateDiscountRequest) GetScopeType() string {
+	if x != nil {
+		return x.ScopeType
+	}
+	return ""
+}
+
+func (x *CreateDiscountRequest) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *CreateDiscountRequest) GetMinSubtotalCents() int64 {
+	if x != nil {
+		return x.MinSubtotalCents
+	}
+	return 0
+}
+
+func (x *CreateDiscountRequest) GetStackable() bool {
+	if x != nil {
+		return x.Stackable
+	}
+	return false
+}
+
+func (x *CreateDiscountRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *CreateDiscountRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *CreateDiscountRequest) GetMaxUsesPerUser() int32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *CreateDiscountRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *CreateDiscountRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *CreateDiscountRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type CreateDiscountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discount      *Discount              `protobuf:"bytes,1,opt,name=discount,proto3" json:"discount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDiscountResponse) Reset() {
+	*x = CreateDiscountResponse{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDiscountResponse) ProtoMessage() {}
+
+func (x *CreateDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDiscountResponse.ProtoReflect.Descriptor instead.
+func (*CreateDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreateDiscountResponse) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+type UpdateDiscountRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code             string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Kind             string                 `protobuf:"bytes,4,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value            int64                  `protobuf:"varint,5,opt,name=value,proto3" json:"value,omitempty"`
+	ScopeType        string                 `protobuf:"bytes,6,opt,name=scope_type,json=scopeType,proto3" json:"scope_type,omitempty"`
+	ScopeId          string                 `protobuf:"bytes,7,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	MinSubtotalCents int64                  `protobuf:"varint,8,opt,name=min_subtotal_cents,json=minSubtotalCents,proto3" json:"min_subtotal_cents,omitempty"`
+	Stackable        bool                   `protobuf:"varint,9,opt,name=stackable,proto3" json:"stackable,omitempty"`
+	Priority         int32                  `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`
+	MaxUses          int32                  `protobuf:"varint,11,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	MaxUsesPerUser   int32                  `protobuf:"varint,12,opt,name=max_uses_per_user,json=maxUsesPerUser,proto3" json:"max_uses_per_user,omitempty"`
+	StartsAt         string                 `protobuf:"bytes,13,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt           string                 `protobuf:"bytes,14,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	IsActive         bool                   `protobuf:"varint,15,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateDiscountRequest) Reset() {
+	*x = UpdateDiscountRequest{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDiscountRequest) ProtoMessage() {}
+
+func (x *UpdateDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDiscountRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UpdateDiscountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateDiscountRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDiscountRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateDiscountRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *UpdateDiscountRequest) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *UpdateDiscountRequest) GetScopeType() string {
+	if x != nil {
+		return x.ScopeType
+	}
+	return ""
+}
+
+func (x *UpdateDiscountRequest) GetScopeId() string {
+	if x != nil {
+		return x.ScopeId
+	}
+	return ""
+}
+
+func (x *UpdateDiscountRequest) GetMinSubtotalCents() int64 {
+	if x != nil {
+		return x.MinSubtotalCents
+	}
+	return 0
+}
+
+func (x *UpdateDiscountRequest) GetStackable() bool {
+	if x != nil {
+		return x.Stackable
+	}
+	return false
+}
+
+func (x *UpdateDiscountRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *UpdateDiscountRequest) GetMaxUses() int32 {
+	if x != nil {
+		return x.MaxUses
+	}
+	return 0
+}
+
+func (x *UpdateDiscountRequest) GetMaxUsesPerUser() int32 {
+	if x != nil {
+		return x.MaxUsesPerUser
+	}
+	return 0
+}
+
+func (x *UpdateDiscountRequest) GetStartsAt() string {
+	if x != nil {
+		return x.StartsAt
+	}
+	return ""
+}
+
+func (x *UpdateDiscountRequest) GetEndsAt() string {
+	if x != nil {
+		return x.EndsAt
+	}
+	return ""
+}
+
+func (x *UpdateDiscountRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UpdateDiscountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Discount      *Discount              `protobuf:"bytes,1,opt,name=discount,proto3" json:"discount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDiscountResponse) Reset() {
+	*x = UpdateDiscountResponse{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDiscountResponse) ProtoMessage() {}
+
+func (x *UpdateDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDiscountResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdateDiscountResponse) GetDiscount() *Discount {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+type DeleteDiscountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDiscountRequest) Reset() {
+	*x = DeleteDiscountRequest{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDiscountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDiscountRequest) ProtoMessage() {}
+
+func (x *DeleteDiscountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDiscountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDiscountRequest) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DeleteDiscountRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeleteDiscountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDiscountResponse) Reset() {
+	*x = DeleteDiscountResponse{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDiscountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDiscountResponse) ProtoMessage() {}
+
+func (x *DeleteDiscountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDiscountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDiscountResponse) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeleteDiscountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type DiscountLineInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiscountLineInput) Reset() {
+	*x = DiscountLineInput{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiscountLineInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscountLineInput) ProtoMessage() {}
+
+func (x *DiscountLineInput) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiscountLineInput.ProtoReflect.Descriptor instead.
+func (*DiscountLineInput) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DiscountLineInput) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *DiscountLineInput) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type AppliedDiscount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DiscountId    string                 `protobuf:"bytes,1,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	AmountCents   int64                  `protobuf:"varint,4,opt,name=amount_cents,json=amountCents,proto3" json:"amount_cents,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ProductIds    []string               `protobuf:"bytes,6,rep,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppliedDiscount) Reset() {
+	*x = AppliedDiscount{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppliedDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedDiscount) ProtoMessage() {}
+
+func (x *AppliedDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedDiscount.ProtoReflect.Descriptor instead.
+func (*AppliedDiscount) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AppliedDiscount) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+func (x *AppliedDiscount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppliedDiscount) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *AppliedDiscount) GetAmountCents() int64 {
+	if x != nil {
+		return x.AmountCents
+	}
+	return 0
+}
+
+func (x *AppliedDiscount) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AppliedDiscount) GetProductIds() []string {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type RejectedDiscount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DiscountId    string                 `protobuf:"bytes,1,opt,name=discount_id,json=discountId,proto3" json:"discount_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectedDiscount) Reset() {
+	*x = RejectedDiscount{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectedDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectedDiscount) ProtoMessage() {}
+
+func (x *RejectedDiscount) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectedDiscount.ProtoReflect.Descriptor instead.
+func (*RejectedDiscount) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RejectedDiscount) GetDiscountId() string {
+	if x != nil {
+		return x.DiscountId
+	}
+	return ""
+}
+
+func (x *RejectedDiscount) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RejectedDiscount) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RejectedDiscount) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type EvaluateDiscountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*DiscountLineInput   `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	PromoCodes    []string               `protobuf:"bytes,2,rep,name=promo_codes,json=promoCodes,proto3" json:"promo_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateDiscountsRequest) Reset() {
+	*x = EvaluateDiscountsRequest{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateDiscountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateDiscountsRequest) ProtoMessage() {}
+
+func (x *EvaluateDiscountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateDiscountsRequest.ProtoReflect.Descriptor instead.
+func (*EvaluateDiscountsRequest) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *EvaluateDiscountsRequest) GetLines() []*DiscountLineInput {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *EvaluateDiscountsRequest) GetPromoCodes() []string {
+	if x != nil {
+		return x.PromoCodes
+	}
+	return nil
+}
+
+type EvaluateDiscountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubtotalCents int64                  `protobuf:"varint,1,opt,name=subtotal_cents,json=subtotalCents,proto3" json:"subtotal_cents,omitempty"`
+	DiscountCents int64                  `protobuf:"varint,2,opt,name=discount_cents,json=discountCents,proto3" json:"discount_cents,omitempty"`
+	TotalCents    int64                  `protobuf:"varint,3,opt,name=total_cents,json=totalCents,proto3" json:"total_cents,omitempty"`
+	Applied       []*AppliedDiscount     `protobuf:"bytes,4,rep,name=applied,proto3" json:"applied,omitempty"`
+	Rejected      []*RejectedDiscount    `protobuf:"bytes,5,rep,name=rejected,proto3" json:"rejected,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EvaluateDiscountsResponse) Reset() {
+	*x = EvaluateDiscountsResponse{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EvaluateDiscountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvaluateDiscountsResponse) ProtoMessage() {}
+
+func (x *EvaluateDiscountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvaluateDiscountsResponse.ProtoReflect.Descriptor instead.
+func (*EvaluateDiscountsResponse) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EvaluateDiscountsResponse) GetSubtotalCents() int64 {
+	if x != nil {
+		return x.SubtotalCents
+	}
+	return 0
+}
+
+func (x *EvaluateDiscountsResponse) GetDiscountCents() int64 {
+	if x != nil {
+		return x.DiscountCents
+	}
+	return 0
+}
+
+func (x *EvaluateDiscountsResponse) GetTotalCents() int64 {
+	if x != nil {
+		return x.TotalCents
+	}
+	return 0
+}
+
+func (x *EvaluateDiscountsResponse) GetApplied() []*AppliedDiscount {
+	if x != nil {
+		return x.Applied
+	}
+	return nil
+}
+
+func (x *EvaluateDiscountsResponse) GetRejected() []*RejectedDiscount {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+type RedeemPromoCodeRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Code    string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	OrderId string                 `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// The customer who placed the order.
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemPromoCodeRequest) Reset() {
+	*x = RedeemPromoCodeRequest{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemPromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemPromoCodeRequest) ProtoMessage() {}
+
+func (x *RedeemPromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemPromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*RedeemPromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RedeemPromoCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RedeemPromoCodeRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *RedeemPromoCodeRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RedeemPromoCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemPromoCodeResponse) Reset() {
+	*x = RedeemPromoCodeResponse{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemPromoCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemPromoCodeResponse) ProtoMessage() {}
+
+func (x *RedeemPromoCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemPromoCodeResponse.ProtoReflect.Descriptor instead.
+func (*RedeemPromoCodeResponse) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RedeemPromoCodeResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ReleaseRedemptionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseRedemptionsRequest) Reset() {
+	*x = ReleaseRedemptionsRequest{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseRedemptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRedemptionsRequest) ProtoMessage() {}
+
+func (x *ReleaseRedemptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRedemptionsRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseRedemptionsRequest) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ReleaseRedemptionsRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ReleaseRedemptionsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Redemptions given back; zero when the order had none left.
+	Released      int32 `protobuf:"varint,1,opt,name=released,proto3" json:"released,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseRedemptionsResponse) Reset() {
+	*x = ReleaseRedemptionsResponse{}
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseRedemptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseRedemptionsResponse) ProtoMessage() {}
+
+func (x *ReleaseRedemptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_discounts_v1_discount_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseRedemptionsResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseRedemptionsResponse) Descriptor() ([]byte, []int) {
+	return file_discounts_v1_discount_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ReleaseRedemptionsResponse) GetReleased() int32 {
+	if x != nil {
+		return x.Released
+	}
+	return 0
+}
+
+var File_discounts_v1_discount_service_proto protoreflect.FileDescriptor
+
+const file_discounts_v1_discount_service_proto_rawDesc = "" +
+	"\n" +
+	"#discounts/v1/discount_service.proto\x12\fdiscounts.v1\x1a\x1cgoogle/api/annotations.proto\"\x84\x04\n" +
+	"\bDiscount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x03R\x05value\x12\x1d\n" +
+	"\n" +
+	"scope_type\x18\x06 \x01(\tR\tscopeType\x12\x19\n" +
+	"\bscope_id\x18\a \x01(\tR\ascopeId\x12,\n" +
+	"\x12min_subtotal_cents\x18\b \x01(\x03R\x10minSubtotalCents\x12\x1c\n" +
+	"\tstackable\x18\t \x01(\bR\tstackable\x12\x1a\n" +
+	"\bpriority\x18\n" +
+	" \x01(\x05R\bpriority\x12\x19\n" +
+	"\bmax_uses\x18\v \x01(\x05R\amaxUses\x12)\n" +
+	"\x11max_uses_per_user\x18\f \x01(\x05R\x0emaxUsesPerUser\x12\x1d\n" +
+	"\n" +
+	"used_count\x18\r \x01(\x05R\tusedCount\x12\x1b\n" +
+	"\tstarts_at\x18\x0e \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x0f \x01(\tR\x06endsAt\x12\x1b\n" +
+	"\tis_active\x18\x10 \x01(\bR\bisActive\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x12 \x01(\tR\tupdatedAt\"K\n" +
+	"\x14ListDiscountsRequest\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\"M\n" +
+	"\x15ListDiscountsResponse\x124\n" +
+	"\tdiscounts\x18\x01 \x03(\v2\x16.discounts.v1.DiscountR\tdiscounts\"$\n" +
+	"\x12GetDiscountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"I\n" +
+	"\x13GetDiscountResponse\x122\n" +
+	"\bdiscount\x18\x01 \x01(\v2\x16.discounts.v1.DiscountR\bdiscount\"\xa4\x03\n" +
+	"\x15CreateDiscountRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05value\x18\x04 \x01(\x03R\x05value\x12\x1d\n" +
+	"\n" +
+	"scope_type\x18\x05 \x01(\tR\tscopeType\x12\x19\n" +
+	"\bscope_id\x18\x06 \x01(\tR\ascopeId\x12,\n" +
+	"\x12min_subtotal_cents\x18\a \x01(\x03R\x10minSubtotalCents\x12\x1c\n" +
+	"\tstackable\x18\b \x01(\bR\tstackable\x12\x1a\n" +
+	"\bpriority\x18\t \x01(\x05R\bpriority\x12\x19\n" +
+	"\bmax_uses\x18\n" +
+	" \x01(\x05R\amaxUses\x12)\n" +
+	"\x11max_uses_per_user\x18\v \x01(\x05R\x0emaxUsesPerUser\x12\x1b\n" +
+	"\tstarts_at\x18\f \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\r \x01(\tR\x06endsAt\x12\x1b\n" +
+	"\tis_active\x18\x0e \x01(\bR\bisActive\"L\n" +
+	"\x16CreateDiscountResponse\x122\n" +
+	"\bdiscount\x18\x01 \x01(\v2\x16.discounts.v1.DiscountR\bdiscount\"\xb4\x03\n" +
+	"\x15UpdateDiscountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x12\n" +
+	"\x04kind\x18\x04 \x01(\tR\x04kind\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x03R\x05value\x12\x1d\n" +
+	"\n" +
+	"scope_type\x18\x06 \x01(\tR\tscopeType\x12\x19\n" +
+	"\bscope_id\x18\a \x01(\tR\ascopeId\x12,\n" +
+	"\x12min_subtotal_cents\x18\b \x01(\x03R\x10minSubtotalCents\x12\x1c\n" +
+	"\tstackable\x18\t \x01(\bR\tstackable\x12\x1a\n" +
+	"\bpriority\x18\n" +
+	" \x01(\x05R\bpriority\x12\x19\n" +
+	"\bmax_uses\x18\v \x01(\x05R\amaxUses\x12)\n" +
+	"\x11max_uses_per_user\x18\f \x01(\x05R\x0emaxUsesPerUser\x12\x1b\n" +
+	"\tstarts_at\x18\r \x01(\tR\bstartsAt\x12\x17\n" +
+	"\aends_at\x18\x0e \x01(\tR\x06endsAt\x12\x1b\n" +
+	"\tis_active\x18\x0f \x01(\bR\bisActive\"L\n" +
+	"\x16UpdateDiscountResponse\x122\n" +
+	"\bdiscount\x18\x01 \x01(\v2\x16.discounts.v1.DiscountR\bdiscount\"'\n" +
+	"\x15DeleteDiscountRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x16DeleteDiscountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"N\n" +
+	"\x11DiscountLineInput\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\tR\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\xb6\x01\n" +
+	"\x0fAppliedDiscount\x12\x1f\n" +
+	"\vdiscount_id\x18\x01 \x01(\tR\n" +
+	"discountId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12!\n" +
+	"\famount_cents\x18\x04 \x01(\x03R\vamountCents\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1f\n" +
+	"\vproduct_ids\x18\x06 \x03(\tR\n" +
+	"productIds\"s\n" +
+	"\x10RejectedDiscount\x12\x1f\n" +
+	"\vdiscount_id\x18\x01 \x01(\tR\n" +
+	"discountId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"r\n" +
+	"\x18EvaluateDiscountsRequest\x125\n" +
+	"\x05lines\x18\x01 \x03(\v2\x1f.discounts.v1.DiscountLineInputR\x05lines\x12\x1f\n" +
+	"\vpromo_codes\x18\x02 \x03(\tR\n" +
+	"promoCodes\"\xff\x01\n" +
+	"\x19EvaluateDiscountsResponse\x12%\n" +
+	"\x0esubtotal_cents\x18\x01 \x01(\x03R\rsubtotalCents\x12%\n" +
+	"\x0ediscount_cents\x18\x02 \x01(\x03R\rdiscountCents\x12\x1f\n" +
+	"\vtotal_cents\x18\x03 \x01(\x03R\n" +
+	"totalCents\x127\n" +
+	"\aapplied\x18\x04 \x03(\v2\x1d.discounts.v1.AppliedDiscountR\aapplied\x12:\n" +
+	"\brejected\x18\x05 \x03(\v2\x1e.discounts.v1.RejectedDiscountR\brejected\"`\n" +
+	"\x16RedeemPromoCodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\tR\aorderId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\"3\n" +
+	"\x17RedeemPromoCodeResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"6\n" +
+	"\x19ReleaseRedemptionsRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\tR\aorderId\"8\n" +
+	"\x1aReleaseRedemptionsResponse\x12\x1a\n" +
+	"\breleased\x18\x01 \x01(\x05R\breleased2\xb1\a\n" +
+	"\x0fDiscountService\x12o\n" +
+	"\rListDiscounts\x12\".discounts.v1.ListDiscountsRequest\x1a#.discounts.v1.ListDiscountsResponse\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/v1/discounts\x12n\n" +
+	"\vGetDiscount\x12 .discounts.v1.GetDiscountRequest\x1a!.discounts.v1.GetDiscountResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/discounts/{id}\x12u\n" +
+	"\x0eCreateDiscount\x12#.discounts.v1.CreateDiscountRequest\x1a$.discounts.v1.CreateDiscountResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/discounts\x12z\n" +
+	"\x0eUpdateDiscount\x12#.discounts.v1.UpdateDiscountRequest\x1a$.discounts.v1.UpdateDiscountResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*2\x12/v1/discounts/{id}\x12w\n" +
+	"\x0eDeleteDiscount\x12#.discounts.v1.DeleteDiscountRequest\x1a$.discounts.v1.DeleteDiscountResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/discounts/{id}\x12\x87\x01\n" +
+	"\x11EvaluateDiscounts\x12&.discounts.v1.EvaluateDiscountsRequest\x1a'.discounts.v1.EvaluateDiscountsResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/discounts/evaluate\x12^\n" +
+	"\x0fRedeemPromoCode\x12$.discounts.v1.RedeemPromoCodeRequest\x1a%.discounts.v1.RedeemPromoCodeResponse\x12g\n" +
+	"\x12ReleaseRedemptions\x12'.discounts.v1.ReleaseRedemptionsRequest\x1a(.discounts.v1.ReleaseRedemptionsResponseBOZMgithub.com/KarpovYuri/caraudio-backend/pkg/api/proto/discounts/v1;discountsv1b\x06proto3"
+
+var (
+	file_discounts_v1_discount_service_proto_rawDescOnce sync.Once
+	file_discounts_v1_discount_service_proto_rawDescData []byte
+)
+
+func file_discounts_v1_discount_service_proto_rawDescGZIP() []byte {
+	file_discounts_v1_discount_service_proto_rawDescOnce.Do(func() {
+		file_discounts_v1_discount_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_discounts_v1_discount_service_proto_rawDesc), len(file_discounts_v1_discount_service_proto_rawDesc)))
+	})
+	return file_discounts_v1_discount_service_proto_rawDescData
+}
+
+var file_discounts_v1_discount_service_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_discounts_v1_discount_service_proto_goTypes = []any{
+	(*Discount)(nil),                   // 0: discounts.v1.Discount
+	(*ListDiscountsRequest)(nil),       // 1: discounts.v1.ListDiscountsRequest
+	(*ListDiscountsResponse)(nil),      // 2: discounts.v1.ListDiscountsResponse
+	(*GetDiscountRequest)(nil),         // 3: discounts.v1.GetDiscountRequest
+	(*GetDiscountResponse)(nil),        // 4: discounts.v1.GetDiscountResponse
+	(*CreateDiscountRequest)(nil),      // 5: discounts.v1.CreateDiscountRequest
+	(*CreateDiscountResponse)(nil),     // 6: discounts.v1.CreateDiscountResponse
+	(*UpdateDiscountRequest)(nil),      // 7: discounts.v1.UpdateDiscountRequest
+	(*UpdateDiscountResponse)(nil),     // 8: discounts.v1.UpdateDiscountResponse
+	(*DeleteDiscountRequest)(nil),      // 9: discounts.v1.DeleteDiscountRequest
+	(*DeleteDiscountResponse)(nil),     // 10: discounts.v1.DeleteDiscountResponse
+	(*DiscountLineInput)(nil),          // 11: discounts.v1.DiscountLineInput
+	(*AppliedDiscount)(nil),            // 12: discounts.v1.AppliedDiscount
+	(*RejectedDiscount)(nil),           // 13: discounts.v1.RejectedDiscount
+	(*EvaluateDiscountsRequest)(nil),   // 14: discounts.v1.EvaluateDiscountsRequest
+	(*EvaluateDiscountsResponse)(nil),  // 15: discounts.v1.EvaluateDiscountsResponse
+	(*RedeemPromoCodeRequest)(nil),     // 16: discounts.v1.RedeemPromoCodeRequest
+	(*RedeemPromoCodeResponse)(nil),    // 17: discounts.v1.RedeemPromoCodeResponse
+	(*ReleaseRedemptionsRequest)(nil),  // 18: discounts.v1.ReleaseRedemptionsRequest
+	(*ReleaseRedemptionsResponse)(nil), // 19: discounts.v1.ReleaseRedemptionsResponse
+}
+var file_discounts_v1_discount_service_proto_depIdxs = []int32{
+	0,  // 0: discounts.v1.ListDiscountsResponse.discounts:type_name -> discounts.v1.Discount
+	0,  // 1: discounts.v1.GetDiscountResponse.discount:type_name -> discounts.v1.Discount
+	0,  // 2: discounts.v1.CreateDiscountResponse.discount:type_name -> discounts.v1.Discount
+	0,  // 3: discounts.v1.UpdateDiscountResponse.discount:type_name -> discounts.v1.Discount
+	11, // 4: discounts.v1.EvaluateDiscountsRequest.lines:type_name -> discounts.v1.DiscountLineInput
+	12, // 5: discounts.v1.EvaluateDiscountsResponse.applied:type_name -> discounts.v1.AppliedDiscount
+	13, // 6: discounts.v1.EvaluateDiscountsResponse.rejected:type_name -> discounts.v1.RejectedDiscount
+	1,  // 7: discounts.v1.DiscountService.ListDiscounts:input_type -> discounts.v1.ListDiscountsRequest
+	3,  // 8: discounts.v1.DiscountService.GetDiscount:input_type -> discounts.v1.GetDiscountRequest
+	5,  // 9: discounts.v1.DiscountService.CreateDiscount:input_type -> discounts.v1.CreateDiscountRequest
+	7,  // 10: discounts.v1.DiscountService.UpdateDiscount:input_type -> discounts.v1.UpdateDiscountRequest
+	9,  // 11: discounts.v1.DiscountService.DeleteDiscount:input_type -> discounts.v1.DeleteDiscountRequest
+	14, // 12: discounts.v1.DiscountService.EvaluateDiscounts:input_type -> discounts.v1.EvaluateDiscountsRequest
+	16, // 13: discounts.v1.DiscountService.RedeemPromoCode:input_type -> discounts.v1.RedeemPromoCodeRequest
+	18, // 14: discounts.v1.DiscountService.ReleaseRedemptions:input_type -> discounts.v1.ReleaseRedemptionsRequest
+	2,  // 15: discounts.v1.DiscountService.ListDiscounts:output_type -> discounts.v1.ListDiscountsResponse
+	4,  // 16: discounts.v1.DiscountService.GetDiscount:output_type -> discounts.v1.GetDiscountResponse
+	6,  // 17: discounts.v1.DiscountService.CreateDiscount:output_type -> discounts.v1.CreateDiscountResponse
+	8,  // 18: discounts.v1.DiscountService.UpdateDiscount:output_type -> discounts.v1.UpdateDiscountResponse
+	10, // 19: discounts.v1.DiscountService.DeleteDiscount:output_type -> discounts.v1.DeleteDiscountResponse
+	15, // 20: discounts.v1.DiscountService.EvaluateDiscounts:output_type -> discounts.v1.EvaluateDiscountsResponse
+	17, // 21: discounts.v1.DiscountService.RedeemPromoCode:output_type -> discounts.v1.RedeemPromoCodeResponse
+	19, // 22: discounts.v1.DiscountService.ReleaseRedemptions:output_type -> discounts.v1.ReleaseRedemptionsResponse
+	15, // [15:23] is the sub-list for method output_type
+	7,  // [7:15] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_discounts_v1_discount_service_proto_init() }
+func file_discounts_v1_discount_service_proto_init() {
+	if File_discounts_v1_discount_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_discounts_v1_discount_service_proto_rawDesc), len(file_discounts_v1_discount_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   20,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_discounts_v1_discount_service_proto_goTypes,
+		DependencyIndexes: file_discounts_v1_discount_service_proto_depIdxs,
+		MessageInfos:      file_discounts_v1_discount_service_proto_msgTypes,
+	}.Build()
+	File_discounts_v1_discount_service_proto = out.File
+	file_discounts_v1_discount_service_proto_goTypes = nil
+	file_discounts_v1_discount_service_proto_depIdxs = nil
+}
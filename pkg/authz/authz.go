@@ -0,0 +1,118 @@
+// Package authz authorizes gRPC calls against a per-method policy table.
+// The interceptor authenticates the bearer token once and hands handlers the
+// resulting Principal through the context.
+package authz
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+)
+
+const (
+	RoleAdmin = "admin"
+	// RoleAPIClient marks tokens issued to integrations through the client
+	// credentials grant rather than to a user.
+	RoleAPIClient = "api_client"
+)
+
+// Principal is the authenticated caller.
+type Principal struct {
+	UserID      string
+	Role        string
+	Permissions []string
+}
+
+func PrincipalFromClaims(claims *jwt.Claims) *Principal {
+	return &Principal{
+		UserID:      claims.UserID,
+		Role:        claims.Role,
+		Permissions: claims.Permissions,
+	}
+}
+
+func (p *Principal) HasPermission(permission string) bool {
+	return p != nil && slices.Contains(p.Permissions, permission)
+}
+
+func (p *Principal) IsAPIClient() bool {
+	return p != nil && p.Role == RoleAPIClient
+}
+
+type principalKey struct{}
+
+func NewContext(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// FromContext returns the caller the interceptor authenticated, or false
+// for an anonymous call.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// UserID returns the id of the authenticated user. Anonymous callers get
+// jwt.ErrUnauthorized and API clients, which act only through the
+// permissions their scopes grant, jwt.ErrForbidden.
+func UserID(ctx context.Context) (string, error) {
+	principal, ok := FromContext(ctx)
+	if !ok || principal.UserID == "" {
+		return "", jwt.ErrUnauthorized
+	}
+	if principal.IsAPIClient() {
+		return "", jwt.ErrForbidden
+	}
+	return principal.UserID, nil
+}
+
+// HasPermission reports whether the caller is authenticated and holds
+// permission.
+func HasPermission(ctx context.Context, permission string) bool {
+	principal, _ := FromContext(ctx)
+	return principal.HasPermission(permission)
+}
+
+// Authenticator turns a bearer token into the principal it was issued to.
+type Authenticator interface {
+	Authenticate(ctx context.Context, token string) (*Principal, error)
+}
+
+type AuthenticatorFunc func(ctx context.Context, token string) (*Principal, error)
+
+func (f AuthenticatorFunc) Authenticate(ctx context.Context, token string) (*Principal, error) {
+	return f(ctx, token)
+}
+
+// NewJWTAuthenticator authenticates tokens with the shared verifier.
+func NewJWTAuthenticator(verifier *jwt.Verifier) Authenticator {
+	return AuthenticatorFunc(func(_ context.Context, token string) (*Principal, error) {
+		claims, err := verifier.ParseToken(token)
+		if err != nil {
+			return nil, jwt.ErrUnauthorized
+		}
+		return PrincipalFromClaims(claims), nil
+	})
+}
+
+// BearerToken returns the token from the authorization metadata, with or
+// without the "Bearer " prefix.
+func BearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return ""
+	}
+	value := strings.TrimSpace(values[0])
+	if len(value) > 7 && strings.EqualFold(value[:7], "bearer ") {
+		return strings.TrimSpace(value[7:])
+	}
+	return value
+}
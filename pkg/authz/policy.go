@@ -0,0 +1,115 @@
+package authz
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type access int
+
+const (
+	accessPublic access = iota + 1
+	accessAuthenticated
+	accessAdmin
+	accessPermission
+)
+
+// Rule is what a caller needs to invoke a method.
+type Rule struct {
+	access     access
+	permission string
+}
+
+var (
+	// Public methods are open to anyone. A valid bearer token still
+	// yields a principal; an invalid one is ignored.
+	Public = Rule{access: accessPublic}
+	// Authenticated methods need a valid bearer token issued to a user;
+	// API clients only reach methods their scopes grant.
+	Authenticated = Rule{access: accessAuthenticated}
+	// Admin methods need a token issued to the admin role.
+	Admin = Rule{access: accessAdmin}
+)
+
+// Permission methods need a token granting permission.
+func Permission(permission string) Rule {
+	return Rule{access: accessPermission, permission: permission}
+}
+
+// Policy maps full gRPC method names to their rule.
+type Policy map[string]Rule
+
+// Merge combines the policies of the services sharing one server. A
+// method declared twice is a programming error.
+func Merge(policies ...Policy) Policy {
+	merged := Policy{}
+	for _, policy := range policies {
+		for method, rule := range policy {
+			if _, ok := merged[method]; ok {
+				panic(fmt.Sprintf("authz: method %s declared twice", method))
+			}
+			merged[method] = rule
+		}
+	}
+	return merged
+}
+
+// UnaryServerInterceptor enforces policy on every call. Methods missing
+// from the policy are refused, so a new RPC stays closed until it is
+// declared.
+func UnaryServerInterceptor(authenticator Authenticator, policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		rule, ok := policy[info.FullMethod]
+		if !ok {
+			slog.WarnContext(ctx, "authz: method missing from policy", "method", info.FullMethod)
+			return nil, status.Error(codes.PermissionDenied, "forbidden")
+		}
+
+		var principal *Principal
+		if token := BearerToken(ctx); token != "" {
+			authenticated, err := authenticator.Authenticate(ctx, token)
+			if err == nil {
+				principal = authenticated
+			} else if rule.access != accessPublic {
+				return nil, status.Error(codes.Unauthenticated, "unauthorized")
+			}
+		}
+
+		if err := rule.check(principal); err != nil {
+			return nil, err
+		}
+		if principal != nil {
+			ctx = NewContext(ctx, principal)
+		}
+		return handler(ctx, req)
+	}
+}
+
+func (r Rule) check(principal *Principal) error {
+	if r.access == accessPublic {
+		return nil
+	}
+	if principal == nil || principal.UserID == "" {
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	switch r.access {
+	case accessAuthenticated:
+		if !principal.IsAPIClient() {
+			return nil
+		}
+	case accessAdmin:
+		if principal.Role == RoleAdmin {
+			return nil
+		}
+	case accessPermission:
+		if principal.HasPermission(r.permission) {
+			return nil
+		}
+	}
+	return status.Error(codes.PermissionDenied, "forbidden")
+}
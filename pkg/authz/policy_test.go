@@ -0,0 +1,112 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/KarpovYuri/caraudio-backend/pkg/jwt"
+)
+
+// testAuthenticator knows a fixed set of tokens.
+var testAuthenticator = AuthenticatorFunc(func(_ context.Context, token string) (*Principal, error) {
+	switch token {
+	case "editor":
+		return &Principal{UserID: "editor-1", Role: "content_manager", Permissions: []string{jwt.PermCatalogProductsWrite}}, nil
+	case "customer":
+		return &Principal{UserID: "user-1", Role: "user"}, nil
+	case "admin":
+		return &Principal{UserID: "admin-1", Role: RoleAdmin, Permissions: jwt.AllPermissions}, nil
+	case "integration":
+		return &Principal{UserID: "client-1", Role: RoleAPIClient, Permissions: []string{jwt.PermCatalogProductsWrite}}, nil
+	default:
+		return nil, jwt.ErrUnauthorized
+	}
+})
+
+func withToken(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestUnaryServerInterceptorEnforcesPolicy(t *testing.T) {
+	ctx := context.Background()
+	interceptor := UnaryServerInterceptor(testAuthenticator, Policy{
+		"/test.Service/Public":   Public,
+		"/test.Service/Signedin": Authenticated,
+		"/test.Service/Admin":    Admin,
+		"/test.Service/Products": Permission(jwt.PermCatalogProductsWrite),
+	})
+
+	call := func(method, token string) (string, codes.Code) {
+		resp, err := interceptor(withToken(ctx, token), nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(ctx context.Context, _ any) (any, error) {
+				principal, _ := FromContext(ctx)
+				if principal == nil {
+					return "", nil
+				}
+				return principal.UserID, nil
+			})
+		if err != nil {
+			return "", status.Code(err)
+		}
+		return resp.(string), codes.OK
+	}
+
+	for _, tc := range []struct {
+		method, token string
+		wantUser      string
+		wantCode      codes.Code
+	}{
+		{"/test.Service/Public", "", "", codes.OK},
+		{"/test.Service/Public", "customer", "user-1", codes.OK},
+		{"/test.Service/Public", "garbage", "", codes.OK},
+		{"/test.Service/Signedin", "", "", codes.Unauthenticated},
+		{"/test.Service/Signedin", "garbage", "", codes.Unauthenticated},
+		{"/test.Service/Signedin", "customer", "user-1", codes.OK},
+		{"/test.Service/Admin", "editor", "", codes.PermissionDenied},
+		{"/test.Service/Admin", "admin", "admin-1", codes.OK},
+		{"/test.Service/Products", "customer", "", codes.PermissionDenied},
+		{"/test.Service/Products", "editor", "editor-1", codes.OK},
+		{"/test.Service/Products", "integration", "client-1", codes.OK},
+		{"/test.Service/Signedin", "integration", "", codes.PermissionDenied},
+		{"/test.Service/Undeclared", "editor", "", codes.PermissionDenied},
+	} {
+		user, code := call(tc.method, tc.token)
+		if code != tc.wantCode || user != tc.wantUser {
+			t.Fatalf("%s with %q: expected %v for %q, got %v for %q", tc.method, tc.token, tc.wantCode, tc.wantUser, code, user)
+		}
+	}
+}
+
+func TestUserID(t *testing.T) {
+	ctx := context.Background()
+
+	if _, err := UserID(ctx); !errors.Is(err, jwt.ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized for an anonymous caller, got %v", err)
+	}
+	client := NewContext(ctx, &Principal{UserID: "client-1", Role: RoleAPIClient})
+	if _, err := UserID(client); !errors.Is(err, jwt.ErrForbidden) {
+		t.Fatalf("expected ErrForbidden for an API client, got %v", err)
+	}
+	user := NewContext(ctx, &Principal{UserID: "user-1", Role: "user"})
+	if id, err := UserID(user); err != nil || id != "user-1" {
+		t.Fatalf("expected user-1, got %q, %v", id, err)
+	}
+}
+
+func TestMergeRejectsDuplicateMethods(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected Merge to panic on a method declared twice")
+		}
+	}()
+	Merge(Policy{"/test.Service/A": Public}, Policy{"/test.Service/A": Admin})
+}
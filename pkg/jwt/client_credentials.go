@@ -0,0 +1,75 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+)
+
+const (
+	clientCredentialsGrant = "client_credentials"
+	// clientTokenRenewBefore renews a token this long before it expires so
+	// that it is still valid when the call carrying it arrives.
+	clientTokenRenewBefore = 30 * time.Second
+)
+
+// ClientCredentials authenticates a service to the others as an API client.
+// It exchanges the client id and secret for an access token with the auth
+// service and reuses that token until shortly before it expires. It is a
+// grpc.PerRPCCredentials, meant to be passed with grpc.PerRPCCredentials on
+// the calls that need it.
+type ClientCredentials struct {
+	client       authv1.ApiClientServiceClient
+	clientID     string
+	clientSecret string
+
+	mu        sync.Mutex
+	token     string
+	expiresAt time.Time
+}
+
+func NewClientCredentials(client authv1.ApiClientServiceClient, clientID, clientSecret string) *ClientCredentials {
+	return &ClientCredentials{client: client, clientID: clientID, clientSecret: clientSecret}
+}
+
+func (c *ClientCredentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[string]string, error) {
+	token, err := c.Token(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"authorization": "Bearer " + token}, nil
+}
+
+// RequireTransportSecurity is false because the services talk over the
+// internal network without TLS.
+func (c *ClientCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// Token returns a valid access token, fetching a new one when needed.
+func (c *ClientCredentials) Token(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && time.Now().Before(c.expiresAt) {
+		return c.token, nil
+	}
+	resp, err := c.client.IssueClientToken(ctx, &authv1.IssueClientTokenRequest{
+		GrantType:    clientCredentialsGrant,
+		ClientId:     c.clientID,
+		ClientSecret: c.clientSecret,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to issue client token: %w", err)
+	}
+	if resp.AccessToken == "" {
+		return "", errors.New("auth service returned an empty client token")
+	}
+	c.token = resp.AccessToken
+	c.expiresAt = time.Now().Add(time.Duration(resp.ExpiresIn)*time.Second - clientTokenRenewBefore)
+	return c.token, nil
+}
@@ -0,0 +1,103 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"testing"
+)
+
+func TestJSONWebKeyRoundTrip(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+	edPublic, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate Ed25519 key: %v", err)
+	}
+
+	for _, tc := range []struct {
+		kid     string
+		key     interface{ Equal(crypto.PublicKey) bool }
+		wantAlg string
+	}{
+		{"rsa", &rsaKey.PublicKey, AlgRS256},
+		{"ed", edPublic, AlgEdDSA},
+	} {
+		jwk, err := NewJSONWebKey(tc.kid, tc.key)
+		if err != nil {
+			t.Fatalf("%s: NewJSONWebKey returned error: %v", tc.kid, err)
+		}
+		if jwk.Kid != tc.kid || jwk.Alg != tc.wantAlg || jwk.Use != "sig" {
+			t.Fatalf("%s: unexpected key %+v", tc.kid, jwk)
+		}
+		parsed, err := jwk.PublicKey()
+		if err != nil {
+			t.Fatalf("%s: PublicKey returned error: %v", tc.kid, err)
+		}
+		if !tc.key.Equal(parsed) {
+			t.Fatalf("%s: parsed key differs from the original", tc.kid)
+		}
+	}
+}
+
+func TestJSONWebKeyRejectsInvalidKeys(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		jwk         JSONWebKey
+		unsupported bool
+	}{
+		{name: "bad modulus", jwk: JSONWebKey{Kty: "RSA", N: "!!", E: "AQAB"}},
+		{name: "empty modulus", jwk: JSONWebKey{Kty: "RSA", N: "", E: "AQAB"}},
+		{name: "exponent one", jwk: JSONWebKey{Kty: "RSA", N: "AQAB", E: "AQ"}},
+		{name: "short Ed25519 key", jwk: JSONWebKey{Kty: "OKP", Crv: "Ed25519", X: "AQAB"}},
+		{name: "other curve", jwk: JSONWebKey{Kty: "OKP", Crv: "X25519", X: "AQAB"}, unsupported: true},
+		{name: "EC key", jwk: JSONWebKey{Kty: "EC", Crv: "P-256"}, unsupported: true},
+	} {
+		_, err := tc.jwk.PublicKey()
+		if err == nil {
+			t.Fatalf("%s: expected an error", tc.name)
+		}
+		if errors.Is(err, ErrUnsupportedKey) != tc.unsupported {
+			t.Fatalf("%s: unexpected error %v", tc.name, err)
+		}
+	}
+}
+
+func TestParseKeySetSkipsKeysNotForSigning(t *testing.T) {
+	edPublic, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate Ed25519 key: %v", err)
+	}
+	signing, err := NewJSONWebKey("signing", edPublic)
+	if err != nil {
+		t.Fatalf("NewJSONWebKey returned error: %v", err)
+	}
+	encryption := signing
+	encryption.Kid = "encryption"
+	encryption.Use = "enc"
+
+	keys, err := parseKeySet(JWKS{Keys: []JSONWebKey{
+		signing,
+		encryption,
+		{Kty: "EC", Kid: "ec", Crv: "P-256"},
+	}})
+	if err != nil {
+		t.Fatalf("parseKeySet returned error: %v", err)
+	}
+	if len(keys) != 1 || keys["signing"] == nil {
+		t.Fatalf("expected only the signing key, got %v", keys)
+	}
+
+	if _, err := parseKeySet(JWKS{Keys: []JSONWebKey{encryption}}); err == nil {
+		t.Fatal("expected a set without signing keys to be rejected")
+	}
+	broken := signing
+	broken.X = "!!"
+	if _, err := parseKeySet(JWKS{Keys: []JSONWebKey{signing, broken}}); err == nil {
+		t.Fatal("expected a malformed signing key to be rejected")
+	}
+}
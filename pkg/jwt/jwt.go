@@ -1,27 +1,75 @@
 package jwt
 
 import (
+	"fmt"
+	"slices"
 	"time"
 
 	jwtlib "github.com/golang-jwt/jwt/v5"
 )
 
-const RoleAdmin = "admin"
+const (
+	DefaultIssuer   = "caraudio-auth"
+	DefaultAudience = "caraudio-api"
+	DefaultLeeway   = 30 * time.Second
+)
+
+// DefaultAlgorithms are the signing algorithms the auth service may use.
+var DefaultAlgorithms = []string{AlgRS256, AlgEdDSA}
 
+// Claims is the access token payload issued by the auth service. Subject
+// and UserID always carry the same user id; user_id is kept for clients
+// that read it directly.
 type Claims struct {
-	UserID string `json:"user_id"`
-	Role   string `json:"role"`
+	UserID      string   `json:"user_id"`
+	Role        string   `json:"role"`
+	Permissions []string `json:"permissions,omitempty"`
 	jwtlib.RegisteredClaims
 }
 
-func ParseToken(tokenString, secret string) (*Claims, error) {
-	token, err := jwtlib.ParseWithClaims(
-		tokenString,
-		&Claims{},
-		func(token *jwtlib.Token) (interface{}, error) {
-			return []byte(secret), nil
-		},
-	)
+// ValidationOptions describe what a token must look like to be accepted.
+type ValidationOptions struct {
+	Issuer     string
+	Audience   string
+	Algorithms []string
+	// Leeway tolerates clock skew between the auth service and verifiers.
+	Leeway time.Duration
+	// Revocations, when set, rejects tokens revoked by the auth service.
+	Revocations RevocationChecker
+}
+
+// Verifier checks access tokens issued by the auth service against its
+// published public keys.
+type Verifier struct {
+	keys    KeySource
+	options ValidationOptions
+}
+
+func NewVerifier(keys KeySource, options ValidationOptions) *Verifier {
+	if len(options.Algorithms) == 0 {
+		options.Algorithms = DefaultAlgorithms
+	}
+	if options.Leeway < 0 {
+		options.Leeway = 0
+	}
+	return &Verifier{keys: keys, options: options}
+}
+
+func (v *Verifier) ParseToken(tokenString string) (*Claims, error) {
+	parserOptions := []jwtlib.ParserOption{
+		jwtlib.WithValidMethods(v.options.Algorithms),
+		jwtlib.WithLeeway(v.options.Leeway),
+		jwtlib.WithExpirationRequired(),
+		jwtlib.WithIssuedAt(),
+	}
+	if v.options.Issuer != "" {
+		parserOptions = append(parserOptions, jwtlib.WithIssuer(v.options.Issuer))
+	}
+	if v.options.Audience != "" {
+		parserOptions = append(parserOptions, jwtlib.WithAudience(v.options.Audience))
+	}
+
+	token, err := jwtlib.ParseWithClaims(tokenString, &Claims{}, v.keyFunc, parserOptions...)
 	if err != nil {
 		return nil, err
 	}
@@ -30,35 +78,33 @@ func ParseToken(tokenString, secret string) (*Claims, error) {
 	if !ok || !token.Valid {
 		return nil, jwtlib.ErrTokenInvalidClaims
 	}
+	if claims.Subject == "" || claims.UserID != claims.Subject || claims.ID == "" {
+		return nil, jwtlib.ErrTokenInvalidClaims
+	}
+	if v.options.Revocations != nil && v.options.Revocations.IsRevoked(claims) {
+		return nil, ErrTokenRevoked
+	}
 
 	return claims, nil
 }
 
-func ValidateAdmin(tokenString, secret string) error {
-	if tokenString == "" {
-		return ErrUnauthorized
+func (v *Verifier) keyFunc(token *jwtlib.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" {
+		return nil, fmt.Errorf("%w: missing kid", ErrUnknownKey)
 	}
-
-	claims, err := ParseToken(tokenString, secret)
+	key, err := v.keys.Key(kid)
 	if err != nil {
-		return ErrUnauthorized
+		return nil, err
 	}
-	if claims.Role != RoleAdmin {
-		return ErrForbidden
+	// The key type pins the algorithm, so an RSA key can never be used to
+	// check a token claiming a different algorithm.
+	alg, err := AlgorithmFor(key)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
-
-// Unused but keeps parity with auth token generation for tests.
-func GenerateToken(userID, role, secret string, ttl time.Duration) (string, error) {
-	claims := Claims{
-		UserID: userID,
-		Role:   role,
-		RegisteredClaims: jwtlib.RegisteredClaims{
-			ExpiresAt: jwtlib.NewNumericDate(time.Now().Add(ttl)),
-			IssuedAt:  jwtlib.NewNumericDate(time.Now()),
-		},
+	if token.Method.Alg() != alg || !slices.Contains(v.options.Algorithms, alg) {
+		return nil, fmt.Errorf("unexpected signing method %q for key %q", token.Method.Alg(), kid)
 	}
-	token := jwtlib.NewWithClaims(jwtlib.SigningMethodHS256, claims)
-	return token.SignedString([]byte(secret))
+	return key, nil
 }
@@ -0,0 +1,138 @@
+package jwt
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"errors"
+	"testing"
+	"time"
+
+	jwtlib "github.com/golang-jwt/jwt/v5"
+)
+
+type testKeys struct {
+	edPrivate  ed25519.PrivateKey
+	rsaPrivate *rsa.PrivateKey
+	keySet     *StaticKeySet
+}
+
+func newTestKeys(t *testing.T) *testKeys {
+	t.Helper()
+	edPublic, edPrivate, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("failed to generate Ed25519 key: %v", err)
+	}
+	rsaPrivate, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate RSA key: %v", err)
+	}
+	set := JWKS{}
+	for kid, key := range map[string]crypto.PublicKey{"ed": edPublic, "rsa": &rsaPrivate.PublicKey} {
+		jwk, err := NewJSONWebKey(kid, key)
+		if err != nil {
+			t.Fatalf("NewJSONWebKey returned error: %v", err)
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	keySet, err := NewStaticKeySet(set)
+	if err != nil {
+		t.Fatalf("NewStaticKeySet returned error: %v", err)
+	}
+	return &testKeys{edPrivate: edPrivate, rsaPrivate: rsaPrivate, keySet: keySet}
+}
+
+func testClaims(userID string) *Claims {
+	now := time.Now()
+	return &Claims{
+		UserID: userID,
+		Role:   "user",
+		RegisteredClaims: jwtlib.RegisteredClaims{
+			Subject:   userID,
+			ID:        "token-" + userID,
+			Issuer:    DefaultIssuer,
+			Audience:  jwtlib.ClaimStrings{DefaultAudience},
+			IssuedAt:  jwtlib.NewNumericDate(now.Add(-time.Minute)),
+			ExpiresAt: jwtlib.NewNumericDate(now.Add(time.Minute)),
+		},
+	}
+}
+
+func sign(t *testing.T, method jwtlib.SigningMethod, kid string, key any, claims *Claims) string {
+	t.Helper()
+	token := jwtlib.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func TestVerifierPinsAlgorithmToKey(t *testing.T) {
+	keys := newTestKeys(t)
+	verifier := NewVerifier(keys.keySet, ValidationOptions{Issuer: DefaultIssuer, Audience: DefaultAudience})
+	edPublic := keys.edPrivate.Public().(ed25519.PublicKey)
+
+	for _, tc := range []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"EdDSA", sign(t, jwtlib.SigningMethodEdDSA, "ed", keys.edPrivate, testClaims("user-1")), true},
+		{"RS256", sign(t, jwtlib.SigningMethodRS256, "rsa", keys.rsaPrivate, testClaims("user-1")), true},
+		{"RS256 naming the Ed25519 key", sign(t, jwtlib.SigningMethodRS256, "ed", keys.rsaPrivate, testClaims("user-1")), false},
+		{"EdDSA naming the RSA key", sign(t, jwtlib.SigningMethodEdDSA, "rsa", keys.edPrivate, testClaims("user-1")), false},
+		// The public key used as an HMAC secret must not verify.
+		{"HS256 with the public key", sign(t, jwtlib.SigningMethodHS256, "ed", []byte(edPublic), testClaims("user-1")), false},
+		{"missing kid", sign(t, jwtlib.SigningMethodEdDSA, "", keys.edPrivate, testClaims("user-1")), false},
+		{"unknown kid", sign(t, jwtlib.SigningMethodEdDSA, "retired", keys.edPrivate, testClaims("user-1")), false},
+	} {
+		_, err := verifier.ParseToken(tc.token)
+		if tc.valid && err != nil {
+			t.Fatalf("%s: expected the token to be accepted, got %v", tc.name, err)
+		}
+		if !tc.valid && err == nil {
+			t.Fatalf("%s: expected the token to be rejected", tc.name)
+		}
+	}
+
+	if _, err := verifier.ParseToken(sign(t, jwtlib.SigningMethodEdDSA, "retired", keys.edPrivate, testClaims("user-1"))); !errors.Is(err, ErrUnknownKey) {
+		t.Fatalf("expected ErrUnknownKey, got %v", err)
+	}
+}
+
+func TestVerifierHonoursAllowedAlgorithms(t *testing.T) {
+	keys := newTestKeys(t)
+	verifier := NewVerifier(keys.keySet, ValidationOptions{Algorithms: []string{AlgRS256}})
+
+	if _, err := verifier.ParseToken(sign(t, jwtlib.SigningMethodRS256, "rsa", keys.rsaPrivate, testClaims("user-1"))); err != nil {
+		t.Fatalf("expected RS256 to be accepted, got %v", err)
+	}
+	if _, err := verifier.ParseToken(sign(t, jwtlib.SigningMethodEdDSA, "ed", keys.edPrivate, testClaims("user-1"))); err == nil {
+		t.Fatal("expected EdDSA to be rejected when only RS256 is allowed")
+	}
+}
+
+func TestVerifierValidatesClaims(t *testing.T) {
+	keys := newTestKeys(t)
+	verifier := NewVerifier(keys.keySet, ValidationOptions{Issuer: DefaultIssuer, Audience: DefaultAudience})
+
+	for name, mutate := range map[string]func(*Claims){
+		"wrong issuer":     func(c *Claims) { c.Issuer = "someone-else" },
+		"wrong audience":   func(c *Claims) { c.Audience = jwtlib.ClaimStrings{"other-api"} },
+		"expired":          func(c *Claims) { c.ExpiresAt = jwtlib.NewNumericDate(time.Now().Add(-time.Minute)) },
+		"no expiry":        func(c *Claims) { c.ExpiresAt = nil },
+		"subject mismatch": func(c *Claims) { c.UserID = "user-2" },
+		"no token id":      func(c *Claims) { c.ID = "" },
+	} {
+		claims := testClaims("user-1")
+		mutate(claims)
+		if _, err := verifier.ParseToken(sign(t, jwtlib.SigningMethodEdDSA, "ed", keys.edPrivate, claims)); err == nil {
+			t.Fatalf("%s: expected the token to be rejected", name)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultJWKSCacheTTL = 10 * time.Minute
+	// minJWKSRefreshInterval limits refetches triggered by unknown key ids,
+	// so tokens with garbage kids cannot hammer the auth service.
+	minJWKSRefreshInterval = 30 * time.Second
+	jwksFetchTimeout       = 5 * time.Second
+)
+
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// KeySource resolves the public key a token was signed with by its kid.
+type KeySource interface {
+	Key(kid string) (crypto.PublicKey, error)
+}
+
+// NewKeySource verifies with the JWKS file when it is set and with the JWKS
+// fetched from url otherwise.
+func NewKeySource(file, url string, cacheTTL time.Duration) (KeySource, error) {
+	switch {
+	case file != "":
+		set, err := LoadJWKSFile(file)
+		if err != nil {
+			return nil, err
+		}
+		return NewStaticKeySet(set)
+	case url != "":
+		return NewRemoteKeySet(url, cacheTTL), nil
+	default:
+		return nil, errors.New("either a JWKS file or a JWKS url is required")
+	}
+}
+
+type StaticKeySet struct {
+	keys map[string]crypto.PublicKey
+}
+
+func NewStaticKeySet(set JWKS) (*StaticKeySet, error) {
+	keys, err := parseKeySet(set)
+	if err != nil {
+		return nil, err
+	}
+	return &StaticKeySet{keys: keys}, nil
+}
+
+func (s *StaticKeySet) Key(kid string) (crypto.PublicKey, error) {
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+// RemoteKeySet caches the JWKS published by the auth service. The set is
+// refetched when the cache expires or a token names a kid we have not seen,
+// which is how newly rotated keys are picked up.
+type RemoteKeySet struct {
+	url    string
+	ttl    time.Duration
+	client *http.Client
+
+	mu          sync.Mutex
+	keys        map[string]crypto.PublicKey
+	fetchedAt   time.Time
+	lastAttempt time.Time
+}
+
+func NewRemoteKeySet(url string, ttl time.Duration) *RemoteKeySet {
+	if ttl <= 0 {
+		ttl = DefaultJWKSCacheTTL
+	}
+	return &RemoteKeySet{
+		url:    url,
+		ttl:    ttl,
+		client: &http.Client{Timeout: jwksFetchTimeout},
+	}
+}
+
+func (s *RemoteKeySet) Key(kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	key, known := s.keys[kid]
+	fresh := now.Sub(s.fetchedAt) < s.ttl
+	if known && fresh {
+		return key, nil
+	}
+
+	if s.lastAttempt.IsZero() || now.Sub(s.lastAttempt) >= minJWKSRefreshInterval {
+		s.lastAttempt = now
+		keys, err := s.fetch()
+		if err != nil && s.keys == nil {
+			return nil, err
+		}
+		// On fetch errors keep verifying with the stale set rather than
+		// rejecting every request while the auth service is unreachable.
+		if err == nil {
+			s.keys = keys
+			s.fetchedAt = now
+		}
+	}
+
+	key, known = s.keys[kid]
+	if !known {
+		return nil, ErrUnknownKey
+	}
+	return key, nil
+}
+
+func (s *RemoteKeySet) fetch() (map[string]crypto.PublicKey, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), jwksFetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build JWKS request: %w", err)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch JWKS: unexpected status %d", resp.StatusCode)
+	}
+
+	var set JWKS
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+	return parseKeySet(set)
+}
+
+func parseKeySet(set JWKS) (map[string]crypto.PublicKey, error) {
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if errors.Is(err, ErrUnsupportedKey) {
+			// Third-party sets may publish key types we do not verify
+			// alongside the ones we do.
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		keys[jwk.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("JWKS contains no signing keys")
+	}
+	return keys, nil
+}
@@ -0,0 +1,102 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	DefaultRevocationsRefreshEvery = 15 * time.Second
+	revocationsFetchTimeout        = 3 * time.Second
+)
+
+var ErrTokenRevoked = errors.New("token revoked")
+
+// RevocationList is the set of revocations published by the auth service.
+type RevocationList struct {
+	TokenIDs    map[string]struct{}
+	UserCutoffs map[string]time.Time
+}
+
+// IsRevoked reports whether the token was revoked by its jti or issued
+// before its user's cutoff.
+func (l *RevocationList) IsRevoked(claims *Claims) bool {
+	if l == nil {
+		return false
+	}
+	if _, ok := l.TokenIDs[claims.ID]; ok {
+		return true
+	}
+	cutoff, ok := l.UserCutoffs[claims.Subject]
+	if !ok {
+		return false
+	}
+	return claims.IssuedAt == nil || claims.IssuedAt.Before(cutoff)
+}
+
+type RevocationSource interface {
+	FetchRevocations(ctx context.Context) (*RevocationList, error)
+}
+
+type RevocationChecker interface {
+	IsRevoked(claims *Claims) bool
+}
+
+// CachedRevocations keeps a local copy of the revocation list, which Run
+// refetches every refreshEvery in the background, so a revocation reaches
+// verifiers within that interval without a call to the auth service per
+// request. If the auth service is unreachable the last known list stays in
+// use.
+type CachedRevocations struct {
+	source       RevocationSource
+	refreshEvery time.Duration
+
+	list atomic.Pointer[RevocationList]
+}
+
+func NewCachedRevocations(source RevocationSource, refreshEvery time.Duration) *CachedRevocations {
+	if refreshEvery <= 0 {
+		refreshEvery = DefaultRevocationsRefreshEvery
+	}
+	return &CachedRevocations{source: source, refreshEvery: refreshEvery}
+}
+
+// IsRevoked checks the claims against the last fetched list; it never waits
+// for the auth service.
+func (c *CachedRevocations) IsRevoked(claims *Claims) bool {
+	return c.list.Load().IsRevoked(claims)
+}
+
+// Run fetches the list right away and then every refreshEvery until ctx is
+// done.
+func (c *CachedRevocations) Run(ctx context.Context) {
+	ticker := time.NewTicker(c.refreshEvery)
+	defer ticker.Stop()
+
+	for {
+		if err := c.Refresh(ctx); err != nil {
+			slog.Warn("failed to refresh token revocation list, using cached copy", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh fetches the list once, keeping the current one on failure.
+func (c *CachedRevocations) Refresh(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, revocationsFetchTimeout)
+	defer cancel()
+
+	list, err := c.source.FetchRevocations(ctx)
+	if err != nil {
+		return err
+	}
+	c.list.Store(list)
+	return nil
+}
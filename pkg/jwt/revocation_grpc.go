@@ -0,0 +1,49 @@
+package jwt
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// GRPCRevocationSource pulls the revocation list from the auth service,
+// authenticated as an API client holding PermAuthRevocationsRead.
+type GRPCRevocationSource struct {
+	client      authv1.AuthServiceClient
+	credentials credentials.PerRPCCredentials
+}
+
+func NewGRPCRevocationSource(
+	client authv1.AuthServiceClient,
+	credentials credentials.PerRPCCredentials,
+) *GRPCRevocationSource {
+	return &GRPCRevocationSource{client: client, credentials: credentials}
+}
+
+func (s *GRPCRevocationSource) FetchRevocations(ctx context.Context) (*RevocationList, error) {
+	resp, err := s.client.ListRevocations(ctx, &authv1.ListRevocationsRequest{},
+		grpc.PerRPCCredentials(s.credentials))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revocations: %w", err)
+	}
+
+	list := &RevocationList{
+		TokenIDs:    make(map[string]struct{}, len(resp.TokenIds)),
+		UserCutoffs: make(map[string]time.Time, len(resp.UserCutoffs)),
+	}
+	for _, jti := range resp.TokenIds {
+		list.TokenIDs[jti] = struct{}{}
+	}
+	for _, cutoff := range resp.UserCutoffs {
+		notBefore, err := time.Parse(time.RFC3339, cutoff.NotBefore)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cutoff for user %s: %w", cutoff.UserId, err)
+		}
+		list.UserCutoffs[cutoff.UserId] = notBefore
+	}
+	return list, nil
+}
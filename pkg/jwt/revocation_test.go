@@ -0,0 +1,168 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	jwtlib "github.com/golang-jwt/jwt/v5"
+	"google.golang.org/grpc"
+
+	authv1 "github.com/KarpovYuri/caraudio-backend/pkg/api/proto/auth/v1"
+)
+
+type fakeRevocationSource struct {
+	mu      sync.Mutex
+	list    *RevocationList
+	err     error
+	fetches int
+}
+
+func (s *fakeRevocationSource) FetchRevocations(_ context.Context) (*RevocationList, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fetches++
+	return s.list, s.err
+}
+
+func (s *fakeRevocationSource) set(list *RevocationList, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.list, s.err = list, err
+}
+
+func (s *fakeRevocationSource) fetchCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.fetches
+}
+
+func TestRevocationListIsRevoked(t *testing.T) {
+	cutoff := time.Now().Truncate(time.Second)
+	list := &RevocationList{
+		TokenIDs:    map[string]struct{}{"revoked-jti": {}},
+		UserCutoffs: map[string]time.Time{"demoted": cutoff},
+	}
+	claims := func(jti, subject string, issuedAt time.Time) *Claims {
+		return &Claims{RegisteredClaims: jwtlib.RegisteredClaims{
+			ID:       jti,
+			Subject:  subject,
+			IssuedAt: jwtlib.NewNumericDate(issuedAt),
+		}}
+	}
+
+	for _, tc := range []struct {
+		name    string
+		claims  *Claims
+		revoked bool
+	}{
+		{"revoked jti", claims("revoked-jti", "user-1", cutoff), true},
+		{"issued before cutoff", claims("a", "demoted", cutoff.Add(-time.Second)), true},
+		{"issued at cutoff", claims("b", "demoted", cutoff), false},
+		{"other user", claims("c", "user-1", cutoff.Add(-time.Hour)), false},
+	} {
+		if got := list.IsRevoked(tc.claims); got != tc.revoked {
+			t.Fatalf("%s: expected revoked=%v, got %v", tc.name, tc.revoked, got)
+		}
+	}
+
+	var empty *RevocationList
+	if empty.IsRevoked(claims("revoked-jti", "demoted", cutoff.Add(-time.Hour))) {
+		t.Fatal("expected no revocations before the first fetch")
+	}
+}
+
+func TestCachedRevocationsKeepsLastListOnFailure(t *testing.T) {
+	ctx := context.Background()
+	revoked := &Claims{RegisteredClaims: jwtlib.RegisteredClaims{ID: "jti-1", Subject: "user-1"}}
+	source := &fakeRevocationSource{list: &RevocationList{TokenIDs: map[string]struct{}{"jti-1": {}}}}
+	cache := NewCachedRevocations(source, time.Minute)
+
+	if cache.IsRevoked(revoked) {
+		t.Fatal("expected nothing to be revoked before the first refresh")
+	}
+	if err := cache.Refresh(ctx); err != nil {
+		t.Fatalf("Refresh returned error: %v", err)
+	}
+	if !cache.IsRevoked(revoked) {
+		t.Fatal("expected the fetched revocation to apply")
+	}
+
+	source.set(nil, errors.New("auth service unavailable"))
+	if err := cache.Refresh(ctx); err == nil {
+		t.Fatal("expected the fetch error to be returned")
+	}
+	if !cache.IsRevoked(revoked) {
+		t.Fatal("expected the last known list to stay in use")
+	}
+}
+
+func TestCachedRevocationsRunRefreshesUntilCancelled(t *testing.T) {
+	source := &fakeRevocationSource{list: &RevocationList{}}
+	cache := NewCachedRevocations(source, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		cache.Run(ctx)
+		close(done)
+	}()
+
+	deadline := time.Now().Add(time.Second)
+	for source.fetchCount() < 3 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected repeated refreshes, got %d", source.fetchCount())
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected Run to return once the context is cancelled")
+	}
+}
+
+// listRevocationsClient serves ListRevocations and records the call options;
+// the other AuthServiceClient methods are not expected to be called.
+type listRevocationsClient struct {
+	authv1.AuthServiceClient
+	resp *authv1.ListRevocationsResponse
+	opts []grpc.CallOption
+}
+
+func (c *listRevocationsClient) ListRevocations(
+	_ context.Context,
+	_ *authv1.ListRevocationsRequest,
+	opts ...grpc.CallOption,
+) (*authv1.ListRevocationsResponse, error) {
+	c.opts = opts
+	return c.resp, nil
+}
+
+func TestGRPCRevocationSourceAuthenticatesAsAPIClient(t *testing.T) {
+	client := &listRevocationsClient{resp: &authv1.ListRevocationsResponse{
+		TokenIds:    []string{"jti-1"},
+		UserCutoffs: []*authv1.UserTokenCutoff{{UserId: "user-1", NotBefore: "2026-01-02T03:04:05Z"}},
+	}}
+	credentials := NewClientCredentials(nil, "catalog-service", "secret")
+
+	list, err := NewGRPCRevocationSource(client, credentials).FetchRevocations(context.Background())
+	if err != nil {
+		t.Fatalf("FetchRevocations returned error: %v", err)
+	}
+	if _, ok := list.TokenIDs["jti-1"]; !ok || list.UserCutoffs["user-1"].IsZero() {
+		t.Fatalf("unexpected revocation list %+v", list)
+	}
+
+	if len(client.opts) != 1 {
+		t.Fatalf("expected the client credentials as the only call option, got %v", client.opts)
+	}
+	option, ok := client.opts[0].(grpc.PerRPCCredsCallOption)
+	if !ok || option.Creds != credentials {
+		t.Fatalf("expected the call to carry the client credentials, got %#v", client.opts[0])
+	}
+}
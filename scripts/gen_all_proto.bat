@@ -13,6 +13,27 @@ echo ========================================
 
 call "%~dp0gen_catalog_proto.bat"
 
+echo.
+echo ========================================
+echo Generating Cart Protos
+echo ========================================
+
+call "%~dp0gen_cart_proto.bat"
+
+echo.
+echo ========================================
+echo Generating Orders Protos
+echo ========================================
+
+call "%~dp0gen_orders_proto.bat"
+
+echo.
+echo ========================================
+echo Generating Discounts Protos
+echo ========================================
+
+call "%~dp0gen_discounts_proto.bat"
+
 echo.
 echo ========================================
 echo All proto files generated successfully